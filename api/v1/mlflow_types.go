@@ -20,26 +20,44 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // MLflowSpec defines the desired state of MLflow
 // +kubebuilder:validation:XValidation:rule="has(self.defaultArtifactRoot) || (has(self.serveArtifacts) && self.serveArtifacts)",message="defaultArtifactRoot must be set when serveArtifacts is not true"
 // +kubebuilder:validation:XValidation:rule="!has(self.defaultArtifactRoot) || !self.defaultArtifactRoot.startsWith('file://') || (has(self.serveArtifacts) && self.serveArtifacts)",message="serveArtifacts must be enabled when defaultArtifactRoot uses file-based storage (file:// prefix)"
+// +kubebuilder:validation:XValidation:rule="!has(self.artifactsServer) || !self.artifactsServer.enabled || (has(self.serveArtifacts) && self.serveArtifacts)",message="serveArtifacts must be enabled when artifactsServer is enabled"
 // +kubebuilder:validation:XValidation:rule="(has(self.backendStoreUri) && size(self.backendStoreUri) > 0) || (has(self.backendStoreUriFrom) && size(self.backendStoreUriFrom.name) > 0 && size(self.backendStoreUriFrom.key) > 0)",message="backendStoreUri or backendStoreUriFrom must be set"
+// +kubebuilder:validation:XValidation:rule="!(has(self.version) && has(self.image) && has(self.image.image))",message="version and image.image are mutually exclusive"
 // +kubebuilder:validation:XValidation:rule="!(has(self.backendStoreUri) && has(self.backendStoreUriFrom))",message="backendStoreUri and backendStoreUriFrom are mutually exclusive"
 // +kubebuilder:validation:XValidation:rule="!(has(self.registryStoreUri) && has(self.registryStoreUriFrom))",message="registryStoreUri and registryStoreUriFrom are mutually exclusive"
 // +kubebuilder:validation:XValidation:rule="!has(self.registryStoreUriFrom) || (size(self.registryStoreUriFrom.name) > 0 && size(self.registryStoreUriFrom.key) > 0)",message="registryStoreUriFrom.name and registryStoreUriFrom.key must be non-empty when registryStoreUriFrom is set"
-// +kubebuilder:validation:XValidation:rule="!has(self.backendStoreUri) || self.backendStoreUri.startsWith('sqlite://') || self.backendStoreUri.startsWith('sqlite+') || self.backendStoreUri.startsWith('postgresql://') || self.backendStoreUri.startsWith('postgresql+') || self.backendStoreUri.startsWith('mysql://') || self.backendStoreUri.startsWith('mysql+')",message="backendStoreUri must use a supported SQL metadata store URI scheme"
-// +kubebuilder:validation:XValidation:rule="!has(self.registryStoreUri) || self.registryStoreUri.startsWith('sqlite://') || self.registryStoreUri.startsWith('sqlite+') || self.registryStoreUri.startsWith('postgresql://') || self.registryStoreUri.startsWith('postgresql+') || self.registryStoreUri.startsWith('mysql://') || self.registryStoreUri.startsWith('mysql+')",message="registryStoreUri must use a supported SQL metadata store URI scheme"
+// +kubebuilder:validation:XValidation:rule="!has(self.backendStoreUri) || self.backendStoreUri.matches('^(sqlite|postgresql|mysql)([+][A-Za-z0-9_]+)?://') || self.backendStoreUri.matches('^postgres([+][A-Za-z0-9_]+)?://')",message="backendStoreUri must start with postgresql://, mysql://, or sqlite://, optionally with a driver such as postgresql+psycopg2://"
+// +kubebuilder:validation:XValidation:rule="!has(self.backendStoreUri) || !self.backendStoreUri.matches('^postgres([+][A-Za-z0-9_]+)?://')",message="backendStoreUri uses postgres://, which SQLAlchemy does not accept; use postgresql:// instead"
+// +kubebuilder:validation:XValidation:rule="!has(self.registryStoreUri) || self.registryStoreUri.matches('^(sqlite|postgresql|mysql)([+][A-Za-z0-9_]+)?://') || self.registryStoreUri.matches('^postgres([+][A-Za-z0-9_]+)?://')",message="registryStoreUri must start with postgresql://, mysql://, or sqlite://, optionally with a driver such as postgresql+psycopg2://"
+// +kubebuilder:validation:XValidation:rule="!has(self.registryStoreUri) || !self.registryStoreUri.matches('^postgres([+][A-Za-z0-9_]+)?://')",message="registryStoreUri uses postgres://, which SQLAlchemy does not accept; use postgresql:// instead"
 // +kubebuilder:validation:XValidation:rule="!has(self.backendStoreUri) || (!self.backendStoreUri.startsWith('sqlite://') && !self.backendStoreUri.startsWith('file://')) || has(self.storage)",message="storage must be configured when using file-based backend store (sqlite:// or file:// prefix)"
 // +kubebuilder:validation:XValidation:rule="!has(self.registryStoreUri) || (!self.registryStoreUri.startsWith('sqlite://') && !self.registryStoreUri.startsWith('file://')) || has(self.storage)",message="storage must be configured when using file-based registry store (sqlite:// or file:// prefix)"
+// +kubebuilder:validation:XValidation:rule="!has(self.replicas) || self.replicas <= 1 || ((!has(self.backendStoreUri) || !self.backendStoreUri.matches('^(sqlite([+][A-Za-z0-9_]+)?|file)://')) && (!has(self.registryStoreUri) || !self.registryStoreUri.matches('^(sqlite([+][A-Za-z0-9_]+)?|file)://')))",message="replicas must be 1 with a file-based backend or registry store (sqlite:// or file:// prefix): concurrent writers corrupt the database; use PostgreSQL or MySQL to run more replicas"
+// +kubebuilder:validation:XValidation:rule="!has(self.workers) || self.workers <= 1 || ((!has(self.backendStoreUri) || !self.backendStoreUri.matches('^(sqlite([+][A-Za-z0-9_]+)?|file)://')) && (!has(self.registryStoreUri) || !self.registryStoreUri.matches('^(sqlite([+][A-Za-z0-9_]+)?|file)://')))",message="workers must be 1 with a file-based backend or registry store (sqlite:// or file:// prefix): concurrent writers corrupt the database; use PostgreSQL or MySQL to run more workers"
 // +kubebuilder:validation:XValidation:rule="!has(self.artifactsDestination) || !self.artifactsDestination.startsWith('file://') || has(self.storage)",message="storage must be configured when artifactsDestination uses file-based storage (file:// prefix)"
 // +kubebuilder:validation:XValidation:rule="!has(self.artifactsDestination) || !self.artifactsDestination.startsWith('file://') || (has(self.serveArtifacts) && self.serveArtifacts)",message="serveArtifacts must be enabled when artifactsDestination uses file-based storage (file:// prefix)"
+// +kubebuilder:validation:XValidation:rule="!has(self.server) || !has(self.server.externalUrl) || ((has(self.routing) && self.routing.rootPath) ? self.server.externalUrl.matches('^https?://[^/]+/?$') : self.server.externalUrl.matches('^https?://[^/]+(/.*)?/mlflow/?$'))",message="server.externalUrl must end with the /mlflow path prefix, or have no path when routing.rootPath is set"
 // +kubebuilder:validation:XValidation:rule="!has(self.env) || self.env.all(e, e.name != 'MLFLOW_SERVER_DISABLE_SECURITY_MIDDLEWARE')",message="setting the MLFLOW_SERVER_DISABLE_SECURITY_MIDDLEWARE environment variable is not allowed"
+// +kubebuilder:validation:XValidation:rule="!has(self.artifacts) || !has(self.artifacts.s3) || !has(self.artifacts.s3.endpointURL) || !has(self.env) || self.env.all(e, e.name != 'MLFLOW_S3_ENDPOINT_URL')",message="artifacts.s3.endpointURL and an MLFLOW_S3_ENDPOINT_URL env entry are mutually exclusive"
 // +kubebuilder:validation:XValidation:rule="!has(self.networkPolicyEgressRules) || self.networkPolicyEgressRules.all(r, (has(r.ports) && size(r.ports) > 0) || (has(r.to) && size(r.to) > 0))",message="each networkPolicyEgressRules entry must specify at least one port or one destination"
 // +kubebuilder:validation:XValidation:rule="!has(self.networkPolicyAdditionalEgressRules) || self.networkPolicyAdditionalEgressRules.all(r, (has(r.ports) && size(r.ports) > 0) || (has(r.to) && size(r.to) > 0))",message="each networkPolicyAdditionalEgressRules entry must specify at least one port or one destination"
 // +kubebuilder:validation:XValidation:rule="!has(self.resourceClaims) || self.resourceClaims.all(c, ((has(c.resourceClaimName) && size(c.resourceClaimName) > 0) != (has(c.resourceClaimTemplateName) && size(c.resourceClaimTemplateName) > 0)))",message="each resourceClaims entry must set exactly one non-empty value: resourceClaimName or resourceClaimTemplateName"
+// +kubebuilder:validation:XValidation:rule="!has(self.retention) || has(self.garbageCollection)",message="retention requires garbageCollection, which schedules the cleanup job"
+// +kubebuilder:validation:XValidation:rule="!has(self.retention) || !has(self.retention.deletedRunPurgeAfter) || !has(self.garbageCollection) || !has(self.garbageCollection.olderThan)",message="retention.deletedRunPurgeAfter and garbageCollection.olderThan are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="!has(self.artifactsDestination) || self.artifactsDestination.matches('^(s3|gs|wasbs|hdfs|file)://') || self.artifactsDestination.matches('^(gcs|wasb)://')",message="artifactsDestination must start with s3://, gs://, wasbs://, hdfs://, or file://"
+// +kubebuilder:validation:XValidation:rule="!has(self.artifactsDestination) || !self.artifactsDestination.startsWith('gcs://')",message="artifactsDestination uses gcs://; use gs:// for Google Cloud Storage"
+// +kubebuilder:validation:XValidation:rule="!has(self.artifactsDestination) || !self.artifactsDestination.startsWith('wasb://')",message="artifactsDestination uses wasb://; MLflow only supports Azure Blob Storage over wasbs://"
+// +kubebuilder:validation:XValidation:rule="!has(self.defaultArtifactRoot) || self.defaultArtifactRoot.matches('^(s3|gs|wasbs|hdfs|file)://') || self.defaultArtifactRoot.startsWith('mlflow-artifacts:/') || self.defaultArtifactRoot.matches('^(gcs|wasb)://')",message="defaultArtifactRoot must start with s3://, gs://, wasbs://, hdfs://, file://, or mlflow-artifacts:/"
+// +kubebuilder:validation:XValidation:rule="!has(self.defaultArtifactRoot) || !self.defaultArtifactRoot.startsWith('gcs://')",message="defaultArtifactRoot uses gcs://; use gs:// for Google Cloud Storage"
+// +kubebuilder:validation:XValidation:rule="!has(self.defaultArtifactRoot) || !self.defaultArtifactRoot.startsWith('wasb://')",message="defaultArtifactRoot uses wasb://; MLflow only supports Azure Blob Storage over wasbs://"
 type MLflowSpec struct {
 	// Image specifies the MLflow container image.
 	// If not specified, use the default image
@@ -47,12 +65,52 @@ type MLflowSpec struct {
 	// +optional
 	Image *ImageConfig `json:"image,omitempty"`
 
-	// Replicas is the number of MLflow pods to run
+	// Version selects the MLflow release to run, as "<major>.<minor>" or
+	// "<major>.<minor>.<patch>" (an optional leading "v" is allowed). The operator
+	// resolves it to the image it ships for that release; versions the bundled
+	// chart does not support are rejected with Available=False and reason
+	// UnsupportedVersion. Mutually exclusive with image.image.
+	// +kubebuilder:validation:Pattern=`^v?[0-9]+\.[0-9]+(\.[0-9]+)?$`
+	// +optional
+	Version *string `json:"version,omitempty"`
+
+	// Replicas is the number of MLflow pods to run. It must be 1 with a SQLite backend or
+	// registry store.
 	// +kubebuilder:default=1
 	// +kubebuilder:validation:Minimum=1
 	// +optional
 	Replicas *int32 `json:"replicas,omitempty"`
 
+	// MinReadySeconds is how long a new MLflow pod must stay ready before the
+	// rollout counts it as available and moves on. A warm-up period lets the
+	// Gateway and Service endpoints settle before old pods are removed, avoiding
+	// brief 502 windows during upgrades.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=3600
+	// +optional
+	MinReadySeconds *int32 `json:"minReadySeconds,omitempty"`
+
+	// StartupProbe tunes the startup probe of the MLflow container, which holds
+	// off the liveness probe until /health first succeeds so a slow first boot
+	// against a large backend store is not restarted in a loop.
+	// +optional
+	StartupProbe *StartupProbeConfig `json:"startupProbe,omitempty"`
+
+	// Suspend hibernates the instance without deleting it: the Deployment is
+	// scaled to zero, the garbage-collection CronJob is paused, and migrations
+	// are deferred until the instance is resumed. The PVC, Service, and routing
+	// resources are kept so the instance comes back unchanged when Suspend is
+	// cleared.
+	// +kubebuilder:default=false
+	// +optional
+	Suspend *bool `json:"suspend,omitempty"`
+
+	// Hibernation suspends the instance automatically during recurring time
+	// windows, for example at night and on weekends. While a window is active
+	// the instance behaves as if Suspend were true. Suspend=true always wins.
+	// +optional
+	Hibernation *HibernationConfig `json:"hibernation,omitempty"`
+
 	// Migration controls operator-managed database migration orchestration.
 	// Add the presence-based mlflow.opendatahub.io/force-migrate annotation to
 	// trigger a one-shot rerun; the annotation value is ignored. If a finished
@@ -72,6 +130,10 @@ type MLflowSpec struct {
 	// +optional
 	ServiceAccountName *string `json:"serviceAccountName,omitempty"`
 
+	// ServiceAccount configures the ServiceAccount generated for the MLflow pod.
+	// +optional
+	ServiceAccount *ServiceAccountConfig `json:"serviceAccount,omitempty"`
+
 	// Storage specifies the persistent storage configuration using standard PVC spec.
 	// Only required if using SQLite backend/registry stores or file-based artifacts.
 	// Not needed when using remote storage (S3, PostgreSQL, etc.).
@@ -84,8 +146,11 @@ type MLflowSpec struct {
 	//       requests:
 	//         storage: 10Gi
 	//     storageClassName: fast-ssd
+	// Set existingClaim instead to mount a PVC you already have:
+	//   storage:
+	//     existingClaim: mlflow-data
 	// +optional
-	Storage *corev1.PersistentVolumeClaimSpec `json:"storage,omitempty"`
+	Storage *StorageConfig `json:"storage,omitempty"`
 
 	// BackendStoreURI is the URI for the MLflow backend store (metadata).
 	// Inline backendStoreUri values intentionally support only sqlite:// and
@@ -121,10 +186,14 @@ type MLflowSpec struct {
 	// +optional
 	RegistryStoreURIFrom *corev1.SecretKeySelector `json:"registryStoreUriFrom,omitempty"`
 
+	// Registry configures how the model registry is served.
+	// +optional
+	Registry *RegistryConfig `json:"registry,omitempty"`
+
 	// ArtifactsDestination is the server-side destination for MLflow artifacts (models, plots, files).
 	// This setting only applies when ServeArtifacts is enabled. When ServeArtifacts is disabled,
 	// this field is ignored and clients access artifact storage directly.
-	// Supported schemes: file://, s3://, gs://, wasbs://, hdfs://, etc.
+	// Supported schemes: file://, s3://, gs://, wasbs://, hdfs://.
 	// Examples:
 	//   - "file:///mlflow/artifacts" (requires Storage to be configured)
 	//   - "s3://my-bucket/mlflow/artifacts" (no Storage needed)
@@ -145,7 +214,8 @@ type MLflowSpec struct {
 
 	// DefaultArtifactRoot is the default artifact root path for MLflow runs on the server.
 	// This is required when serveArtifacts is false.
-	// Supported schemes: file://, s3://, gs://, wasbs://, hdfs://, etc.
+	// Supported schemes: file://, s3://, gs://, wasbs://, hdfs://, and mlflow-artifacts:/
+	// for the artifact proxy when serveArtifacts is true.
 	// Examples:
 	//   - "s3://my-bucket/mlflow/artifacts"
 	//   - "gs://my-bucket/mlflow/artifacts"
@@ -162,14 +232,47 @@ type MLflowSpec struct {
 	// +optional
 	ServeArtifacts *bool `json:"serveArtifacts,omitempty"`
 
+	// Server configures request handling limits of the MLflow server.
+	// +optional
+	Server *ServerConfig `json:"server,omitempty"`
+
+	// ArtifactsServer moves artifact uploads and downloads to a dedicated
+	// `mlflow server --artifacts-only` Deployment, so heavy transfers do not starve
+	// metadata requests. Requires serveArtifacts.
+	// +optional
+	ArtifactsServer *ArtifactsServerConfig `json:"artifactsServer,omitempty"`
+
+	// Artifacts configures how MLflow reaches the artifact store.
+	// +optional
+	Artifacts *ArtifactsConfig `json:"artifacts,omitempty"`
+
 	// Workers is the number of uvicorn worker processes for the MLflow server.
 	// Note: This is different from pod replicas. Each pod will run this many worker processes.
-	// Defaults to 1. For high-traffic deployments, consider increasing pod replicas instead.
-	// +kubebuilder:default=1
+	// When unset, the count comes from WorkersPolicy (1 unless the policy is Auto).
+	// For high-traffic deployments, consider increasing pod replicas instead.
+	// It must be 1 with a SQLite backend or registry store.
 	// +kubebuilder:validation:Minimum=1
 	// +optional
 	Workers *int32 `json:"workers,omitempty"`
 
+	// WorkersPolicy selects how the worker count is chosen when Workers is unset.
+	// Fixed runs a single worker. Auto derives the count from the container's CPU
+	// request (or limit, when no request is set) as two workers per core, capped
+	// at 16. An explicit Workers value always takes precedence.
+	// +kubebuilder:validation:Enum=Fixed;Auto
+	// +kubebuilder:default=Fixed
+	// +optional
+	WorkersPolicy WorkersPolicy `json:"workersPolicy,omitempty"`
+
+	// Profile applies vetted sizing defaults for common instance sizes: the
+	// container's resource requests and limits, the worker count, and the
+	// SQLAlchemy connection pool of each worker. Explicit resources entries,
+	// workers, workersPolicy: Auto, and MLFLOW_SQLALCHEMYSTORE_* env vars take
+	// precedence over the profile.
+	// +kubebuilder:validation:Enum=small;medium;large
+	// +optional
+	Profile ResourceProfile `json:"profile,omitempty"`
+
 	// ExtraAllowedOrigins is a list of additional origins to allow for CORS requests.
 	// The operator preconfigures safe defaults including Kubernetes service names,
 	// the data science gateway domain, and localhost.
@@ -179,6 +282,10 @@ type MLflowSpec struct {
 	// +optional
 	ExtraAllowedOrigins []string `json:"extraAllowedOrigins,omitempty"`
 
+	// Workspaces configures MLflow workspaces, which map tracking data to namespaces.
+	// +optional
+	Workspaces *WorkspacesConfig `json:"workspaces,omitempty"`
+
 	// WorkspaceLabelSelector is a label selector used to determine which namespaces are exposed
 	// as MLflow workspaces when using the Kubernetes workspace provider.
 	// +optional
@@ -192,9 +299,17 @@ type MLflowSpec struct {
 	// +optional
 	EnvFrom []corev1.EnvFromSource `json:"envFrom,omitempty"`
 
+	// CommonLabels are added to every object rendered from the MLflow chart, including the
+	// pod template, next to the fixed component=mlflow label. The app and component keys are
+	// reserved for the operator.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="!('app' in self) && !('component' in self)",message="app and component are reserved common labels"
+	// +kubebuilder:validation:XValidation:rule="self.all(key, size(self[key]) <= 63)",message="label values must be 63 characters or less"
+	CommonLabels map[string]string `json:"commonLabels,omitempty"`
+
 	// PodLabels are labels to add only to the MLflow pod, not to other resources.
 	// Use this for pod-specific labels like version, component-specific metadata, etc.
-	// For labels that should be applied to all resources (Service, Deployment, etc.), use commonLabels in values.yaml.
+	// For labels that should be applied to all resources (Service, Deployment, etc.), use propagateLabels.
 	// +optional
 	// +kubebuilder:validation:XValidation:rule="self.all(key, size(self[key]) <= 63)",message="label values must be 63 characters or less"
 	PodLabels map[string]string `json:"podLabels,omitempty"`
@@ -204,6 +319,47 @@ type MLflowSpec struct {
 	// +optional
 	PodAnnotations map[string]string `json:"podAnnotations,omitempty"`
 
+	// PropagateLabels selects labels of this MLflow resource that are copied to the metadata
+	// of every resource the operator manages for it, such as cost-allocation or ownership
+	// labels. Each entry is a label key, or a key prefix ending in "*" such as
+	// "finops.example.com/*". Labels set by the operator itself are never overridden, and pods
+	// are not affected; use podLabels for those. Existing PVCs are not updated, since the
+	// operator never patches them.
+	// +optional
+	// +kubebuilder:validation:MaxItems=64
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=317
+	PropagateLabels []string `json:"propagateLabels,omitempty"`
+
+	// PropagateAnnotations selects annotations of this MLflow resource that are copied to the
+	// metadata of every managed resource, using the same matching rules as propagateLabels.
+	// +optional
+	// +kubebuilder:validation:MaxItems=64
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=317
+	PropagateAnnotations []string `json:"propagateAnnotations,omitempty"`
+
+	// ExtraManifests are additional objects applied and owned alongside the chart output, such
+	// as an ExternalSecret or a ConfigMap the server reads. Each entry holds one or more YAML
+	// documents and is rendered as a chart template with the same values, so expressions such
+	// as {{ .Values.namespace }} or {{ toYaml .Values.commonLabels }} work. Objects are created
+	// in the applications namespace. Only ConfigMaps, Secrets, and ExternalSecrets are accepted;
+	// other kinds and objects that share a kind and name with a chart-rendered object are
+	// rejected. Objects removed from the list are deleted.
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=65536
+	ExtraManifests []string `json:"extraManifests,omitempty"`
+
+	// ValuesFrom reads additional chart values from ConfigMaps and Secrets in the applications
+	// namespace, like valuesFrom on a Flux HelmRelease, so environment-specific overrides can
+	// be kept outside this resource. Later entries override earlier ones, and every value
+	// derived from this spec, including its defaults, overrides them all.
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	ValuesFrom []ValuesReference `json:"valuesFrom,omitempty"`
+
 	// PodSecurityContext specifies the security context for the MLflow pod
 	// +optional
 	PodSecurityContext *corev1.PodSecurityContext `json:"podSecurityContext,omitempty"`
@@ -225,6 +381,101 @@ type MLflowSpec struct {
 	// +optional
 	Affinity *corev1.Affinity `json:"affinity,omitempty"`
 
+	// HighAvailability configures scheduling presets for multi-replica deployments.
+	// +optional
+	HighAvailability *HighAvailabilityConfig `json:"highAvailability,omitempty"`
+
+	// DeepHealthCheck makes the operator probe /health and a one-result
+	// experiment search through the MLflow Service before reporting the
+	// instance Available, so a server that starts but cannot reach its backend
+	// store is reported with reason HealthCheckFailed. The operator must trust
+	// the serving certificate; defaults to true on OpenShift, where the
+	// certificate is signed by the service CA, and false elsewhere.
+	// +optional
+	DeepHealthCheck *bool `json:"deepHealthCheck,omitempty"`
+
+	// ExternalReachabilityCheck periodically requests /health through the
+	// published status.url and reports the result in the ExternallyReachable
+	// condition, catching DNS, certificate, and Gateway problems that checks
+	// against the in-cluster Service cannot see.
+	// +optional
+	ExternalReachabilityCheck *ExternalReachabilityCheckConfig `json:"externalReachabilityCheck,omitempty"`
+
+	// UsageStatistics periodically counts experiments, recent runs, and registered
+	// models through the tracking API and reports them in status.usage and the
+	// operator's metrics, as a capacity and adoption signal.
+	// +optional
+	UsageStatistics *UsageStatisticsConfig `json:"usageStatistics,omitempty"`
+
+	// Bootstrap declares MLflow objects the operator creates through the MLflow REST
+	// API once the server is ready, so every environment starts from the same baseline.
+	// +optional
+	Bootstrap *BootstrapConfig `json:"bootstrap,omitempty"`
+
+	// Fleet delivers the instance to the managed clusters selected by an Open Cluster
+	// Management Placement instead of the local cluster. The operator must run on the OCM
+	// hub; each selected cluster gets its own MLflow instance from the same rendered
+	// manifests.
+	// +optional
+	Fleet *FleetConfig `json:"fleet,omitempty"`
+
+	// UpgradeStrategy configures how changes to the MLflow pod template are rolled out.
+	// +optional
+	UpgradeStrategy *UpgradeStrategy `json:"upgradeStrategy,omitempty"`
+
+	// RevisionHistoryLimit is the number of applied manifest sets kept as revision Secrets in
+	// the applications namespace, newest first. A new revision is recorded whenever the
+	// applied manifests change. 0 disables the history.
+	// +kubebuilder:default=10
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty"`
+
+	// AccessRoles configures the mlflow-viewer, mlflow-editor, and mlflow-admin
+	// ClusterRoles rendered for this instance.
+	// +optional
+	AccessRoles *AccessRolesConfig `json:"accessRoles,omitempty"`
+
+	// ClientCredentials mints programmatic access credentials in every namespace
+	// with an MLflowConfig, for notebooks and pipelines that cannot use a user token.
+	// +optional
+	ClientCredentials *ClientCredentialsConfig `json:"clientCredentials,omitempty"`
+
+	// WorkspaceArtifactCredentials mirrors a platform-managed artifact storage
+	// Secret into every namespace with an MLflowConfig, so team namespaces do
+	// not have to manage raw storage keys themselves.
+	// +optional
+	WorkspaceArtifactCredentials *WorkspaceArtifactCredentialsConfig `json:"workspaceArtifactCredentials,omitempty"`
+
+	// Metrics configures how Prometheus discovers the server metrics endpoint.
+	// +optional
+	Metrics *MetricsConfig `json:"metrics,omitempty"`
+
+	// Alerts configures the default PrometheusRule rendered for the instance.
+	// +optional
+	Alerts *AlertsConfig `json:"alerts,omitempty"`
+
+	// Notifications posts instance state changes, such as the server becoming
+	// unavailable, a database migration failing, or a backup failing, to a webhook.
+	// +optional
+	Notifications *NotificationsConfig `json:"notifications,omitempty"`
+
+	// ServiceMesh enrolls the MLflow pods in an Istio-based service mesh such as
+	// OpenShift Service Mesh, with mutual TLS between the sidecars.
+	// +optional
+	ServiceMesh *ServiceMeshConfig `json:"serviceMesh,omitempty"`
+
+	// SystemMetrics turns on MLflow system metrics logging for runs started from
+	// workspace namespaces, through the mlflow-connection ConfigMap.
+	// +optional
+	SystemMetrics *SystemMetricsConfig `json:"systemMetrics,omitempty"`
+
+	// Tracing exports OpenTelemetry traces of the MLflow server's own requests to
+	// an OTLP endpoint, such as the platform's tracing collector.
+	// +optional
+	Tracing *TracingConfig `json:"tracing,omitempty"`
+
 	// ResourceClaims defines which ResourceClaims must be allocated
 	// and reserved before the Pod is allowed to start. The resources
 	// will be made available to those containers which consume them
@@ -244,6 +495,10 @@ type MLflowSpec struct {
 	// CABundleConfigMap specifies a ConfigMap containing a CA certificate bundle.
 	// The bundle will be mounted into the MLflow container and configured for use
 	// with TLS connections (e.g. PostgreSQL SSL, S3 with custom certificates).
+	// On OpenShift, the ConfigMap may be labeled
+	// config.openshift.io/inject-trusted-cabundle=true to have the cluster trust
+	// bundle injected; the operator waits for the injection and restarts the pods
+	// when the injected bundle changes.
 	// +optional
 	CABundleConfigMap *CABundleConfigMapSpec `json:"caBundleConfigMap,omitempty"`
 
@@ -273,6 +528,355 @@ type MLflowSpec struct {
 	// that have been in the deleted state for a minimum duration.
 	// +optional
 	GarbageCollection *GarbageCollectionSpec `json:"garbageCollection,omitempty"`
+
+	// Retention bounds how long runs are kept. Each garbage collection run first
+	// soft-deletes runs older than MaxRunAge through the tracking server API, then
+	// purges soft-deleted resources older than DeletedRunPurgeAfter. Requires
+	// GarbageCollection, which provides the schedule.
+	// +optional
+	Retention *RetentionSpec `json:"retention,omitempty"`
+
+	// ConsoleLink customizes the OpenShift console application-menu entry for this
+	// MLflow instance. Only applies on clusters where the ConsoleLink API is available.
+	// +optional
+	ConsoleLink *ConsoleLinkConfig `json:"consoleLink,omitempty"`
+
+	// ConsolePlugin deploys the MLflow OpenShift console dynamic plugin, which shows
+	// experiment and run summaries and the health of this instance inside the console.
+	// Only applies on clusters where the ConsolePlugin API is available and the operator
+	// has a plugin image configured.
+	// +optional
+	ConsolePlugin *ConsolePluginConfig `json:"consolePlugin,omitempty"`
+
+	// Routing controls the operator-managed external routing resources
+	// (HTTPRoute and ConsoleLink) for this MLflow instance.
+	// +optional
+	Routing *RoutingConfig `json:"routing,omitempty"`
+
+	// Service configures the Service that fronts the MLflow server.
+	// +optional
+	Service *ServiceConfig `json:"service,omitempty"`
+}
+
+// ServiceConfig configures how the MLflow Service is exposed.
+// +kubebuilder:validation:XValidation:rule="!has(self.loadBalancer) || (has(self.type) && self.type == 'LoadBalancer')",message="loadBalancer can only be set when type is LoadBalancer"
+// +kubebuilder:validation:XValidation:rule="!has(self.sessionAffinityTimeoutSeconds) || (has(self.sessionAffinity) && self.sessionAffinity == 'ClientIP')",message="sessionAffinityTimeoutSeconds can only be set when sessionAffinity is ClientIP"
+type ServiceConfig struct {
+	// Type is the Kubernetes Service type. Use NodePort or LoadBalancer on
+	// bare-metal or edge clusters that expose MLflow directly rather than
+	// through a Gateway. When the type is not ClusterIP, the generated
+	// NetworkPolicy also admits traffic from outside the cluster.
+	// +kubebuilder:validation:Enum=ClusterIP;NodePort;LoadBalancer
+	// +kubebuilder:default=ClusterIP
+	// +optional
+	Type *corev1.ServiceType `json:"type,omitempty"`
+
+	// LoadBalancer configures a Service of type LoadBalancer.
+	// +optional
+	LoadBalancer *LoadBalancerConfig `json:"loadBalancer,omitempty"`
+
+	// SessionAffinity pins each client to one MLflow pod. Set to ClientIP when
+	// running multiple replicas if UI flows such as artifact uploads or long
+	// searches misbehave when consecutive requests land on different pods.
+	// +kubebuilder:validation:Enum=None;ClientIP
+	// +kubebuilder:default=None
+	// +optional
+	SessionAffinity *corev1.ServiceAffinity `json:"sessionAffinity,omitempty"`
+
+	// SessionAffinityTimeoutSeconds is how long a ClientIP affinity lasts after
+	// the client's last request. Defaults to the Kubernetes default of 10800
+	// (3 hours).
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=86400
+	// +optional
+	SessionAffinityTimeoutSeconds *int32 `json:"sessionAffinityTimeoutSeconds,omitempty"`
+}
+
+// LoadBalancerConfig holds options specific to LoadBalancer Services.
+type LoadBalancerConfig struct {
+	// Annotations are added to the Service, typically to select cloud or
+	// MetalLB load balancer behavior. The operator-managed serving-cert
+	// annotation cannot be overridden.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// SourceRanges restricts which client CIDRs may reach the load balancer.
+	// The same ranges limit external ingress in the generated NetworkPolicy.
+	// When empty, all sources are allowed.
+	// +kubebuilder:validation:MaxItems=64
+	// +optional
+	SourceRanges []string `json:"sourceRanges,omitempty"`
+}
+
+// RoutingConfig controls how the operator exposes an MLflow instance outside the cluster.
+// +kubebuilder:validation:XValidation:rule="!has(self.rootPath) || !self.rootPath || (has(self.hostnames) && size(self.hostnames) > 0)",message="rootPath requires hostnames"
+type RoutingConfig struct {
+	// Enabled controls whether the operator creates routing resources for this
+	// instance. Set to false when MLflow is fronted by a user-managed ingress
+	// stack; the operator then skips HTTPRoute and ConsoleLink creation and
+	// removes any it previously created. status.url is cleared while routing
+	// is disabled; status.address still reports the in-cluster Service URL.
+	// +kubebuilder:default=true
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Gateways lists the Gateways the HTTPRoute attaches to, rendered as
+	// parentRefs. Use this when a cluster splits internal and external traffic
+	// across separate Gateways. When empty, the route attaches to the Gateway
+	// found by the operator-wide GATEWAY_SELECTOR and GATEWAY_CLASS_NAME, or to
+	// the GATEWAY_NAME Gateway in the openshift-ingress namespace.
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	Gateways []GatewayReference `json:"gateways,omitempty"`
+
+	// Hostnames restricts the route to these hostnames, rendered as the HTTPRoute
+	// spec.hostnames or the VirtualService hosts. Use this to front MLflow with a
+	// dedicated hostname. status.url uses the first hostname without a wildcard.
+	// When empty, the route matches every hostname of the Gateway listeners.
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:items:MaxLength=253
+	// +kubebuilder:validation:items:Pattern=`^(\*\.)?[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// +optional
+	Hostnames []string `json:"hostnames,omitempty"`
+
+	// RootPath serves MLflow at "/" instead of under the /mlflow path prefix. It
+	// requires hostnames, since a root route on a shared hostname would capture all
+	// of its traffic.
+	// +optional
+	RootPath bool `json:"rootPath,omitempty"`
+
+	// TrafficSplit sets the HTTPRoute weights between the current and the candidate
+	// revision of a Canary rollout by hand, replacing the timed
+	// spec.upgradeStrategy.canary.steps. The candidate is promoted once it receives 100%
+	// of the traffic, after which the route returns to 100/0 on the promoted revision. It
+	// has no effect while no canary rollout is in progress.
+	// +optional
+	TrafficSplit *TrafficSplitConfig `json:"trafficSplit,omitempty"`
+
+	// CustomDomain serves the instance at a branded hostname with its own certificate,
+	// through a Gateway the operator creates in the target namespace for this instance.
+	// The HTTPRoute attaches to it in addition to the shared Gateway, and status.url uses
+	// the custom host. Pointing DNS at the address of that Gateway is left to the admin.
+	// With the Istio VirtualService backend only the host is routed; serving it with the
+	// certificate is left to the Istio Gateway.
+	// +optional
+	CustomDomain *CustomDomainConfig `json:"customDomain,omitempty"`
+}
+
+// CustomDomainConfig names the hostname and certificate of a per-instance custom domain.
+type CustomDomainConfig struct {
+	// Host is the fully qualified hostname MLflow is served at, e.g. mlflow.example.com.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	Host string `json:"host"`
+
+	// TLSSecretRef names a kubernetes.io/tls Secret in the target namespace holding the
+	// certificate and key for host. The Gateway terminates TLS with it.
+	// +kubebuilder:validation:Required
+	TLSSecretRef SecretReference `json:"tlsSecretRef"`
+
+	// GatewayClassName is the class of the custom domain Gateway. Defaults to
+	// GATEWAY_CLASS_NAME, or else to the class of the shared Gateway.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +optional
+	GatewayClassName *string `json:"gatewayClassName,omitempty"`
+}
+
+// SecretReference names a Secret in the target namespace.
+type SecretReference struct {
+	// Name is the name of the Secret.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+}
+
+// TrafficSplitConfig weights gateway traffic between two MLflow revisions.
+type TrafficSplitConfig struct {
+	// CandidateWeight is the percentage of gateway traffic routed to the candidate
+	// revision; the current revision receives the rest.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	CandidateWeight int32 `json:"candidateWeight"`
+}
+
+// GatewayReference identifies a Gateway (and optionally one of its listeners)
+// that the MLflow HTTPRoute attaches to.
+type GatewayReference struct {
+	// Name is the name of the Gateway.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the Gateway.
+	// Defaults to "openshift-ingress".
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+
+	// SectionName restricts the attachment to a single listener on the Gateway.
+	// When unset, the route attaches to all listeners that allow it.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +optional
+	SectionName *string `json:"sectionName,omitempty"`
+}
+
+// SelectedGateway identifies the Gateway the operator selected for the HTTPRoute.
+type SelectedGateway struct {
+	// name is the name of the Gateway.
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+
+	// namespace is the namespace of the Gateway.
+	// +kubebuilder:validation:MaxLength=63
+	Namespace string `json:"namespace"`
+}
+
+// ManagedResource identifies an object the operator applied for an MLflow instance.
+type ManagedResource struct {
+	// apiVersion is the group and version of the object, for example apps/v1.
+	// +kubebuilder:validation:MaxLength=253
+	APIVersion string `json:"apiVersion"`
+
+	// kind is the kind of the object.
+	// +kubebuilder:validation:MaxLength=63
+	Kind string `json:"kind"`
+
+	// namespace is the namespace of the object. It is empty for cluster-scoped objects.
+	// +optional
+	// +kubebuilder:validation:MaxLength=63
+	Namespace string `json:"namespace,omitempty"`
+
+	// name is the name of the object.
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+
+	// uid is the UID of the applied object, so an object deleted and recreated by someone
+	// else can be told apart from the one the operator applied.
+	// +optional
+	// +kubebuilder:validation:MaxLength=36
+	UID string `json:"uid,omitempty"`
+}
+
+// StorageConfig is a standard PVC spec for the operator-created mlflow-pvc, a reference to
+// an existing claim, or an ephemeral emptyDir.
+// +kubebuilder:validation:XValidation:rule="!has(self.ephemeral) || !self.ephemeral || (!has(self.existingClaim) && !has(self.database) && !has(self.artifacts))",message="ephemeral storage cannot be combined with existingClaim, database, or artifacts"
+// +kubebuilder:validation:XValidation:rule="!has(self.ephemeralSizeLimit) || (has(self.ephemeral) && self.ephemeral)",message="ephemeralSizeLimit requires ephemeral: true"
+type StorageConfig struct {
+	corev1.PersistentVolumeClaimSpec `json:",inline"`
+
+	// Ephemeral mounts an emptyDir at /mlflow instead of a PVC, for demo and CI instances.
+	// Everything on it, including a SQLite database, is lost whenever the pod is replaced,
+	// and each replica gets its own copy. The PVC spec fields are ignored when it is set.
+	// +optional
+	Ephemeral bool `json:"ephemeral,omitempty"`
+
+	// EphemeralSizeLimit caps the emptyDir; the pod is evicted when it grows past the limit.
+	// +optional
+	EphemeralSizeLimit *resource.Quantity `json:"ephemeralSizeLimit,omitempty"`
+
+	// ExistingClaim names a PersistentVolumeClaim in the target namespace to mount at /mlflow
+	// instead of creating mlflow-pvc, e.g. the volume of a manual MLflow install. The PVC
+	// spec fields are ignored when it is set.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +optional
+	ExistingClaim string `json:"existingClaim,omitempty"`
+
+	// Database puts the SQLite database on its own volume, mounted at /mlflow/db, e.g. on a
+	// fast SSD class. Point backendStoreUri at it: sqlite:////mlflow/db/mlflow.db.
+	// +optional
+	Database *StorageVolume `json:"database,omitempty"`
+
+	// Artifacts puts file-based artifacts on their own volume, mounted at /mlflow/artifacts,
+	// e.g. on a cheaper, larger class. The default artifactsDestination already points there.
+	// When both database and artifacts are set and existingClaim is not, mlflow-pvc is not
+	// created and nothing is mounted at /mlflow itself.
+	// +optional
+	Artifacts *StorageVolume `json:"artifacts,omitempty"`
+}
+
+// StorageVolume is a standard PVC spec for an operator-created claim, or a reference to an
+// existing claim in the target namespace.
+type StorageVolume struct {
+	corev1.PersistentVolumeClaimSpec `json:",inline"`
+
+	// ExistingClaim names a PersistentVolumeClaim to mount instead of creating one. The PVC
+	// spec fields are ignored when it is set.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +optional
+	ExistingClaim string `json:"existingClaim,omitempty"`
+}
+
+// ServiceAccountConfig customizes the ServiceAccount generated for the MLflow pod.
+type ServiceAccountConfig struct {
+	// Annotations are added to the generated ServiceAccount. Use this for cloud
+	// workload identity, e.g. eks.amazonaws.com/role-arn (EKS IRSA) or
+	// iam.gke.io/gcp-service-account (GKE Workload Identity), so the MLflow
+	// server can reach S3/GCS artifact storage without static credentials.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// ConsoleLinkConfig customizes the OpenShift ConsoleLink created for an MLflow instance.
+type ConsoleLinkConfig struct {
+	// Text is the link text shown in the application menu.
+	// Defaults to "MLflow".
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	// +optional
+	Text *string `json:"text,omitempty"`
+
+	// Section is the application-menu section the link is listed under.
+	// Defaults to the operator-wide SECTION_TITLE (or the MLflowOperator
+	// sectionTitle projection when the module controller is enabled).
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	// +optional
+	Section *string `json:"section,omitempty"`
+
+	// Disable suppresses the ConsoleLink for this instance. When set to true,
+	// any previously created ConsoleLink is removed.
+	// +kubebuilder:default=false
+	// +optional
+	Disable *bool `json:"disable,omitempty"`
+}
+
+// ValuesReference names a ConfigMap or Secret key holding chart values as YAML.
+type ValuesReference struct {
+	// Kind of the object holding the values.
+	// +kubebuilder:validation:Enum=ConfigMap;Secret
+	Kind string `json:"kind"`
+
+	// Name of the ConfigMap or Secret.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+
+	// ValuesKey is the data key holding the values. Defaults to values.yaml.
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[-._a-zA-Z0-9]+$`
+	ValuesKey string `json:"valuesKey,omitempty"`
+
+	// Optional skips the reference while the object or key does not exist, instead of
+	// blocking the reconcile.
+	// +optional
+	Optional bool `json:"optional,omitempty"`
+}
+
+// ConsolePluginConfig configures the OpenShift console dynamic plugin.
+type ConsolePluginConfig struct {
+	// Enabled deploys the plugin and registers its ConsolePlugin. Setting it back to false
+	// removes both.
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
 }
 
 // CABundleConfigMapSpec specifies a ConfigMap containing CA certificates.
@@ -307,17 +911,74 @@ type GarbageCollectionSpec struct {
 	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
 }
 
+// RetentionSpec configures time-based cleanup of MLflow runs. Durations use the
+// same format as GarbageCollectionSpec.OlderThan (e.g., "90d", "7d12h").
+type RetentionSpec struct {
+	// MaxRunAge soft-deletes active runs that started at least this long ago.
+	// Deleted runs can still be restored until they are purged.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=`^(\d+(\.\d+)?d)?(\d+(\.\d+)?h)?(\d+(\.\d+)?m)?(\d+(\.\d+)?s)?$`
+	// +optional
+	MaxRunAge *string `json:"maxRunAge,omitempty"`
+
+	// DeletedRunPurgeAfter permanently removes soft-deleted resources, and their
+	// artifacts, once they have been deleted for at least this long. It replaces
+	// GarbageCollectionSpec.OlderThan, so the two cannot be set together.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=`^(\d+(\.\d+)?d)?(\d+(\.\d+)?h)?(\d+(\.\d+)?m)?(\d+(\.\d+)?s)?$`
+	// +optional
+	DeletedRunPurgeAfter *string `json:"deletedRunPurgeAfter,omitempty"`
+}
+
 // ImageConfig contains container image configuration
 type ImageConfig struct {
 	// Image is the container image (includes tag)
+	// A tag naming an MLflow major version that the bundled chart does not support is
+	// rejected with Available=False and reason IncompatibleVersion.
 	// +optional
 	Image *string `json:"image,omitempty"`
 
+	// Registry replaces the registry of every image the instance runs, including its
+	// migration, garbage-collection and blue-green jobs, e.g. registry.internal:5000 or
+	// registry.internal/mirror. The repository path, tag and digest are kept. It takes
+	// precedence over the operator's IMAGE_REGISTRY_MIRRORS and IMAGE_REGISTRY.
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([a-z0-9.-]*[a-z0-9])?(:[0-9]+)?(/[a-z0-9._-]+)*$`
+	// +optional
+	Registry *string `json:"registry,omitempty"`
+
 	// ImagePullPolicy is the image pull policy.
 	// If not specified, uses Kubernetes defaults (IfNotPresent for most images, Always for :latest tag).
 	// +kubebuilder:validation:Enum=Always;IfNotPresent;Never
 	// +optional
 	ImagePullPolicy *corev1.PullPolicy `json:"imagePullPolicy,omitempty"`
+
+	// ResolveDigest pins the image to its current manifest digest at reconcile
+	// time, so the Deployment references an immutable image even when a moving
+	// tag such as :main or :latest is configured. The operator queries the
+	// registry anonymously and re-resolves at most every few minutes; while the
+	// registry is unreachable the instance reports Available=False with reason
+	// ImageResolutionFailed and existing workloads are left untouched.
+	// +optional
+	ResolveDigest *bool `json:"resolveDigest,omitempty"`
+
+	// DigestPollInterval makes resolveDigest check the tag on this schedule, so a
+	// new digest pushed to a moving tag rolls the Deployment within one interval
+	// instead of on the next reconcile caused by another change. status.image
+	// records the digest that runs. Values below 5m are treated as 5m, and the
+	// field is ignored unless resolveDigest is true.
+	// +optional
+	DigestPollInterval *metav1.Duration `json:"digestPollInterval,omitempty"`
+
+	// ArchitectureAffinity restricts MLflow pods to nodes whose kubernetes.io/arch
+	// the image is published for, when the image manifest does not cover every
+	// common cluster architecture (amd64, arm64, ppc64le, s390x). The operator
+	// inspects the manifest anonymously and skips the affinity when the registry
+	// is unreachable. A nodeSelector or node affinity on kubernetes.io/arch always
+	// takes precedence.
+	// Defaults to true; set false to disable.
+	// +optional
+	ArchitectureAffinity *bool `json:"architectureAffinity,omitempty"`
 }
 
 // MLflowMigrationConfig controls operator-managed database migration behavior.
@@ -343,6 +1004,14 @@ type MLflowMigrationConfig struct {
 	// +kubebuilder:validation:Minimum=3600
 	// +optional
 	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+
+	// CopySQLite copies a SQLite backend store on the PVC into the new backend
+	// store when backendStoreUri or backendStoreUriFrom moves to PostgreSQL. The
+	// MLflow Deployment is scaled to zero while a Job upgrades and copies the data
+	// and verifies the row counts; it only switches to the new backend store once
+	// the copy succeeds, and keeps serving from SQLite otherwise. Defaults to true.
+	// +optional
+	CopySQLite *bool `json:"copySQLite,omitempty"`
 }
 
 // MLflowMigrateMode controls operator-managed database migration behavior.
@@ -355,44 +1024,1081 @@ const (
 	MLflowMigrateAlways MLflowMigrateMode = "Always"
 )
 
-// MLflowAddressStatus holds an addressable endpoint for the managed MLflow deployment.
-type MLflowAddressStatus struct {
-	// url is the in-cluster HTTPS URL for the managed MLflow Service.
+// StartupProbeConfig tunes the MLflow startup probe. The container is restarted when /health
+// has not succeeded within periodSeconds * failureThreshold.
+type StartupProbeConfig struct {
+	// PeriodSeconds is how often the probe runs. Defaults to 10.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=300
 	// +optional
-	// +kubebuilder:validation:MaxLength=2048
-	URL string `json:"url,omitempty"`
-}
+	PeriodSeconds *int32 `json:"periodSeconds,omitempty"`
 
-// MLflowStatus defines the observed state of MLflow.
-type MLflowStatus struct {
-	// conditions represent the current state of the MLflow resource.
-	// Each condition has a unique type and reflects the status of a specific aspect of the resource.
-	//
-	// Standard condition types include:
-	// - "Available": the resource is fully functional
-	// - "Progressing": the resource is being created or updated
-	// - "Degraded": the resource failed to reach or maintain its desired state
-	// - "Migration": the operator-managed migration state for the current observed generation
-	//
-	// The status of each condition is one of True, False, or Unknown.
-	// +listType=map
-	// +listMapKey=type
+	// TimeoutSeconds bounds each probe request. Defaults to 5.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=60
 	// +optional
-	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
 
-	// url is the externally reachable MLflow URL exposed through the data science gateway.
+	// FailureThreshold is the number of failed probes tolerated before the
+	// container is restarted. Defaults to 30 (5 minutes at the default period),
+	// or 180 (30 minutes) when an operator-managed migration was requested for
+	// the current generation, since the first start after a migration can be
+	// much slower.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10000
 	// +optional
-	// +kubebuilder:validation:MaxLength=2048
-	URL string `json:"url,omitempty"`
+	FailureThreshold *int32 `json:"failureThreshold,omitempty"`
+}
 
-	// address holds the internal addressable endpoint for the managed MLflow Service.
+// HibernationConfig configures recurring hibernation windows for an MLflow instance.
+type HibernationConfig struct {
+	// Schedule lists the hibernation windows. The instance is hibernated while
+	// any window is active.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Schedule []HibernationWindow `json:"schedule"`
+
+	// TimeZone is the IANA time zone the window expressions are evaluated in,
+	// e.g. "Europe/Berlin". Defaults to UTC.
+	// +kubebuilder:validation:MinLength=1
 	// +optional
-	Address *MLflowAddressStatus `json:"address,omitempty"`
+	TimeZone *string `json:"timeZone,omitempty"`
+}
 
-	// version records the installed MLflow version.
+// HibernationWindow is a recurring window bounded by two standard 5-field cron
+// expressions (minute hour day-of-month month day-of-week).
+type HibernationWindow struct {
+	// Start is the cron expression at which the instance is hibernated,
+	// e.g. "0 19 * * 1-5" for 19:00 on weekdays.
+	// +kubebuilder:validation:MinLength=9
+	Start string `json:"start"`
+
+	// End is the cron expression at which the instance is woken up,
+	// e.g. "0 7 * * 1-5" for 07:00 on weekdays.
+	// +kubebuilder:validation:MinLength=9
+	End string `json:"end"`
+}
+
+// HighAvailabilityConfig configures scheduling presets for multi-replica deployments.
+type HighAvailabilityConfig struct {
+	// AntiAffinity spreads MLflow replicas across nodes using podAntiAffinity
+	// on the instance's app label. Soft prefers different nodes; Hard requires
+	// them, leaving replicas Pending when too few nodes are schedulable.
+	// Only applies when replicas is greater than 1, and is ignored when
+	// affinity.podAntiAffinity is set explicitly.
+	// +kubebuilder:validation:Enum=Soft;Hard
 	// +optional
-	// +kubebuilder:validation:MaxLength=64
-	Version string `json:"version,omitempty"`
+	AntiAffinity AntiAffinityMode `json:"antiAffinity,omitempty"`
+}
+
+// WorkspacesConfig configures the MLflow workspace provider.
+type WorkspacesConfig struct {
+	// Enabled turns on MLflow workspaces. Single-tenant installs can turn them off to
+	// keep all tracking data in one default workspace. Defaults to true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// StoreURI is the workspace store the server reads workspaces from. Defaults to
+	// "kubernetes://", which exposes namespaces as workspaces.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	StoreURI *string `json:"storeUri,omitempty"`
+
+	// Routes creates an HTTPRoute in each workspace namespace that serves the workspace
+	// at <status.url>/<workspace> and scopes every request to it, and a ReferenceGrant
+	// that lets those routes reach the MLflow Service. Requires Gateway API and routing.
+	// +optional
+	Routes bool `json:"routes,omitempty"`
+}
+
+// AccessRolesConfig configures the per-instance access ClusterRoles.
+type AccessRolesConfig struct {
+	// AggregateToDefaultRoles labels the access roles for aggregation into the
+	// built-in Kubernetes roles: mlflow-viewer into view, mlflow-editor into edit,
+	// and mlflow-admin into admin. Users bound to those roles in a namespace then
+	// get the matching MLflow access in that workspace. Defaults to true.
+	// +optional
+	AggregateToDefaultRoles *bool `json:"aggregateToDefaultRoles,omitempty"`
+
+	// Groups binds groups to the access roles in every namespace with an
+	// MLflowConfig, through the mlflow-viewers, mlflow-editors, and mlflow-admins
+	// RoleBindings. Bindings name the group rather than its members, so access
+	// follows the group membership kept by the identity provider, e.g. OpenShift
+	// groups synced from LDAP. Removing a group, or this field, updates or deletes
+	// the bindings.
+	// +optional
+	Groups *AccessRoleGroups `json:"groups,omitempty"`
+}
+
+// AccessRoleGroups lists the groups bound to each access role.
+type AccessRoleGroups struct {
+	// Viewers are bound to mlflow-viewer.
+	// +optional
+	// +kubebuilder:validation:MaxItems=64
+	// +kubebuilder:validation:items:MinLength=1
+	Viewers []string `json:"viewers,omitempty"`
+
+	// Editors are bound to mlflow-editor.
+	// +optional
+	// +kubebuilder:validation:MaxItems=64
+	// +kubebuilder:validation:items:MinLength=1
+	Editors []string `json:"editors,omitempty"`
+
+	// Admins are bound to mlflow-admin.
+	// +optional
+	// +kubebuilder:validation:MaxItems=64
+	// +kubebuilder:validation:items:MinLength=1
+	Admins []string `json:"admins,omitempty"`
+}
+
+// ClientCredentialsConfig configures per-workspace client credentials.
+type ClientCredentialsConfig struct {
+	// Enabled creates an mlflow-client ServiceAccount, a long-lived token in the
+	// mlflow-client-token Secret, and a RoleBinding to the mlflow-integration
+	// ClusterRole in each workspace namespace. Disabling it deletes them.
+	// +kubebuilder:validation:Required
+	Enabled bool `json:"enabled"`
+}
+
+// WorkspaceArtifactCredentialsConfig configures mirroring of the artifact storage Secret.
+type WorkspaceArtifactCredentialsConfig struct {
+	// SecretName is the source Secret in the applications namespace. Its type
+	// and data are copied into the mlflow-artifact-connection Secret of each
+	// workspace and kept in sync. Workspaces that already have their own
+	// mlflow-artifact-connection Secret keep it. Removing this field deletes
+	// the mirrored copies.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	SecretName string `json:"secretName"`
+}
+
+// ArtifactsServerConfig configures the dedicated artifact-serving Deployment.
+type ArtifactsServerConfig struct {
+	// Enabled runs the mlflow-artifacts Deployment and routes the artifact API
+	// (/api/2.0/mlflow-artifacts) to it. The main server keeps serving artifacts for
+	// in-cluster clients that use its Service directly.
+	// +kubebuilder:validation:Required
+	Enabled bool `json:"enabled"`
+
+	// Replicas is the number of artifact server replicas.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default=1
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// Resources are the compute resources of the artifact server container.
+	// Defaults to spec.resources.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// ArtifactsConfig configures the client side of the artifact store connection.
+type ArtifactsConfig struct {
+	// S3 configures access to S3-compatible artifact stores.
+	// +optional
+	S3 *S3ArtifactsConfig `json:"s3,omitempty"`
+}
+
+// S3ArtifactsConfig configures access to S3-compatible artifact stores.
+type S3ArtifactsConfig struct {
+	// EndpointURL is the S3 API endpoint of an S3-compatible store such as MinIO or
+	// Ceph RGW, e.g. https://minio.minio.svc:9000 (MLFLOW_S3_ENDPOINT_URL). It is only
+	// rendered when artifactsDestination or defaultArtifactRoot uses s3://.
+	// +kubebuilder:validation:Pattern=`^https?://[^\s]+$`
+	// +kubebuilder:validation:MaxLength=2048
+	// +optional
+	EndpointURL *string `json:"endpointURL,omitempty"`
+
+	// PathStyle addresses buckets as <endpoint>/<bucket> instead of
+	// <bucket>.<endpoint> (MLFLOW_BOTO_CLIENT_ADDRESSING_STYLE=path), which
+	// S3-compatible stores without wildcard DNS need.
+	// +kubebuilder:default=false
+	// +optional
+	PathStyle *bool `json:"pathStyle,omitempty"`
+
+	// InsecureSkipTLSVerify disables TLS certificate verification for the S3
+	// endpoint (MLFLOW_S3_IGNORE_TLS), for lab MinIO instances with self-signed
+	// certificates. Prefer caBundleConfigMap, which keeps verification on. The
+	// operator sets the S3TLSVerificationDisabled warning condition when this is
+	// enabled in a namespace labeled environment=production or environment=prod.
+	// +kubebuilder:default=false
+	// +optional
+	InsecureSkipTLSVerify *bool `json:"insecureSkipTLSVerify,omitempty"`
+}
+
+// ServerConfig configures the MLflow server process.
+type ServerConfig struct {
+	// Limits bounds how long requests and idle connections may take.
+	// +optional
+	Limits *ServerLimits `json:"limits,omitempty"`
+
+	// Logging configures the tracking server's log output.
+	// +optional
+	Logging *ServerLogging `json:"logging,omitempty"`
+
+	// RateLimit limits the requests each client may send through the operator-managed
+	// HTTPRoute, so a runaway logging loop cannot overload the backend store. It is
+	// enforced with a Kuadrant RateLimitPolicy and requires Kuadrant (Red Hat
+	// Connectivity Link) on the cluster; the RateLimitNotEnforced condition reports
+	// when it cannot be applied. Clients that call the MLflow Service directly are
+	// not limited.
+	// +optional
+	RateLimit *ServerRateLimit `json:"rateLimit,omitempty"`
+
+	// ExternalURL is the URL users reach MLflow at through a reverse proxy the
+	// operator does not manage, for example https://ml.example.com/mlflow. It is
+	// reported as status.url, used for the ConsoleLink and the mlflow-connection
+	// ConfigMap, and added to the allowed CORS origins. The proxy must forward the
+	// path unchanged, so it ends with the /mlflow prefix the server runs under, or
+	// has no path with spec.routing.rootPath.
+	// +kubebuilder:validation:Pattern=`^https?://[^\s/]+(/[^\s]*)?$`
+	// +kubebuilder:validation:MaxLength=2048
+	// +optional
+	ExternalURL *string `json:"externalUrl,omitempty"`
+
+	// ProxyHeaders configures which X-Forwarded-For and X-Forwarded-Proto headers
+	// uvicorn trusts, so client addresses and redirect schemes are taken from the
+	// proxy in front of MLflow.
+	// +optional
+	ProxyHeaders *ServerProxyHeaders `json:"proxyHeaders,omitempty"`
+}
+
+// ServerProxyHeaders configures uvicorn's handling of proxy headers.
+// +kubebuilder:validation:XValidation:rule="!has(self.forwardedAllowIPs) || !has(self.enabled) || self.enabled",message="forwardedAllowIPs requires enabled: true"
+type ServerProxyHeaders struct {
+	// Enabled passes --proxy-headers to uvicorn. Defaults to true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// ForwardedAllowIPs are the proxy addresses or CIDRs whose forwarded headers are
+	// trusted (--forwarded-allow-ips), or "*" for any peer. Uvicorn defaults to
+	// 127.0.0.1, so headers set by a proxy outside the pod are ignored.
+	// +kubebuilder:validation:MaxItems=32
+	// +kubebuilder:validation:items:Pattern=`^(\*|[0-9a-fA-F.:]+(/[0-9]{1,3})?)$`
+	// +kubebuilder:validation:items:MaxLength=64
+	// +listType=set
+	// +optional
+	ForwardedAllowIPs []string `json:"forwardedAllowIPs,omitempty"`
+}
+
+// ServerRateLimit configures per-client request rate limiting.
+// +kubebuilder:validation:XValidation:rule="!has(self.burst) || self.burst >= self.requestsPerSecond",message="burst must not be less than requestsPerSecond"
+type ServerRateLimit struct {
+	// RequestsPerSecond is the sustained request rate allowed per client address.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Required
+	RequestsPerSecond int32 `json:"requestsPerSecond"`
+
+	// Burst is the number of requests a client may send at once before being
+	// limited to requestsPerSecond. Defaults to requestsPerSecond.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Burst *int32 `json:"burst,omitempty"`
+}
+
+// ServerLogging configures MLflow and uvicorn logging.
+type ServerLogging struct {
+	// Level is the minimum level logged by MLflow and uvicorn. Defaults to info.
+	// +kubebuilder:validation:Enum=debug;info;warning;error;critical
+	// +optional
+	Level ServerLogLevel `json:"level,omitempty"`
+
+	// Format selects plain text or one JSON object per line. JSON records carry
+	// time, level, logger, message, and exception fields for log pipelines.
+	// +kubebuilder:validation:Enum=text;json
+	// +kubebuilder:default=text
+	// +optional
+	Format ServerLogFormat `json:"format,omitempty"`
+
+	// AccessLog controls uvicorn's per-request access log.
+	// +kubebuilder:default=true
+	// +optional
+	AccessLog *bool `json:"accessLog,omitempty"`
+
+	// Collection labels and annotates the MLflow pods for cluster log collectors
+	// such as OpenShift Logging, so their logs can be routed to a dedicated
+	// stream without per-cluster selector rules.
+	// +optional
+	Collection *LogCollectionConfig `json:"collection,omitempty"`
+}
+
+// LogCollectionConfig configures log collection hints on the MLflow pods.
+type LogCollectionConfig struct {
+	// Enabled adds the mlflow.opendatahub.io/log-stream pod label, for
+	// ClusterLogForwarder input selectors, and the
+	// containerType.logging.openshift.io/mlflow annotation that names the
+	// structured stream of the MLflow container. When format is json, the
+	// fluentbit.io/parser: json hint is added too.
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Stream is the stream name written to the label and annotation. Defaults to mlflow.
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$`
+	// +optional
+	Stream string `json:"stream,omitempty"`
+}
+
+// ServerLogLevel is a tracking server log level: debug, info, warning, error, or critical.
+type ServerLogLevel string
+
+// ServerLogFormat is a tracking server log format.
+type ServerLogFormat string
+
+const (
+	// ServerLogFormatText keeps the default MLflow and uvicorn formatters.
+	ServerLogFormatText ServerLogFormat = "text"
+	// ServerLogFormatJSON writes one JSON object per log record.
+	ServerLogFormatJSON ServerLogFormat = "json"
+)
+
+// ServerLimits configures request and connection timeouts.
+type ServerLimits struct {
+	// RequestTimeoutSeconds is the time the operator-managed route allows for a whole
+	// request, including large artifact uploads. Requests that exceed it fail with a
+	// 504 from the gateway. Defaults to the gateway implementation's timeout.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	RequestTimeoutSeconds *int32 `json:"requestTimeoutSeconds,omitempty"`
+
+	// KeepAliveTimeoutSeconds is how long uvicorn keeps idle connections open
+	// (--timeout-keep-alive). Keep it above the gateway's idle timeout so the gateway
+	// does not reuse connections the server has already closed. Defaults to uvicorn's 5 seconds.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	KeepAliveTimeoutSeconds *int32 `json:"keepAliveTimeoutSeconds,omitempty"`
+}
+
+// RegistryConfig configures the model registry server.
+type RegistryConfig struct {
+	// SeparateDeployment runs the model registry API on its own mlflow-registry
+	// Deployment and Service, so registry availability does not depend on experiment
+	// logging load. Set registryStoreUri or registryStoreUriFrom to also give the
+	// registry its own database.
+	// +kubebuilder:default=false
+	// +optional
+	SeparateDeployment bool `json:"separateDeployment,omitempty"`
+
+	// Replicas is the number of registry server replicas.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default=1
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// Resources are the compute resources of the registry server container.
+	// Defaults to spec.resources.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// MetricsConfig configures Prometheus scraping of the MLflow server.
+type MetricsConfig struct {
+	// PodMonitor scrapes the server pods through a PodMonitor instead of a ServiceMonitor.
+	// +optional
+	PodMonitor *PodMonitorConfig `json:"podMonitor,omitempty"`
+}
+
+// PodMonitorConfig configures the PodMonitor alternative to the default ServiceMonitor.
+type PodMonitorConfig struct {
+	// Enabled renders a PodMonitor and removes the ServiceMonitor, for monitoring stacks
+	// that only consume PodMonitors. Ignored when the PodMonitor CRD is not installed.
+	// +kubebuilder:validation:Required
+	Enabled bool `json:"enabled"`
+}
+
+// AlertsConfig configures the default MLflow alerting rules.
+type AlertsConfig struct {
+	// Enabled renders the mlflow-alerts PrometheusRule. Alerts are on by default
+	// whenever the PrometheusRule CRD is installed.
+	// +kubebuilder:default=true
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// NotificationsConfig configures webhook notifications for state transitions.
+type NotificationsConfig struct {
+	// WebhookURLFrom selects the Secret key, in the operator's applications
+	// namespace, that holds the webhook URL. Chat webhook URLs embed their
+	// credentials, so the URL is never stored in the CR.
+	// +kubebuilder:validation:Required
+	WebhookURLFrom corev1.SecretKeySelector `json:"webhookUrlFrom"`
+
+	// Format selects the request body: Generic posts a JSON event object, and
+	// Slack posts a {"text": ...} message accepted by Slack-compatible incoming
+	// webhooks.
+	// +kubebuilder:validation:Enum=Generic;Slack
+	// +kubebuilder:default=Generic
+	// +optional
+	Format *string `json:"format,omitempty"`
+}
+
+// ExternalReachabilityCheckConfig configures the check of the published URL.
+type ExternalReachabilityCheckConfig struct {
+	// Enabled turns the check on. The certificate served at status.url must be
+	// trusted by the system roots of the operator image.
+	// +kubebuilder:validation:Required
+	Enabled bool `json:"enabled"`
+
+	// Interval is the time between checks. Defaults to 5m.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+}
+
+// UsageStatisticsConfig configures usage statistics collection.
+type UsageStatisticsConfig struct {
+	// Enabled turns collection on.
+	// +kubebuilder:validation:Required
+	Enabled bool `json:"enabled"`
+
+	// Interval is the time between two collections. Every collection searches
+	// each workspace, so large clusters should keep it long. Defaults to 1h, and
+	// intervals under 5m are raised to 5m.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+}
+
+// FleetConfig selects the managed clusters that receive the instance.
+type FleetConfig struct {
+	// PlacementRef names the Placement on the hub whose decisions list the managed clusters.
+	// +kubebuilder:validation:Required
+	PlacementRef FleetPlacementReference `json:"placementRef"`
+}
+
+// FleetPlacementReference identifies an Open Cluster Management Placement.
+type FleetPlacementReference struct {
+	// Name is the name of the Placement.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+
+	// Namespace is the hub namespace of the Placement, bound to the ManagedClusterSets it
+	// selects from.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Namespace string `json:"namespace"`
+}
+
+// BootstrapConfig lists the MLflow objects to create once the server is ready.
+type BootstrapConfig struct {
+	// Experiments are created when missing and restored when deleted. Tags listed
+	// here are set on every bootstrap; other tags on the experiment are kept.
+	// +kubebuilder:validation:MaxItems=100
+	// +optional
+	Experiments []BootstrapExperiment `json:"experiments,omitempty"`
+
+	// RegisteredModels are created in the model registry when missing. Listed tags
+	// and aliases are set on every bootstrap; others on the model are kept.
+	// +kubebuilder:validation:MaxItems=100
+	// +optional
+	RegisteredModels []BootstrapRegisteredModel `json:"registeredModels,omitempty"`
+}
+
+// BootstrapExperiment is an MLflow experiment the operator ensures exists.
+type BootstrapExperiment struct {
+	// Name is the experiment name.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Name string `json:"name"`
+
+	// Workspace is the namespace the experiment belongs to when workspaces are
+	// enabled. Required then, and ignored otherwise.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +optional
+	Workspace *string `json:"workspace,omitempty"`
+
+	// ArtifactLocation overrides the artifact root of the experiment. It is only
+	// used when the experiment is created, since MLflow cannot move an experiment's
+	// artifacts afterwards.
+	// +kubebuilder:validation:MaxLength=2048
+	// +optional
+	ArtifactLocation *string `json:"artifactLocation,omitempty"`
+
+	// Tags are set on the experiment.
+	// +kubebuilder:validation:MaxProperties=50
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// BootstrapRegisteredModel is a registered model the operator ensures exists.
+type BootstrapRegisteredModel struct {
+	// Name is the registered model name.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Name string `json:"name"`
+
+	// Workspace is the namespace the model belongs to when workspaces are enabled.
+	// Required then, and ignored otherwise.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +optional
+	Workspace *string `json:"workspace,omitempty"`
+
+	// Tags are set on the registered model.
+	// +kubebuilder:validation:MaxProperties=50
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// Aliases point alias names, such as staging or production, at model version
+	// numbers. An alias is set once its version is registered; until then the
+	// Bootstrapped condition reports it as pending.
+	// +kubebuilder:validation:MaxProperties=20
+	// +kubebuilder:validation:XValidation:rule="self.all(k, k != 'latest' && !k.matches('^[vV][0-9]+$'))",message="aliases cannot be latest or v<number>, which MLflow reserves"
+	// +kubebuilder:validation:XValidation:rule="self.all(k, self[k] >= 1)",message="alias versions start at 1"
+	// +optional
+	Aliases map[string]int64 `json:"aliases,omitempty"`
+}
+
+// ServiceMeshConfig configures service mesh participation for the MLflow server.
+type ServiceMeshConfig struct {
+	// Enabled injects the mesh sidecar into the server pods and renders a
+	// PeerAuthentication and DestinationRule for each server Service. MLflow keeps
+	// terminating its own TLS, which the sidecars carry inside mutual TLS. Ignored
+	// when the Istio security APIs are not installed.
+	// +kubebuilder:validation:Required
+	Enabled bool `json:"enabled"`
+
+	// MTLSMode is the PeerAuthentication mode for the server pods. STRICT rejects
+	// clients outside the mesh, including Prometheus and the operator's deep health
+	// check; PERMISSIVE also accepts them.
+	// +kubebuilder:validation:Enum=STRICT;PERMISSIVE
+	// +kubebuilder:default=STRICT
+	// +optional
+	MTLSMode *string `json:"mtlsMode,omitempty"`
+}
+
+// SystemMetricsConfig configures MLflow system metrics logging in training workloads.
+// The MLflow client samples the metrics, so training images need psutil for CPU,
+// memory, disk, and network metrics, plus pynvml for NVIDIA GPU metrics; the
+// tracking server image is unaffected.
+// +kubebuilder:validation:XValidation:rule="self.enabled || (!has(self.samplingIntervalSeconds) && !has(self.samplesBeforeLogging))",message="samplingIntervalSeconds and samplesBeforeLogging require enabled: true"
+type SystemMetricsConfig struct {
+	// Enabled publishes MLFLOW_ENABLE_SYSTEM_METRICS_LOGGING=true, so every run
+	// logs CPU, GPU, and memory series without code changes.
+	// +kubebuilder:validation:Required
+	Enabled bool `json:"enabled"`
+
+	// SamplingIntervalSeconds is the time between two samples
+	// (MLFLOW_SYSTEM_METRICS_SAMPLING_INTERVAL). MLflow defaults to 10 seconds.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=3600
+	// +optional
+	SamplingIntervalSeconds *int32 `json:"samplingIntervalSeconds,omitempty"`
+
+	// SamplesBeforeLogging is the number of samples aggregated into one logged
+	// value (MLFLOW_SYSTEM_METRICS_SAMPLES_BEFORE_LOGGING). MLflow defaults to 1.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1000
+	// +optional
+	SamplesBeforeLogging *int32 `json:"samplesBeforeLogging,omitempty"`
+}
+
+// TracingConfig configures OpenTelemetry trace export from the MLflow server. The
+// operator sets the standard OTEL_* variables, so the MLflow image needs the
+// OpenTelemetry Python SDK and instrumentation to emit the traces.
+// +kubebuilder:validation:XValidation:rule="!self.enabled || has(self.otlpEndpoint)",message="otlpEndpoint is required when tracing is enabled"
+type TracingConfig struct {
+	// Enabled turns on trace export.
+	// +kubebuilder:validation:Required
+	Enabled bool `json:"enabled"`
+
+	// OTLPEndpoint is the URL traces are sent to, for example
+	// http://otel-collector.observability.svc:4317. An http:// URL is sent in
+	// plain text.
+	// +kubebuilder:validation:Pattern=`^https?://[^\s/]+(/[^\s]*)?$`
+	// +kubebuilder:validation:MaxLength=2048
+	// +optional
+	OTLPEndpoint *string `json:"otlpEndpoint,omitempty"`
+
+	// Protocol is the OTLP transport of the endpoint.
+	// +kubebuilder:validation:Enum=grpc;http/protobuf
+	// +kubebuilder:default=grpc
+	// +optional
+	Protocol *string `json:"protocol,omitempty"`
+
+	// Sampler selects which requests are traced. By default every request is,
+	// unless the caller's trace context says otherwise.
+	// +optional
+	Sampler *TracingSamplerConfig `json:"sampler,omitempty"`
+
+	// Collector runs an OpenTelemetry Collector sidecar that receives the traces
+	// on localhost, batches them, and forwards them to the endpoint, so slow or
+	// unavailable backends do not hold up MLflow requests.
+	// +optional
+	Collector *TracingCollectorConfig `json:"collector,omitempty"`
+}
+
+// TracingSamplerConfig configures the OpenTelemetry trace sampler.
+// +kubebuilder:validation:XValidation:rule="!has(self.ratio) || self.type.endsWith('traceidratio')",message="ratio requires a traceidratio sampler"
+type TracingSamplerConfig struct {
+	// Type is the sampler (OTEL_TRACES_SAMPLER).
+	// +kubebuilder:validation:Enum=always_on;always_off;traceidratio;parentbased_always_on;parentbased_always_off;parentbased_traceidratio
+	// +kubebuilder:validation:Required
+	Type string `json:"type"`
+
+	// Ratio is the fraction of traces the traceidratio samplers keep
+	// (OTEL_TRACES_SAMPLER_ARG), from "0" to "1". The SDK defaults to "1".
+	// +kubebuilder:validation:Pattern=`^(0(\.[0-9]+)?|1(\.0+)?)$`
+	// +optional
+	Ratio *string `json:"ratio,omitempty"`
+}
+
+// TracingCollectorConfig configures the OpenTelemetry Collector sidecar.
+type TracingCollectorConfig struct {
+	// Image is the OpenTelemetry Collector image. Its distribution must include
+	// the otlp receiver, the batch processor, and the otlp and otlphttp exporters.
+	// spec.image.registry and the operator's registry mirrors apply to it.
+	// +kubebuilder:validation:MinLength=1
+	Image string `json:"image"`
+
+	// Resources of the collector container. Defaults to small requests and limits.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// UpgradeStrategy configures how pod template changes are rolled out.
+type UpgradeStrategy struct {
+	// Type selects the rollout strategy. RollingUpdate updates the Deployment in
+	// place. Canary runs the new revision in a separate mlflow-canary Deployment,
+	// shifts HTTPRoute traffic to it in weighted steps, and rolls back
+	// automatically when it fails its health checks. BlueGreen handles backend
+	// store changes: it copies the data into the new backend, runs the new
+	// revision in a separate mlflow-green Deployment, and flips the HTTPRoute to
+	// it with a rollback window; other changes roll out in place. Canary and
+	// BlueGreen only apply when the instance is exposed through a Gateway API
+	// HTTPRoute and spec.storage is unset; otherwise rollouts fall back to
+	// RollingUpdate.
+	// +kubebuilder:validation:Enum=RollingUpdate;Canary;BlueGreen
+	// +kubebuilder:default=RollingUpdate
+	// +optional
+	Type UpgradeStrategyType `json:"type,omitempty"`
+
+	// RollingUpdate paces in-place rollouts of the MLflow Deployment, including
+	// the final promotion of a canary.
+	// +optional
+	RollingUpdate *RollingUpdateStrategy `json:"rollingUpdate,omitempty"`
+
+	// Canary tunes the Canary strategy.
+	// +optional
+	Canary *CanaryStrategy `json:"canary,omitempty"`
+
+	// BlueGreen tunes the BlueGreen strategy.
+	// +optional
+	BlueGreen *BlueGreenStrategy `json:"blueGreen,omitempty"`
+}
+
+// RollingUpdateStrategy paces rolling updates of the MLflow Deployment. It has
+// no effect when spec.storage is set, because a ReadWriteOnce volume forces the
+// Recreate strategy. maxSurge and maxUnavailable cannot both be zero.
+type RollingUpdateStrategy struct {
+	// MaxSurge is the number or percentage of extra pods created above the
+	// desired replica count during a rollout. Defaults to 1.
+	// +optional
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+
+	// MaxUnavailable is the number or percentage of pods that may be unavailable
+	// during a rollout. Defaults to 0, so serving capacity never drops.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// UpgradeStrategyType names a rollout strategy.
+type UpgradeStrategyType string
+
+const (
+	// UpgradeStrategyRollingUpdate updates the MLflow Deployment in place.
+	UpgradeStrategyRollingUpdate UpgradeStrategyType = "RollingUpdate"
+	// UpgradeStrategyCanary shifts traffic to a new revision in weighted steps.
+	UpgradeStrategyCanary UpgradeStrategyType = "Canary"
+	// UpgradeStrategyBlueGreen moves the instance to a new backend store next to
+	// the running revision and flips traffic once the new revision is ready.
+	UpgradeStrategyBlueGreen UpgradeStrategyType = "BlueGreen"
+)
+
+// CanaryStrategy tunes canary rollouts.
+type CanaryStrategy struct {
+	// Steps are the percentages of gateway traffic sent to the new revision, in
+	// order. The new revision is promoted after the last step. Defaults to [10, 50].
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=10
+	// +kubebuilder:validation:items:Minimum=1
+	// +kubebuilder:validation:items:Maximum=99
+	// +optional
+	Steps []int32 `json:"steps,omitempty"`
+
+	// StepDuration is how long the new revision must stay healthy at each step
+	// before traffic shifts further. Defaults to 5m.
+	// +optional
+	StepDuration *metav1.Duration `json:"stepDuration,omitempty"`
+
+	// ReadyTimeout bounds how long the new revision may take to pass its
+	// readiness checks before it is rolled back. Defaults to 10m.
+	// +optional
+	ReadyTimeout *metav1.Duration `json:"readyTimeout,omitempty"`
+}
+
+// BlueGreenStrategy tunes blue/green backend migrations.
+type BlueGreenStrategy struct {
+	// CopyData copies the live PostgreSQL backend store into the new one with
+	// pg_dump and pg_restore before the new revision starts. Set it to false to
+	// start the new revision against an empty or pre-seeded backend. Defaults to
+	// true.
+	// +optional
+	CopyData *bool `json:"copyData,omitempty"`
+
+	// ReadyTimeout bounds how long the data copy and the new revision's
+	// readiness checks may take before the migration is rolled back. Defaults
+	// to 30m.
+	// +optional
+	ReadyTimeout *metav1.Duration `json:"readyTimeout,omitempty"`
+
+	// RollbackWindow is how long the previous revision keeps running after
+	// traffic flips to the new one. Within it, the
+	// mlflow.opendatahub.io/rollback annotation or a failed health check flips
+	// traffic back. Defaults to 30m.
+	// +optional
+	RollbackWindow *metav1.Duration `json:"rollbackWindow,omitempty"`
+}
+
+// BlueGreenPhase is the state of a blue/green backend migration.
+type BlueGreenPhase string
+
+const (
+	// BlueGreenPhaseCopying means the data copy and schema migration Job is running.
+	BlueGreenPhaseCopying BlueGreenPhase = "Copying"
+	// BlueGreenPhaseVerifying means the new revision is starting and receives no traffic.
+	BlueGreenPhaseVerifying BlueGreenPhase = "Verifying"
+	// BlueGreenPhaseSwitched means all gateway traffic goes to the new revision
+	// while the previous one stays up for rollback.
+	BlueGreenPhaseSwitched BlueGreenPhase = "Switched"
+	// BlueGreenPhasePromoting means the main Deployment is rolling out the new revision.
+	BlueGreenPhasePromoting BlueGreenPhase = "Promoting"
+	// BlueGreenPhaseRolledBack means traffic went back to the previous revision,
+	// which keeps serving until the backend store changes again.
+	BlueGreenPhaseRolledBack BlueGreenPhase = "RolledBack"
+)
+
+// BlueGreenStatus tracks the current or most recent blue/green backend migration.
+type BlueGreenStatus struct {
+	// revision identifies the backend store the new revision points at.
+	// +kubebuilder:validation:MaxLength=64
+	Revision string `json:"revision"`
+
+	// phase is the state of the migration.
+	Phase BlueGreenPhase `json:"phase"`
+
+	// weight is the percentage of gateway traffic routed to the new revision.
+	// +optional
+	Weight int32 `json:"weight,omitempty"`
+
+	// jobName is the data copy and schema migration Job of this migration.
+	// +optional
+	// +kubebuilder:validation:MaxLength=63
+	JobName string `json:"jobName,omitempty"`
+
+	// startedTime is when the migration started.
+	StartedTime metav1.Time `json:"startedTime"`
+
+	// switchedTime is when gateway traffic flipped to the new revision.
+	// +optional
+	SwitchedTime *metav1.Time `json:"switchedTime,omitempty"`
+
+	// message describes the migration state, including the reason for a rollback.
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	Message string `json:"message,omitempty"`
+}
+
+// SQLiteMigrationPhase is the state of a SQLite to PostgreSQL copy.
+type SQLiteMigrationPhase string
+
+const (
+	// SQLiteMigrationPhaseScalingDown means the MLflow pods are stopping so the SQLite
+	// file is no longer written.
+	SQLiteMigrationPhaseScalingDown SQLiteMigrationPhase = "ScalingDown"
+	// SQLiteMigrationPhaseCopying means the copy and verification Job is running.
+	SQLiteMigrationPhaseCopying SQLiteMigrationPhase = "Copying"
+	// SQLiteMigrationPhaseSucceeded means the data was copied and the Deployment
+	// switched to the new backend store.
+	SQLiteMigrationPhaseSucceeded SQLiteMigrationPhase = "Succeeded"
+	// SQLiteMigrationPhaseFailed means the copy failed and the Deployment keeps
+	// serving from SQLite until the Job is deleted or the backend store changes again.
+	SQLiteMigrationPhaseFailed SQLiteMigrationPhase = "Failed"
+)
+
+// SQLiteMigrationStatus tracks the current or most recent SQLite to PostgreSQL copy.
+type SQLiteMigrationStatus struct {
+	// revision identifies the backend store the data is copied into.
+	// +kubebuilder:validation:MaxLength=64
+	Revision string `json:"revision"`
+
+	// phase is the state of the copy.
+	Phase SQLiteMigrationPhase `json:"phase"`
+
+	// jobName is the copy and verification Job.
+	// +optional
+	// +kubebuilder:validation:MaxLength=63
+	JobName string `json:"jobName,omitempty"`
+
+	// startedTime is when the copy started.
+	StartedTime metav1.Time `json:"startedTime"`
+
+	// completionTime is when the copy succeeded or failed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// message describes the copy state, including the reason for a failure.
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	Message string `json:"message,omitempty"`
+}
+
+// CanaryPhase is the state of a canary rollout.
+type CanaryPhase string
+
+const (
+	// CanaryPhaseProgressing means the new revision is receiving stepped traffic.
+	CanaryPhaseProgressing CanaryPhase = "Progressing"
+	// CanaryPhasePromoting means the stable Deployment is rolling out the new revision.
+	CanaryPhasePromoting CanaryPhase = "Promoting"
+	// CanaryPhaseRolledBack means the new revision failed its health checks and the
+	// stable revision keeps serving until the pod template changes again.
+	CanaryPhaseRolledBack CanaryPhase = "RolledBack"
+)
+
+// CanaryStatus tracks the current or most recent canary rollout.
+type CanaryStatus struct {
+	// revision is the pod template hash of the canary revision.
+	// +kubebuilder:validation:MaxLength=64
+	Revision string `json:"revision"`
+
+	// phase is the state of the rollout.
+	Phase CanaryPhase `json:"phase"`
+
+	// step is the index into spec.upgradeStrategy.canary.steps currently applied.
+	// +optional
+	Step int32 `json:"step,omitempty"`
+
+	// weight is the percentage of gateway traffic routed to the canary revision.
+	// +optional
+	Weight int32 `json:"weight,omitempty"`
+
+	// startedTime is when the canary revision was first deployed.
+	StartedTime metav1.Time `json:"startedTime"`
+
+	// stepStartedTime is when the current weight was applied.
+	// +optional
+	StepStartedTime *metav1.Time `json:"stepStartedTime,omitempty"`
+
+	// message describes the rollout state, including the reason for a rollback.
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	Message string `json:"message,omitempty"`
+}
+
+// ResourceProfile names a sizing preset.
+type ResourceProfile string
+
+const (
+	// ResourceProfileSmall suits evaluation and small teams.
+	ResourceProfileSmall ResourceProfile = "small"
+	// ResourceProfileMedium suits a shared team instance.
+	ResourceProfileMedium ResourceProfile = "medium"
+	// ResourceProfileLarge suits a busy shared platform instance.
+	ResourceProfileLarge ResourceProfile = "large"
+)
+
+// WorkersPolicy selects how the uvicorn worker count is derived.
+type WorkersPolicy string
+
+const (
+	// WorkersPolicyFixed runs a single worker unless workers is set.
+	WorkersPolicyFixed WorkersPolicy = "Fixed"
+	// WorkersPolicyAuto derives the worker count from the CPU request or limit.
+	WorkersPolicyAuto WorkersPolicy = "Auto"
+)
+
+// AntiAffinityMode selects how strictly MLflow replicas are spread across nodes.
+type AntiAffinityMode string
+
+const (
+	// AntiAffinitySoft prefers scheduling replicas on different nodes.
+	AntiAffinitySoft AntiAffinityMode = "Soft"
+	// AntiAffinityHard requires scheduling replicas on different nodes.
+	AntiAffinityHard AntiAffinityMode = "Hard"
+)
+
+// MLflowAddressStatus holds an addressable endpoint for the managed MLflow deployment.
+type MLflowAddressStatus struct {
+	// url is the in-cluster HTTPS URL for the managed MLflow Service.
+	// +optional
+	// +kubebuilder:validation:MaxLength=2048
+	URL string `json:"url,omitempty"`
+}
+
+// MLflowStatus defines the observed state of MLflow.
+type MLflowStatus struct {
+	// conditions represent the current state of the MLflow resource.
+	// Each condition has a unique type and reflects the status of a specific aspect of the resource.
+	//
+	// Standard condition types include:
+	// - "Available": the resource is fully functional
+	// - "Progressing": the resource is being created or updated
+	// - "Degraded": the resource failed to reach or maintain its desired state
+	// - "Migration": the operator-managed migration state for the current observed generation
+	//
+	// The status of each condition is one of True, False, or Unknown.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// url is the externally reachable MLflow URL exposed through the data science gateway.
+	// +optional
+	// +kubebuilder:validation:MaxLength=2048
+	URL string `json:"url,omitempty"`
+
+	// gateway is the Gateway the HTTPRoute attaches to when the operator selected it by
+	// GATEWAY_SELECTOR or GATEWAY_CLASS_NAME instead of spec.routing.gateways or GATEWAY_NAME.
+	// +optional
+	Gateway *SelectedGateway `json:"gateway,omitempty"`
+
+	// address holds the internal addressable endpoint for the managed MLflow Service.
+	// +optional
+	Address *MLflowAddressStatus `json:"address,omitempty"`
+
+	// version records the installed MLflow version.
+	// +optional
+	// +kubebuilder:validation:MaxLength=64
+	Version string `json:"version,omitempty"`
+
+	// replicas is the desired replica count of the MLflow Deployment.
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// readyReplicas is the number of MLflow Deployment pods that are ready.
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+
+	// image is the MLflow container image the Deployment currently runs, after digest
+	// resolution and registry mirroring.
+	// +optional
+	// +kubebuilder:validation:MaxLength=512
+	Image string `json:"image,omitempty"`
+
+	// observedGeneration is the MLflow generation whose rendered manifests were last applied.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// helmChartVersion is the version of the embedded Helm chart that produced the
+	// applied resources.
+	// +optional
+	// +kubebuilder:validation:MaxLength=64
+	HelmChartVersion string `json:"helmChartVersion,omitempty"`
+
+	// lastAppliedTime is when the rendered manifests last changed, i.e. when a new
+	// generation, chart version, or resource set was applied. Periodic resyncs that
+	// re-apply identical manifests do not move it.
+	// +optional
+	LastAppliedTime *metav1.Time `json:"lastAppliedTime,omitempty"`
+
+	// appliedResourceCount is the number of chart-rendered resources applied in the
+	// last successful reconcile.
+	// +optional
+	AppliedResourceCount int32 `json:"appliedResourceCount,omitempty"`
+
+	// inventory lists the chart-rendered resources applied in the last successful reconcile,
+	// sorted by kind, namespace, and name.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=512
+	Inventory []ManagedResource `json:"inventory,omitempty"`
+
+	// revision is the number of the revision Secret holding the currently applied manifests.
+	// It is 0 when spec.revisionHistoryLimit is 0.
+	// +optional
+	Revision int64 `json:"revision,omitempty"`
+
+	// consecutiveFailures counts render or apply failures since the last successful apply.
+	// Requeues back off exponentially with it, and it resets to zero on success.
+	// +optional
+	ConsecutiveFailures int32 `json:"consecutiveFailures,omitempty"`
+
+	// canary tracks the current or most recent canary rollout when
+	// spec.upgradeStrategy.type is Canary.
+	// +optional
+	Canary *CanaryStatus `json:"canary,omitempty"`
+
+	// blueGreen tracks the current or most recent blue/green backend migration
+	// when spec.upgradeStrategy.type is BlueGreen.
+	// +optional
+	BlueGreen *BlueGreenStatus `json:"blueGreen,omitempty"`
+
+	// sqliteMigration tracks the current or most recent copy of a SQLite backend
+	// store into PostgreSQL; see spec.migration.copySQLite.
+	// +optional
+	SQLiteMigration *SQLiteMigrationStatus `json:"sqliteMigration,omitempty"`
+
+	// fleet reports the delivery to each managed cluster selected by spec.fleet.
+	// +optional
+	// +listType=map
+	// +listMapKey=clusterName
+	// +kubebuilder:validation:MaxItems=1024
+	Fleet []FleetClusterStatus `json:"fleet,omitempty"`
+
+	// usage reports the counts collected by spec.usageStatistics.
+	// +optional
+	Usage *UsageStatus `json:"usage,omitempty"`
+}
+
+// UsageStatus reports MLflow usage counts across all workspaces.
+type UsageStatus struct {
+	// experiments is the number of active experiments.
+	Experiments int64 `json:"experiments"`
+
+	// runsLast24h is the number of active runs started in the 24 hours before
+	// lastUpdateTime.
+	RunsLast24h int64 `json:"runsLast24h"`
+
+	// registeredModels is the number of registered models.
+	RegisteredModels int64 `json:"registeredModels"`
+
+	// workspaces is the number of workspaces searched, or 0 when workspaces are
+	// disabled.
+	// +optional
+	Workspaces int32 `json:"workspaces,omitempty"`
+
+	// lastUpdateTime is when the counts were collected. It stops advancing while
+	// collection fails; the operator logs the errors.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime"`
+}
+
+// FleetClusterStatus reports the ManifestWork of one managed cluster.
+type FleetClusterStatus struct {
+	// clusterName is the name of the managed cluster.
+	// +kubebuilder:validation:MaxLength=253
+	ClusterName string `json:"clusterName"`
+
+	// applied reports whether the work agent applied every manifest on the cluster.
+	// +optional
+	// +kubebuilder:validation:Enum=True;False;Unknown
+	Applied metav1.ConditionStatus `json:"applied,omitempty"`
+
+	// available reports whether every applied object exists on the cluster.
+	// +optional
+	// +kubebuilder:validation:Enum=True;False;Unknown
+	Available metav1.ConditionStatus `json:"available,omitempty"`
+
+	// readyReplicas is the number of ready MLflow server pods the work agent reports.
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+
+	// message explains why the cluster is not ready yet.
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	Message string `json:"message,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -400,8 +2106,12 @@ type MLflowStatus struct {
 // +kubebuilder:resource:scope=Cluster
 // +kubebuilder:printcolumn:name="Available",type="string",JSONPath=".status.conditions[?(@.type=='Available')].status"
 // +kubebuilder:printcolumn:name="Progressing",type="string",JSONPath=".status.conditions[?(@.type=='Progressing')].status"
+// +kubebuilder:printcolumn:name="Ready",type="integer",JSONPath=".status.readyReplicas"
+// +kubebuilder:printcolumn:name="Replicas",type="integer",JSONPath=".status.replicas"
 // +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".status.version"
-// +kubebuilder:printcolumn:name="URL",type="string",priority=1,JSONPath=".status.url"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.url"
+// +kubebuilder:printcolumn:name="Image",type="string",priority=1,JSONPath=".status.image"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:validation:XValidation:rule="self.metadata.name == 'mlflow'",message="MLflow resource name must be 'mlflow'"
 // +kubebuilder:validation:XValidation:rule="self.metadata.name.size() <= 40",message="MLflow resource name must be at most 40 characters to ensure generated resource names stay within Kubernetes 63-character limit"
 
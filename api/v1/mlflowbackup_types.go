@@ -0,0 +1,132 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BackupPhase is the lifecycle phase of an MLflowBackup or MLflowRestore.
+// +kubebuilder:validation:Enum=Pending;Running;Succeeded;Failed
+type BackupPhase string
+
+const (
+	// BackupPhasePending waits for the MLflow instance, the source backup, or another restore.
+	BackupPhasePending BackupPhase = "Pending"
+	// BackupPhaseRunning has a Job copying data.
+	BackupPhaseRunning BackupPhase = "Running"
+	// BackupPhaseSucceeded is terminal: the Job completed.
+	BackupPhaseSucceeded BackupPhase = "Succeeded"
+	// BackupPhaseFailed is terminal: the Job failed or the request cannot be served.
+	BackupPhaseFailed BackupPhase = "Failed"
+)
+
+// BackupDestination is the object storage prefix backups are written under.
+type BackupDestination struct {
+	// URI is the object storage prefix. Each backup is written under <uri>/<backup name>/
+	// with MLflow's artifact repository for the scheme, e.g. s3://bucket/mlflow-backups.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^(s3|gs|wasbs)://[^/]+`
+	URI string `json:"uri"`
+
+	// EnvFrom supplies credentials for the destination, e.g. a Secret with AWS_ACCESS_KEY_ID,
+	// AWS_SECRET_ACCESS_KEY, and MLFLOW_S3_ENDPOINT_URL. It is added after the MLflow server's
+	// own env and envFrom, so it takes precedence over the artifact storage credentials.
+	// +optional
+	EnvFrom []corev1.EnvFromSource `json:"envFrom,omitempty"`
+}
+
+// MLflowBackupSpec defines the desired state of MLflowBackup
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="MLflowBackup spec is immutable; create a new backup instead"
+type MLflowBackupSpec struct {
+	// Destination is where the backup is written.
+	// +kubebuilder:validation:Required
+	Destination BackupDestination `json:"destination"`
+
+	// IncludeArtifacts also copies file-based artifacts (a file:// artifactsDestination on
+	// the MLflow volume). Artifacts already in object storage are not copied; rely on the
+	// bucket's own versioning or replication for those.
+	// +optional
+	IncludeArtifacts bool `json:"includeArtifacts,omitempty"`
+}
+
+// MLflowBackupStatus defines the observed state of MLflowBackup.
+type MLflowBackupStatus struct {
+	// phase is the lifecycle phase of the backup.
+	// +optional
+	Phase BackupPhase `json:"phase,omitempty"`
+
+	// conditions represent the current state of the MLflowBackup resource. The Complete
+	// condition is True once the backup has been written.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// location is the object storage URI the backup is written to.
+	// +optional
+	// +kubebuilder:validation:MaxLength=2048
+	Location string `json:"location,omitempty"`
+
+	// startTime is when the backup Job was created.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// completionTime is when the backup reached a terminal phase.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Location",type="string",priority=1,JSONPath=".status.location"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// MLflowBackup is the Schema for the mlflowbackups API. It snapshots the backend store of
+// the MLflow instance (pg_dump for PostgreSQL, a consistent copy for SQLite) and optionally
+// its file-based artifacts to object storage.
+type MLflowBackup struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the desired state of MLflowBackup
+	// +required
+	Spec MLflowBackupSpec `json:"spec"`
+
+	// status defines the observed state of MLflowBackup
+	// +optional
+	Status MLflowBackupStatus `json:"status,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// MLflowBackupList contains a list of MLflowBackup
+type MLflowBackupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []MLflowBackup `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MLflowBackup{}, &MLflowBackupList{})
+}
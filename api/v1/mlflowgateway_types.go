@@ -0,0 +1,173 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GatewayEndpointType is the kind of requests an MLflow AI Gateway endpoint serves.
+// +kubebuilder:validation:Enum=llm/v1/completions;llm/v1/chat;llm/v1/embeddings
+type GatewayEndpointType string
+
+const (
+	GatewayEndpointTypeCompletions GatewayEndpointType = "llm/v1/completions"
+	GatewayEndpointTypeChat        GatewayEndpointType = "llm/v1/chat"
+	GatewayEndpointTypeEmbeddings  GatewayEndpointType = "llm/v1/embeddings"
+)
+
+// MLflowGatewaySpec defines the desired state of MLflowGateway
+type MLflowGatewaySpec struct {
+	// Image is the container image for the gateway server. It must include the
+	// mlflow[genai] extras. Defaults to the image of the operator's MLflow release.
+	// +optional
+	Image *string `json:"image,omitempty"`
+
+	// ImagePullPolicy is the image pull policy.
+	// +kubebuilder:validation:Enum=Always;IfNotPresent;Never
+	// +optional
+	ImagePullPolicy *corev1.PullPolicy `json:"imagePullPolicy,omitempty"`
+
+	// Replicas is the number of gateway replicas.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default=1
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// Resources are the compute resources of the gateway container.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// Endpoints are the routes the gateway serves, each forwarding to one provider model.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	// +listType=map
+	// +listMapKey=name
+	Endpoints []GatewayEndpoint `json:"endpoints"`
+
+	// Routing publishes the gateway through an HTTPRoute under /mlflow-gateway.
+	// The gateway server does not authenticate callers, so unlike the tracking
+	// server no route is published unless spec.routing is set. hostnames, rootPath,
+	// and customDomain apply to the tracking server only and are rejected here.
+	// +kubebuilder:validation:XValidation:rule="!has(self.hostnames) && !has(self.rootPath) && !has(self.customDomain)",message="hostnames, rootPath, and customDomain are not supported for the gateway"
+	// +optional
+	Routing *RoutingConfig `json:"routing,omitempty"`
+}
+
+// GatewayEndpoint is one MLflow AI Gateway endpoint.
+type GatewayEndpoint struct {
+	// Name is the endpoint name used in request paths.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9_-]+$`
+	Name string `json:"name"`
+
+	// EndpointType is the kind of requests the endpoint serves.
+	// +kubebuilder:validation:Required
+	EndpointType GatewayEndpointType `json:"endpointType"`
+
+	// Model is the provider model requests are forwarded to.
+	// +kubebuilder:validation:Required
+	Model GatewayModel `json:"model"`
+}
+
+// GatewayModel identifies a provider model and its provider configuration.
+type GatewayModel struct {
+	// Provider is the MLflow gateway provider, for example openai, anthropic,
+	// bedrock, or mistral.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Provider string `json:"provider"`
+
+	// Name is the provider's model name.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Config holds non-secret provider configuration, for example openai_api_base.
+	// +optional
+	Config map[string]string `json:"config,omitempty"`
+
+	// SecretConfig holds provider configuration read from Secrets in the operator's
+	// applications namespace, keyed by provider configuration name, for example
+	// openai_api_key. Values are passed to the gateway as environment variables and
+	// never appear in its configuration file.
+	// +optional
+	SecretConfig map[string]corev1.SecretKeySelector `json:"secretConfig,omitempty"`
+}
+
+// MLflowGatewayStatus defines the observed state of MLflowGateway.
+type MLflowGatewayStatus struct {
+	// conditions represent the current state of the MLflowGateway resource.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// url is the externally reachable gateway URL, when routing is enabled.
+	// +optional
+	// +kubebuilder:validation:MaxLength=2048
+	URL string `json:"url,omitempty"`
+
+	// address holds the in-cluster endpoint of the gateway Service.
+	// +optional
+	Address *MLflowAddressStatus `json:"address,omitempty"`
+
+	// observedGeneration is the generation whose rendered manifests were last applied.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Available",type="string",JSONPath=".status.conditions[?(@.type=='Available')].status"
+// +kubebuilder:printcolumn:name="Address",type="string",priority=1,JSONPath=".status.address.url"
+// +kubebuilder:validation:XValidation:rule="self.metadata.name == 'mlflow'",message="MLflowGateway resource name must be 'mlflow'"
+
+// MLflowGateway is the Schema for the mlflowgateways API. It runs the MLflow AI
+// Gateway server, which routes LLM requests to providers, next to the tracking server.
+type MLflowGateway struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the desired state of MLflowGateway
+	// +required
+	Spec MLflowGatewaySpec `json:"spec"`
+
+	// status defines the observed state of MLflowGateway
+	// +optional
+	Status MLflowGatewayStatus `json:"status,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// MLflowGatewayList contains a list of MLflowGateway
+type MLflowGatewayList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []MLflowGateway `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MLflowGateway{}, &MLflowGatewayList{})
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MLflowRestoreSpec defines the desired state of MLflowRestore
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="MLflowRestore spec is immutable; create a new restore instead"
+type MLflowRestoreSpec struct {
+	// BackupName is the MLflowBackup to restore. The restore waits until it has succeeded.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	BackupName string `json:"backupName"`
+
+	// IncludeArtifacts also restores the file-based artifacts captured by the backup,
+	// merging them into the artifacts directory on the MLflow volume.
+	// +optional
+	IncludeArtifacts bool `json:"includeArtifacts,omitempty"`
+}
+
+// MLflowRestoreStatus defines the observed state of MLflowRestore.
+type MLflowRestoreStatus struct {
+	// phase is the lifecycle phase of the restore.
+	// +optional
+	Phase BackupPhase `json:"phase,omitempty"`
+
+	// conditions represent the current state of the MLflowRestore resource. The Complete
+	// condition is True once the data has been restored.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// startTime is when the MLflow server was scaled down for the restore.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// completionTime is when the restore reached a terminal phase.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Backup",type="string",JSONPath=".spec.backupName"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// MLflowRestore is the Schema for the mlflowrestores API. It scales the MLflow instance to
+// zero, replaces its backend store with a named MLflowBackup, and restarts it with a forced
+// schema migration so older backups are upgraded to the running MLflow version.
+type MLflowRestore struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the desired state of MLflowRestore
+	// +required
+	Spec MLflowRestoreSpec `json:"spec"`
+
+	// status defines the observed state of MLflowRestore
+	// +optional
+	Status MLflowRestoreStatus `json:"status,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// MLflowRestoreList contains a list of MLflowRestore
+type MLflowRestoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []MLflowRestore `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MLflowRestore{}, &MLflowRestoreList{})
+}
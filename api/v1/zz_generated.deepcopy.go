@@ -25,373 +25,2416 @@ import (
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CABundleConfigMapSpec) DeepCopyInto(out *CABundleConfigMapSpec) {
+func (in *AccessRoleGroups) DeepCopyInto(out *AccessRoleGroups) {
 	*out = *in
+	if in.Viewers != nil {
+		in, out := &in.Viewers, &out.Viewers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Editors != nil {
+		in, out := &in.Editors, &out.Editors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Admins != nil {
+		in, out := &in.Admins, &out.Admins
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CABundleConfigMapSpec.
-func (in *CABundleConfigMapSpec) DeepCopy() *CABundleConfigMapSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessRoleGroups.
+func (in *AccessRoleGroups) DeepCopy() *AccessRoleGroups {
 	if in == nil {
 		return nil
 	}
-	out := new(CABundleConfigMapSpec)
+	out := new(AccessRoleGroups)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GarbageCollectionSpec) DeepCopyInto(out *GarbageCollectionSpec) {
+func (in *AccessRolesConfig) DeepCopyInto(out *AccessRolesConfig) {
 	*out = *in
-	if in.OlderThan != nil {
-		in, out := &in.OlderThan, &out.OlderThan
-		*out = new(string)
+	if in.AggregateToDefaultRoles != nil {
+		in, out := &in.AggregateToDefaultRoles, &out.AggregateToDefaultRoles
+		*out = new(bool)
 		**out = **in
 	}
-	if in.Resources != nil {
-		in, out := &in.Resources, &out.Resources
-		*out = new(corev1.ResourceRequirements)
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = new(AccessRoleGroups)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GarbageCollectionSpec.
-func (in *GarbageCollectionSpec) DeepCopy() *GarbageCollectionSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessRolesConfig.
+func (in *AccessRolesConfig) DeepCopy() *AccessRolesConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(GarbageCollectionSpec)
+	out := new(AccessRolesConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ImageConfig) DeepCopyInto(out *ImageConfig) {
+func (in *AlertsConfig) DeepCopyInto(out *AlertsConfig) {
 	*out = *in
-	if in.Image != nil {
-		in, out := &in.Image, &out.Image
-		*out = new(string)
-		**out = **in
-	}
-	if in.ImagePullPolicy != nil {
-		in, out := &in.ImagePullPolicy, &out.ImagePullPolicy
-		*out = new(corev1.PullPolicy)
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageConfig.
-func (in *ImageConfig) DeepCopy() *ImageConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsConfig.
+func (in *AlertsConfig) DeepCopy() *AlertsConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(ImageConfig)
+	out := new(AlertsConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MLflow) DeepCopyInto(out *MLflow) {
+func (in *ArtifactsConfig) DeepCopyInto(out *ArtifactsConfig) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(S3ArtifactsConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLflow.
-func (in *MLflow) DeepCopy() *MLflow {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactsConfig.
+func (in *ArtifactsConfig) DeepCopy() *ArtifactsConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(MLflow)
+	out := new(ArtifactsConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MLflow) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MLflowAddressStatus) DeepCopyInto(out *MLflowAddressStatus) {
+func (in *ArtifactsServerConfig) DeepCopyInto(out *ArtifactsServerConfig) {
 	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLflowAddressStatus.
-func (in *MLflowAddressStatus) DeepCopy() *MLflowAddressStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactsServerConfig.
+func (in *ArtifactsServerConfig) DeepCopy() *ArtifactsServerConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(MLflowAddressStatus)
+	out := new(ArtifactsServerConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MLflowList) DeepCopyInto(out *MLflowList) {
+func (in *BackupDestination) DeepCopyInto(out *BackupDestination) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]MLflow, len(*in))
+	if in.EnvFrom != nil {
+		in, out := &in.EnvFrom, &out.EnvFrom
+		*out = make([]corev1.EnvFromSource, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLflowList.
-func (in *MLflowList) DeepCopy() *MLflowList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupDestination.
+func (in *BackupDestination) DeepCopy() *BackupDestination {
 	if in == nil {
 		return nil
 	}
-	out := new(MLflowList)
+	out := new(BackupDestination)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MLflowList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MLflowMigrationConfig) DeepCopyInto(out *MLflowMigrationConfig) {
+func (in *BlueGreenStatus) DeepCopyInto(out *BlueGreenStatus) {
 	*out = *in
-	if in.TTLSecondsAfterFinished != nil {
-		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
-		*out = new(int32)
-		**out = **in
+	in.StartedTime.DeepCopyInto(&out.StartedTime)
+	if in.SwitchedTime != nil {
+		in, out := &in.SwitchedTime, &out.SwitchedTime
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLflowMigrationConfig.
-func (in *MLflowMigrationConfig) DeepCopy() *MLflowMigrationConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlueGreenStatus.
+func (in *BlueGreenStatus) DeepCopy() *BlueGreenStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MLflowMigrationConfig)
+	out := new(BlueGreenStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MLflowSpec) DeepCopyInto(out *MLflowSpec) {
+func (in *BlueGreenStrategy) DeepCopyInto(out *BlueGreenStrategy) {
 	*out = *in
-	if in.Image != nil {
-		in, out := &in.Image, &out.Image
-		*out = new(ImageConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Replicas != nil {
-		in, out := &in.Replicas, &out.Replicas
-		*out = new(int32)
-		**out = **in
-	}
-	if in.Migration != nil {
-		in, out := &in.Migration, &out.Migration
-		*out = new(MLflowMigrationConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Resources != nil {
-		in, out := &in.Resources, &out.Resources
-		*out = new(corev1.ResourceRequirements)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ServiceAccountName != nil {
-		in, out := &in.ServiceAccountName, &out.ServiceAccountName
-		*out = new(string)
-		**out = **in
-	}
-	if in.Storage != nil {
-		in, out := &in.Storage, &out.Storage
-		*out = new(corev1.PersistentVolumeClaimSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.BackendStoreURI != nil {
-		in, out := &in.BackendStoreURI, &out.BackendStoreURI
-		*out = new(string)
-		**out = **in
-	}
-	if in.BackendStoreURIFrom != nil {
-		in, out := &in.BackendStoreURIFrom, &out.BackendStoreURIFrom
-		*out = new(corev1.SecretKeySelector)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.RegistryStoreURI != nil {
-		in, out := &in.RegistryStoreURI, &out.RegistryStoreURI
-		*out = new(string)
-		**out = **in
-	}
-	if in.RegistryStoreURIFrom != nil {
-		in, out := &in.RegistryStoreURIFrom, &out.RegistryStoreURIFrom
-		*out = new(corev1.SecretKeySelector)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ArtifactsDestination != nil {
-		in, out := &in.ArtifactsDestination, &out.ArtifactsDestination
-		*out = new(string)
-		**out = **in
-	}
-	if in.DefaultArtifactRoot != nil {
-		in, out := &in.DefaultArtifactRoot, &out.DefaultArtifactRoot
-		*out = new(string)
-		**out = **in
-	}
-	if in.ServeArtifacts != nil {
-		in, out := &in.ServeArtifacts, &out.ServeArtifacts
+	if in.CopyData != nil {
+		in, out := &in.CopyData, &out.CopyData
 		*out = new(bool)
 		**out = **in
 	}
-	if in.Workers != nil {
-		in, out := &in.Workers, &out.Workers
-		*out = new(int32)
+	if in.ReadyTimeout != nil {
+		in, out := &in.ReadyTimeout, &out.ReadyTimeout
+		*out = new(metav1.Duration)
 		**out = **in
 	}
-	if in.ExtraAllowedOrigins != nil {
-		in, out := &in.ExtraAllowedOrigins, &out.ExtraAllowedOrigins
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.RollbackWindow != nil {
+		in, out := &in.RollbackWindow, &out.RollbackWindow
+		*out = new(metav1.Duration)
+		**out = **in
 	}
-	if in.WorkspaceLabelSelector != nil {
-		in, out := &in.WorkspaceLabelSelector, &out.WorkspaceLabelSelector
-		*out = new(metav1.LabelSelector)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlueGreenStrategy.
+func (in *BlueGreenStrategy) DeepCopy() *BlueGreenStrategy {
+	if in == nil {
+		return nil
 	}
-	if in.Env != nil {
-		in, out := &in.Env, &out.Env
-		*out = make([]corev1.EnvVar, len(*in))
+	out := new(BlueGreenStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BootstrapConfig) DeepCopyInto(out *BootstrapConfig) {
+	*out = *in
+	if in.Experiments != nil {
+		in, out := &in.Experiments, &out.Experiments
+		*out = make([]BootstrapExperiment, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.EnvFrom != nil {
-		in, out := &in.EnvFrom, &out.EnvFrom
-		*out = make([]corev1.EnvFromSource, len(*in))
+	if in.RegisteredModels != nil {
+		in, out := &in.RegisteredModels, &out.RegisteredModels
+		*out = make([]BootstrapRegisteredModel, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.PodLabels != nil {
-		in, out := &in.PodLabels, &out.PodLabels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootstrapConfig.
+func (in *BootstrapConfig) DeepCopy() *BootstrapConfig {
+	if in == nil {
+		return nil
 	}
-	if in.PodAnnotations != nil {
-		in, out := &in.PodAnnotations, &out.PodAnnotations
+	out := new(BootstrapConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BootstrapExperiment) DeepCopyInto(out *BootstrapExperiment) {
+	*out = *in
+	if in.Workspace != nil {
+		in, out := &in.Workspace, &out.Workspace
+		*out = new(string)
+		**out = **in
+	}
+	if in.ArtifactLocation != nil {
+		in, out := &in.ArtifactLocation, &out.ArtifactLocation
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
 		*out = make(map[string]string, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val
 		}
 	}
-	if in.PodSecurityContext != nil {
-		in, out := &in.PodSecurityContext, &out.PodSecurityContext
-		*out = new(corev1.PodSecurityContext)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootstrapExperiment.
+func (in *BootstrapExperiment) DeepCopy() *BootstrapExperiment {
+	if in == nil {
+		return nil
 	}
-	if in.SecurityContext != nil {
-		in, out := &in.SecurityContext, &out.SecurityContext
-		*out = new(corev1.SecurityContext)
-		(*in).DeepCopyInto(*out)
+	out := new(BootstrapExperiment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BootstrapRegisteredModel) DeepCopyInto(out *BootstrapRegisteredModel) {
+	*out = *in
+	if in.Workspace != nil {
+		in, out := &in.Workspace, &out.Workspace
+		*out = new(string)
+		**out = **in
 	}
-	if in.NodeSelector != nil {
-		in, out := &in.NodeSelector, &out.NodeSelector
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
 		*out = make(map[string]string, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val
 		}
 	}
-	if in.Tolerations != nil {
-		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]corev1.Toleration, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+	if in.Aliases != nil {
+		in, out := &in.Aliases, &out.Aliases
+		*out = make(map[string]int64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
 		}
 	}
-	if in.Affinity != nil {
-		in, out := &in.Affinity, &out.Affinity
-		*out = new(corev1.Affinity)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ResourceClaims != nil {
-		in, out := &in.ResourceClaims, &out.ResourceClaims
-		*out = make([]corev1.PodResourceClaim, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootstrapRegisteredModel.
+func (in *BootstrapRegisteredModel) DeepCopy() *BootstrapRegisteredModel {
+	if in == nil {
+		return nil
 	}
-	if in.CABundleConfigMap != nil {
-		in, out := &in.CABundleConfigMap, &out.CABundleConfigMap
-		*out = new(CABundleConfigMapSpec)
+	out := new(BootstrapRegisteredModel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CABundleConfigMapSpec) DeepCopyInto(out *CABundleConfigMapSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CABundleConfigMapSpec.
+func (in *CABundleConfigMapSpec) DeepCopy() *CABundleConfigMapSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CABundleConfigMapSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryStatus) DeepCopyInto(out *CanaryStatus) {
+	*out = *in
+	in.StartedTime.DeepCopyInto(&out.StartedTime)
+	if in.StepStartedTime != nil {
+		in, out := &in.StepStartedTime, &out.StepStartedTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryStatus.
+func (in *CanaryStatus) DeepCopy() *CanaryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryStrategy) DeepCopyInto(out *CanaryStrategy) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.StepDuration != nil {
+		in, out := &in.StepDuration, &out.StepDuration
+		*out = new(metav1.Duration)
 		**out = **in
 	}
-	if in.NetworkPolicyEgressRules != nil {
-		in, out := &in.NetworkPolicyEgressRules, &out.NetworkPolicyEgressRules
-		*out = make([]networkingv1.NetworkPolicyEgressRule, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.ReadyTimeout != nil {
+		in, out := &in.ReadyTimeout, &out.ReadyTimeout
+		*out = new(metav1.Duration)
+		**out = **in
 	}
-	if in.NetworkPolicyAdditionalEgressRules != nil {
-		in, out := &in.NetworkPolicyAdditionalEgressRules, &out.NetworkPolicyAdditionalEgressRules
-		*out = make([]networkingv1.NetworkPolicyEgressRule, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryStrategy.
+func (in *CanaryStrategy) DeepCopy() *CanaryStrategy {
+	if in == nil {
+		return nil
 	}
-	if in.GarbageCollection != nil {
-		in, out := &in.GarbageCollection, &out.GarbageCollection
-		*out = new(GarbageCollectionSpec)
+	out := new(CanaryStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientCredentialsConfig) DeepCopyInto(out *ClientCredentialsConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientCredentialsConfig.
+func (in *ClientCredentialsConfig) DeepCopy() *ClientCredentialsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientCredentialsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsoleLinkConfig) DeepCopyInto(out *ConsoleLinkConfig) {
+	*out = *in
+	if in.Text != nil {
+		in, out := &in.Text, &out.Text
+		*out = new(string)
+		**out = **in
+	}
+	if in.Section != nil {
+		in, out := &in.Section, &out.Section
+		*out = new(string)
+		**out = **in
+	}
+	if in.Disable != nil {
+		in, out := &in.Disable, &out.Disable
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleLinkConfig.
+func (in *ConsoleLinkConfig) DeepCopy() *ConsoleLinkConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsoleLinkConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsolePluginConfig) DeepCopyInto(out *ConsolePluginConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsolePluginConfig.
+func (in *ConsolePluginConfig) DeepCopy() *ConsolePluginConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsolePluginConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomDomainConfig) DeepCopyInto(out *CustomDomainConfig) {
+	*out = *in
+	out.TLSSecretRef = in.TLSSecretRef
+	if in.GatewayClassName != nil {
+		in, out := &in.GatewayClassName, &out.GatewayClassName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomDomainConfig.
+func (in *CustomDomainConfig) DeepCopy() *CustomDomainConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomDomainConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalReachabilityCheckConfig) DeepCopyInto(out *ExternalReachabilityCheckConfig) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalReachabilityCheckConfig.
+func (in *ExternalReachabilityCheckConfig) DeepCopy() *ExternalReachabilityCheckConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalReachabilityCheckConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetClusterStatus) DeepCopyInto(out *FleetClusterStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetClusterStatus.
+func (in *FleetClusterStatus) DeepCopy() *FleetClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetConfig) DeepCopyInto(out *FleetConfig) {
+	*out = *in
+	out.PlacementRef = in.PlacementRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetConfig.
+func (in *FleetConfig) DeepCopy() *FleetConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetPlacementReference) DeepCopyInto(out *FleetPlacementReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetPlacementReference.
+func (in *FleetPlacementReference) DeepCopy() *FleetPlacementReference {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetPlacementReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GarbageCollectionSpec) DeepCopyInto(out *GarbageCollectionSpec) {
+	*out = *in
+	if in.OlderThan != nil {
+		in, out := &in.OlderThan, &out.OlderThan
+		*out = new(string)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLflowSpec.
-func (in *MLflowSpec) DeepCopy() *MLflowSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GarbageCollectionSpec.
+func (in *GarbageCollectionSpec) DeepCopy() *GarbageCollectionSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MLflowSpec)
+	out := new(GarbageCollectionSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MLflowStatus) DeepCopyInto(out *MLflowStatus) {
+func (in *GatewayEndpoint) DeepCopyInto(out *GatewayEndpoint) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+	in.Model.DeepCopyInto(&out.Model)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayEndpoint.
+func (in *GatewayEndpoint) DeepCopy() *GatewayEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayModel) DeepCopyInto(out *GatewayModel) {
+	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
 		}
 	}
-	if in.Address != nil {
-		in, out := &in.Address, &out.Address
-		*out = new(MLflowAddressStatus)
+	if in.SecretConfig != nil {
+		in, out := &in.SecretConfig, &out.SecretConfig
+		*out = make(map[string]corev1.SecretKeySelector, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayModel.
+func (in *GatewayModel) DeepCopy() *GatewayModel {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayModel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayReference) DeepCopyInto(out *GatewayReference) {
+	*out = *in
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.SectionName != nil {
+		in, out := &in.SectionName, &out.SectionName
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLflowStatus.
-func (in *MLflowStatus) DeepCopy() *MLflowStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayReference.
+func (in *GatewayReference) DeepCopy() *GatewayReference {
 	if in == nil {
 		return nil
 	}
-	out := new(MLflowStatus)
+	out := new(GatewayReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernationConfig) DeepCopyInto(out *HibernationConfig) {
+	*out = *in
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = make([]HibernationWindow, len(*in))
+		copy(*out, *in)
+	}
+	if in.TimeZone != nil {
+		in, out := &in.TimeZone, &out.TimeZone
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernationConfig.
+func (in *HibernationConfig) DeepCopy() *HibernationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernationWindow) DeepCopyInto(out *HibernationWindow) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernationWindow.
+func (in *HibernationWindow) DeepCopy() *HibernationWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernationWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HighAvailabilityConfig) DeepCopyInto(out *HighAvailabilityConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HighAvailabilityConfig.
+func (in *HighAvailabilityConfig) DeepCopy() *HighAvailabilityConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HighAvailabilityConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageConfig) DeepCopyInto(out *ImageConfig) {
+	*out = *in
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
+	if in.Registry != nil {
+		in, out := &in.Registry, &out.Registry
+		*out = new(string)
+		**out = **in
+	}
+	if in.ImagePullPolicy != nil {
+		in, out := &in.ImagePullPolicy, &out.ImagePullPolicy
+		*out = new(corev1.PullPolicy)
+		**out = **in
+	}
+	if in.ResolveDigest != nil {
+		in, out := &in.ResolveDigest, &out.ResolveDigest
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DigestPollInterval != nil {
+		in, out := &in.DigestPollInterval, &out.DigestPollInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ArchitectureAffinity != nil {
+		in, out := &in.ArchitectureAffinity, &out.ArchitectureAffinity
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageConfig.
+func (in *ImageConfig) DeepCopy() *ImageConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerConfig) DeepCopyInto(out *LoadBalancerConfig) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SourceRanges != nil {
+		in, out := &in.SourceRanges, &out.SourceRanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerConfig.
+func (in *LoadBalancerConfig) DeepCopy() *LoadBalancerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogCollectionConfig) DeepCopyInto(out *LogCollectionConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogCollectionConfig.
+func (in *LogCollectionConfig) DeepCopy() *LogCollectionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LogCollectionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLflow) DeepCopyInto(out *MLflow) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLflow.
+func (in *MLflow) DeepCopy() *MLflow {
+	if in == nil {
+		return nil
+	}
+	out := new(MLflow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MLflow) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLflowAddressStatus) DeepCopyInto(out *MLflowAddressStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLflowAddressStatus.
+func (in *MLflowAddressStatus) DeepCopy() *MLflowAddressStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MLflowAddressStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLflowBackup) DeepCopyInto(out *MLflowBackup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLflowBackup.
+func (in *MLflowBackup) DeepCopy() *MLflowBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(MLflowBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MLflowBackup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLflowBackupList) DeepCopyInto(out *MLflowBackupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MLflowBackup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLflowBackupList.
+func (in *MLflowBackupList) DeepCopy() *MLflowBackupList {
+	if in == nil {
+		return nil
+	}
+	out := new(MLflowBackupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MLflowBackupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLflowBackupSpec) DeepCopyInto(out *MLflowBackupSpec) {
+	*out = *in
+	in.Destination.DeepCopyInto(&out.Destination)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLflowBackupSpec.
+func (in *MLflowBackupSpec) DeepCopy() *MLflowBackupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MLflowBackupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLflowBackupStatus) DeepCopyInto(out *MLflowBackupStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLflowBackupStatus.
+func (in *MLflowBackupStatus) DeepCopy() *MLflowBackupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MLflowBackupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLflowGateway) DeepCopyInto(out *MLflowGateway) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLflowGateway.
+func (in *MLflowGateway) DeepCopy() *MLflowGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(MLflowGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MLflowGateway) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLflowGatewayList) DeepCopyInto(out *MLflowGatewayList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MLflowGateway, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLflowGatewayList.
+func (in *MLflowGatewayList) DeepCopy() *MLflowGatewayList {
+	if in == nil {
+		return nil
+	}
+	out := new(MLflowGatewayList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MLflowGatewayList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLflowGatewaySpec) DeepCopyInto(out *MLflowGatewaySpec) {
+	*out = *in
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
+	if in.ImagePullPolicy != nil {
+		in, out := &in.ImagePullPolicy, &out.ImagePullPolicy
+		*out = new(corev1.PullPolicy)
+		**out = **in
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]GatewayEndpoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Routing != nil {
+		in, out := &in.Routing, &out.Routing
+		*out = new(RoutingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLflowGatewaySpec.
+func (in *MLflowGatewaySpec) DeepCopy() *MLflowGatewaySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MLflowGatewaySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLflowGatewayStatus) DeepCopyInto(out *MLflowGatewayStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Address != nil {
+		in, out := &in.Address, &out.Address
+		*out = new(MLflowAddressStatus)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLflowGatewayStatus.
+func (in *MLflowGatewayStatus) DeepCopy() *MLflowGatewayStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MLflowGatewayStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLflowList) DeepCopyInto(out *MLflowList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MLflow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLflowList.
+func (in *MLflowList) DeepCopy() *MLflowList {
+	if in == nil {
+		return nil
+	}
+	out := new(MLflowList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MLflowList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLflowMigrationConfig) DeepCopyInto(out *MLflowMigrationConfig) {
+	*out = *in
+	if in.TTLSecondsAfterFinished != nil {
+		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CopySQLite != nil {
+		in, out := &in.CopySQLite, &out.CopySQLite
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLflowMigrationConfig.
+func (in *MLflowMigrationConfig) DeepCopy() *MLflowMigrationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MLflowMigrationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLflowRestore) DeepCopyInto(out *MLflowRestore) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLflowRestore.
+func (in *MLflowRestore) DeepCopy() *MLflowRestore {
+	if in == nil {
+		return nil
+	}
+	out := new(MLflowRestore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MLflowRestore) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLflowRestoreList) DeepCopyInto(out *MLflowRestoreList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MLflowRestore, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLflowRestoreList.
+func (in *MLflowRestoreList) DeepCopy() *MLflowRestoreList {
+	if in == nil {
+		return nil
+	}
+	out := new(MLflowRestoreList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MLflowRestoreList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLflowRestoreSpec) DeepCopyInto(out *MLflowRestoreSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLflowRestoreSpec.
+func (in *MLflowRestoreSpec) DeepCopy() *MLflowRestoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MLflowRestoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLflowRestoreStatus) DeepCopyInto(out *MLflowRestoreStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLflowRestoreStatus.
+func (in *MLflowRestoreStatus) DeepCopy() *MLflowRestoreStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MLflowRestoreStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLflowSpec) DeepCopyInto(out *MLflowSpec) {
+	*out = *in
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(ImageConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinReadySeconds != nil {
+		in, out := &in.MinReadySeconds, &out.MinReadySeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.StartupProbe != nil {
+		in, out := &in.StartupProbe, &out.StartupProbe
+		*out = new(StartupProbeConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Suspend != nil {
+		in, out := &in.Suspend, &out.Suspend
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		*out = new(HibernationConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Migration != nil {
+		in, out := &in.Migration, &out.Migration
+		*out = new(MLflowMigrationConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceAccountName != nil {
+		in, out := &in.ServiceAccountName, &out.ServiceAccountName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServiceAccount != nil {
+		in, out := &in.ServiceAccount, &out.ServiceAccount
+		*out = new(ServiceAccountConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(StorageConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BackendStoreURI != nil {
+		in, out := &in.BackendStoreURI, &out.BackendStoreURI
+		*out = new(string)
+		**out = **in
+	}
+	if in.BackendStoreURIFrom != nil {
+		in, out := &in.BackendStoreURIFrom, &out.BackendStoreURIFrom
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RegistryStoreURI != nil {
+		in, out := &in.RegistryStoreURI, &out.RegistryStoreURI
+		*out = new(string)
+		**out = **in
+	}
+	if in.RegistryStoreURIFrom != nil {
+		in, out := &in.RegistryStoreURIFrom, &out.RegistryStoreURIFrom
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Registry != nil {
+		in, out := &in.Registry, &out.Registry
+		*out = new(RegistryConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ArtifactsDestination != nil {
+		in, out := &in.ArtifactsDestination, &out.ArtifactsDestination
+		*out = new(string)
+		**out = **in
+	}
+	if in.DefaultArtifactRoot != nil {
+		in, out := &in.DefaultArtifactRoot, &out.DefaultArtifactRoot
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServeArtifacts != nil {
+		in, out := &in.ServeArtifacts, &out.ServeArtifacts
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Server != nil {
+		in, out := &in.Server, &out.Server
+		*out = new(ServerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ArtifactsServer != nil {
+		in, out := &in.ArtifactsServer, &out.ArtifactsServer
+		*out = new(ArtifactsServerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Artifacts != nil {
+		in, out := &in.Artifacts, &out.Artifacts
+		*out = new(ArtifactsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ExtraAllowedOrigins != nil {
+		in, out := &in.ExtraAllowedOrigins, &out.ExtraAllowedOrigins
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Workspaces != nil {
+		in, out := &in.Workspaces, &out.Workspaces
+		*out = new(WorkspacesConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkspaceLabelSelector != nil {
+		in, out := &in.WorkspaceLabelSelector, &out.WorkspaceLabelSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]corev1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EnvFrom != nil {
+		in, out := &in.EnvFrom, &out.EnvFrom
+		*out = make([]corev1.EnvFromSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CommonLabels != nil {
+		in, out := &in.CommonLabels, &out.CommonLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.PodLabels != nil {
+		in, out := &in.PodLabels, &out.PodLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.PodAnnotations != nil {
+		in, out := &in.PodAnnotations, &out.PodAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.PropagateLabels != nil {
+		in, out := &in.PropagateLabels, &out.PropagateLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PropagateAnnotations != nil {
+		in, out := &in.PropagateAnnotations, &out.PropagateAnnotations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExtraManifests != nil {
+		in, out := &in.ExtraManifests, &out.ExtraManifests
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ValuesFrom != nil {
+		in, out := &in.ValuesFrom, &out.ValuesFrom
+		*out = make([]ValuesReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.PodSecurityContext != nil {
+		in, out := &in.PodSecurityContext, &out.PodSecurityContext
+		*out = new(corev1.PodSecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityContext != nil {
+		in, out := &in.SecurityContext, &out.SecurityContext
+		*out = new(corev1.SecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(corev1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HighAvailability != nil {
+		in, out := &in.HighAvailability, &out.HighAvailability
+		*out = new(HighAvailabilityConfig)
+		**out = **in
+	}
+	if in.DeepHealthCheck != nil {
+		in, out := &in.DeepHealthCheck, &out.DeepHealthCheck
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExternalReachabilityCheck != nil {
+		in, out := &in.ExternalReachabilityCheck, &out.ExternalReachabilityCheck
+		*out = new(ExternalReachabilityCheckConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UsageStatistics != nil {
+		in, out := &in.UsageStatistics, &out.UsageStatistics
+		*out = new(UsageStatisticsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Bootstrap != nil {
+		in, out := &in.Bootstrap, &out.Bootstrap
+		*out = new(BootstrapConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Fleet != nil {
+		in, out := &in.Fleet, &out.Fleet
+		*out = new(FleetConfig)
+		**out = **in
+	}
+	if in.UpgradeStrategy != nil {
+		in, out := &in.UpgradeStrategy, &out.UpgradeStrategy
+		*out = new(UpgradeStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RevisionHistoryLimit != nil {
+		in, out := &in.RevisionHistoryLimit, &out.RevisionHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AccessRoles != nil {
+		in, out := &in.AccessRoles, &out.AccessRoles
+		*out = new(AccessRolesConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientCredentials != nil {
+		in, out := &in.ClientCredentials, &out.ClientCredentials
+		*out = new(ClientCredentialsConfig)
+		**out = **in
+	}
+	if in.WorkspaceArtifactCredentials != nil {
+		in, out := &in.WorkspaceArtifactCredentials, &out.WorkspaceArtifactCredentials
+		*out = new(WorkspaceArtifactCredentialsConfig)
+		**out = **in
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = new(MetricsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Alerts != nil {
+		in, out := &in.Alerts, &out.Alerts
+		*out = new(AlertsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = new(NotificationsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceMesh != nil {
+		in, out := &in.ServiceMesh, &out.ServiceMesh
+		*out = new(ServiceMeshConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SystemMetrics != nil {
+		in, out := &in.SystemMetrics, &out.SystemMetrics
+		*out = new(SystemMetricsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tracing != nil {
+		in, out := &in.Tracing, &out.Tracing
+		*out = new(TracingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceClaims != nil {
+		in, out := &in.ResourceClaims, &out.ResourceClaims
+		*out = make([]corev1.PodResourceClaim, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CABundleConfigMap != nil {
+		in, out := &in.CABundleConfigMap, &out.CABundleConfigMap
+		*out = new(CABundleConfigMapSpec)
+		**out = **in
+	}
+	if in.NetworkPolicyEgressRules != nil {
+		in, out := &in.NetworkPolicyEgressRules, &out.NetworkPolicyEgressRules
+		*out = make([]networkingv1.NetworkPolicyEgressRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NetworkPolicyAdditionalEgressRules != nil {
+		in, out := &in.NetworkPolicyAdditionalEgressRules, &out.NetworkPolicyAdditionalEgressRules
+		*out = make([]networkingv1.NetworkPolicyEgressRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.GarbageCollection != nil {
+		in, out := &in.GarbageCollection, &out.GarbageCollection
+		*out = new(GarbageCollectionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(RetentionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConsoleLink != nil {
+		in, out := &in.ConsoleLink, &out.ConsoleLink
+		*out = new(ConsoleLinkConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConsolePlugin != nil {
+		in, out := &in.ConsolePlugin, &out.ConsolePlugin
+		*out = new(ConsolePluginConfig)
+		**out = **in
+	}
+	if in.Routing != nil {
+		in, out := &in.Routing, &out.Routing
+		*out = new(RoutingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(ServiceConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLflowSpec.
+func (in *MLflowSpec) DeepCopy() *MLflowSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MLflowSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLflowStatus) DeepCopyInto(out *MLflowStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Gateway != nil {
+		in, out := &in.Gateway, &out.Gateway
+		*out = new(SelectedGateway)
+		**out = **in
+	}
+	if in.Address != nil {
+		in, out := &in.Address, &out.Address
+		*out = new(MLflowAddressStatus)
+		**out = **in
+	}
+	if in.LastAppliedTime != nil {
+		in, out := &in.LastAppliedTime, &out.LastAppliedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Inventory != nil {
+		in, out := &in.Inventory, &out.Inventory
+		*out = make([]ManagedResource, len(*in))
+		copy(*out, *in)
+	}
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(CanaryStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BlueGreen != nil {
+		in, out := &in.BlueGreen, &out.BlueGreen
+		*out = new(BlueGreenStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SQLiteMigration != nil {
+		in, out := &in.SQLiteMigration, &out.SQLiteMigration
+		*out = new(SQLiteMigrationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Fleet != nil {
+		in, out := &in.Fleet, &out.Fleet
+		*out = make([]FleetClusterStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Usage != nil {
+		in, out := &in.Usage, &out.Usage
+		*out = new(UsageStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLflowStatus.
+func (in *MLflowStatus) DeepCopy() *MLflowStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MLflowStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedResource) DeepCopyInto(out *ManagedResource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedResource.
+func (in *ManagedResource) DeepCopy() *ManagedResource {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricsConfig) DeepCopyInto(out *MetricsConfig) {
+	*out = *in
+	if in.PodMonitor != nil {
+		in, out := &in.PodMonitor, &out.PodMonitor
+		*out = new(PodMonitorConfig)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricsConfig.
+func (in *MetricsConfig) DeepCopy() *MetricsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationsConfig) DeepCopyInto(out *NotificationsConfig) {
+	*out = *in
+	in.WebhookURLFrom.DeepCopyInto(&out.WebhookURLFrom)
+	if in.Format != nil {
+		in, out := &in.Format, &out.Format
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationsConfig.
+func (in *NotificationsConfig) DeepCopy() *NotificationsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodMonitorConfig) DeepCopyInto(out *PodMonitorConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodMonitorConfig.
+func (in *PodMonitorConfig) DeepCopy() *PodMonitorConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PodMonitorConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryConfig) DeepCopyInto(out *RegistryConfig) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryConfig.
+func (in *RegistryConfig) DeepCopy() *RegistryConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetentionSpec) DeepCopyInto(out *RetentionSpec) {
+	*out = *in
+	if in.MaxRunAge != nil {
+		in, out := &in.MaxRunAge, &out.MaxRunAge
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeletedRunPurgeAfter != nil {
+		in, out := &in.DeletedRunPurgeAfter, &out.DeletedRunPurgeAfter
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetentionSpec.
+func (in *RetentionSpec) DeepCopy() *RetentionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RetentionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RollingUpdateStrategy) DeepCopyInto(out *RollingUpdateStrategy) {
+	*out = *in
+	if in.MaxSurge != nil {
+		in, out := &in.MaxSurge, &out.MaxSurge
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollingUpdateStrategy.
+func (in *RollingUpdateStrategy) DeepCopy() *RollingUpdateStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(RollingUpdateStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoutingConfig) DeepCopyInto(out *RoutingConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Gateways != nil {
+		in, out := &in.Gateways, &out.Gateways
+		*out = make([]GatewayReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Hostnames != nil {
+		in, out := &in.Hostnames, &out.Hostnames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TrafficSplit != nil {
+		in, out := &in.TrafficSplit, &out.TrafficSplit
+		*out = new(TrafficSplitConfig)
+		**out = **in
+	}
+	if in.CustomDomain != nil {
+		in, out := &in.CustomDomain, &out.CustomDomain
+		*out = new(CustomDomainConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingConfig.
+func (in *RoutingConfig) DeepCopy() *RoutingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RoutingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3ArtifactsConfig) DeepCopyInto(out *S3ArtifactsConfig) {
+	*out = *in
+	if in.EndpointURL != nil {
+		in, out := &in.EndpointURL, &out.EndpointURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.PathStyle != nil {
+		in, out := &in.PathStyle, &out.PathStyle
+		*out = new(bool)
+		**out = **in
+	}
+	if in.InsecureSkipTLSVerify != nil {
+		in, out := &in.InsecureSkipTLSVerify, &out.InsecureSkipTLSVerify
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3ArtifactsConfig.
+func (in *S3ArtifactsConfig) DeepCopy() *S3ArtifactsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(S3ArtifactsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SQLiteMigrationStatus) DeepCopyInto(out *SQLiteMigrationStatus) {
+	*out = *in
+	in.StartedTime.DeepCopyInto(&out.StartedTime)
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SQLiteMigrationStatus.
+func (in *SQLiteMigrationStatus) DeepCopy() *SQLiteMigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SQLiteMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretReference) DeepCopyInto(out *SecretReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretReference.
+func (in *SecretReference) DeepCopy() *SecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SelectedGateway) DeepCopyInto(out *SelectedGateway) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelectedGateway.
+func (in *SelectedGateway) DeepCopy() *SelectedGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(SelectedGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerConfig) DeepCopyInto(out *ServerConfig) {
+	*out = *in
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = new(ServerLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(ServerLogging)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(ServerRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExternalURL != nil {
+		in, out := &in.ExternalURL, &out.ExternalURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProxyHeaders != nil {
+		in, out := &in.ProxyHeaders, &out.ProxyHeaders
+		*out = new(ServerProxyHeaders)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerConfig.
+func (in *ServerConfig) DeepCopy() *ServerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerLimits) DeepCopyInto(out *ServerLimits) {
+	*out = *in
+	if in.RequestTimeoutSeconds != nil {
+		in, out := &in.RequestTimeoutSeconds, &out.RequestTimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.KeepAliveTimeoutSeconds != nil {
+		in, out := &in.KeepAliveTimeoutSeconds, &out.KeepAliveTimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerLimits.
+func (in *ServerLimits) DeepCopy() *ServerLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerLogging) DeepCopyInto(out *ServerLogging) {
+	*out = *in
+	if in.AccessLog != nil {
+		in, out := &in.AccessLog, &out.AccessLog
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Collection != nil {
+		in, out := &in.Collection, &out.Collection
+		*out = new(LogCollectionConfig)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerLogging.
+func (in *ServerLogging) DeepCopy() *ServerLogging {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerLogging)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerProxyHeaders) DeepCopyInto(out *ServerProxyHeaders) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ForwardedAllowIPs != nil {
+		in, out := &in.ForwardedAllowIPs, &out.ForwardedAllowIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerProxyHeaders.
+func (in *ServerProxyHeaders) DeepCopy() *ServerProxyHeaders {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerProxyHeaders)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerRateLimit) DeepCopyInto(out *ServerRateLimit) {
+	*out = *in
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerRateLimit.
+func (in *ServerRateLimit) DeepCopy() *ServerRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountConfig) DeepCopyInto(out *ServiceAccountConfig) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountConfig.
+func (in *ServiceAccountConfig) DeepCopy() *ServiceAccountConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceConfig) DeepCopyInto(out *ServiceConfig) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(corev1.ServiceType)
+		**out = **in
+	}
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(LoadBalancerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SessionAffinity != nil {
+		in, out := &in.SessionAffinity, &out.SessionAffinity
+		*out = new(corev1.ServiceAffinity)
+		**out = **in
+	}
+	if in.SessionAffinityTimeoutSeconds != nil {
+		in, out := &in.SessionAffinityTimeoutSeconds, &out.SessionAffinityTimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceConfig.
+func (in *ServiceConfig) DeepCopy() *ServiceConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceMeshConfig) DeepCopyInto(out *ServiceMeshConfig) {
+	*out = *in
+	if in.MTLSMode != nil {
+		in, out := &in.MTLSMode, &out.MTLSMode
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceMeshConfig.
+func (in *ServiceMeshConfig) DeepCopy() *ServiceMeshConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceMeshConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StartupProbeConfig) DeepCopyInto(out *StartupProbeConfig) {
+	*out = *in
+	if in.PeriodSeconds != nil {
+		in, out := &in.PeriodSeconds, &out.PeriodSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FailureThreshold != nil {
+		in, out := &in.FailureThreshold, &out.FailureThreshold
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StartupProbeConfig.
+func (in *StartupProbeConfig) DeepCopy() *StartupProbeConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(StartupProbeConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageConfig) DeepCopyInto(out *StorageConfig) {
+	*out = *in
+	in.PersistentVolumeClaimSpec.DeepCopyInto(&out.PersistentVolumeClaimSpec)
+	if in.EphemeralSizeLimit != nil {
+		in, out := &in.EphemeralSizeLimit, &out.EphemeralSizeLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Database != nil {
+		in, out := &in.Database, &out.Database
+		*out = new(StorageVolume)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Artifacts != nil {
+		in, out := &in.Artifacts, &out.Artifacts
+		*out = new(StorageVolume)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageConfig.
+func (in *StorageConfig) DeepCopy() *StorageConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageVolume) DeepCopyInto(out *StorageVolume) {
+	*out = *in
+	in.PersistentVolumeClaimSpec.DeepCopyInto(&out.PersistentVolumeClaimSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageVolume.
+func (in *StorageVolume) DeepCopy() *StorageVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemMetricsConfig) DeepCopyInto(out *SystemMetricsConfig) {
+	*out = *in
+	if in.SamplingIntervalSeconds != nil {
+		in, out := &in.SamplingIntervalSeconds, &out.SamplingIntervalSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SamplesBeforeLogging != nil {
+		in, out := &in.SamplesBeforeLogging, &out.SamplesBeforeLogging
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemMetricsConfig.
+func (in *SystemMetricsConfig) DeepCopy() *SystemMetricsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemMetricsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TracingCollectorConfig) DeepCopyInto(out *TracingCollectorConfig) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracingCollectorConfig.
+func (in *TracingCollectorConfig) DeepCopy() *TracingCollectorConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TracingCollectorConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TracingConfig) DeepCopyInto(out *TracingConfig) {
+	*out = *in
+	if in.OTLPEndpoint != nil {
+		in, out := &in.OTLPEndpoint, &out.OTLPEndpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(string)
+		**out = **in
+	}
+	if in.Sampler != nil {
+		in, out := &in.Sampler, &out.Sampler
+		*out = new(TracingSamplerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Collector != nil {
+		in, out := &in.Collector, &out.Collector
+		*out = new(TracingCollectorConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracingConfig.
+func (in *TracingConfig) DeepCopy() *TracingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TracingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TracingSamplerConfig) DeepCopyInto(out *TracingSamplerConfig) {
+	*out = *in
+	if in.Ratio != nil {
+		in, out := &in.Ratio, &out.Ratio
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracingSamplerConfig.
+func (in *TracingSamplerConfig) DeepCopy() *TracingSamplerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TracingSamplerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficSplitConfig) DeepCopyInto(out *TrafficSplitConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficSplitConfig.
+func (in *TrafficSplitConfig) DeepCopy() *TrafficSplitConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficSplitConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeStrategy) DeepCopyInto(out *UpgradeStrategy) {
+	*out = *in
+	if in.RollingUpdate != nil {
+		in, out := &in.RollingUpdate, &out.RollingUpdate
+		*out = new(RollingUpdateStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(CanaryStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BlueGreen != nil {
+		in, out := &in.BlueGreen, &out.BlueGreen
+		*out = new(BlueGreenStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeStrategy.
+func (in *UpgradeStrategy) DeepCopy() *UpgradeStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UsageStatisticsConfig) DeepCopyInto(out *UsageStatisticsConfig) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UsageStatisticsConfig.
+func (in *UsageStatisticsConfig) DeepCopy() *UsageStatisticsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(UsageStatisticsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UsageStatus) DeepCopyInto(out *UsageStatus) {
+	*out = *in
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UsageStatus.
+func (in *UsageStatus) DeepCopy() *UsageStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(UsageStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValuesReference) DeepCopyInto(out *ValuesReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValuesReference.
+func (in *ValuesReference) DeepCopy() *ValuesReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ValuesReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkspaceArtifactCredentialsConfig) DeepCopyInto(out *WorkspaceArtifactCredentialsConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceArtifactCredentialsConfig.
+func (in *WorkspaceArtifactCredentialsConfig) DeepCopy() *WorkspaceArtifactCredentialsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkspaceArtifactCredentialsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkspacesConfig) DeepCopyInto(out *WorkspacesConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.StoreURI != nil {
+		in, out := &in.StoreURI, &out.StoreURI
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspacesConfig.
+func (in *WorkspacesConfig) DeepCopy() *WorkspacesConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkspacesConfig)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -0,0 +1,77 @@
+"""Soft-delete MLflow runs older than the configured retention age.
+
+Runs the retention step of the garbage collection CronJob before `mlflow gc`. Runs are
+deleted through the tracking server API, so they stay restorable until `mlflow gc` purges
+them according to its --older-than setting.
+
+Environment:
+  MLFLOW_TRACKING_URI                   - tracking server URL
+  MLFLOW_RETENTION_MAX_RUN_AGE_SECONDS  - runs that started at least this long ago are deleted
+  MLFLOW_ENABLE_WORKSPACES              - "true" to walk every workspace the job can see
+"""
+
+import os
+import sys
+import time
+
+import mlflow
+from mlflow.entities import ViewType
+from mlflow.tracking import MlflowClient
+from mlflow.tracking._tracking_service.utils import _get_default_host_creds
+from mlflow.utils.rest_utils import http_request
+
+PAGE_SIZE = 1000
+
+
+def list_workspaces():
+    if os.environ.get("MLFLOW_ENABLE_WORKSPACES") != "true":
+        return [None]
+    host_creds = _get_default_host_creds(mlflow.get_tracking_uri())
+    response = http_request(host_creds, "/api/3.0/mlflow/workspaces", "GET")
+    response.raise_for_status()
+    return [workspace["name"] for workspace in response.json().get("workspaces", [])]
+
+
+def paged(search, **kwargs):
+    page_token = None
+    while True:
+        page = search(page_token=page_token, **kwargs)
+        yield from page
+        page_token = page.token
+        if not page_token:
+            return
+
+
+def archive_runs(client, cutoff_ms):
+    deleted = 0
+    for experiment in paged(client.search_experiments, view_type=ViewType.ACTIVE_ONLY):
+        runs = paged(
+            client.search_runs,
+            experiment_ids=[experiment.experiment_id],
+            filter_string=f"attributes.start_time < {cutoff_ms}",
+            run_view_type=ViewType.ACTIVE_ONLY,
+            max_results=PAGE_SIZE,
+        )
+        # Collect first so deleting does not shift the pages being read.
+        for run_id in [run.info.run_id for run in runs]:
+            client.delete_run(run_id)
+            deleted += 1
+    return deleted
+
+
+def main():
+    max_age_seconds = int(os.environ["MLFLOW_RETENTION_MAX_RUN_AGE_SECONDS"])
+    cutoff_ms = int((time.time() - max_age_seconds) * 1000)
+    client = MlflowClient()
+    total = 0
+    for workspace in list_workspaces():
+        if workspace is not None:
+            mlflow.set_workspace(workspace)
+        deleted = archive_runs(client, cutoff_ms)
+        print(f"workspace={workspace or 'default'} deleted_runs={deleted}", flush=True)
+        total += deleted
+    print(f"retention complete: deleted {total} runs started before {cutoff_ms}", flush=True)
+
+
+if __name__ == "__main__":
+    sys.exit(main())
@@ -0,0 +1,68 @@
+{{/*
+S3 client settings of the artifact store, shared by the server and garbage collection containers.
+Usage: {{ include "mlflow.s3Env" . | nindent 12 }}
+*/}}
+{{- define "mlflow.s3Env" -}}
+{{- if .Values.mlflow.s3EndpointUrl }}
+- name: MLFLOW_S3_ENDPOINT_URL
+  value: {{ .Values.mlflow.s3EndpointUrl | quote }}
+{{- end }}
+{{- if .Values.mlflow.s3AddressingStyle }}
+- name: MLFLOW_BOTO_CLIENT_ADDRESSING_STYLE
+  value: {{ .Values.mlflow.s3AddressingStyle | quote }}
+{{- end }}
+{{- end -}}
+
+{{/*
+Environment shared by the garbage collection CronJob containers.
+Usage: {{ include "mlflow.gcEnv" . | nindent 16 }}
+*/}}
+{{- define "mlflow.gcEnv" -}}
+- name: MLFLOW_DISABLE_TELEMETRY
+  value: "true"
+{{- if .Values.mlflow.enableWorkspaces }}
+- name: MLFLOW_ENABLE_WORKSPACES
+  value: "true"
+- name: MLFLOW_WORKSPACE_STORE_URI
+  value: {{ .Values.mlflow.workspaceStoreUri | quote }}
+{{- if .Values.mlflow.workspaceLabelSelector }}
+- name: MLFLOW_K8S_WORKSPACE_LABEL_SELECTOR
+  value: {{ .Values.mlflow.workspaceLabelSelector | quote }}
+{{- end }}
+{{- end }}
+- name: MLFLOW_BACKEND_STORE_URI
+  {{- if .Values.mlflow.backendStoreUriFrom }}
+  valueFrom:
+    {{- toYaml .Values.mlflow.backendStoreUriFrom | nindent 4 }}
+  {{- else }}
+  value: {{ .Values.mlflow.backendStoreUri | quote }}
+  {{- end }}
+- name: MLFLOW_TRACKING_URI
+  value: "https://mlflow{{ .Values.resourceSuffix }}.{{ .Values.namespace }}.svc:{{ .Values.mlflow.port }}{{ .Values.mlflow.staticPrefix | trimSuffix "/" }}"
+- name: MLFLOW_TRACKING_INSECURE_TLS
+  value: "false"
+- name: MLFLOW_TRACKING_AUTH
+  value: "kubernetes"
+{{- include "mlflow.s3Env" . }}
+{{- include "mlflow.proxyEnv" . }}
+{{- if .Values.caBundle.configMaps }}
+- name: SSL_CERT_FILE
+  value: {{ .Values.caBundle.outputPath | quote }}
+- name: REQUESTS_CA_BUNDLE
+  value: {{ .Values.caBundle.outputPath | quote }}
+- name: CURL_CA_BUNDLE
+  value: {{ .Values.caBundle.outputPath | quote }}
+- name: AWS_CA_BUNDLE
+  value: {{ .Values.caBundle.outputPath | quote }}
+- name: PGSSLROOTCERT
+  value: {{ .Values.caBundle.outputPath | quote }}
+- name: PGSSLMODE
+  value: "verify-full"
+- name: MLFLOW_MYSQL_CA
+  value: {{ .Values.caBundle.outputPath | quote }}
+{{- end }}
+{{- if or .Values.caBundle.configMaps .Values.mlflow.s3IgnoreTLS }}
+- name: MLFLOW_S3_IGNORE_TLS
+  value: {{ .Values.mlflow.s3IgnoreTLS | default false | quote }}
+{{- end }}
+{{- end -}}
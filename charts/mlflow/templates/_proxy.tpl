@@ -0,0 +1,18 @@
+{{/*
+Cluster-wide egress proxy settings, shared by the server and garbage collection containers.
+Usage: {{ include "mlflow.proxyEnv" . | nindent 12 }}
+*/}}
+{{- define "mlflow.proxyEnv" -}}
+{{- with .Values.proxy.httpProxy }}
+- name: HTTP_PROXY
+  value: {{ . | quote }}
+{{- end }}
+{{- with .Values.proxy.httpsProxy }}
+- name: HTTPS_PROXY
+  value: {{ . | quote }}
+{{- end }}
+{{- with .Values.proxy.noProxy }}
+- name: NO_PROXY
+  value: {{ . | quote }}
+{{- end }}
+{{- end -}}
@@ -0,0 +1,94 @@
+{{/*
+Name of the PersistentVolumeClaim mounted at /mlflow: storage.existingClaim when set,
+otherwise the chart-managed claim.
+Usage: {{ include "mlflow.storageClaimName" . }}
+*/}}
+{{- define "mlflow.storageClaimName" -}}
+{{- .Values.storage.existingClaim | default (printf "mlflow-pvc%s" .Values.resourceSuffix) -}}
+{{- end }}
+
+{{/*
+Name of the claim behind a separate storage volume.
+Usage: {{ include "mlflow.storageVolumeClaimName" (dict "volume" .Values.storage.database "name" "db" "root" $) }}
+*/}}
+{{- define "mlflow.storageVolumeClaimName" -}}
+{{- .volume.existingClaim | default (printf "mlflow-%s-pvc%s" .name .root.Values.resourceSuffix) -}}
+{{- end }}
+
+{{/*
+Non-empty when a volume is mounted at /mlflow: storage is enabled and either an existing
+claim is referenced or the database and artifacts do not both have their own volume.
+Usage: {{ if include "mlflow.storageRootVolume" . }}
+*/}}
+{{- define "mlflow.storageRootVolume" -}}
+{{- if and .Values.storage.enabled (or .Values.storage.existingClaim (not (and .Values.storage.database.enabled .Values.storage.artifacts.enabled))) -}}
+true
+{{- end -}}
+{{- end }}
+
+{{/*
+Names of all mounted storage claims, for matching kubelet volume metrics. Empty when no PVC
+is mounted, e.g. with ephemeral storage.
+Usage: {{ include "mlflow.storageClaimNames" . }}
+*/}}
+{{- define "mlflow.storageClaimNames" -}}
+{{- $names := list -}}
+{{- if and (include "mlflow.storageRootVolume" .) (not .Values.storage.ephemeral) -}}
+{{- $names = append $names (include "mlflow.storageClaimName" .) -}}
+{{- end -}}
+{{- if .Values.storage.database.enabled -}}
+{{- $names = append $names (include "mlflow.storageVolumeClaimName" (dict "volume" .Values.storage.database "name" "db" "root" .)) -}}
+{{- end -}}
+{{- if .Values.storage.artifacts.enabled -}}
+{{- $names = append $names (include "mlflow.storageVolumeClaimName" (dict "volume" .Values.storage.artifacts "name" "artifacts" "root" .)) -}}
+{{- end -}}
+{{- join "|" $names -}}
+{{- end }}
+
+{{/*
+Storage volumes of the MLflow pod and the GC CronJob.
+Usage: {{- include "mlflow.storageVolumes" . | nindent 8 }}
+*/}}
+{{- define "mlflow.storageVolumes" -}}
+{{- if .Values.storage.ephemeral }}
+- name: mlflow-storage
+  emptyDir:
+    {{- with .Values.storage.ephemeralSizeLimit }}
+    sizeLimit: {{ . }}
+    {{- end }}
+{{- else if include "mlflow.storageRootVolume" . }}
+- name: mlflow-storage
+  persistentVolumeClaim:
+    claimName: {{ include "mlflow.storageClaimName" . }}
+{{- end }}
+{{- if .Values.storage.database.enabled }}
+- name: mlflow-storage-db
+  persistentVolumeClaim:
+    claimName: {{ include "mlflow.storageVolumeClaimName" (dict "volume" .Values.storage.database "name" "db" "root" .) }}
+{{- end }}
+{{- if .Values.storage.artifacts.enabled }}
+- name: mlflow-storage-artifacts
+  persistentVolumeClaim:
+    claimName: {{ include "mlflow.storageVolumeClaimName" (dict "volume" .Values.storage.artifacts "name" "artifacts" "root" .) }}
+{{- end }}
+{{- end }}
+
+{{/*
+Storage volume mounts. The database and artifacts volumes nest under /mlflow so the default
+file and SQLite URIs keep working next to a root volume.
+Usage: {{- include "mlflow.storageVolumeMounts" . | nindent 12 }}
+*/}}
+{{- define "mlflow.storageVolumeMounts" -}}
+{{- if include "mlflow.storageRootVolume" . }}
+- name: mlflow-storage
+  mountPath: /mlflow
+{{- end }}
+{{- if .Values.storage.database.enabled }}
+- name: mlflow-storage-db
+  mountPath: /mlflow/db
+{{- end }}
+{{- if .Values.storage.artifacts.enabled }}
+- name: mlflow-storage-artifacts
+  mountPath: /mlflow/artifacts
+{{- end }}
+{{- end }}
@@ -0,0 +1,34 @@
+{{/*
+OpenTelemetry Collector configuration of the tracing sidecar. It is passed in an environment
+variable, so a change rolls the pods like any other template change.
+Usage: {{ include "mlflow.otelCollectorConfig" . | quote }}
+*/}}
+{{- define "mlflow.otelCollectorConfig" -}}
+{{- $exporter := ternary "otlphttp" "otlp" (eq .Values.tracing.protocol "http/protobuf") -}}
+receivers:
+  otlp:
+    protocols:
+      grpc:
+        endpoint: 127.0.0.1:4317
+processors:
+  batch: {}
+exporters:
+  {{ $exporter }}:
+    endpoint: {{ .Values.tracing.endpoint | quote }}
+    {{- if .Values.tracing.insecure }}
+    tls:
+      insecure: true
+    {{- else if .Values.caBundle.configMaps }}
+    tls:
+      ca_file: {{ .Values.caBundle.outputPath | quote }}
+    {{- end }}
+service:
+  telemetry:
+    metrics:
+      level: none
+  pipelines:
+    traces:
+      receivers: [otlp]
+      processors: [batch]
+      exporters: [{{ $exporter }}]
+{{- end -}}
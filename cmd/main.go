@@ -19,10 +19,19 @@ package main
 import (
 	"context"
 	"crypto/tls"
+	"errors"
 	"flag"
 	"fmt"
+	"net"
+	"net/http"
+	"net/url"
 	"os"
+	"path/filepath"
+	"strings"
 	"time"
+	// Embed the IANA time zone database so spec.hibernation.timeZone resolves
+	// even in base images without /usr/share/zoneinfo.
+	_ "time/tzdata"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
@@ -38,13 +47,16 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/discovery"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/leaderelection"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -52,23 +64,29 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	modulev1alpha1 "github.com/opendatahub-io/mlflow-operator/api/mlflowoperator/v1alpha1"
 	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
 	"github.com/opendatahub-io/mlflow-operator/internal/config"
 	"github.com/opendatahub-io/mlflow-operator/internal/controller"
+	mlflowwebhook "github.com/opendatahub-io/mlflow-operator/internal/webhook"
 	// +kubebuilder:scaffold:imports
 )
 
 var (
 	scheme   = runtime.NewScheme()
 	setupLog = ctrl.Log.WithName("setup")
+
+	// serviceAccountNamespaceFile is mounted into every pod with a service account token.
+	serviceAccountNamespaceFile = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
 )
 
 const (
 	defaultNamespace                  = "opendatahub"
 	mlflowOperatorCRDWaitPollInterval = 2 * time.Second
+	cacheSyncCheckTimeout             = time.Second
 )
 
 func validateStartupConfig(namespace string, cfg *config.OperatorConfig, supportedMLflowVersion string) error {
@@ -82,9 +100,93 @@ func validateStartupConfig(namespace string, cfg *config.OperatorConfig, support
 		return fmt.Errorf(
 			"SupportedMLflowVersion must be injected via build ldflags from config/component_metadata.yaml")
 	}
+	if _, err := config.ParseRegistryMirrors(os.Getenv("IMAGE_REGISTRY_MIRRORS")); err != nil {
+		return err
+	}
+	if _, err := config.ParseImageRegistry(os.Getenv("IMAGE_REGISTRY")); err != nil {
+		return err
+	}
+	if _, err := config.ParseFeatureGates(os.Getenv("FEATURE_GATES")); err != nil {
+		return err
+	}
+	if cfg.Namespaced() {
+		if strings.Contains(cfg.WatchNamespace, ",") {
+			return fmt.Errorf("WATCH_NAMESPACE takes a single namespace, got %q", cfg.WatchNamespace)
+		}
+		if len(cfg.WatchNamespaces) > 0 {
+			return fmt.Errorf(
+				"WATCH_NAMESPACES cannot be combined with WATCH_NAMESPACE: namespaced install mode has no workspaces")
+		}
+		if cfg.EnableMLflowOperatorModuleController {
+			return fmt.Errorf("ENABLE_MLFLOW_OPERATOR_MODULE_CONTROLLER cannot be combined with WATCH_NAMESPACE")
+		}
+	}
+	if cfg.TelemetryEndpoint != "" {
+		endpoint, err := url.Parse(cfg.TelemetryEndpoint)
+		if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+			return fmt.Errorf("TELEMETRY_ENDPOINT must be an absolute http or https URL, got %q", cfg.TelemetryEndpoint)
+		}
+		if cfg.TelemetryInterval <= 0 {
+			return fmt.Errorf("TELEMETRY_INTERVAL must be a positive duration")
+		}
+	}
+	return nil
+}
+
+// gateFeature returns available unless the feature gate turns the subsystem off, so a
+// disabled gate behaves as if discovery had not found the API.
+func gateFeature(cfg *config.OperatorConfig, gate string, available bool) bool {
+	if available && !cfg.FeatureEnabled(gate) {
+		setupLog.Info("Feature disabled by FEATURE_GATES", "gate", gate)
+		return false
+	}
+	return available
+}
+
+// gateClusterScoped returns available unless the operator runs in namespaced install mode,
+// where subsystems that read or write outside the operator namespace are treated as if
+// discovery had not found their API.
+func gateClusterScoped(cfg *config.OperatorConfig, subsystem string, available bool) bool {
+	if available && cfg.Namespaced() {
+		setupLog.Info("Cluster-scoped integration disabled in namespaced install mode", "subsystem", subsystem)
+		return false
+	}
+	return available
+}
+
+// validateLeaderElectionTiming applies the constraints client-go enforces on the leader
+// lease at startup, so bad flag values fail before the manager is built.
+func validateLeaderElectionTiming(leaseDuration, renewDeadline, retryPeriod time.Duration) error {
+	if retryPeriod <= 0 {
+		return fmt.Errorf("--leader-elect-retry-period must be positive")
+	}
+	if leaseDuration <= renewDeadline {
+		return fmt.Errorf("--leader-elect-lease-duration (%s) must be greater than --leader-elect-renew-deadline (%s)",
+			leaseDuration, renewDeadline)
+	}
+	if float64(renewDeadline) <= leaderelection.JitterFactor*float64(retryPeriod) {
+		return fmt.Errorf(
+			"--leader-elect-renew-deadline (%s) must be greater than %.1f times --leader-elect-retry-period (%s)",
+			renewDeadline, leaderelection.JitterFactor, retryPeriod)
+	}
 	return nil
 }
 
+// cacheSyncCheck reports ready once every cache has synced. A replica that is not the leader
+// keeps its informers running, so it passes the check while standing by.
+func cacheSyncCheck(caches ...cache.Cache) healthz.Checker {
+	return func(req *http.Request) error {
+		ctx, cancel := context.WithTimeout(req.Context(), cacheSyncCheckTimeout)
+		defer cancel()
+		for _, c := range caches {
+			if !c.WaitForCacheSync(ctx) {
+				return fmt.Errorf("informer caches have not synced")
+			}
+		}
+		return nil
+	}
+}
+
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(modulev1alpha1.AddToScheme(scheme))
@@ -96,14 +198,55 @@ func init() {
 	// +kubebuilder:scaffold:scheme
 }
 
+// inferPodNamespace returns the operator's own namespace: POD_NAMESPACE from the downward
+// API, then the mounted service account namespace for installs, such as OLM bundles, that do
+// not set it, then defaultNamespace outside a cluster.
 func inferPodNamespace() string {
 	if ns := os.Getenv("POD_NAMESPACE"); ns != "" {
 		return ns
 	}
+	if content, err := os.ReadFile(serviceAccountNamespaceFile); err == nil {
+		if ns := strings.TrimSpace(string(content)); ns != "" {
+			return ns
+		}
+	}
 	return defaultNamespace
 }
 
+// findChartDir returns charts/<chart> in start or the nearest parent directory that has it,
+// so --dev works from any directory of the source tree.
+func findChartDir(start, chart string) (string, error) {
+	for dir := start; ; {
+		candidate := filepath.Join(dir, "charts", chart)
+		if _, err := os.Stat(filepath.Join(candidate, "Chart.yaml")); err == nil {
+			return candidate, nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", fmt.Errorf("chart %s not found under charts/ in %s or any parent directory", chart, start)
+		}
+		dir = parent
+	}
+}
+
+// isLoopbackBindAddress reports whether the host:port address only accepts connections from
+// inside the pod. An empty host binds every interface.
+func isLoopbackBindAddress(address string) bool {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
 func resolveManagerNamespace(namespace string, operatorConfig *config.OperatorConfig) string {
+	if operatorConfig != nil && operatorConfig.Namespaced() {
+		return operatorConfig.WatchNamespace
+	}
 	if operatorConfig != nil &&
 		operatorConfig.EnableMLflowOperatorModuleController &&
 		operatorConfig.ApplicationsNamespace != "" {
@@ -167,41 +310,107 @@ func waitForMLflowOperatorCRD(
 func main() {
 	var metricsAddr string
 	var metricsCertPath, metricsCertName, metricsCertKey string
+	var webhookCertPath, webhookCertName, webhookCertKey string
 	var enableLeaderElection bool
+	var leaseDuration, renewDeadline, retryPeriod time.Duration
 	var probeAddr string
 	var secureMetrics bool
+	var logApplyDiffs bool
+	var enableDebugRender bool
+	var pprofAddr string
+	var instanceReconcileThreshold time.Duration
+	var optionalAPIInterval time.Duration
+	var devMode bool
 	var namespace string
 	var tlsOpts []func(*tls.Config)
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flag.StringVar(&namespace, "namespace", inferPodNamespace(),
-		"Target namespace for MLflow resources. Defaults to the pod's own namespace when running in-cluster.")
+		"Target namespace for MLflow resources. Defaults to the operator's own namespace when running in-cluster.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
+	// The defaults lessen the load on the Kubernetes API server and help reduce the number of
+	// restarts on the pod; shorter leases fail over faster.
+	flag.DurationVar(&leaseDuration, "leader-elect-lease-duration", 60*time.Second,
+		"How long non-leader replicas wait before taking over an unrenewed leader lease.")
+	flag.DurationVar(&renewDeadline, "leader-elect-renew-deadline", 50*time.Second,
+		"How long the leader retries renewing its lease before giving up leadership.")
+	flag.DurationVar(&retryPeriod, "leader-elect-retry-period", 15*time.Second,
+		"How long replicas wait between attempts to acquire or renew the leader lease.")
 	flag.BoolVar(&secureMetrics, "metrics-secure", true,
 		"If set, the metrics endpoint is served securely via HTTPS. Use --metrics-secure=false to use HTTP instead.")
 	flag.StringVar(&metricsCertPath, "metrics-cert-path", "",
 		"The directory that contains the metrics server certificate.")
 	flag.StringVar(&metricsCertName, "metrics-cert-name", "tls.crt", "The name of the metrics server certificate file.")
 	flag.StringVar(&metricsCertKey, "metrics-cert-key", "tls.key", "The name of the metrics server key file.")
+	flag.StringVar(&webhookCertPath, "webhook-cert-path", "",
+		"The directory that contains the webhook server certificate. Webhooks are only served when it is set.")
+	flag.StringVar(&webhookCertName, "webhook-cert-name", "tls.crt", "The name of the webhook server certificate file.")
+	flag.StringVar(&webhookCertKey, "webhook-cert-key", "tls.key", "The name of the webhook server key file.")
+	flag.BoolVar(&logApplyDiffs, "log-apply-diffs", false,
+		"If set, log and record an event with the fields each apply changes on a managed object.")
+	flag.BoolVar(&enableDebugRender, "enable-debug-render", false,
+		"If set, serve the latest Helm values and rendered manifests of each instance, with secrets redacted, "+
+			"at /debug/render on the metrics endpoint. Requires --metrics-secure.")
+	flag.StringVar(&pprofAddr, "pprof-bind-address", "0",
+		"The address the unauthenticated pprof endpoint binds to, for example 127.0.0.1:8082. "+
+			"Leave as 0 to disable it.")
+	flag.DurationVar(&instanceReconcileThreshold, "instance-reconcile-threshold", 0,
+		"If set, the leader fails the instances readiness check when an MLflow instance has not finished a "+
+			"reconcile within this duration, and re-reconciles every instance at half this interval.")
+	flag.DurationVar(&optionalAPIInterval, "optional-api-discovery-interval", time.Minute,
+		"How often to check whether the ConsoleLink or Gateway API CRDs were installed or removed since startup. "+
+			"The operator restarts to pick up a change. Set to 0 to only check at startup.")
+	flag.BoolVar(&devMode, "dev", false,
+		"Run for local development against the current kubeconfig: find the charts in the source tree, "+
+			"disable leader election and webhooks, log in development mode, and write each new render "+
+			"of an instance, redacted, to stdout.")
 	opts := zap.Options{
 		Development: false,
 	}
 	opts.BindFlags(flag.CommandLine)
 	flag.Parse()
+	if devMode {
+		opts.Development = true
+	}
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 	operatorConfig := config.GetConfig()
 	namespace = resolveManagerNamespace(namespace, operatorConfig)
 
-	if err := validateStartupConfig(namespace, operatorConfig, controller.SupportedMLflowVersion); err != nil {
+	if err := validateStartupConfig(namespace, operatorConfig, config.SupportedMLflowVersion); err != nil {
 		setupLog.Error(err, "invalid startup configuration")
 		os.Exit(1)
 	}
+	if err := validateLeaderElectionTiming(leaseDuration, renewDeadline, retryPeriod); err != nil {
+		setupLog.Error(err, "invalid leader election configuration")
+		os.Exit(1)
+	}
 	setupLog.Info("Starting operator", "targetNamespace", namespace)
 
+	mlflowChartPath, gatewayChartPath := "charts/mlflow", "charts/mlflow-gateway"
+	if devMode {
+		workingDir, err := os.Getwd()
+		if err == nil {
+			mlflowChartPath, err = findChartDir(workingDir, "mlflow")
+		}
+		if err == nil {
+			gatewayChartPath, err = findChartDir(workingDir, "mlflow-gateway")
+		}
+		if err != nil {
+			setupLog.Error(err, "--dev needs to run from inside the source tree")
+			os.Exit(1)
+		}
+		// A single local process needs neither, and webhooks would need a certificate the
+		// API server trusts.
+		enableLeaderElection = false
+		webhookCertPath = ""
+		setupLog.Info("Development mode: leader election and webhooks are disabled",
+			"chart", mlflowChartPath, "gatewayChart", gatewayChartPath)
+	}
+
 	// Fetch cluster TLS profile from apiservers.config.openshift.io/cluster
 	cfg := ctrl.GetConfigOrDie()
 	bootstrapClient, err := client.New(cfg, client.Options{Scheme: scheme})
@@ -214,34 +423,41 @@ func main() {
 	defer cancelBootstrap()
 
 	tlsProfileFetched := false
-	tlsProfile, err := tlspkg.FetchAPIServerTLSProfile(bootstrapCtx, bootstrapClient)
-	if err != nil {
-		if apierrors.IsNotFound(err) || apimeta.IsNoMatchError(err) {
-			setupLog.Info("APIServer TLS profile API unavailable, using defaults")
-		} else {
-			setupLog.Error(err, "unable to fetch APIServer TLS profile")
-			os.Exit(1)
-		}
+	tlsAdherenceFetched := false
+	var tlsProfile configv1.TLSProfileSpec
+	var tlsAdherence configv1.TLSAdherencePolicy
+	if operatorConfig.Namespaced() {
+		// The APIServer config is cluster-scoped, out of reach of namespace-scoped RBAC.
+		setupLog.Info("Namespaced install mode, skipping the APIServer TLS profile and using defaults")
 	} else {
-		tlsProfileFetched = true
-		tlsConfigFn, unsupported := tlspkg.NewTLSConfigFromProfile(tlsProfile)
-		if len(unsupported) > 0 {
-			setupLog.Info("TLS profile contains ciphers unsupported by Go", "unsupported", unsupported)
+		tlsProfile, err = tlspkg.FetchAPIServerTLSProfile(bootstrapCtx, bootstrapClient)
+		if err != nil {
+			if apierrors.IsNotFound(err) || apimeta.IsNoMatchError(err) {
+				setupLog.Info("APIServer TLS profile API unavailable, using defaults")
+			} else {
+				setupLog.Error(err, "unable to fetch APIServer TLS profile")
+				os.Exit(1)
+			}
+		} else {
+			tlsProfileFetched = true
+			tlsConfigFn, unsupported := tlspkg.NewTLSConfigFromProfile(tlsProfile)
+			if len(unsupported) > 0 {
+				setupLog.Info("TLS profile contains ciphers unsupported by Go", "unsupported", unsupported)
+			}
+			tlsOpts = append(tlsOpts, tlsConfigFn)
 		}
-		tlsOpts = append(tlsOpts, tlsConfigFn)
-	}
 
-	tlsAdherenceFetched := false
-	tlsAdherence, err := tlspkg.FetchAPIServerTLSAdherencePolicy(bootstrapCtx, bootstrapClient)
-	if err != nil {
-		if apierrors.IsNotFound(err) || apimeta.IsNoMatchError(err) {
-			setupLog.Info("APIServer TLS adherence policy unavailable")
+		tlsAdherence, err = tlspkg.FetchAPIServerTLSAdherencePolicy(bootstrapCtx, bootstrapClient)
+		if err != nil {
+			if apierrors.IsNotFound(err) || apimeta.IsNoMatchError(err) {
+				setupLog.Info("APIServer TLS adherence policy unavailable")
+			} else {
+				setupLog.Error(err, "unable to fetch APIServer TLS adherence policy")
+				os.Exit(1)
+			}
 		} else {
-			setupLog.Error(err, "unable to fetch APIServer TLS adherence policy")
-			os.Exit(1)
+			tlsAdherenceFetched = true
 		}
-	} else {
-		tlsAdherenceFetched = true
 	}
 
 	tlsOpts = append(tlsOpts, func(c *tls.Config) {
@@ -266,6 +482,35 @@ func main() {
 		metricsServerOptions.FilterProvider = filters.WithAuthenticationAndAuthorization
 	}
 
+	// The debug render endpoint shares the metrics server, and with it the authn/authz filter. It is
+	// never served without the filter since the manifests describe the deployment in detail.
+	var renderRecorder *controller.RenderRecorder
+	if devMode {
+		// Logs go to stderr, so stdout only carries the manifests.
+		renderRecorder = &controller.RenderRecorder{Output: os.Stdout}
+	}
+	if enableDebugRender {
+		if secureMetrics {
+			if renderRecorder == nil {
+				renderRecorder = &controller.RenderRecorder{}
+			}
+			metricsServerOptions.ExtraHandlers = map[string]http.Handler{controller.DebugRenderPath: renderRecorder}
+		} else {
+			setupLog.Error(nil, "--enable-debug-render requires --metrics-secure; not serving the debug render endpoint")
+		}
+	}
+
+	// pprof has no authn/authz of its own, so anything but a loopback address exposes heap
+	// contents, including rendered secrets, to whoever can reach the pod.
+	if pprofAddr != "" && pprofAddr != "0" {
+		if isLoopbackBindAddress(pprofAddr) {
+			setupLog.Info("Serving pprof", "address", pprofAddr, "path", "/debug/pprof/")
+		} else {
+			setupLog.Info("Serving unauthenticated pprof on a non-loopback address; "+
+				"bind it to 127.0.0.1 and use kubectl port-forward instead", "address", pprofAddr)
+		}
+	}
+
 	// If the certificate is not specified, controller-runtime will automatically
 	// generate self-signed certificates for the metrics server. While convenient for development and testing,
 	// this setup is not recommended for production.
@@ -277,14 +522,9 @@ func main() {
 		metricsServerOptions.KeyName = metricsCertKey
 	}
 
-	// Lease is valid for 60 seconds, but renew it after 15 seconds and then give up after 50 seconds.
-	// This will lessen the load on the Kubernetes API server and help reduce the number of restarts on the pod.
-	leaseDuration := 60 * time.Second
-	retryPeriod := 15 * time.Second
-	renewDeadline := 50 * time.Second
-
 	// Create label selector for MLflow-owned resources
 	labelSelector := labels.SelectorFromSet(labels.Set{"app": "mlflow"})
+	gcCronJobLabelSelector := labels.SelectorFromSet(labels.Set{"app": "mlflow-gc"})
 	migrationJobLabelSelector := labels.SelectorFromSet(labels.Set{controller.MigrationJobLabelKey: "true"})
 	sharedClusterRoleFieldSelector := fields.OneTermEqualSelector("metadata.name", controller.ClusterRoleName)
 	sharedClusterRoleBindingFieldSelector := fields.OneTermEqualSelector(
@@ -301,6 +541,7 @@ func main() {
 	byObjectCache := map[client.Object]cache.ByObject{
 		&appsv1.Deployment{}:            {Label: labelSelector},
 		&batchv1.Job{}:                  {Label: migrationJobLabelSelector},
+		&batchv1.CronJob{}:              {Label: gcCronJobLabelSelector},
 		&corev1.Pod{}:                   {Label: migrationJobLabelSelector},
 		&corev1.Secret{}:                {Label: labelSelector},
 		&corev1.Service{}:               {Label: labelSelector},
@@ -310,40 +551,223 @@ func main() {
 		// resourceNames-scoped RBAC for the shared server ClusterRole/ClusterRoleBinding.
 		&rbacv1.ClusterRole{}:        {Field: sharedClusterRoleFieldSelector},
 		&rbacv1.ClusterRoleBinding{}: {Field: sharedClusterRoleBindingFieldSelector},
+		// ConfigMaps stay namespace-wide: the platform CA bundle and runtime settings are watched
+		// by name and CA injection targets by their own label, which one selector cannot express.
 	}
 
 	// Conditionally add ConsoleLink to cache if available
 	consoleLinkAvailable, err := controller.IsConsoleLinkAvailable(discoveryClient)
 	if err != nil {
 		setupLog.Error(err, "Failed to check ConsoleLink availability")
-	} else if consoleLinkAvailable {
+		consoleLinkAvailable = false
+	}
+	consoleLinkAvailable = gateFeature(operatorConfig, config.FeatureConsoleLink, consoleLinkAvailable)
+	if consoleLinkAvailable {
 		setupLog.Info("ConsoleLink CRD available, adding to cache with label selector")
 		byObjectCache[&consolev1.ConsoleLink{}] = cache.ByObject{Label: labelSelector}
 	} else {
 		setupLog.Info("ConsoleLink CRD not available, skipping cache configuration")
 	}
 
+	// Conditionally add ConsolePlugin to cache if available
+	consolePluginAvailable, err := controller.IsConsolePluginAvailable(discoveryClient)
+	if err != nil {
+		setupLog.Error(err, "Failed to check ConsolePlugin availability")
+		consolePluginAvailable = false
+	}
+	consolePluginAvailable = gateClusterScoped(operatorConfig, "ConsolePlugin", consolePluginAvailable)
+	if consolePluginAvailable {
+		setupLog.Info("ConsolePlugin CRD available, adding to cache with label selector")
+		byObjectCache[&consolev1.ConsolePlugin{}] = cache.ByObject{Label: labelSelector}
+	}
+
 	// Conditionally add HTTPRoute to cache if available
 	httpRouteAvailable, err := controller.IsHTTPRouteAvailable(discoveryClient)
 	if err != nil {
 		setupLog.Error(err, "Failed to check HTTPRoute availability")
-	} else if httpRouteAvailable {
+		httpRouteAvailable = false
+	}
+	httpRouteAvailable = gateFeature(operatorConfig, config.FeatureHTTPRoute, httpRouteAvailable)
+	// The Gateways the HTTPRoute attaches to live in other namespaces.
+	httpRouteAvailable = gateClusterScoped(operatorConfig, "HTTPRoute", httpRouteAvailable)
+	if httpRouteAvailable {
 		setupLog.Info("HTTPRoute CRD available, adding to cache with label selector")
 		byObjectCache[&gatewayv1.HTTPRoute{}] = cache.ByObject{Label: labelSelector}
+		// Gateways are shared platform objects in other namespaces, read for RouteReady.
+		byObjectCache[&gatewayv1.Gateway{}] = cache.ByObject{Namespaces: map[string]cache.Config{cache.AllNamespaces: {}}}
 	} else {
 		setupLog.Info("HTTPRoute CRD not available, skipping cache configuration")
 	}
 
+	// Kuadrant RateLimitPolicies attach to the HTTPRoute, so they are only managed alongside it.
+	rateLimitPolicyAvailable, err := controller.IsRateLimitPolicyAvailable(discoveryClient)
+	if err != nil {
+		setupLog.Error(err, "Failed to check RateLimitPolicy availability")
+		rateLimitPolicyAvailable = false
+	}
+	rateLimitPolicyAvailable = rateLimitPolicyAvailable && httpRouteAvailable
+	if rateLimitPolicyAvailable {
+		setupLog.Info("RateLimitPolicy CRD available, adding to cache with label selector")
+		rateLimitPolicy := &unstructured.Unstructured{}
+		rateLimitPolicy.SetGroupVersionKind(controller.RateLimitPolicyGVK)
+		byObjectCache[rateLimitPolicy] = cache.ByObject{Label: labelSelector}
+	}
+
+	// BackendTLSPolicies secure the hop from the Gateway to the HTTPRoute backends, so they are
+	// only managed alongside it.
+	backendTLSPolicyAvailable, err := controller.IsBackendTLSPolicyAvailable(discoveryClient)
+	if err != nil {
+		setupLog.Error(err, "Failed to check BackendTLSPolicy availability")
+		backendTLSPolicyAvailable = false
+	}
+	backendTLSPolicyAvailable = backendTLSPolicyAvailable && httpRouteAvailable
+	if backendTLSPolicyAvailable {
+		setupLog.Info("BackendTLSPolicy CRD available, adding to cache with label selector")
+		byObjectCache[&gatewayv1.BackendTLSPolicy{}] = cache.ByObject{Label: labelSelector}
+	}
+
+	// On an Open Cluster Management hub, spec.fleet delivers instances through ManifestWorks.
+	// They live in the managed cluster namespaces, and the Placements they follow may be in
+	// any namespace, so both are cached cluster-wide.
+	openClusterManagementAvailable, err := controller.IsOpenClusterManagementAvailable(discoveryClient)
+	if err != nil {
+		setupLog.Error(err, "Failed to check Open Cluster Management availability")
+		openClusterManagementAvailable = false
+	}
+	openClusterManagementAvailable = gateClusterScoped(
+		operatorConfig, "OpenClusterManagement", openClusterManagementAvailable)
+	if openClusterManagementAvailable {
+		setupLog.Info("Open Cluster Management APIs available, adding ManifestWork and PlacementDecision to cache")
+		allNamespaces := map[string]cache.Config{cache.AllNamespaces: {}}
+		manifestWork := &unstructured.Unstructured{}
+		manifestWork.SetGroupVersionKind(controller.ManifestWorkGVK)
+		byObjectCache[manifestWork] = cache.ByObject{Label: labelSelector, Namespaces: allNamespaces}
+		placementDecision := &unstructured.Unstructured{}
+		placementDecision.SetGroupVersionKind(controller.PlacementDecisionGVK)
+		byObjectCache[placementDecision] = cache.ByObject{Namespaces: allNamespaces}
+	}
+
+	// Conditionally add Istio routing objects to cache when they are the active routing backend
+	// The mesh needs the Istio networking API even when the VirtualService backend is gated off.
+	istioNetworkingAvailable, err := controller.IsVirtualServiceAvailable(discoveryClient)
+	if err != nil {
+		setupLog.Error(err, "Failed to check VirtualService availability")
+		istioNetworkingAvailable = false
+	}
+	virtualServiceAvailable := gateFeature(operatorConfig, config.FeatureVirtualService, istioNetworkingAvailable)
+	virtualServiceRouting := virtualServiceAvailable && !httpRouteAvailable
+	if virtualServiceRouting {
+		setupLog.Info("VirtualService CRD available without HTTPRoute, adding Istio routing objects to cache with label selector")
+		for _, gvk := range []schema.GroupVersionKind{controller.VirtualServiceGVK, controller.DestinationRuleGVK} {
+			obj := &unstructured.Unstructured{}
+			obj.SetGroupVersionKind(gvk)
+			byObjectCache[obj] = cache.ByObject{Label: labelSelector}
+		}
+	} else {
+		setupLog.Info("Istio routing backend not selected, skipping cache configuration")
+	}
+
+	// Conditionally add Istio mesh objects to cache; spec.serviceMesh needs both the security
+	// and networking APIs.
+	peerAuthenticationAvailable, err := controller.IsPeerAuthenticationAvailable(discoveryClient)
+	if err != nil {
+		setupLog.Error(err, "Failed to check PeerAuthentication availability")
+		peerAuthenticationAvailable = false
+	}
+	peerAuthenticationAvailable = gateFeature(operatorConfig, config.FeatureServiceMesh, peerAuthenticationAvailable)
+	serviceMeshAvailable := peerAuthenticationAvailable && istioNetworkingAvailable
+	if serviceMeshAvailable {
+		setupLog.Info("Istio security APIs available, adding service mesh objects to cache with label selector")
+		gvks := []schema.GroupVersionKind{controller.PeerAuthenticationGVK}
+		if !virtualServiceRouting {
+			gvks = append(gvks, controller.DestinationRuleGVK)
+		}
+		for _, gvk := range gvks {
+			obj := &unstructured.Unstructured{}
+			obj.SetGroupVersionKind(gvk)
+			byObjectCache[obj] = cache.ByObject{Label: labelSelector}
+		}
+	} else {
+		setupLog.Info("Istio security APIs not available, skipping service mesh cache configuration")
+	}
+
 	// Conditionally add ServiceMonitor to cache if available
 	serviceMonitorAvailable, err := controller.IsServiceMonitorAvailable(discoveryClient)
 	if err != nil {
 		setupLog.Error(err, "Failed to check ServiceMonitor availability")
-	} else if serviceMonitorAvailable {
+		serviceMonitorAvailable = false
+	}
+	serviceMonitorAvailable = gateFeature(operatorConfig, config.FeatureMonitoring, serviceMonitorAvailable)
+	if serviceMonitorAvailable {
 		setupLog.Info("ServiceMonitor CRD available, adding to cache with label selector")
 		byObjectCache[&monitoringv1.ServiceMonitor{}] = cache.ByObject{Label: labelSelector}
 	} else {
 		setupLog.Info("ServiceMonitor CRD not available, skipping cache configuration")
 	}
+	podMonitorAvailable, err := controller.IsPodMonitorAvailable(discoveryClient)
+	if err != nil {
+		setupLog.Error(err, "Failed to check PodMonitor availability")
+		podMonitorAvailable = false
+	}
+	podMonitorAvailable = gateFeature(operatorConfig, config.FeatureMonitoring, podMonitorAvailable)
+	if podMonitorAvailable {
+		setupLog.Info("PodMonitor CRD available, adding to cache with label selector")
+		byObjectCache[&monitoringv1.PodMonitor{}] = cache.ByObject{Label: labelSelector}
+	}
+	prometheusRuleAvailable, err := controller.IsPrometheusRuleAvailable(discoveryClient)
+	if err != nil {
+		setupLog.Error(err, "Failed to check PrometheusRule availability")
+		prometheusRuleAvailable = false
+	}
+	prometheusRuleAvailable = gateFeature(operatorConfig, config.FeatureMonitoring, prometheusRuleAvailable)
+	if prometheusRuleAvailable {
+		setupLog.Info("PrometheusRule CRD available, adding to cache with label selector")
+		byObjectCache[&monitoringv1.PrometheusRule{}] = cache.ByObject{Label: labelSelector}
+	}
+
+	// Conditionally add MLflowConfig to cache if available. The singletons live in workspace
+	// namespaces, so unlike owned resources they are watched cluster-wide unless
+	// WATCH_NAMESPACES narrows the scan.
+	mlflowConfigAvailable, err := controller.IsMLflowConfigAvailable(discoveryClient)
+	if err != nil {
+		setupLog.Error(err, "Failed to check MLflowConfig availability")
+		mlflowConfigAvailable = false
+	}
+	mlflowConfigAvailable = gateClusterScoped(operatorConfig, "MLflowConfig", mlflowConfigAvailable)
+	if mlflowConfigAvailable {
+		mlflowConfigNamespaces := map[string]cache.Config{cache.AllNamespaces: {}}
+		if len(operatorConfig.WatchNamespaces) > 0 {
+			mlflowConfigNamespaces = make(map[string]cache.Config, len(operatorConfig.WatchNamespaces))
+			for _, ns := range operatorConfig.WatchNamespaces {
+				mlflowConfigNamespaces[ns] = cache.Config{}
+			}
+			setupLog.Info("MLflowConfig CRD available, adding to cache for watch namespaces",
+				"namespaces", operatorConfig.WatchNamespaces)
+		} else {
+			setupLog.Info("MLflowConfig CRD available, adding to cache for all namespaces")
+		}
+		mlflowConfig := &unstructured.Unstructured{}
+		mlflowConfig.SetGroupVersionKind(controller.MLflowConfigGVK)
+		byObjectCache[mlflowConfig] = cache.ByObject{Namespaces: mlflowConfigNamespaces}
+	} else {
+		setupLog.Info("MLflowConfig CRD not available, skipping cache configuration")
+	}
+
+	// Without the OpenShift service CA or cert-manager nothing issues the serving certificate,
+	// so the operator issues a self-signed one. A failed lookup keeps the fallback off rather
+	// than competing with an issuer that may be present.
+	serviceCAAvailable, serviceCAErr := controller.IsServiceCAAvailable(discoveryClient)
+	if serviceCAErr != nil {
+		setupLog.Error(serviceCAErr, "Failed to check ServiceCA availability")
+	}
+	certManagerAvailable, certManagerErr := controller.IsCertManagerAvailable(discoveryClient)
+	if certManagerErr != nil {
+		setupLog.Error(certManagerErr, "Failed to check cert-manager availability")
+	}
+	selfSignedTLS := serviceCAErr == nil && certManagerErr == nil && !serviceCAAvailable && !certManagerAvailable
+	if selfSignedTLS {
+		setupLog.Info("No certificate issuer available, issuing self-signed TLS certificates")
+	}
 
 	if operatorConfig.EnableMLflowOperatorModuleController {
 		setupLog.Info(
@@ -363,10 +787,19 @@ func main() {
 		setupLog.Info("MLflowOperator CRD available, proceeding with controller setup")
 	}
 
+	webhookServer := webhook.NewServer(webhook.Options{
+		CertDir:  webhookCertPath,
+		CertName: webhookCertName,
+		KeyName:  webhookCertKey,
+		TLSOpts:  tlsOpts,
+	})
+
 	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
 		Scheme:                 scheme,
 		Metrics:                metricsServerOptions,
 		HealthProbeBindAddress: probeAddr,
+		PprofBindAddress:       pprofAddr,
+		WebhookServer:          webhookServer,
 		LeaderElection:         enableLeaderElection,
 		LeaderElectionID:       "a5eb1b3b.opendatahub.io",
 		LeaseDuration:          &leaseDuration,
@@ -380,18 +813,14 @@ func main() {
 			},
 			// Apply label selector specifically to owned resources
 			ByObject: byObjectCache,
+			// Nothing reads managedFields from the cache, and they are often the largest part of
+			// a cached object.
+			DefaultTransform: cache.TransformStripManagedFields(),
 		},
-		// LeaderElectionReleaseOnCancel defines if the leader should step down voluntarily
-		// when the Manager ends. This requires the binary to immediately end when the
-		// Manager is stopped, otherwise, this setting is unsafe. Setting this significantly
-		// speeds up voluntary leader transitions as the new leader don't have to wait
-		// LeaseDuration time first.
-		//
-		// In the default scaffold provided, the program ends immediately after
-		// the manager stops, so would be fine to enable this option. However,
-		// if you are doing or is intended to do any operation such as perform cleanups
-		// after the manager stops then its usage might be unsafe.
-		// LeaderElectionReleaseOnCancel: true,
+		// Step down as soon as the manager stops so a standby replica takes over without
+		// waiting out the lease. This is only safe because main returns right after
+		// mgr.Start; do not add cleanup that writes to the cluster after it.
+		LeaderElectionReleaseOnCancel: true,
 	})
 	if err != nil {
 		setupLog.Error(err, "unable to start manager")
@@ -415,19 +844,162 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Client token Secrets live in workspace namespaces and are only readable by exact name, so
+	// they get an exact-name cache of their own as well. ODH data connections are cached by their
+	// managed label in another.
+	var clientTokenWatchCache, dataConnectionWatchCache cache.Cache
+	if mlflowConfigAvailable {
+		clientTokenWatchCache, err = controller.NewClientTokenWatchCache(cfg, scheme, operatorConfig.WatchNamespaces)
+		if err != nil {
+			setupLog.Error(err, "unable to create client token watch cache")
+			os.Exit(1)
+		}
+		if err := mgr.Add(clientTokenWatchCache); err != nil {
+			setupLog.Error(err, "unable to add client token watch cache")
+			os.Exit(1)
+		}
+		dataConnectionWatchCache, err = controller.NewDataConnectionWatchCache(cfg, scheme, operatorConfig.WatchNamespaces)
+		if err != nil {
+			setupLog.Error(err, "unable to create data connection watch cache")
+			os.Exit(1)
+		}
+		if err := mgr.Add(dataConnectionWatchCache); err != nil {
+			setupLog.Error(err, "unable to add data connection watch cache")
+			os.Exit(1)
+		}
+	}
+
+	// MLflow server pods are watched only for stuck containers; readiness comes from the
+	// Deployment. The main Pod cache is already scoped to migration Job pods.
+	serverPodWatchCache, err := controller.NewServerPodWatchCache(cfg, scheme, namespace)
+	if err != nil {
+		setupLog.Error(err, "unable to create server pod watch cache")
+		os.Exit(1)
+	}
+	if err := mgr.Add(serverPodWatchCache); err != nil {
+		setupLog.Error(err, "unable to add server pod watch cache")
+		os.Exit(1)
+	}
+
+	var reconcileTracker *controller.ReconcileTracker
+	if instanceReconcileThreshold > 0 {
+		reconcileTracker = controller.NewReconcileTracker(instanceReconcileThreshold)
+		if err := mgr.Add(reconcileTracker); err != nil {
+			setupLog.Error(err, "unable to add reconcile tracker")
+			os.Exit(1)
+		}
+	}
+
+	// APIs turned off by FEATURE_GATES or namespaced install mode stay off whatever discovery
+	// finds, so only the others are watched.
+	var optionalAPIs []controller.OptionalAPI
+	if operatorConfig.FeatureEnabled(config.FeatureConsoleLink) {
+		optionalAPIs = append(optionalAPIs, controller.OptionalAPI{
+			Name: "ConsoleLink", Available: consoleLinkAvailable,
+			Check: func() (bool, error) { return controller.IsConsoleLinkAvailable(discoveryClient) },
+		})
+	}
+	if operatorConfig.FeatureEnabled(config.FeatureHTTPRoute) && !operatorConfig.Namespaced() {
+		optionalAPIs = append(optionalAPIs, controller.OptionalAPI{
+			Name: "HTTPRoute", Available: httpRouteAvailable,
+			Check: func() (bool, error) { return controller.IsHTTPRouteAvailable(discoveryClient) },
+		})
+	}
+	if optionalAPIInterval > 0 && len(optionalAPIs) > 0 {
+		watcher := &controller.OptionalAPIWatcher{Interval: optionalAPIInterval, APIs: optionalAPIs}
+		if err := mgr.Add(watcher); err != nil {
+			setupLog.Error(err, "unable to add optional API watcher")
+			os.Exit(1)
+		}
+	}
+
+	if operatorConfig.TelemetryEndpoint != "" {
+		setupLog.Info("Usage telemetry enabled", "endpoint", operatorConfig.TelemetryEndpoint,
+			"interval", operatorConfig.TelemetryInterval)
+		reporter := controller.NewTelemetryReporter(mgr.GetClient(), operatorConfig.TelemetryEndpoint,
+			operatorConfig.TelemetryInterval)
+		if err := mgr.Add(reporter); err != nil {
+			setupLog.Error(err, "unable to add telemetry reporter")
+			os.Exit(1)
+		}
+	}
+
 	if err := (&controller.MLflowReconciler{
-		Client:                  mgr.GetClient(),
-		Scheme:                  mgr.GetScheme(),
-		Namespace:               namespace,
-		ChartPath:               "charts/mlflow",
-		ConsoleLinkAvailable:    consoleLinkAvailable,
-		HTTPRouteAvailable:      httpRouteAvailable,
-		ServiceMonitorAvailable: serviceMonitorAvailable,
-		GCRBACWatchCache:        gcRBACWatchCache,
+		Client:                         mgr.GetClient(),
+		Scheme:                         mgr.GetScheme(),
+		Namespace:                      namespace,
+		ChartPath:                      mlflowChartPath,
+		ConsoleLinkAvailable:           consoleLinkAvailable,
+		HTTPRouteAvailable:             httpRouteAvailable,
+		VirtualServiceAvailable:        virtualServiceAvailable,
+		ServiceMonitorAvailable:        serviceMonitorAvailable,
+		PodMonitorAvailable:            podMonitorAvailable,
+		PrometheusRuleAvailable:        prometheusRuleAvailable,
+		ServiceMeshAvailable:           serviceMeshAvailable,
+		MLflowConfigAvailable:          mlflowConfigAvailable,
+		BackendTLSPolicyAvailable:      backendTLSPolicyAvailable,
+		Namespaced:                     operatorConfig.Namespaced(),
+		ConsolePluginAvailable:         consolePluginAvailable,
+		RateLimitPolicyAvailable:       rateLimitPolicyAvailable,
+		OpenClusterManagementAvailable: openClusterManagementAvailable,
+		SelfSignedTLS:                  selfSignedTLS,
+		WatchNamespaces:                operatorConfig.WatchNamespaces,
+		GCRBACWatchCache:               gcRBACWatchCache,
+		ClientTokenWatchCache:          clientTokenWatchCache,
+		DataConnectionWatchCache:       dataConnectionWatchCache,
+		ServerPodWatchCache:            serverPodWatchCache,
+		LogApplyDiffs:                  logApplyDiffs,
+		Recorder:                       mgr.GetEventRecorder("mlflow-operator"),
+		ReconcileTracker:               reconcileTracker,
+		RenderRecorder:                 renderRecorder,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "MLflow")
 		os.Exit(1)
 	}
+	if err := (&controller.MLflowGatewayReconciler{
+		Client:             mgr.GetClient(),
+		Scheme:             mgr.GetScheme(),
+		Namespace:          namespace,
+		ChartPath:          gatewayChartPath,
+		HTTPRouteAvailable: httpRouteAvailable,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "MLflowGateway")
+		os.Exit(1)
+	}
+	if err := (&controller.MLflowBackupReconciler{
+		Client:    mgr.GetClient(),
+		Scheme:    mgr.GetScheme(),
+		Namespace: namespace,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "MLflowBackup")
+		os.Exit(1)
+	}
+	if err := (&controller.MLflowRestoreReconciler{
+		Client:    mgr.GetClient(),
+		Scheme:    mgr.GetScheme(),
+		Namespace: namespace,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "MLflowRestore")
+		os.Exit(1)
+	}
+	// On ODH, the mlflowoperator component of the DataScienceCluster creates and removes the MLflow CR.
+	dscAvailable, err := controller.IsDataScienceClusterAvailable(discoveryClient)
+	if err != nil {
+		setupLog.Error(err, "Failed to check DataScienceCluster availability")
+		dscAvailable = false
+	}
+	dscAvailable = gateFeature(operatorConfig, config.FeatureDataScienceCluster, dscAvailable)
+	dscAvailable = gateClusterScoped(operatorConfig, "DataScienceCluster", dscAvailable)
+	if dscAvailable {
+		if err := (&controller.DataScienceClusterReconciler{
+			Client:    mgr.GetClient(),
+			Scheme:    mgr.GetScheme(),
+			Namespace: namespace,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "DataScienceCluster")
+			os.Exit(1)
+		}
+	}
 	// Only turn on the new MLflowOperator ownership path during the coordinated ODH handoff.
 	if operatorConfig.EnableMLflowOperatorModuleController {
 		if err := (&controller.MLflowOperatorReconciler{
@@ -476,9 +1048,47 @@ func main() {
 		setupLog.Error(err, "unable to set up ready check")
 		os.Exit(1)
 	}
+	// Informers run on every replica, leader or not. Gating readiness on them means a ready
+	// standby can take over reconciling without a cold cache.
+	watchCaches := []cache.Cache{mgr.GetCache(), gcRBACWatchCache}
+	if clientTokenWatchCache != nil {
+		watchCaches = append(watchCaches, clientTokenWatchCache, dataConnectionWatchCache)
+	}
+	if err := mgr.AddReadyzCheck("informers", cacheSyncCheck(watchCaches...)); err != nil {
+		setupLog.Error(err, "unable to set up informer sync check")
+		os.Exit(1)
+	}
+	// GetWebhookServer adds the webhook server to the manager, so it is only started, and only
+	// needs certificates, when --webhook-cert-path asks for webhooks.
+	if webhookCertPath != "" {
+		setupLog.Info("Serving admission webhooks",
+			"webhook-cert-path", webhookCertPath, "webhook-cert-name", webhookCertName, "webhook-cert-key", webhookCertKey)
+		mgr.GetWebhookServer().Register(mlflowwebhook.MLflowValidatorPath, &webhook.Admission{
+			Handler: &mlflowwebhook.MLflowValidator{Client: mgr.GetClient(), Namespace: namespace},
+		})
+		if mlflowConfigAvailable {
+			mgr.GetWebhookServer().Register(mlflowwebhook.MLflowConfigDefaulterPath,
+				&webhook.Admission{Handler: &mlflowwebhook.MLflowConfigDefaulter{}})
+		}
+		if err := mgr.AddReadyzCheck("webhook", mgr.GetWebhookServer().StartedChecker()); err != nil {
+			setupLog.Error(err, "unable to set up webhook check")
+			os.Exit(1)
+		}
+	}
+	if reconcileTracker != nil {
+		if err := mgr.AddReadyzCheck("instances", reconcileTracker.Checker(mgr.GetClient())); err != nil {
+			setupLog.Error(err, "unable to set up instances check")
+			os.Exit(1)
+		}
+	}
 
 	setupLog.Info("starting manager")
 	if err := mgr.Start(ctx); err != nil {
+		if errors.Is(err, controller.ErrOptionalAPIsChanged) {
+			// The container restarts and sets the manager up for the APIs now available.
+			setupLog.Info("Exiting to restart", "reason", err.Error())
+			os.Exit(0)
+		}
 		setupLog.Error(err, "problem running manager")
 		os.Exit(1)
 	}
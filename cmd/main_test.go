@@ -2,10 +2,16 @@ package main
 
 import (
 	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
 
+	"sigs.k8s.io/controller-runtime/pkg/cache/informertest"
+
 	"github.com/opendatahub-io/mlflow-operator/internal/config"
 )
 
@@ -16,19 +22,85 @@ func TestInferPodNamespaceFromEnv(t *testing.T) {
 	}
 }
 
+func TestInferPodNamespaceFromServiceAccount(t *testing.T) {
+	t.Setenv("POD_NAMESPACE", "")
+	namespaceFile := filepath.Join(t.TempDir(), "namespace")
+	if err := os.WriteFile(namespaceFile, []byte("redhat-ods-applications\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	original := serviceAccountNamespaceFile
+	serviceAccountNamespaceFile = namespaceFile
+	t.Cleanup(func() { serviceAccountNamespaceFile = original })
+
+	if got := inferPodNamespace(); got != "redhat-ods-applications" {
+		t.Fatalf("inferPodNamespace() = %q, want %q", got, "redhat-ods-applications")
+	}
+	t.Setenv("POD_NAMESPACE", "custom-apps")
+	if got := inferPodNamespace(); got != "custom-apps" {
+		t.Fatalf("inferPodNamespace() = %q, want POD_NAMESPACE to take precedence", got)
+	}
+}
+
 func TestInferPodNamespaceFallsBackOutsideCluster(t *testing.T) {
 	t.Setenv("POD_NAMESPACE", "")
+	original := serviceAccountNamespaceFile
+	serviceAccountNamespaceFile = filepath.Join(t.TempDir(), "missing")
+	t.Cleanup(func() { serviceAccountNamespaceFile = original })
 	if got := inferPodNamespace(); got != defaultNamespace {
 		t.Fatalf("inferPodNamespace() = %q, want %q (fallback when env is unset)", got, defaultNamespace)
 	}
 }
 
+func TestFindChartDir(t *testing.T) {
+	root := t.TempDir()
+	chartDir := filepath.Join(root, "charts", "mlflow")
+	if err := os.MkdirAll(chartDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(chartDir, "Chart.yaml"), []byte("name: mlflow\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	nested := filepath.Join(root, "internal", "controller")
+	if err := os.MkdirAll(nested, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, start := range []string{root, nested} {
+		got, err := findChartDir(start, "mlflow")
+		if err != nil || got != chartDir {
+			t.Fatalf("findChartDir(%q) = %q, %v, want %q", start, got, err, chartDir)
+		}
+	}
+	if _, err := findChartDir(nested, "mlflow-gateway"); err == nil {
+		t.Fatal("findChartDir() found a chart that does not exist")
+	}
+}
+
+func TestIsLoopbackBindAddress(t *testing.T) {
+	for address, want := range map[string]bool{
+		"127.0.0.1:8082": true,
+		"localhost:8082": true,
+		"[::1]:8082":     true,
+		":8082":          false,
+		"0.0.0.0:8082":   false,
+		"10.0.0.5:8082":  false,
+		"8082":           false,
+	} {
+		if got := isLoopbackBindAddress(address); got != want {
+			t.Errorf("isLoopbackBindAddress(%q) = %v, want %v", address, got, want)
+		}
+	}
+}
+
 func TestValidateStartupConfig(t *testing.T) {
 	tests := []struct {
 		name                   string
 		namespace              string
 		cfg                    *config.OperatorConfig
 		supportedMLflowVersion string
+		registryMirrors        string
+		imageRegistry          string
+		featureGates           string
 		wantErr                bool
 	}{
 		{
@@ -59,10 +131,127 @@ func TestValidateStartupConfig(t *testing.T) {
 			supportedMLflowVersion: "",
 			wantErr:                true,
 		},
+		{
+			name:                   "accepts registry mirrors",
+			namespace:              "opendatahub",
+			cfg:                    &config.OperatorConfig{MLflowImage: "quay.io/example/mlflow:test"},
+			supportedMLflowVersion: "3.11.0",
+			registryMirrors:        "quay.io=mirror.internal/quay",
+			wantErr:                false,
+		},
+		{
+			name:                   "rejects malformed registry mirrors",
+			namespace:              "opendatahub",
+			cfg:                    &config.OperatorConfig{MLflowImage: "quay.io/example/mlflow:test"},
+			supportedMLflowVersion: "3.11.0",
+			registryMirrors:        "quay.io",
+			wantErr:                true,
+		},
+		{
+			name:                   "rejects an image registry with a scheme",
+			namespace:              "opendatahub",
+			cfg:                    &config.OperatorConfig{MLflowImage: "quay.io/example/mlflow:test"},
+			supportedMLflowVersion: "3.11.0",
+			imageRegistry:          "https://registry.internal",
+			wantErr:                true,
+		},
+		{
+			name:                   "accepts feature gates",
+			namespace:              "opendatahub",
+			cfg:                    &config.OperatorConfig{MLflowImage: "quay.io/example/mlflow:test"},
+			supportedMLflowVersion: "3.11.0",
+			featureGates:           "ConsoleLink=false,Workspaces=true",
+			wantErr:                false,
+		},
+		{
+			name:                   "rejects unknown feature gates",
+			namespace:              "opendatahub",
+			cfg:                    &config.OperatorConfig{MLflowImage: "quay.io/example/mlflow:test"},
+			supportedMLflowVersion: "3.11.0",
+			featureGates:           "Routes=false",
+			wantErr:                true,
+		},
+		{
+			name:      "accepts telemetry endpoint",
+			namespace: "opendatahub",
+			cfg: &config.OperatorConfig{
+				MLflowImage:       "quay.io/example/mlflow:test",
+				TelemetryEndpoint: "https://telemetry.example.com/v1/reports",
+				TelemetryInterval: config.DefaultTelemetryInterval,
+			},
+			supportedMLflowVersion: "3.11.0",
+			wantErr:                false,
+		},
+		{
+			name:      "rejects relative telemetry endpoint",
+			namespace: "opendatahub",
+			cfg: &config.OperatorConfig{
+				MLflowImage:       "quay.io/example/mlflow:test",
+				TelemetryEndpoint: "telemetry.example.com",
+				TelemetryInterval: config.DefaultTelemetryInterval,
+			},
+			supportedMLflowVersion: "3.11.0",
+			wantErr:                true,
+		},
+		{
+			name:      "rejects telemetry without interval",
+			namespace: "opendatahub",
+			cfg: &config.OperatorConfig{
+				MLflowImage:       "quay.io/example/mlflow:test",
+				TelemetryEndpoint: "https://telemetry.example.com/v1/reports",
+			},
+			supportedMLflowVersion: "3.11.0",
+			wantErr:                true,
+		},
+		{
+			name:      "accepts watch namespace",
+			namespace: "team-a",
+			cfg: &config.OperatorConfig{
+				MLflowImage:    "quay.io/example/mlflow:test",
+				WatchNamespace: "team-a",
+			},
+			supportedMLflowVersion: "3.11.0",
+			wantErr:                false,
+		},
+		{
+			name:      "rejects several watch namespaces",
+			namespace: "team-a,team-b",
+			cfg: &config.OperatorConfig{
+				MLflowImage:    "quay.io/example/mlflow:test",
+				WatchNamespace: "team-a,team-b",
+			},
+			supportedMLflowVersion: "3.11.0",
+			wantErr:                true,
+		},
+		{
+			name:      "rejects watch namespace with workspace namespaces",
+			namespace: "team-a",
+			cfg: &config.OperatorConfig{
+				MLflowImage:     "quay.io/example/mlflow:test",
+				WatchNamespace:  "team-a",
+				WatchNamespaces: []string{"team-b"},
+			},
+			supportedMLflowVersion: "3.11.0",
+			wantErr:                true,
+		},
+		{
+			name:      "rejects watch namespace with module controller",
+			namespace: "team-a",
+			cfg: &config.OperatorConfig{
+				MLflowImage:                          "quay.io/example/mlflow:test",
+				WatchNamespace:                       "team-a",
+				EnableMLflowOperatorModuleController: true,
+			},
+			supportedMLflowVersion: "3.11.0",
+			wantErr:                true,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("IMAGE_REGISTRY_MIRRORS", tt.registryMirrors)
+			t.Setenv("IMAGE_REGISTRY", tt.imageRegistry)
+			t.Setenv("FEATURE_GATES", tt.featureGates)
 			err := validateStartupConfig(tt.namespace, tt.cfg, tt.supportedMLflowVersion)
 			if tt.wantErr && err == nil {
 				t.Fatal("expected error, got nil")
@@ -107,6 +296,15 @@ func TestResolveManagerNamespace(t *testing.T) {
 			},
 			expectedNamespace: "opendatahub",
 		},
+		{
+			name:      "uses watch namespace in namespaced install mode",
+			namespace: "opendatahub",
+			operatorConfig: &config.OperatorConfig{
+				ApplicationsNamespace: "redhat-ods-applications",
+				WatchNamespace:        "team-a",
+			},
+			expectedNamespace: "team-a",
+		},
 		{
 			name:              "falls back when config missing",
 			namespace:         "opendatahub",
@@ -182,3 +380,72 @@ func TestWaitForRequiredCRDRejectsNonPositiveTimeout(t *testing.T) {
 		t.Fatalf("expected validation message, got %v", err)
 	}
 }
+
+func TestValidateLeaderElectionTiming(t *testing.T) {
+	tests := []struct {
+		name          string
+		leaseDuration time.Duration
+		renewDeadline time.Duration
+		retryPeriod   time.Duration
+		wantErr       bool
+	}{
+		{
+			name:          "accepts defaults",
+			leaseDuration: 60 * time.Second,
+			renewDeadline: 50 * time.Second,
+			retryPeriod:   15 * time.Second,
+		},
+		{
+			name:          "accepts fast failover",
+			leaseDuration: 15 * time.Second,
+			renewDeadline: 10 * time.Second,
+			retryPeriod:   2 * time.Second,
+		},
+		{
+			name:          "rejects renew deadline at lease duration",
+			leaseDuration: 30 * time.Second,
+			renewDeadline: 30 * time.Second,
+			retryPeriod:   5 * time.Second,
+			wantErr:       true,
+		},
+		{
+			name:          "rejects retry period close to renew deadline",
+			leaseDuration: 30 * time.Second,
+			renewDeadline: 10 * time.Second,
+			retryPeriod:   10 * time.Second,
+			wantErr:       true,
+		},
+		{
+			name:          "rejects non-positive retry period",
+			leaseDuration: 30 * time.Second,
+			renewDeadline: 10 * time.Second,
+			wantErr:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateLeaderElectionTiming(tt.leaseDuration, tt.renewDeadline, tt.retryPeriod)
+			if tt.wantErr && err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestCacheSyncCheck(t *testing.T) {
+	synced := true
+	unsynced := false
+	req := httptest.NewRequest(http.MethodGet, "/readyz/informers", nil)
+
+	if err := cacheSyncCheck(&informertest.FakeInformers{Synced: &synced})(req); err != nil {
+		t.Fatalf("expected synced caches to be ready, got %v", err)
+	}
+	check := cacheSyncCheck(&informertest.FakeInformers{Synced: &synced}, &informertest.FakeInformers{Synced: &unsynced})
+	if err := check(req); err == nil {
+		t.Fatal("expected an unsynced cache to fail the check")
+	}
+}
@@ -17,7 +17,12 @@ limitations under the License.
 package config
 
 import (
+	"fmt"
 	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -27,8 +32,54 @@ import (
 const (
 	DefaultMLflowURL                    = "https://mlflow.example.com"
 	DefaultMLflowOperatorCRDWaitTimeout = 30 * time.Second
+	// DefaultPostgreSQLImage provides pg_dump and pg_restore for MLflowBackup and MLflowRestore.
+	// Its major version must be at least the database server's.
+	DefaultPostgreSQLImage = "quay.io/sclorg/postgresql-16-c9s:latest"
+	// DefaultTelemetryInterval is how often usage telemetry is sent once TELEMETRY_ENDPOINT is set.
+	DefaultTelemetryInterval = 24 * time.Hour
+
+	// RuntimeConfigMapName is the ConfigMap in the operator's target namespace whose data
+	// overrides the env-derived settings at runtime, without a manager restart.
+	RuntimeConfigMapName = "mlflow-operator-config"
+)
+
+// SupportedMLflowVersion is injected via -ldflags from config/component_metadata.yaml.
+var SupportedMLflowVersion string
+
+// Feature gate names accepted in FEATURE_GATES. Every gate defaults to enabled; disabling one
+// turns its subsystem off cluster-wide, as if the cluster did not serve the API behind it.
+const (
+	// FeatureConsoleLink controls the OpenShift ConsoleLink for each instance.
+	FeatureConsoleLink = "ConsoleLink"
+	// FeatureHTTPRoute controls Gateway API routing (HTTPRoute, RouteReady, workspace routes).
+	FeatureHTTPRoute = "HTTPRoute"
+	// FeatureVirtualService controls the Istio VirtualService routing backend.
+	FeatureVirtualService = "VirtualService"
+	// FeatureServiceMesh controls spec.serviceMesh enrollment.
+	FeatureServiceMesh = "ServiceMesh"
+	// FeatureMonitoring controls ServiceMonitor, PodMonitor, and PrometheusRule objects.
+	FeatureMonitoring = "Monitoring"
+	// FeatureWorkspaces controls MLflow workspaces; when disabled every instance runs with
+	// spec.workspaces.enabled false.
+	FeatureWorkspaces = "Workspaces"
+	// FeatureDataScienceCluster controls creating and removing the MLflow CR for the ODH
+	// DataScienceCluster mlflowoperator component.
+	FeatureDataScienceCluster = "DataScienceCluster"
 )
 
+// knownFeatureGates lists the gates ParseFeatureGates accepts.
+var knownFeatureGates = []string{
+	FeatureConsoleLink, FeatureHTTPRoute, FeatureVirtualService, FeatureServiceMesh, FeatureMonitoring, FeatureWorkspaces,
+	FeatureDataScienceCluster,
+}
+
+// runtimeOverridableKeys lists the settings RuntimeConfigMapName may override. Settings that
+// shape manager startup (namespace, cache scope, controller toggles) stay env-only.
+var runtimeOverridableKeys = []string{
+	"MLFLOW_IMAGE", "POSTGRESQL_IMAGE", "GATEWAY_NAME", "GATEWAY_SELECTOR", "GATEWAY_CLASS_NAME", "MLFLOW_URL",
+	"SECTION_TITLE", "IMAGE_REGISTRY_MIRRORS", "IMAGE_REGISTRY",
+}
+
 // OperatorConfig holds the configuration for the MLflow operator
 type OperatorConfig struct {
 	// ApplicationsNamespace is the namespace where MLflow operands are created.
@@ -40,14 +91,55 @@ type OperatorConfig struct {
 	MLflowOperatorCRDWaitTimeout time.Duration
 	// MLflowImage is the default image to use for MLflow deployments
 	MLflowImage string
+	// PostgreSQLImage is the PostgreSQL client image used by backup and restore Jobs
+	PostgreSQLImage string
+	// ConsolePluginImage serves the OpenShift console dynamic plugin. Empty disables it.
+	ConsolePluginImage string
 	// GatewayName is the name of the Gateway resource for HttpRoute
 	GatewayName string
+	// GatewaySelector is a label selector that, together with GatewayClassName, finds the
+	// default Gateway across namespaces instead of GatewayName in openshift-ingress.
+	GatewaySelector string
+	// GatewayClassName limits the Gateway search to Gateways of this class.
+	GatewayClassName string
+	// GatewayNamespace is the namespace of the Gateway found by GatewaySelector or
+	// GatewayClassName. It is empty when GatewayName is used as is.
+	GatewayNamespace string
 	// MLflowURL is the external URL for accessing MLflow
 	MLflowURL string
 	// MLflowURLConfigured reports whether MLFLOW_URL was explicitly configured.
 	MLflowURLConfigured bool
+	// MLflowURLDiscovered reports that MLflowURL was derived from the cluster rather than
+	// configured; MLflowURLConfigured is then set too.
+	MLflowURLDiscovered bool
 	// SectionTitle is the title for the ConsoleLink section in OpenShift console
 	SectionTitle string
+	// RegistryMirrors maps image reference prefixes (a registry, or registry/path) to the
+	// mirror prefix that replaces them, for disconnected clusters.
+	RegistryMirrors map[string]string
+	// ImageRegistry replaces the registry of every managed image that no RegistryMirrors entry
+	// matches, so a single setting covers all images of an air-gapped install.
+	ImageRegistry string
+	// FeatureGates holds the FEATURE_GATES overrides by gate name. Gates that are not listed
+	// are enabled; see FeatureEnabled.
+	FeatureGates map[string]bool
+	// WatchNamespaces limits the namespaces scanned for MLflowConfig workspaces. Empty means
+	// all namespaces.
+	WatchNamespaces []string
+	// WatchNamespace, when set, runs the operator in namespaced install mode: MLflow is deployed
+	// to this namespace, and the operator only reads and writes namespace-scoped objects there,
+	// besides its own cluster-scoped APIs. See Namespaced.
+	WatchNamespace string
+	// HTTPProxy, HTTPSProxy and NoProxy are the operator's own proxy settings, which OLM
+	// injects from the cluster-wide proxy. They are passed on to the MLflow pods when the
+	// OpenShift Proxy CR is not available.
+	HTTPProxy  string
+	HTTPSProxy string
+	NoProxy    string
+	// TelemetryEndpoint receives anonymous usage reports. Empty, the default, disables telemetry.
+	TelemetryEndpoint string
+	// TelemetryInterval is the time between usage reports.
+	TelemetryInterval time.Duration
 }
 
 var (
@@ -60,22 +152,50 @@ type envLookupFn func(string) (string, bool)
 func loadConfig(v *viper.Viper, lookupEnv envLookupFn) *OperatorConfig {
 	_, mlflowURLConfigured := lookupEnv("MLFLOW_URL")
 
+	// Malformed entries are skipped here; ParseRegistryMirrors reports them at startup.
+	registryMirrors, _ := ParseRegistryMirrors(v.GetString("IMAGE_REGISTRY_MIRRORS"))
+	imageRegistry, _ := ParseImageRegistry(v.GetString("IMAGE_REGISTRY"))
+	featureGates, _ := ParseFeatureGates(v.GetString("FEATURE_GATES"))
+
 	// RELATED_IMAGE_* is the platform override. MLFLOW_IMAGE remains the
 	// operator's built-in default image fallback rather than a legacy-only path.
 	mlflowImage := v.GetString("RELATED_IMAGE_ODH_MLFLOW_IMAGE")
 	if mlflowImage == "" {
 		mlflowImage = v.GetString("MLFLOW_IMAGE")
 	}
+	postgreSQLImage := v.GetString("RELATED_IMAGE_ODH_POSTGRESQL_IMAGE")
+	if postgreSQLImage == "" {
+		postgreSQLImage = v.GetString("POSTGRESQL_IMAGE")
+	}
+
+	consolePluginImage := v.GetString("RELATED_IMAGE_ODH_MLFLOW_CONSOLE_PLUGIN_IMAGE")
+	if consolePluginImage == "" {
+		consolePluginImage = v.GetString("CONSOLE_PLUGIN_IMAGE")
+	}
 
 	return &OperatorConfig{
 		ApplicationsNamespace:                v.GetString("APPLICATIONS_NAMESPACE"),
 		EnableMLflowOperatorModuleController: v.GetBool("ENABLE_MLFLOW_OPERATOR_MODULE_CONTROLLER"),
 		MLflowOperatorCRDWaitTimeout:         v.GetDuration("MLFLOW_OPERATOR_MODULE_CONTROLLER_CRD_WAIT_TIMEOUT"),
 		MLflowImage:                          mlflowImage,
+		PostgreSQLImage:                      postgreSQLImage,
+		ConsolePluginImage:                   consolePluginImage,
 		GatewayName:                          v.GetString("GATEWAY_NAME"),
+		GatewaySelector:                      v.GetString("GATEWAY_SELECTOR"),
+		GatewayClassName:                     v.GetString("GATEWAY_CLASS_NAME"),
 		MLflowURL:                            v.GetString("MLFLOW_URL"),
 		MLflowURLConfigured:                  mlflowURLConfigured,
 		SectionTitle:                         v.GetString("SECTION_TITLE"),
+		RegistryMirrors:                      registryMirrors,
+		ImageRegistry:                        imageRegistry,
+		FeatureGates:                         featureGates,
+		WatchNamespaces:                      ParseNamespaceList(v.GetString("WATCH_NAMESPACES")),
+		WatchNamespace:                       strings.TrimSpace(v.GetString("WATCH_NAMESPACE")),
+		HTTPProxy:                            v.GetString("HTTP_PROXY"),
+		HTTPSProxy:                           v.GetString("HTTPS_PROXY"),
+		NoProxy:                              v.GetString("NO_PROXY"),
+		TelemetryEndpoint:                    v.GetString("TELEMETRY_ENDPOINT"),
+		TelemetryInterval:                    v.GetDuration("TELEMETRY_INTERVAL"),
 	}
 }
 
@@ -90,11 +210,192 @@ func GetConfig() *OperatorConfig {
 		v.SetDefault("GATEWAY_NAME", "data-science-gateway")
 		v.SetDefault("MLFLOW_URL", DefaultMLflowURL)
 		v.SetDefault("SECTION_TITLE", "MLflow")
+		v.SetDefault("POSTGRESQL_IMAGE", DefaultPostgreSQLImage)
 		v.SetDefault("APPLICATIONS_NAMESPACE", "")
+		v.SetDefault("WATCH_NAMESPACES", "")
 		v.SetDefault("ENABLE_MLFLOW_OPERATOR_MODULE_CONTROLLER", false)
 		v.SetDefault("MLFLOW_OPERATOR_MODULE_CONTROLLER_CRD_WAIT_TIMEOUT", DefaultMLflowOperatorCRDWaitTimeout)
+		v.SetDefault("TELEMETRY_INTERVAL", DefaultTelemetryInterval)
 
 		instance = loadConfig(v, os.LookupEnv)
 	})
 	return instance
 }
+
+// WithRuntimeOverrides returns a copy of c with non-empty values from the runtime ConfigMap
+// data applied. Keys use the same names as the corresponding environment variables; unknown
+// keys are ignored.
+func (c *OperatorConfig) WithRuntimeOverrides(data map[string]string) *OperatorConfig {
+	merged := *c
+	for _, key := range runtimeOverridableKeys {
+		value := data[key]
+		if value == "" {
+			continue
+		}
+		switch key {
+		case "MLFLOW_IMAGE":
+			merged.MLflowImage = value
+		case "POSTGRESQL_IMAGE":
+			merged.PostgreSQLImage = value
+		case "GATEWAY_NAME":
+			merged.GatewayName = value
+		case "GATEWAY_SELECTOR":
+			merged.GatewaySelector = value
+		case "GATEWAY_CLASS_NAME":
+			merged.GatewayClassName = value
+		case "MLFLOW_URL":
+			merged.MLflowURL = value
+			merged.MLflowURLConfigured = true
+		case "SECTION_TITLE":
+			merged.SectionTitle = value
+		case "IMAGE_REGISTRY_MIRRORS":
+			if mirrors, err := ParseRegistryMirrors(value); err == nil {
+				merged.RegistryMirrors = mirrors
+			}
+		case "IMAGE_REGISTRY":
+			if registry, err := ParseImageRegistry(value); err == nil {
+				merged.ImageRegistry = registry
+			}
+		}
+	}
+	return &merged
+}
+
+// ParseRegistryMirrors parses a comma- or newline-separated list of source=mirror prefix
+// pairs, e.g. "quay.io/opendatahub=registry.internal/odh,docker.io=registry.internal/hub".
+// Valid pairs are returned even when the error reports malformed ones.
+func ParseRegistryMirrors(value string) (map[string]string, error) {
+	var mirrors map[string]string
+	var invalid []string
+	for _, entry := range strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == '\n' }) {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		source, mirror, ok := strings.Cut(entry, "=")
+		source, mirror = strings.TrimSuffix(strings.TrimSpace(source), "/"), strings.TrimSuffix(strings.TrimSpace(mirror), "/")
+		if !ok || source == "" || mirror == "" {
+			invalid = append(invalid, entry)
+			continue
+		}
+		if mirrors == nil {
+			mirrors = make(map[string]string)
+		}
+		mirrors[source] = mirror
+	}
+	if len(invalid) > 0 {
+		return mirrors, fmt.Errorf("invalid IMAGE_REGISTRY_MIRRORS entries (want source=mirror): %s", strings.Join(invalid, ", "))
+	}
+	return mirrors, nil
+}
+
+// ParseImageRegistry parses a registry host, optionally with a port and a path prefix, e.g.
+// "registry.internal:5000/mirror". It returns "" for an invalid value.
+func ParseImageRegistry(value string) (string, error) {
+	registry := strings.TrimSuffix(strings.TrimSpace(value), "/")
+	if registry == "" {
+		return "", nil
+	}
+	if strings.Contains(registry, "://") || strings.ContainsAny(registry, " \t\n@") ||
+		strings.HasPrefix(registry, "/") || strings.Contains(registry, "//") {
+		return "", fmt.Errorf("invalid IMAGE_REGISTRY %q (want host[:port][/path], without a scheme)", value)
+	}
+	return registry, nil
+}
+
+// ParseFeatureGates parses a comma- or newline-separated list of Name=true|false pairs, e.g.
+// "ConsoleLink=false,Workspaces=true". Valid pairs are returned even when the error reports
+// unknown gates or malformed entries.
+func ParseFeatureGates(value string) (map[string]bool, error) {
+	var gates map[string]bool
+	var invalid []string
+	for _, entry := range strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == '\n' }) {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		name, rawEnabled, ok := strings.Cut(entry, "=")
+		name = strings.TrimSpace(name)
+		enabled, err := strconv.ParseBool(strings.TrimSpace(rawEnabled))
+		if !ok || err != nil || !slices.Contains(knownFeatureGates, name) {
+			invalid = append(invalid, entry)
+			continue
+		}
+		if gates == nil {
+			gates = make(map[string]bool)
+		}
+		gates[name] = enabled
+	}
+	if len(invalid) > 0 {
+		return gates, fmt.Errorf("invalid FEATURE_GATES entries (want Name=true|false with Name one of %s): %s",
+			strings.Join(knownFeatureGates, ", "), strings.Join(invalid, ", "))
+	}
+	return gates, nil
+}
+
+// FeatureEnabled reports whether the named feature gate is enabled. Gates default to enabled.
+func (c *OperatorConfig) FeatureEnabled(name string) bool {
+	enabled, ok := c.FeatureGates[name]
+	return !ok || enabled
+}
+
+// ParseNamespaceList parses a comma- or newline-separated list of namespace names, dropping
+// blanks and duplicates while keeping the first-seen order.
+func ParseNamespaceList(value string) []string {
+	var namespaces []string
+	for _, entry := range strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == '\n' }) {
+		entry = strings.TrimSpace(entry)
+		if entry == "" || slices.Contains(namespaces, entry) {
+			continue
+		}
+		namespaces = append(namespaces, entry)
+	}
+	return namespaces
+}
+
+// Namespaced reports whether the operator runs in namespaced install mode, confined to
+// WatchNamespace.
+func (c *OperatorConfig) Namespaced() bool {
+	return c.WatchNamespace != ""
+}
+
+// MirrorImage rewrites image to its configured mirror. The longest matching source prefix
+// wins, and a prefix only matches on a path, tag or digest boundary. Images no prefix matches
+// move to ImageRegistry when it is set.
+func (c *OperatorConfig) MirrorImage(image string) string {
+	sources := make([]string, 0, len(c.RegistryMirrors))
+	for source := range c.RegistryMirrors {
+		sources = append(sources, source)
+	}
+	sort.Slice(sources, func(i, j int) bool { return len(sources[i]) > len(sources[j]) })
+
+	for _, source := range sources {
+		if !strings.HasPrefix(image, source) {
+			continue
+		}
+		rest := image[len(source):]
+		if rest == "" || strings.ContainsRune("/:@", rune(rest[0])) {
+			return c.RegistryMirrors[source] + rest
+		}
+	}
+	if c.ImageRegistry != "" {
+		return ReplaceRegistry(image, c.ImageRegistry)
+	}
+	return image
+}
+
+// ReplaceRegistry returns image with its registry replaced by registry, keeping the repository
+// path, tag and digest. Images without a registry are Docker Hub images, so "postgres:16"
+// becomes "<registry>/library/postgres:16".
+func ReplaceRegistry(image, registry string) string {
+	if image == "" {
+		return image
+	}
+	repository := image
+	if first, rest, ok := strings.Cut(image, "/"); ok && (strings.ContainsAny(first, ".:") || first == "localhost") {
+		repository = rest
+	} else if !ok {
+		repository = "library/" + image
+	}
+	return registry + "/" + repository
+}
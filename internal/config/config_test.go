@@ -2,6 +2,7 @@ package config
 
 import (
 	"os"
+	"slices"
 	"testing"
 	"time"
 
@@ -48,6 +49,10 @@ func TestLoadConfigFallsBackToLegacyInputs(t *testing.T) {
 	if cfg.MLflowOperatorCRDWaitTimeout != DefaultMLflowOperatorCRDWaitTimeout {
 		t.Fatalf("expected default CRD wait timeout %s, got %s", DefaultMLflowOperatorCRDWaitTimeout, cfg.MLflowOperatorCRDWaitTimeout)
 	}
+	if cfg.TelemetryEndpoint != "" || cfg.TelemetryInterval != DefaultTelemetryInterval {
+		t.Fatalf("expected telemetry to default to off every %s, got %q every %s",
+			DefaultTelemetryInterval, cfg.TelemetryEndpoint, cfg.TelemetryInterval)
+	}
 }
 
 func newTestViper() *viper.Viper {
@@ -59,5 +64,190 @@ func newTestViper() *viper.Viper {
 	v.SetDefault("APPLICATIONS_NAMESPACE", "")
 	v.SetDefault("ENABLE_MLFLOW_OPERATOR_MODULE_CONTROLLER", false)
 	v.SetDefault("MLFLOW_OPERATOR_MODULE_CONTROLLER_CRD_WAIT_TIMEOUT", DefaultMLflowOperatorCRDWaitTimeout)
+	v.SetDefault("TELEMETRY_INTERVAL", DefaultTelemetryInterval)
 	return v
 }
+
+func TestWithRuntimeOverrides(t *testing.T) {
+	base := &OperatorConfig{
+		ApplicationsNamespace: "opendatahub",
+		MLflowImage:           "quay.io/opendatahub/mlflow:env",
+		GatewayName:           "env-gateway",
+		MLflowURL:             DefaultMLflowURL,
+		SectionTitle:          "MLflow",
+	}
+
+	merged := base.WithRuntimeOverrides(map[string]string{
+		"MLFLOW_IMAGE":           "quay.io/opendatahub/mlflow:runtime",
+		"POSTGRESQL_IMAGE":       "registry.example.com/postgresql-17:latest",
+		"MLFLOW_URL":             "https://mlflow.apps.example.com",
+		"GATEWAY_SELECTOR":       "gateway.opendatahub.io/default=true",
+		"SECTION_TITLE":          "",
+		"APPLICATIONS_NAMESPACE": "ignored",
+		"IMAGE_REGISTRY":         "registry.internal:5000/",
+	})
+
+	if merged.MLflowImage != "quay.io/opendatahub/mlflow:runtime" {
+		t.Fatalf("expected runtime image override, got %q", merged.MLflowImage)
+	}
+	if merged.PostgreSQLImage != "registry.example.com/postgresql-17:latest" {
+		t.Fatalf("expected runtime PostgreSQL image override, got %q", merged.PostgreSQLImage)
+	}
+	if merged.MLflowURL != "https://mlflow.apps.example.com" || !merged.MLflowURLConfigured {
+		t.Fatalf("expected runtime URL override to be marked configured, got %q (configured=%v)", merged.MLflowURL, merged.MLflowURLConfigured)
+	}
+	if merged.GatewayName != "env-gateway" {
+		t.Fatalf("expected gateway name to keep env value, got %q", merged.GatewayName)
+	}
+	if merged.GatewaySelector != "gateway.opendatahub.io/default=true" {
+		t.Fatalf("expected runtime gateway selector override, got %q", merged.GatewaySelector)
+	}
+	if merged.SectionTitle != "MLflow" {
+		t.Fatalf("expected empty override to be ignored, got %q", merged.SectionTitle)
+	}
+	if merged.ImageRegistry != "registry.internal:5000" {
+		t.Fatalf("expected runtime image registry override, got %q", merged.ImageRegistry)
+	}
+	if merged.ApplicationsNamespace != "opendatahub" {
+		t.Fatalf("expected startup-only setting to be ignored, got %q", merged.ApplicationsNamespace)
+	}
+	if base.MLflowImage != "quay.io/opendatahub/mlflow:env" {
+		t.Fatalf("expected base config to be left untouched, got %q", base.MLflowImage)
+	}
+}
+
+func TestParseRegistryMirrors(t *testing.T) {
+	mirrors, err := ParseRegistryMirrors("quay.io/opendatahub=mirror.internal/odh/, docker.io=mirror.internal/hub\nbroken")
+	if err == nil {
+		t.Fatalf("expected malformed entry to be reported")
+	}
+	if len(mirrors) != 2 || mirrors["quay.io/opendatahub"] != "mirror.internal/odh" || mirrors["docker.io"] != "mirror.internal/hub" {
+		t.Fatalf("expected valid entries to be kept with trailing slashes trimmed, got %v", mirrors)
+	}
+
+	mirrors, err = ParseRegistryMirrors("")
+	if err != nil || mirrors != nil {
+		t.Fatalf("expected empty input to yield no mirrors, got %v (err=%v)", mirrors, err)
+	}
+}
+
+func TestParseFeatureGates(t *testing.T) {
+	gates, err := ParseFeatureGates("ConsoleLink=false, Workspaces=true\nRoutes=false\nHTTPRoute=maybe")
+	if err == nil {
+		t.Fatalf("expected unknown gate and malformed value to be reported")
+	}
+	if len(gates) != 2 || gates[FeatureConsoleLink] || !gates[FeatureWorkspaces] {
+		t.Fatalf("expected valid entries to be kept, got %v", gates)
+	}
+
+	cfg := &OperatorConfig{FeatureGates: gates}
+	if cfg.FeatureEnabled(FeatureConsoleLink) {
+		t.Fatalf("expected ConsoleLink to be disabled")
+	}
+	if !cfg.FeatureEnabled(FeatureWorkspaces) || !cfg.FeatureEnabled(FeatureHTTPRoute) {
+		t.Fatalf("expected listed and unlisted gates to be enabled")
+	}
+	if !(&OperatorConfig{}).FeatureEnabled(FeatureMonitoring) {
+		t.Fatalf("expected gates to default to enabled")
+	}
+}
+
+func TestLoadConfigWatchNamespaces(t *testing.T) {
+	t.Setenv("WATCH_NAMESPACES", "team-a, team-b\nteam-a,,")
+
+	cfg := loadConfig(newTestViper(), os.LookupEnv)
+
+	if !slices.Equal(cfg.WatchNamespaces, []string{"team-a", "team-b"}) {
+		t.Fatalf("expected deduplicated watch namespaces, got %v", cfg.WatchNamespaces)
+	}
+
+	t.Setenv("WATCH_NAMESPACES", "")
+	if cfg := loadConfig(newTestViper(), os.LookupEnv); cfg.WatchNamespaces != nil {
+		t.Fatalf("expected unset WATCH_NAMESPACES to watch all namespaces, got %v", cfg.WatchNamespaces)
+	}
+}
+
+func TestLoadConfigWatchNamespace(t *testing.T) {
+	t.Setenv("WATCH_NAMESPACE", " team-a ")
+
+	cfg := loadConfig(newTestViper(), os.LookupEnv)
+
+	if cfg.WatchNamespace != "team-a" || !cfg.Namespaced() {
+		t.Fatalf("expected namespaced install mode for team-a, got %q", cfg.WatchNamespace)
+	}
+
+	t.Setenv("WATCH_NAMESPACE", "")
+	if cfg := loadConfig(newTestViper(), os.LookupEnv); cfg.Namespaced() {
+		t.Fatalf("expected unset WATCH_NAMESPACE to keep the cluster-wide install, got %q", cfg.WatchNamespace)
+	}
+}
+
+func TestLoadConfigProxy(t *testing.T) {
+	t.Setenv("HTTP_PROXY", "http://proxy.example.com:3128")
+	t.Setenv("HTTPS_PROXY", "http://proxy.example.com:3129")
+	t.Setenv("NO_PROXY", ".cluster.local,10.0.0.0/16")
+
+	cfg := loadConfig(newTestViper(), os.LookupEnv)
+	if cfg.HTTPProxy != "http://proxy.example.com:3128" || cfg.HTTPSProxy != "http://proxy.example.com:3129" {
+		t.Fatalf("expected proxy URLs from env, got %q and %q", cfg.HTTPProxy, cfg.HTTPSProxy)
+	}
+	if cfg.NoProxy != ".cluster.local,10.0.0.0/16" {
+		t.Fatalf("expected NO_PROXY from env, got %q", cfg.NoProxy)
+	}
+}
+
+func TestMirrorImage(t *testing.T) {
+	cfg := &OperatorConfig{RegistryMirrors: map[string]string{
+		"quay.io":             "mirror.internal/quay",
+		"quay.io/opendatahub": "mirror.internal/odh",
+	}}
+
+	tests := map[string]string{
+		"quay.io/opendatahub/mlflow:odh-stable": "mirror.internal/odh/mlflow:odh-stable",
+		"quay.io/other/mlflow@sha256:abc":       "mirror.internal/quay/other/mlflow@sha256:abc",
+		"quay.io.example.com/mlflow:test":       "quay.io.example.com/mlflow:test",
+		"registry.example.com/mlflow:test":      "registry.example.com/mlflow:test",
+	}
+	for image, want := range tests {
+		if got := cfg.MirrorImage(image); got != want {
+			t.Errorf("MirrorImage(%q) = %q, want %q", image, got, want)
+		}
+	}
+}
+
+func TestMirrorImageFallsBackToImageRegistry(t *testing.T) {
+	cfg := &OperatorConfig{
+		RegistryMirrors: map[string]string{"quay.io/opendatahub": "mirror.internal/odh"},
+		ImageRegistry:   "registry.internal:5000/mirror",
+	}
+
+	tests := map[string]string{
+		"quay.io/opendatahub/mlflow:odh-stable":  "mirror.internal/odh/mlflow:odh-stable",
+		"registry.redhat.io/rhel9/postgresql-16": "registry.internal:5000/mirror/rhel9/postgresql-16",
+		"localhost:5000/mlflow@sha256:abc":       "registry.internal:5000/mirror/mlflow@sha256:abc",
+		"bitnami/postgresql:16":                  "registry.internal:5000/mirror/bitnami/postgresql:16",
+		"postgres:16":                            "registry.internal:5000/mirror/library/postgres:16",
+	}
+	for image, want := range tests {
+		if got := cfg.MirrorImage(image); got != want {
+			t.Errorf("MirrorImage(%q) = %q, want %q", image, got, want)
+		}
+	}
+}
+
+func TestParseImageRegistry(t *testing.T) {
+	for value, want := range map[string]string{
+		"":                         "",
+		" registry.internal:5000/": "registry.internal:5000",
+		"registry.internal/mirror": "registry.internal/mirror",
+	} {
+		if got, err := ParseImageRegistry(value); err != nil || got != want {
+			t.Errorf("ParseImageRegistry(%q) = %q, %v, want %q", value, got, err, want)
+		}
+	}
+	for _, value := range []string{"https://registry.internal", "registry.internal//mirror", "registry internal"} {
+		if _, err := ParseImageRegistry(value); err == nil {
+			t.Errorf("ParseImageRegistry(%q) succeeded, want an error", value)
+		}
+	}
+}
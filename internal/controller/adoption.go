@@ -0,0 +1,142 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/csaupgrade"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+const (
+	// AdoptAnnotation asks the operator to take over existing objects with the names it renders,
+	// such as those left by a manual or Helm install. The operator removes it once the rendered
+	// objects have been applied.
+	AdoptAnnotation = "mlflow.opendatahub.io/adopt"
+
+	adoptionFailedReason = "AdoptionFailed"
+)
+
+func adoptionRequested(mlflow *mlflowv1.MLflow) bool {
+	_, ok := mlflow.Annotations[AdoptAnnotation]
+	return ok
+}
+
+// adoptableFieldManagers returns the managers whose client-side writes to live are handed to
+// the operator. Writes by Kubernetes controllers, such as the Deployment revision annotation,
+// stay with them.
+func adoptableFieldManagers(live *unstructured.Unstructured) sets.Set[string] {
+	managers := sets.New[string]()
+	for _, entry := range live.GetManagedFields() {
+		if entry.Operation != metav1.ManagedFieldsOperationUpdate || entry.Subresource != "" ||
+			entry.Manager == "mlflow-operator" || strings.HasPrefix(entry.Manager, "kube-") {
+			continue
+		}
+		managers.Insert(entry.Manager)
+	}
+	return managers
+}
+
+// adoptRenderedObjects prepares existing objects that mlflow does not control for the
+// operator's server-side apply. The fields written by other clients are transferred to the
+// operator's field manager, so the next apply removes whatever the chart no longer sets, such
+// as Helm release labels, instead of leaving them behind. A Deployment whose immutable selector
+// differs from the rendered one is deleted so the apply can recreate it. Objects controlled by
+// another owner are never taken over. It returns the adopted objects as kind/name.
+func (r *MLflowReconciler) adoptRenderedObjects(
+	ctx context.Context,
+	mlflow *mlflowv1.MLflow,
+	objects []*unstructured.Unstructured,
+) ([]string, error) {
+	var adopted []string
+	for _, obj := range objects {
+		// Namespaces and shared RBAC are never controlled by one instance, and existing PVCs are
+		// reused as-is to keep their data.
+		if obj.GetKind() == "Namespace" || obj.GetKind() == "PersistentVolumeClaim" || isSharedRBACObject(obj) {
+			continue
+		}
+		live := &unstructured.Unstructured{}
+		live.SetGroupVersionKind(obj.GroupVersionKind())
+		if err := r.Get(ctx, client.ObjectKeyFromObject(obj), live); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return adopted, fmt.Errorf("get %s/%s: %w", obj.GetKind(), obj.GetName(), err)
+		}
+		if metav1.IsControlledBy(live, mlflow) {
+			continue
+		}
+		if owner := metav1.GetControllerOf(live); owner != nil {
+			return adopted, fmt.Errorf("%s/%s is controlled by %s %s and cannot be adopted", obj.GetKind(), obj.GetName(), owner.Kind, owner.Name)
+		}
+
+		name := obj.GetKind() + "/" + obj.GetName()
+		if obj.GetKind() == "Deployment" {
+			liveSelector, _, _ := unstructured.NestedFieldNoCopy(live.Object, "spec", "selector")
+			renderedSelector, _, _ := unstructured.NestedFieldNoCopy(obj.Object, "spec", "selector")
+			if !reflect.DeepEqual(liveSelector, renderedSelector) {
+				if err := r.Delete(ctx, live, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !errors.IsNotFound(err) {
+					return adopted, fmt.Errorf("delete %s with a different selector: %w", name, err)
+				}
+				adopted = append(adopted, name)
+				continue
+			}
+		}
+
+		patch, err := csaupgrade.UpgradeManagedFieldsPatch(live, adoptableFieldManagers(live), "mlflow-operator")
+		if err != nil {
+			return adopted, fmt.Errorf("transfer field ownership of %s: %w", name, err)
+		}
+		if patch != nil {
+			if err := r.Patch(ctx, live, client.RawPatch(types.JSONPatchType, patch)); err != nil {
+				return adopted, fmt.Errorf("transfer field ownership of %s: %w", name, err)
+			}
+		}
+		adopted = append(adopted, name)
+	}
+	return adopted, nil
+}
+
+func (r *MLflowReconciler) clearAdoptAnnotation(ctx context.Context, mlflow *mlflowv1.MLflow) error {
+	patchBytes, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]any{
+				AdoptAnnotation: nil,
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("marshal adopt annotation clear patch: %w", err)
+	}
+	return r.Patch(
+		ctx,
+		&mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: mlflow.Name}},
+		client.RawPatch(types.MergePatchType, patchBytes),
+	)
+}
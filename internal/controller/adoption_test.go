@@ -0,0 +1,87 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func TestAdoptableFieldManagers(t *testing.T) {
+	g := gomega.NewWithT(t)
+	live := &unstructured.Unstructured{}
+	live.SetManagedFields([]metav1.ManagedFieldsEntry{
+		{Manager: "helm", Operation: metav1.ManagedFieldsOperationUpdate},
+		{Manager: "kubectl-client-side-apply", Operation: metav1.ManagedFieldsOperationUpdate},
+		{Manager: "kubectl", Operation: metav1.ManagedFieldsOperationApply},
+		{Manager: "mlflow-operator", Operation: metav1.ManagedFieldsOperationUpdate},
+		{Manager: "kube-controller-manager", Operation: metav1.ManagedFieldsOperationUpdate},
+		{Manager: "helm", Operation: metav1.ManagedFieldsOperationUpdate, Subresource: "status"},
+	})
+
+	g.Expect(sets.List(adoptableFieldManagers(live))).To(gomega.ConsistOf("helm", "kubectl-client-side-apply"))
+}
+
+func TestAdoptRenderedObjects(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: ResourceName, UID: "uid"}}
+	// A Helm install of the same chart under a different release uses a different selector.
+	helmDeployment := blueGreenLiveDeployment(t, ResourceName, blueBackendURI)
+	helmDeployment.Spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"app.kubernetes.io/instance": "mlflow"}}
+	helmService := &corev1.Service{ObjectMeta: metav1.ObjectMeta{
+		Name: ResourceName, Namespace: canaryTestNamespace, Labels: map[string]string{"app.kubernetes.io/managed-by": "Helm"},
+	}}
+	r := newTestReconciler(t, mlflow.DeepCopy(), helmDeployment, helmService)
+
+	objects := canaryTestObjects(t, "quay.io/opendatahub/mlflow:latest")
+	adopted, err := r.adoptRenderedObjects(ctx, mlflow, objects)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(adopted).To(gomega.ConsistOf("Deployment/mlflow", "Service/mlflow"))
+	// The Deployment is recreated by the apply since its selector cannot change.
+	err = r.Get(ctx, types.NamespacedName{Name: ResourceName, Namespace: canaryTestNamespace}, &appsv1.Deployment{})
+	g.Expect(apierrors.IsNotFound(err)).To(gomega.BeTrue())
+	g.Expect(r.Get(ctx, types.NamespacedName{Name: ResourceName, Namespace: canaryTestNamespace}, &corev1.Service{})).To(gomega.Succeed())
+}
+
+func TestAdoptRenderedObjects_ControlledByAnotherOwner(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: ResourceName, UID: "uid"}}
+	service := &corev1.Service{ObjectMeta: metav1.ObjectMeta{
+		Name: ResourceName, Namespace: canaryTestNamespace,
+		OwnerReferences: []metav1.OwnerReference{{
+			APIVersion: "apps/v1", Kind: "StatefulSet", Name: "other", UID: "other-uid", Controller: ptr(true),
+		}},
+	}}
+	r := newTestReconciler(t, mlflow.DeepCopy(), service)
+
+	_, err := r.adoptRenderedObjects(context.Background(), mlflow, canaryTestObjects(t, "quay.io/opendatahub/mlflow:latest"))
+	g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("Service/mlflow is controlled by StatefulSet other")))
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlcontroller "sigs.k8s.io/controller-runtime/pkg/controller"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// maxEventNoteLength is the API server's limit on an event's note.
+	maxEventNoteLength = 1024
+	// maxDiffValueLength bounds each old and new value shown in a diff line.
+	maxDiffValueLength = 120
+)
+
+// ignoredDiffPaths are server-populated or operator bookkeeping fields that say nothing about
+// why an object changed.
+var ignoredDiffPaths = map[string]bool{
+	"apiVersion":                 true,
+	"kind":                       true,
+	"status":                     true,
+	"metadata.managedFields":     true,
+	"metadata.resourceVersion":   true,
+	"metadata.generation":        true,
+	"metadata.uid":               true,
+	"metadata.creationTimestamp": true,
+	"metadata.annotations." + appliedHashAnnotation: true,
+}
+
+// manifestDiff lists the fields set by desired whose live value differs, one "path: old -> new"
+// line each, sorted by path. Fields only present on the live object are not reported: they
+// are either server defaults or owned by someone else.
+func manifestDiff(live, desired map[string]interface{}) []string {
+	var lines []string
+	walkManifestDiff("", live, desired, &lines)
+	slices.Sort(lines)
+	return lines
+}
+
+func walkManifestDiff(path string, live, desired interface{}, lines *[]string) {
+	if ignoredDiffPaths[path] {
+		return
+	}
+	switch desiredValue := desired.(type) {
+	case map[string]interface{}:
+		liveMap, ok := live.(map[string]interface{})
+		if ok || (live == nil && len(desiredValue) == 0) {
+			for key, value := range desiredValue {
+				walkManifestDiff(joinDiffPath(path, key), liveMap[key], value, lines)
+			}
+			return
+		}
+	case []interface{}:
+		liveList, ok := live.([]interface{})
+		if ok && len(liveList) == len(desiredValue) {
+			for i, value := range desiredValue {
+				walkManifestDiff(path+"["+strconv.Itoa(i)+"]", liveList[i], value, lines)
+			}
+			return
+		}
+		if live == nil && len(desiredValue) == 0 {
+			return
+		}
+	}
+	oldValue, newValue := formatDiffValue(live), formatDiffValue(desired)
+	if oldValue != newValue {
+		*lines = append(*lines, fmt.Sprintf("%s: %s -> %s", path, oldValue, newValue))
+	}
+}
+
+func joinDiffPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// formatDiffValue renders value as compact JSON, which also makes int64 and float64 forms of
+// the same number compare equal. Missing fields show as <unset>.
+func formatDiffValue(value interface{}) string {
+	if value == nil {
+		return "<unset>"
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return truncateDiffText(string(data), maxDiffValueLength)
+}
+
+func truncateDiffText(text string, limit int) string {
+	if len(text) <= limit {
+		return text
+	}
+	return text[:limit-3] + "..."
+}
+
+// eventNote truncates note to the event limit and ends it with the reconcile ID, which
+// controller-runtime also adds to every log line of the reconcile as reconcileID, so an event
+// can be matched to the logs around it.
+func eventNote(note, reconcileID string) string {
+	if reconcileID == "" {
+		return truncateDiffText(note, maxEventNoteLength)
+	}
+	suffix := " (reconcileID " + reconcileID + ")"
+	return truncateDiffText(note, maxEventNoteLength-len(suffix)) + suffix
+}
+
+// reportApplyDiff logs the fields obj is about to change and records them as an event on the
+// live object, when LogApplyDiffs is set. Secret data is never shown. Failures only skip the
+// report; the apply itself decides the outcome.
+func (r *MLflowReconciler) reportApplyDiff(ctx context.Context, obj client.Object) {
+	if !r.LogApplyDiffs {
+		return
+	}
+	log := logf.FromContext(ctx)
+	kind := obj.GetObjectKind().GroupVersionKind().Kind
+
+	live, ok := obj.DeepCopyObject().(client.Object)
+	if !ok {
+		return
+	}
+	err := r.Get(ctx, client.ObjectKeyFromObject(obj), live)
+	switch {
+	case errors.IsNotFound(err):
+		log.Info("Creating object", "kind", kind, "name", obj.GetName(), "namespace", obj.GetNamespace())
+		return
+	case err != nil:
+		log.V(1).Info("Unable to read live object for diff", "kind", kind, "name", obj.GetName(), "error", err.Error())
+		return
+	}
+
+	desiredContent, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return
+	}
+	liveContent, err := runtime.DefaultUnstructuredConverter.ToUnstructured(live)
+	if err != nil {
+		return
+	}
+	if kind == "Secret" {
+		for _, content := range []map[string]interface{}{desiredContent, liveContent} {
+			delete(content, "data")
+			delete(content, "stringData")
+		}
+	}
+	lines := manifestDiff(liveContent, desiredContent)
+	if len(lines) == 0 {
+		return
+	}
+	log.Info("Applying changes", "kind", kind, "name", obj.GetName(), "namespace", obj.GetNamespace(), "diff", lines)
+
+	if r.Recorder != nil && live.GetNamespace() != "" {
+		note := eventNote("Applying changes: "+strings.Join(lines, "; "), string(ctrlcontroller.ReconcileIDFromContext(ctx)))
+		r.Recorder.Eventf(live, nil, corev1.EventTypeNormal, "ManifestChanged", "Apply", "%s", note)
+	}
+}
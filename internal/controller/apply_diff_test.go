@@ -0,0 +1,122 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestManifestDiff(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	live := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":            "mlflow",
+			"resourceVersion": "42",
+			"labels":          map[string]interface{}{"app": "mlflow", "team": "ml"},
+		},
+		"spec": map[string]interface{}{
+			"replicas": int64(1),
+			"template": map[string]interface{}{"spec": map[string]interface{}{
+				"containers": []interface{}{map[string]interface{}{"name": "mlflow", "image": "mlflow:2.0"}},
+			}},
+		},
+		"status": map[string]interface{}{"replicas": int64(1)},
+	}
+	desired := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":   "mlflow",
+			"labels": map[string]interface{}{"app": "mlflow"},
+		},
+		"spec": map[string]interface{}{
+			"replicas": float64(1),
+			"template": map[string]interface{}{"spec": map[string]interface{}{
+				"containers":   []interface{}{map[string]interface{}{"name": "mlflow", "image": "mlflow:3.0", "args": []interface{}{}}},
+				"nodeSelector": map[string]interface{}{"zone": "a"},
+			}},
+		},
+	}
+
+	g.Expect(manifestDiff(live, desired)).To(gomega.Equal([]string{
+		`spec.template.spec.containers[0].image: "mlflow:2.0" -> "mlflow:3.0"`,
+		`spec.template.spec.nodeSelector: <unset> -> {"zone":"a"}`,
+	}))
+	g.Expect(manifestDiff(live, live)).To(gomega.BeEmpty())
+}
+
+func TestReportApplyDiff(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(gomega.Succeed())
+	objectMeta := metav1.ObjectMeta{Name: "mlflow", Namespace: "test-ns"}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&appsv1.Deployment{ObjectMeta: objectMeta, Spec: appsv1.DeploymentSpec{Replicas: ptr(int32(1))}},
+		&corev1.Secret{ObjectMeta: objectMeta, Data: map[string][]byte{"password": []byte("old")}},
+	).Build()
+	recorder := events.NewFakeRecorder(10)
+	r := &MLflowReconciler{Client: k8sClient, Scheme: scheme, LogApplyDiffs: true, Recorder: recorder}
+	ctx := context.Background()
+
+	deployment := &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: objectMeta,
+		Spec:       appsv1.DeploymentSpec{Replicas: ptr(int32(2))},
+	}
+	r.reportApplyDiff(ctx, deployment)
+	g.Expect(recorder.Events).To(gomega.Receive(gomega.Equal("Normal ManifestChanged Applying changes: spec.replicas: 1 -> 2")))
+
+	// Secret data never reaches logs or events.
+	secret := &corev1.Secret{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+		ObjectMeta: objectMeta,
+		Data:       map[string][]byte{"password": []byte("new")},
+	}
+	r.reportApplyDiff(ctx, secret)
+	g.Expect(recorder.Events).NotTo(gomega.Receive())
+
+	r.LogApplyDiffs = false
+	r.reportApplyDiff(ctx, &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: objectMeta,
+		Spec:       appsv1.DeploymentSpec{Replicas: ptr(int32(3))},
+	})
+	g.Expect(recorder.Events).NotTo(gomega.Receive())
+}
+
+func TestEventNote(t *testing.T) {
+	g := gomega.NewWithT(t)
+	id := "0d4c7c1e-0d6a-4b8e-9f5b-2a1d3c4e5f60"
+	g.Expect(eventNote("Applying changes: spec.replicas: 1 -> 2", "")).To(gomega.Equal("Applying changes: spec.replicas: 1 -> 2"))
+	g.Expect(eventNote("Applying changes: spec.replicas: 1 -> 2", id)).To(gomega.Equal("Applying changes: spec.replicas: 1 -> 2 (reconcileID " + id + ")"))
+
+	// The ID survives truncation.
+	note := eventNote(strings.Repeat("x", 2*maxEventNoteLength), id)
+	g.Expect(note).To(gomega.HaveLen(maxEventNoteLength))
+	g.Expect(note).To(gomega.HaveSuffix("... (reconcileID " + id + ")"))
+}
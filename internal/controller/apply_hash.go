@@ -0,0 +1,226 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// appliedHashAnnotation records the hash of the manifest last applied by the operator.
+const appliedHashAnnotation = "mlflow.opendatahub.io/applied-hash"
+
+// appliedObjectKey identifies an applied object across kinds.
+type appliedObjectKey struct {
+	gvk schema.GroupVersionKind
+	key types.NamespacedName
+}
+
+// appliedObjectState is what the live object looked like right after the operator's last patch.
+type appliedObjectState struct {
+	hash            string
+	generation      int64
+	resourceVersion string
+	// metadata is the digest of the labels, annotations, and owner references, which change
+	// without a generation bump.
+	metadata string
+}
+
+// appliedObjects remembers the outcome of successful patches so unchanged manifests can skip
+// the server-side apply. The hash annotation alone is not enough: out-of-band edits keep the
+// annotation, so the live generation and owned metadata (or resourceVersion for kinds without
+// a generation) must also still match what the patch produced for the object to be
+// considered in sync. The resourceVersion of kinds with a generation moves with every status
+// write, so comparing it would defeat the skip.
+type appliedObjects struct {
+	mu     sync.Mutex
+	states map[appliedObjectKey]appliedObjectState
+}
+
+func (a *appliedObjects) record(obj client.Object, hash string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.states == nil {
+		a.states = make(map[appliedObjectKey]appliedObjectState)
+	}
+	a.states[appliedKey(obj)] = appliedObjectState{
+		hash:            hash,
+		generation:      obj.GetGeneration(),
+		resourceVersion: obj.GetResourceVersion(),
+		metadata:        metadataDigest(obj),
+	}
+}
+
+// inSync reports whether live still matches the last patch that applied hash.
+func (a *appliedObjects) inSync(live client.Object, hash string) bool {
+	if live.GetAnnotations()[appliedHashAnnotation] != hash {
+		return false
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	state, ok := a.states[appliedKey(live)]
+	if !ok || state.hash != hash {
+		return false
+	}
+	if live.GetGeneration() > 0 {
+		return live.GetGeneration() == state.generation && metadataDigest(live) == state.metadata
+	}
+	return live.GetResourceVersion() == state.resourceVersion
+}
+
+// metadataDigest hashes the labels, annotations, and owner references of obj.
+func metadataDigest(obj client.Object) string {
+	data, err := json.Marshal(struct {
+		Labels          map[string]string       `json:"labels"`
+		Annotations     map[string]string       `json:"annotations"`
+		OwnerReferences []metav1.OwnerReference `json:"ownerReferences"`
+	}{obj.GetLabels(), obj.GetAnnotations(), obj.GetOwnerReferences()})
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func appliedKey(obj client.Object) appliedObjectKey {
+	return appliedObjectKey{
+		gvk: obj.GetObjectKind().GroupVersionKind(),
+		key: client.ObjectKeyFromObject(obj),
+	}
+}
+
+// manifestHash hashes the desired manifest, ignoring any previously stamped hash annotation.
+func manifestHash(obj client.Object) (string, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj.DeepCopyObject())
+	if err != nil {
+		return "", fmt.Errorf("failed to convert %s/%s: %w", obj.GetObjectKind().GroupVersionKind().Kind, obj.GetName(), err)
+	}
+	if metadata, ok := content["metadata"].(map[string]interface{}); ok {
+		if annotations, ok := metadata["annotations"].(map[string]interface{}); ok {
+			delete(annotations, appliedHashAnnotation)
+			if len(annotations) == 0 {
+				delete(metadata, "annotations")
+			}
+		}
+	}
+	data, err := json.Marshal(content)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal %s/%s: %w", obj.GetObjectKind().GroupVersionKind().Kind, obj.GetName(), err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// stampManifestHash computes the manifest hash and records it on obj's annotations.
+func stampManifestHash(obj client.Object) (string, error) {
+	hash, err := manifestHash(obj)
+	if err != nil {
+		return "", err
+	}
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string, 1)
+	}
+	annotations[appliedHashAnnotation] = hash
+	obj.SetAnnotations(annotations)
+	return hash, nil
+}
+
+// liveObjectInSync reports whether patching live with the manifest identified by hash would be a
+// no-op, in which case obj takes the live UID.
+func (r *MLflowReconciler) liveObjectInSync(obj, live client.Object, hash string) bool {
+	if !r.appliedObjects.inSync(live, hash) {
+		return false
+	}
+	obj.SetUID(live.GetUID())
+	return true
+}
+
+// readLive gets the live counterpart of obj, preferring a read the dry-run pass left in reads.
+func (r *MLflowReconciler) readLive(ctx context.Context, obj client.Object, reads *liveReads) (client.Object, error) {
+	if read, ok := reads.take(obj); ok {
+		return read.live, read.err
+	}
+	live, ok := obj.DeepCopyObject().(client.Object)
+	if !ok {
+		return nil, fmt.Errorf("%T is not a client.Object", obj)
+	}
+	if err := r.Get(ctx, client.ObjectKeyFromObject(obj), live); err != nil {
+		return nil, err
+	}
+	// Typed Get responses may drop TypeMeta; keep the GVK used as the state key.
+	live.GetObjectKind().SetGroupVersionKind(obj.GetObjectKind().GroupVersionKind())
+	return live, nil
+}
+
+// liveReads carries the live objects the dry-run pass read over to the apply pass of the same
+// reconcile, so a rendered object costs one GET instead of two. Each read is taken at most
+// once; objects the dry run did not read, and later applies, go back to the API.
+type liveReads struct {
+	mu      sync.Mutex
+	entries map[appliedObjectKey]liveRead
+}
+
+type liveRead struct {
+	live client.Object
+	err  error
+}
+
+// put records the outcome of reading obj. A nil *liveReads records nothing.
+func (l *liveReads) put(obj, live client.Object, err error) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.entries == nil {
+		l.entries = make(map[appliedObjectKey]liveRead)
+	}
+	var copied client.Object
+	if live != nil {
+		copied, _ = live.DeepCopyObject().(client.Object)
+	}
+	l.entries[appliedKey(obj)] = liveRead{live: copied, err: err}
+}
+
+// take returns and removes the recorded read of obj.
+func (l *liveReads) take(obj client.Object) (liveRead, bool) {
+	if l == nil {
+		return liveRead{}, false
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	key := appliedKey(obj)
+	read, ok := l.entries[key]
+	delete(l.entries, key)
+	return read, ok
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func newApplyHashTestDeployment(replicas int64) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":      "mlflow",
+			"namespace": "test-ns",
+		},
+		"spec": map[string]interface{}{
+			"replicas": replicas,
+			"selector": map[string]interface{}{
+				"matchLabels": map[string]interface{}{"app": "mlflow"},
+			},
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"labels": map[string]interface{}{"app": "mlflow"},
+				},
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{"name": "mlflow", "image": "quay.io/opendatahub/mlflow:test"},
+					},
+				},
+			},
+		},
+	}}
+	return obj
+}
+
+// newApplyHashTestReconciler counts the patches its fake client receives in patches.
+func newApplyHashTestReconciler(t *testing.T, patches *int) *MLflowReconciler {
+	t.Helper()
+	return newTestReconcilerFrom(t, fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+		Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+			*patches++
+			return c.Patch(ctx, obj, patch, opts...)
+		},
+	}))
+}
+
+func TestApplyObjectSkipsUnchangedManifest(t *testing.T) {
+	var patches int
+	r := newApplyHashTestReconciler(t, &patches)
+	ctx := context.Background()
+
+	if err := r.applyObject(ctx, newApplyHashTestDeployment(1)); err != nil {
+		t.Fatalf("first applyObject() error = %v", err)
+	}
+	if err := r.applyObject(ctx, newApplyHashTestDeployment(1)); err != nil {
+		t.Fatalf("second applyObject() error = %v", err)
+	}
+	if patches != 1 {
+		t.Fatalf("patches = %d, want 1 (unchanged manifest should skip SSA)", patches)
+	}
+
+	live := &appsv1.Deployment{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: "test-ns", Name: "mlflow"}, live); err != nil {
+		t.Fatalf("get deployment: %v", err)
+	}
+	if live.Annotations[appliedHashAnnotation] == "" {
+		t.Errorf("expected %s annotation on the applied object", appliedHashAnnotation)
+	}
+
+	if err := r.applyObject(ctx, newApplyHashTestDeployment(2)); err != nil {
+		t.Fatalf("changed applyObject() error = %v", err)
+	}
+	if patches != 2 {
+		t.Errorf("patches = %d, want 2 (changed manifest must be applied)", patches)
+	}
+}
+
+func TestApplyObjectReappliesAfterOutOfBandEdit(t *testing.T) {
+	var patches int
+	r := newApplyHashTestReconciler(t, &patches)
+	ctx := context.Background()
+
+	if err := r.applyObject(ctx, newApplyHashTestDeployment(1)); err != nil {
+		t.Fatalf("applyObject() error = %v", err)
+	}
+
+	// Simulate a manual edit: the hash annotation survives but the spec generation moves.
+	live := &appsv1.Deployment{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: "test-ns", Name: "mlflow"}, live); err != nil {
+		t.Fatalf("get deployment: %v", err)
+	}
+	live.Spec.Replicas = ptr(int32(5))
+	live.Generation++
+	if err := r.Update(ctx, live); err != nil {
+		t.Fatalf("update deployment: %v", err)
+	}
+
+	if err := r.applyObject(ctx, newApplyHashTestDeployment(1)); err != nil {
+		t.Fatalf("applyObject() error = %v", err)
+	}
+	if patches != 2 {
+		t.Errorf("patches = %d, want 2 (drifted object must be re-applied)", patches)
+	}
+}
+
+func TestApplyObjectReappliesAfterLabelEdit(t *testing.T) {
+	var patches int
+	r := newApplyHashTestReconciler(t, &patches)
+	ctx := context.Background()
+	key := client.ObjectKey{Namespace: "test-ns", Name: "mlflow"}
+
+	// The fake client does not set generations; give the Deployment one, as the API server
+	// would, so the generation comparison is exercised.
+	if err := r.applyObject(ctx, newApplyHashTestDeployment(1)); err != nil {
+		t.Fatalf("applyObject() error = %v", err)
+	}
+	live := &appsv1.Deployment{}
+	if err := r.Get(ctx, key, live); err != nil {
+		t.Fatalf("get deployment: %v", err)
+	}
+	live.Generation = 1
+	if err := r.Update(ctx, live); err != nil {
+		t.Fatalf("update deployment: %v", err)
+	}
+	if err := r.applyObject(ctx, newApplyHashTestDeployment(1)); err != nil {
+		t.Fatalf("applyObject() error = %v", err)
+	}
+
+	// A label edit keeps the generation.
+	if err := r.Get(ctx, key, live); err != nil {
+		t.Fatalf("get deployment: %v", err)
+	}
+	if live.Generation != 1 {
+		t.Fatalf("generation = %d, want 1", live.Generation)
+	}
+	live.Labels = map[string]string{"edited": "true"}
+	if err := r.Update(ctx, live); err != nil {
+		t.Fatalf("update deployment: %v", err)
+	}
+
+	if err := r.applyObject(ctx, newApplyHashTestDeployment(1)); err != nil {
+		t.Fatalf("applyObject() error = %v", err)
+	}
+	if patches != 3 {
+		t.Errorf("patches = %d, want 3 (a label edit must be re-applied)", patches)
+	}
+}
+
+func TestManifestHashIgnoresHashAnnotation(t *testing.T) {
+	obj := newApplyHashTestDeployment(1)
+	before, err := manifestHash(obj)
+	if err != nil {
+		t.Fatalf("manifestHash() error = %v", err)
+	}
+	stamped, err := stampManifestHash(obj)
+	if err != nil {
+		t.Fatalf("stampManifestHash() error = %v", err)
+	}
+	after, err := manifestHash(obj)
+	if err != nil {
+		t.Fatalf("manifestHash() error = %v", err)
+	}
+	if before != stamped || before != after {
+		t.Errorf("hash changed after stamping: before=%s stamped=%s after=%s", before, stamped, after)
+	}
+}
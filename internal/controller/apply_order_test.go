@@ -0,0 +1,249 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func newApplyOrderTestObject(apiVersion, kind, name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetName(name)
+	obj.SetNamespace("test-ns")
+	return obj
+}
+
+func TestGroupApplyWaves(t *testing.T) {
+	objects := []*unstructured.Unstructured{
+		newApplyOrderTestObject("apps/v1", "Deployment", "mlflow"),
+		newApplyOrderTestObject("v1", "Service", "mlflow"),
+		newApplyOrderTestObject("v1", "ServiceAccount", "mlflow-sa"),
+		newApplyOrderTestObject("batch/v1", "CronJob", "mlflow-gc"),
+		newApplyOrderTestObject("v1", "ServiceAccount", "mlflow-gc-sa"),
+	}
+
+	waves := groupApplyWaves(objects)
+
+	var got []string
+	for _, wave := range waves {
+		var names []string
+		for _, obj := range wave {
+			names = append(names, obj.GetKind()+"/"+obj.GetName())
+		}
+		got = append(got, strings.Join(names, ","))
+	}
+	want := []string{
+		"ServiceAccount/mlflow-sa,ServiceAccount/mlflow-gc-sa",
+		"Service/mlflow",
+		"Deployment/mlflow,CronJob/mlflow-gc",
+	}
+	if strings.Join(got, " | ") != strings.Join(want, " | ") {
+		t.Errorf("groupApplyWaves() = %v, want %v", got, want)
+	}
+}
+
+func newApplyOrderTestReconciler(t *testing.T, funcs interceptor.Funcs) *MLflowReconciler {
+	t.Helper()
+	return newTestReconcilerFrom(t, fake.NewClientBuilder().WithInterceptorFuncs(funcs))
+}
+
+// isDryRunPatch reports whether opts request a dry-run patch.
+func isDryRunPatch(opts []client.PatchOption) bool {
+	patchOpts := &client.PatchOptions{}
+	patchOpts.ApplyOptions(opts)
+	return len(patchOpts.DryRun) > 0
+}
+
+func TestApplyRenderedObjectsRespectsWaveOrder(t *testing.T) {
+	var mu sync.Mutex
+	var applied []string
+	r := newApplyOrderTestReconciler(t, interceptor.Funcs{
+		Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+			if isDryRunPatch(opts) {
+				return nil
+			}
+			mu.Lock()
+			applied = append(applied, obj.GetObjectKind().GroupVersionKind().Kind)
+			mu.Unlock()
+			return nil
+		},
+	})
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "mlflow", UID: "uid"}}
+
+	objects := []*unstructured.Unstructured{
+		newApplyOrderTestObject("apps/v1", "Deployment", "mlflow"),
+		newApplyOrderTestObject("v1", "ServiceAccount", "mlflow-sa"),
+		newApplyOrderTestObject("v1", "Service", "mlflow"),
+	}
+	if err := r.applyRenderedObjects(context.Background(), mlflow, objects); err != nil {
+		t.Fatalf("applyRenderedObjects() error = %v", err)
+	}
+
+	want := "ServiceAccount,Service,Deployment"
+	if got := strings.Join(applied, ","); got != want {
+		t.Errorf("apply order = %s, want %s", got, want)
+	}
+	for _, obj := range objects {
+		if len(obj.GetOwnerReferences()) != 1 {
+			t.Errorf("%s/%s owner references = %v, want the MLflow controller reference", obj.GetKind(), obj.GetName(), obj.GetOwnerReferences())
+		}
+	}
+}
+
+func TestApplyRenderedObjectsStopsAfterFailedWave(t *testing.T) {
+	applyErr := errors.New("apply rejected")
+	var mu sync.Mutex
+	var applied []string
+	r := newApplyOrderTestReconciler(t, interceptor.Funcs{
+		Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+			if isDryRunPatch(opts) {
+				return nil
+			}
+			kind := obj.GetObjectKind().GroupVersionKind().Kind
+			mu.Lock()
+			applied = append(applied, kind)
+			mu.Unlock()
+			if kind == "ServiceAccount" {
+				return applyErr
+			}
+			return nil
+		},
+	})
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "mlflow", UID: "uid"}}
+
+	objects := []*unstructured.Unstructured{
+		newApplyOrderTestObject("v1", "ServiceAccount", "mlflow-sa"),
+		newApplyOrderTestObject("apps/v1", "Deployment", "mlflow"),
+	}
+	err := r.applyRenderedObjects(context.Background(), mlflow, objects)
+	if !errors.Is(err, applyErr) {
+		t.Fatalf("applyRenderedObjects() error = %v, want %v", err, applyErr)
+	}
+	if !strings.Contains(err.Error(), "apply ServiceAccount/mlflow-sa") {
+		t.Errorf("error %q does not identify the failing object", err)
+	}
+	for _, kind := range applied {
+		if kind == "Deployment" {
+			t.Error("Deployment was applied even though its ServiceAccount wave failed")
+		}
+	}
+}
+
+func TestApplyRenderedObjectsReportsAllDryRunRejections(t *testing.T) {
+	var mu sync.Mutex
+	var applied []string
+	r := newApplyOrderTestReconciler(t, interceptor.Funcs{
+		Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+			kind := obj.GetObjectKind().GroupVersionKind().Kind
+			if isDryRunPatch(opts) {
+				if kind == "ClusterRoleBinding" {
+					t.Error("ClusterRoleBinding to a rendered ClusterRole was dry-run before its role exists")
+				}
+				if kind == "Service" || kind == "Deployment" {
+					return errors.New("denied by admission webhook")
+				}
+				return nil
+			}
+			mu.Lock()
+			applied = append(applied, kind)
+			mu.Unlock()
+			return nil
+		},
+	})
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "mlflow", UID: "uid"}}
+	binding := newApplyOrderTestObject("rbac.authorization.k8s.io/v1", "ClusterRoleBinding", "mlflow")
+	binding.Object["roleRef"] = map[string]interface{}{"apiGroup": "rbac.authorization.k8s.io", "kind": "ClusterRole", "name": "mlflow"}
+
+	objects := []*unstructured.Unstructured{
+		newApplyOrderTestObject("apps/v1", "Deployment", "mlflow"),
+		newApplyOrderTestObject("v1", "ServiceAccount", "mlflow-sa"),
+		newApplyOrderTestObject("v1", "Service", "mlflow"),
+		newApplyOrderTestObject("rbac.authorization.k8s.io/v1", "ClusterRole", "mlflow"),
+		binding,
+	}
+	err := r.applyRenderedObjects(context.Background(), mlflow, objects)
+	if !isDryRunError(err) {
+		t.Fatalf("applyRenderedObjects() error = %v, want a dry-run error", err)
+	}
+	want := "dry run rejected 2 object(s): Deployment/mlflow: denied by admission webhook; Service/mlflow: denied by admission webhook"
+	if err.Error() != want {
+		t.Errorf("error = %q, want %q", err, want)
+	}
+	if len(applied) != 0 {
+		t.Errorf("applied %v after a failed dry run, want nothing", applied)
+	}
+}
+
+func TestApplyRenderedObjectsReadsEachObjectOnce(t *testing.T) {
+	var mu sync.Mutex
+	gets, patches := 0, 0
+	r := newApplyOrderTestReconciler(t, interceptor.Funcs{
+		Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+			mu.Lock()
+			gets++
+			mu.Unlock()
+			return c.Get(ctx, key, obj, opts...)
+		},
+		Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+			if isDryRunPatch(opts) {
+				return nil
+			}
+			mu.Lock()
+			patches++
+			mu.Unlock()
+			return c.Patch(ctx, obj, patch, opts...)
+		},
+	})
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "mlflow", UID: "uid"}}
+	rendered := func() []*unstructured.Unstructured {
+		return []*unstructured.Unstructured{
+			newApplyOrderTestObject("v1", "ServiceAccount", "mlflow-sa"),
+			newApplyOrderTestObject("v1", "ConfigMap", "mlflow-config"),
+			newApplyOrderTestObject("v1", "PersistentVolumeClaim", "mlflow-pvc"),
+		}
+	}
+
+	// Creating the objects reads each one once, in the dry run.
+	if err := r.applyRenderedObjects(context.Background(), mlflow, rendered()); err != nil {
+		t.Fatalf("first applyRenderedObjects() error = %v", err)
+	}
+	if gets != 3 || patches != 3 {
+		t.Fatalf("gets = %d, patches = %d, want 3 and 3", gets, patches)
+	}
+
+	// A steady-state reconcile reads each object once and patches nothing.
+	if err := r.applyRenderedObjects(context.Background(), mlflow, rendered()); err != nil {
+		t.Fatalf("second applyRenderedObjects() error = %v", err)
+	}
+	if gets != 6 || patches != 3 {
+		t.Errorf("gets = %d, patches = %d, want 6 and 3", gets, patches)
+	}
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+)
+
+func newArtifactsServerMLflow() *mlflowv1.MLflow {
+	return &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI:      ptr(testBackendStoreURI),
+			ServeArtifacts:       ptr(true),
+			ArtifactsDestination: ptr("s3://bucket/artifacts"),
+			ArtifactsServer: &mlflowv1.ArtifactsServerConfig{
+				Enabled:  true,
+				Replicas: ptr(int32(3)),
+				Resources: &corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+				},
+			},
+		},
+	}
+}
+
+func TestBuildHTTPRoute_ArtifactsServer(t *testing.T) {
+	g := gomega.NewWithT(t)
+	cfg := &config.OperatorConfig{GatewayName: "data-science-gateway"}
+
+	mlflow := newArtifactsServerMLflow()
+	route := buildHTTPRoute(mlflow, "test-ns", cfg)
+	g.Expect(route.Spec.Rules).To(gomega.HaveLen(3))
+	artifactsRule := route.Spec.Rules[2]
+	var paths []string
+	for _, match := range artifactsRule.Matches {
+		paths = append(paths, *match.Path.Value)
+	}
+	g.Expect(paths).To(gomega.Equal([]string{"/mlflow/api/2.0/mlflow-artifacts", "/mlflow/ajax-api/2.0/mlflow-artifacts"}))
+	g.Expect(artifactsRule.BackendRefs).To(gomega.HaveLen(1))
+	g.Expect(string(artifactsRule.BackendRefs[0].Name)).To(gomega.Equal("mlflow-artifacts"))
+
+	mlflow.Spec.ArtifactsServer = nil
+	g.Expect(buildHTTPRoute(mlflow, "test-ns", cfg).Spec.Rules).To(gomega.HaveLen(2))
+}
+
+func TestDeleteDerivedServerObjects(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(gomega.Succeed())
+	objectMeta := metav1.ObjectMeta{Name: "mlflow-artifacts", Namespace: "test-ns"}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&appsv1.Deployment{ObjectMeta: objectMeta},
+		&corev1.Service{ObjectMeta: objectMeta},
+	).Build()
+	r := &MLflowReconciler{Client: k8sClient, Scheme: scheme}
+	ctx := context.Background()
+
+	g.Expect(r.deleteDerivedServerObjects(ctx, "mlflow-artifacts", "test-ns")).To(gomega.Succeed())
+	key := types.NamespacedName{Name: "mlflow-artifacts", Namespace: "test-ns"}
+	g.Expect(apierrors.IsNotFound(k8sClient.Get(ctx, key, &appsv1.Deployment{}))).To(gomega.BeTrue())
+	g.Expect(apierrors.IsNotFound(k8sClient.Get(ctx, key, &corev1.Service{}))).To(gomega.BeTrue())
+
+	// Nothing left to delete is not an error.
+	g.Expect(r.deleteDerivedServerObjects(ctx, "mlflow-artifacts", "test-ns")).To(gomega.Succeed())
+}
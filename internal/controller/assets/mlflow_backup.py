@@ -0,0 +1,138 @@
+import json
+import os
+import shutil
+import sqlite3
+import sys
+from datetime import datetime, timezone
+from urllib.parse import unquote, urlparse
+
+from mlflow.store.artifact.artifact_repository_registry import get_artifact_repository
+from mlflow.version import VERSION
+
+WORK_DIR = "/backup"
+POSTGRESQL_DUMP = "backend.dump"
+SQLITE_COPY = "mlflow.db"
+METADATA = "metadata.json"
+ARTIFACTS = "artifacts"
+
+
+def fail(message):
+    print(message, file=sys.stderr)
+    try:
+        with open("/dev/termination-log", "w", encoding="utf-8") as termination_log:
+            termination_log.write(message)
+    except OSError:
+        pass
+    raise SystemExit(1)
+
+
+def dialect(uri):
+    return uri.split(":", 1)[0].split("+", 1)[0]
+
+
+def sqlite_path(uri):
+    path = uri.split(":///", 1)[-1].split("?", 1)[0]
+    if not path or path == ":memory:":
+        fail(f"SQLite store {uri!r} has no database file to back up")
+    return unquote(path)
+
+
+def local_artifacts_dir():
+    destination = os.environ.get("BACKUP_ARTIFACTS_DESTINATION", "")
+    if not destination.startswith("file://"):
+        fail(
+            "includeArtifacts requires a file:// artifactsDestination; artifacts in object "
+            f"storage ({destination or 'unset'}) are not copied"
+        )
+    return urlparse(destination).path
+
+
+def backup(repo, uri, include_artifacts):
+    kind = dialect(uri)
+    if kind == "sqlite":
+        source = sqlite3.connect(f"file:{sqlite_path(uri)}?mode=ro", uri=True)
+        target = sqlite3.connect(os.path.join(WORK_DIR, SQLITE_COPY))
+        with target:
+            source.backup(target)
+        source.close()
+        target.close()
+        snapshot = SQLITE_COPY
+    elif kind == "postgresql":
+        snapshot = POSTGRESQL_DUMP
+        if not os.path.exists(os.path.join(WORK_DIR, snapshot)):
+            fail("pg_dump did not produce a dump")
+    else:
+        fail(f"{kind} backend stores are not supported; only PostgreSQL and SQLite are backed up")
+
+    artifacts_dir = local_artifacts_dir() if include_artifacts else None
+    metadata = {
+        "mlflowVersion": VERSION,
+        "backend": kind,
+        "includesArtifacts": artifacts_dir is not None,
+        "createdAt": datetime.now(timezone.utc).isoformat(),
+    }
+    registry_uri = os.environ.get("MLFLOW_REGISTRY_STORE_URI", "")
+    if registry_uri and registry_uri != uri:
+        print("warning: the separate registry store is not included in the backup", file=sys.stderr)
+
+    repo.log_artifact(os.path.join(WORK_DIR, snapshot))
+    if artifacts_dir is not None:
+        repo.log_artifacts(artifacts_dir, ARTIFACTS)
+    # Written last so a partial upload is never mistaken for a complete backup.
+    metadata_path = os.path.join(WORK_DIR, METADATA)
+    with open(metadata_path, "w", encoding="utf-8") as f:
+        json.dump(metadata, f)
+    repo.log_artifact(metadata_path)
+    print(f"backed up the {kind} backend store from MLflow {VERSION}")
+
+
+def restore(repo, uri, include_artifacts):
+    try:
+        metadata_path = repo.download_artifacts(METADATA, WORK_DIR)
+    except Exception as exc:
+        fail(f"backup is incomplete or unreadable: {exc}")
+    with open(metadata_path, encoding="utf-8") as f:
+        metadata = json.load(f)
+
+    kind = dialect(uri)
+    if metadata["backend"] != kind:
+        fail(f"a backup of a {metadata['backend']} store cannot be restored into a {kind} store")
+    if include_artifacts and not metadata.get("includesArtifacts"):
+        fail("includeArtifacts is set but the backup does not contain artifacts")
+
+    if kind == "sqlite":
+        path = sqlite_path(uri)
+        downloaded = repo.download_artifacts(SQLITE_COPY, WORK_DIR)
+        staged = path + ".restore"
+        shutil.copyfile(downloaded, staged)
+        for suffix in ("-wal", "-shm"):
+            if os.path.exists(path + suffix):
+                os.remove(path + suffix)
+        os.replace(staged, path)
+    else:
+        # The pg-restore container loads the dump once this container exits.
+        repo.download_artifacts(POSTGRESQL_DUMP, WORK_DIR)
+
+    if include_artifacts:
+        artifacts_dir = local_artifacts_dir()
+        download_dir = os.path.join(WORK_DIR, "download")
+        os.makedirs(download_dir, exist_ok=True)
+        downloaded = repo.download_artifacts(ARTIFACTS, download_dir)
+        shutil.copytree(downloaded, artifacts_dir, dirs_exist_ok=True)
+    print(f"restored a {kind} backup taken with MLflow {metadata['mlflowVersion']}")
+
+
+def main():
+    uri = os.environ.get("MLFLOW_BACKEND_STORE_URI", "")
+    if not uri:
+        fail("MLFLOW_BACKEND_STORE_URI is not set")
+    repo = get_artifact_repository(os.environ["BACKUP_LOCATION"])
+    include_artifacts = os.environ.get("BACKUP_INCLUDE_ARTIFACTS") == "true"
+    if os.environ["BACKUP_MODE"] == "restore":
+        restore(repo, uri, include_artifacts)
+    else:
+        backup(repo, uri, include_artifacts)
+
+
+if __name__ == "__main__":
+    main()
@@ -0,0 +1,143 @@
+import os
+import sqlite3
+import sys
+from urllib.parse import unquote
+
+import mlflow.store.db.utils as db_utils
+import sqlalchemy as sa
+from mlflow.version import VERSION
+
+WORK_DIR = "/backup"
+SQLITE_COPY = "mlflow.db"
+INSERT_BATCH_SIZE = 1000
+SKIPPED_TABLES = ("alembic_version",)
+
+
+def fail(message):
+    print(message, file=sys.stderr)
+    try:
+        with open("/dev/termination-log", "w", encoding="utf-8") as termination_log:
+            termination_log.write(message)
+    except OSError:
+        pass
+    raise SystemExit(1)
+
+
+def dialect(uri):
+    return uri.split(":", 1)[0].split("+", 1)[0]
+
+
+def sqlite_path(uri):
+    path = uri.split(":///", 1)[-1].split("?", 1)[0]
+    if not path or path == ":memory:":
+        fail(f"SQLite store {uri!r} has no database file to copy")
+    return unquote(path)
+
+
+def upgrade(name, engine):
+    if db_utils._get_schema_version(engine):
+        db_utils._upgrade_db(engine)
+    else:
+        db_utils._initialize_tables(engine)
+    print(f"{name} store at revision {db_utils._get_schema_version(engine)!r}")
+
+
+def snapshot(path):
+    # Copy the live file so upgrading its schema never touches the store the previous
+    # revision falls back to.
+    if not os.path.exists(path):
+        fail(f"SQLite database {path} does not exist on the volume")
+    copy = os.path.join(WORK_DIR, SQLITE_COPY)
+    source = sqlite3.connect(f"file:{path}?mode=ro", uri=True)
+    target = sqlite3.connect(copy)
+    with target:
+        source.backup(target)
+    source.close()
+    target.close()
+    return f"sqlite:///{copy}"
+
+
+def row_counts(connection, tables):
+    return {
+        table.name: connection.execute(sa.select(sa.func.count()).select_from(table)).scalar_one()
+        for table in tables
+    }
+
+
+def reset_sequences(connection, tables):
+    # Rows are inserted with their IDs, so serial sequences must move past them.
+    for table in tables:
+        for column in table.primary_key.columns:
+            if not isinstance(column.type, sa.Integer):
+                continue
+            sequence = connection.execute(
+                sa.text("SELECT pg_get_serial_sequence(:table, :column)"),
+                {"table": table.name, "column": column.name},
+            ).scalar_one()
+            if sequence:
+                connection.execute(
+                    sa.text(
+                        f'SELECT setval(:sequence, COALESCE(MAX("{column.name}"), 1), MAX("{column.name}") IS NOT NULL) '
+                        f'FROM "{table.name}"'
+                    ),
+                    {"sequence": sequence},
+                )
+
+
+def main():
+    source_uri = os.environ.get("MLFLOW_BACKEND_STORE_URI", "").strip()
+    target_uri = os.environ.get("SQLITE_MIGRATION_TARGET_URI", "").strip()
+    if dialect(source_uri) != "sqlite":
+        fail(f"the current backend store is {dialect(source_uri)!r}, not SQLite")
+    if dialect(target_uri) != "postgresql":
+        fail(f"SQLite data can only be copied into PostgreSQL; the new backend store is {dialect(target_uri)!r}")
+
+    print(f"Copying the SQLite backend store into PostgreSQL with MLflow {VERSION}")
+    source_engine = db_utils.create_sqlalchemy_engine_with_retry(snapshot(sqlite_path(source_uri)))
+    target_engine = db_utils.create_sqlalchemy_engine_with_retry(target_uri)
+    upgrade("SQLite", source_engine)
+    upgrade("PostgreSQL", target_engine)
+
+    source_metadata = sa.MetaData()
+    source_metadata.reflect(source_engine)
+    target_metadata = sa.MetaData()
+    target_metadata.reflect(target_engine)
+    tables = [table for table in target_metadata.sorted_tables if table.name not in SKIPPED_TABLES]
+    missing = [table.name for table in tables if table.name not in source_metadata.tables]
+    if missing:
+        fail(f"SQLite store is missing tables after the upgrade: {', '.join(missing)}")
+
+    with target_engine.connect() as connection:
+        populated = [name for name, count in row_counts(connection, tables).items() if count]
+    if populated:
+        fail(
+            "the new backend store already contains data in "
+            f"{', '.join(sorted(populated))}; copy into an empty PostgreSQL database"
+        )
+
+    with source_engine.connect() as source, target_engine.begin() as target:
+        for table in tables:
+            source_table = source_metadata.tables[table.name]
+            columns = [column.name for column in table.columns if column.name in source_table.columns]
+            result = source.execute(sa.select(*(source_table.columns[name] for name in columns)))
+            while batch := result.fetchmany(INSERT_BATCH_SIZE):
+                target.execute(table.insert(), [dict(zip(columns, row)) for row in batch])
+        reset_sequences(target, tables)
+
+    with source_engine.connect() as source, target_engine.connect() as target:
+        expected = row_counts(source, [source_metadata.tables[table.name] for table in tables])
+        copied = row_counts(target, tables)
+    mismatched = [f"{name} ({copied[name]} of {count})" for name, count in expected.items() if copied[name] != count]
+    if mismatched:
+        fail(f"row counts differ after the copy: {', '.join(mismatched)}")
+    print(f"Copied and verified {sum(copied.values())} rows in {len(tables)} tables")
+    return 0
+
+
+if __name__ == "__main__":
+    try:
+        raise SystemExit(main())
+    except SystemExit:
+        raise
+    except Exception as exc:
+        fail(f"copying the SQLite backend store failed: {exc.__class__.__name__}: {exc}"[:1024])
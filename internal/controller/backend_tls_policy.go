@@ -0,0 +1,180 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
+)
+
+const (
+	BackendTLSPolicyCRDName = "BackendTLSPolicy"
+
+	// backendCAConfigMapKey is the key Gateway API implementations read a referenced CA
+	// ConfigMap from. The service CA ConfigMap uses service-ca.crt, so its bundle is copied.
+	backendCAConfigMapKey = "ca.crt"
+)
+
+// IsBackendTLSPolicyAvailable checks if the Gateway API BackendTLSPolicy CRD is available in the cluster using discovery API
+func IsBackendTLSPolicyAvailable(discoveryClient discovery.DiscoveryInterface) (bool, error) {
+	return isKindAvailable(discoveryClient, gatewayv1.SchemeGroupVersion, BackendTLSPolicyCRDName)
+}
+
+// backendCAConfigMapName returns the name of the ConfigMap holding the CA the Gateway verifies
+// the MLflow serving certificate against.
+func backendCAConfigMapName(mlflow *mlflowv1.MLflow) string {
+	return ResourceName + render.ResourceSuffix(mlflow.Name) + "-backend-ca"
+}
+
+// buildBackendCAConfigMap copies the service CA bundle into the ca.crt key.
+func buildBackendCAConfigMap(mlflow *mlflowv1.MLflow, namespace, caBundle string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      backendCAConfigMapName(mlflow),
+			Namespace: namespace,
+			Labels:    map[string]string{"app": ResourceName},
+		},
+		Data: map[string]string{backendCAConfigMapKey: caBundle},
+	}
+}
+
+// buildBackendTLSPolicy constructs the BackendTLSPolicy that makes the Gateway verify the
+// serving certificate of every Service httpRoute sends traffic to. The revision and derived
+// server Services reuse the main serving certificate, so all of them are verified against
+// the main Service hostname.
+func buildBackendTLSPolicy(mlflow *mlflowv1.MLflow, namespace string, httpRoute *gatewayv1.HTTPRoute) *gatewayv1.BackendTLSPolicy {
+	var targetRefs []gatewayv1.LocalPolicyTargetReferenceWithSectionName
+	seen := map[gatewayv1.ObjectName]bool{}
+	for _, rule := range httpRoute.Spec.Rules {
+		for _, backendRef := range rule.BackendRefs {
+			if seen[backendRef.Name] {
+				continue
+			}
+			seen[backendRef.Name] = true
+			targetRefs = append(targetRefs, gatewayv1.LocalPolicyTargetReferenceWithSectionName{
+				LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+					Group: "",
+					Kind:  "Service",
+					Name:  backendRef.Name,
+				},
+			})
+		}
+	}
+
+	return &gatewayv1.BackendTLSPolicy{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "gateway.networking.k8s.io/v1",
+			Kind:       BackendTLSPolicyCRDName,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ResourceName + render.ResourceSuffix(mlflow.Name),
+			Namespace: namespace,
+			Labels:    map[string]string{"app": ResourceName},
+		},
+		Spec: gatewayv1.BackendTLSPolicySpec{
+			TargetRefs: targetRefs,
+			Validation: gatewayv1.BackendTLSPolicyValidation{
+				CACertificateRefs: []gatewayv1.LocalObjectReference{{
+					Group: "",
+					Kind:  "ConfigMap",
+					Name:  gatewayv1.ObjectName(backendCAConfigMapName(mlflow)),
+				}},
+				Hostname: gatewayv1.PreciseHostname(mlflowServiceHost(mlflow, namespace)),
+			},
+		},
+	}
+}
+
+// reconcileBackendTLSPolicy applies the BackendTLSPolicy for the HTTPRoute hop to the MLflow
+// Services, together with the CA ConfigMap it references, or deletes both when there is no
+// HTTPRoute. The policy needs the OpenShift service CA that signs the serving certificate; it
+// is not rendered with operator-issued self-signed certificates, and waits until the service
+// CA has been injected into the namespace.
+func (r *MLflowReconciler) reconcileBackendTLSPolicy(
+	ctx context.Context,
+	mlflow *mlflowv1.MLflow,
+	namespace string,
+	cfg *config.OperatorConfig,
+) error {
+	log := logf.FromContext(ctx)
+	if !r.BackendTLSPolicyAvailable {
+		return nil
+	}
+
+	caBundle := ""
+	if r.HTTPRouteAvailable && routingEnabled(mlflow) && r.ConsoleLinkAvailable && !r.SelfSignedTLS {
+		serviceCA := &corev1.ConfigMap{}
+		err := r.Get(ctx, types.NamespacedName{Name: render.ServiceCABundleConfigMapName, Namespace: namespace}, serviceCA)
+		if err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to get ConfigMap %s: %w", render.ServiceCABundleConfigMapName, err)
+		}
+		caBundle = serviceCA.Data[render.ServiceCABundleConfigMapKey]
+		if caBundle == "" {
+			log.V(1).Info("Service CA bundle not injected yet, skipping BackendTLSPolicy", "configmap", render.ServiceCABundleConfigMapName)
+		}
+	}
+
+	policy := buildBackendTLSPolicy(mlflow, namespace, buildHTTPRoute(mlflow, namespace, cfg))
+	configMap := buildBackendCAConfigMap(mlflow, namespace, caBundle)
+	if caBundle == "" {
+		// Both objects are cached, so checking first avoids a delete call on every reconcile.
+		for _, obj := range []client.Object{policy, configMap} {
+			existing := obj.DeepCopyObject().(client.Object)
+			err := r.Get(ctx, client.ObjectKeyFromObject(obj), existing)
+			if errors.IsNotFound(err) {
+				continue
+			} else if err != nil {
+				return fmt.Errorf("failed to get %s %s: %w", obj.GetObjectKind().GroupVersionKind().Kind, obj.GetName(), err)
+			}
+			if !metav1.IsControlledBy(existing, mlflow) {
+				continue
+			}
+			if err := r.Delete(ctx, existing); err != nil && !errors.IsNotFound(err) {
+				return fmt.Errorf("failed to delete %s %s: %w", obj.GetObjectKind().GroupVersionKind().Kind, obj.GetName(), err)
+			}
+			log.Info("Deleted backend TLS object", "kind", obj.GetObjectKind().GroupVersionKind().Kind, "name", obj.GetName())
+		}
+		return nil
+	}
+
+	for _, obj := range []client.Object{configMap, policy} {
+		propagateMetadata(mlflow, obj)
+		if err := controllerutil.SetControllerReference(mlflow, obj, r.Scheme); err != nil {
+			return fmt.Errorf("failed to set controller reference on %s: %w", obj.GetObjectKind().GroupVersionKind().Kind, err)
+		}
+		if err := r.applyObject(ctx, obj); err != nil {
+			return fmt.Errorf("failed to apply %s %s: %w", obj.GetObjectKind().GroupVersionKind().Kind, obj.GetName(), err)
+		}
+	}
+	log.V(1).Info("Successfully reconciled BackendTLSPolicy", "name", policy.GetName(), "targets", len(policy.Spec.TargetRefs))
+	return nil
+}
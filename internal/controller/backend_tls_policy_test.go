@@ -0,0 +1,116 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
+)
+
+func TestBuildBackendTLSPolicy(t *testing.T) {
+	g := gomega.NewWithT(t)
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: ResourceName},
+		Spec: mlflowv1.MLflowSpec{
+			ServeArtifacts:  ptr(true),
+			ArtifactsServer: &mlflowv1.ArtifactsServerConfig{Enabled: true},
+		},
+	}
+
+	policy := buildBackendTLSPolicy(mlflow, "test-ns", buildHTTPRoute(mlflow, "test-ns", &config.OperatorConfig{}))
+	var targets []gatewayv1.ObjectName
+	for _, ref := range policy.Spec.TargetRefs {
+		g.Expect(ref.Kind).To(gomega.Equal(gatewayv1.Kind("Service")))
+		targets = append(targets, ref.Name)
+	}
+	// Each Service is targeted once, however many rules send traffic to it.
+	g.Expect(targets).To(gomega.Equal([]gatewayv1.ObjectName{"mlflow", gatewayv1.ObjectName(render.ArtifactsServerResourceName(mlflow))}))
+	g.Expect(policy.Spec.Validation.Hostname).To(gomega.Equal(gatewayv1.PreciseHostname("mlflow.test-ns.svc.cluster.local")))
+	g.Expect(policy.Spec.Validation.CACertificateRefs).To(gomega.ConsistOf(gatewayv1.LocalObjectReference{
+		Kind: "ConfigMap", Name: "mlflow-backend-ca",
+	}))
+}
+
+func TestReconcileBackendTLSPolicy(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(gomega.Succeed())
+	g.Expect(gatewayv1.Install(scheme)).To(gomega.Succeed())
+	g.Expect(mlflowv1.AddToScheme(scheme)).To(gomega.Succeed())
+	serviceCA := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: render.ServiceCABundleConfigMapName, Namespace: "test-ns"},
+		Data:       map[string]string{render.ServiceCABundleConfigMapKey: "-----BEGIN CERTIFICATE-----"},
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(serviceCA).Build()
+	r := &MLflowReconciler{
+		Client: k8sClient, Scheme: scheme,
+		HTTPRouteAvailable: true, BackendTLSPolicyAvailable: true, ConsoleLinkAvailable: true,
+	}
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: ResourceName, UID: "uid"}}
+	cfg := &config.OperatorConfig{}
+	key := func(name string) types.NamespacedName { return types.NamespacedName{Name: name, Namespace: "test-ns"} }
+
+	g.Expect(r.reconcileBackendTLSPolicy(ctx, mlflow, "test-ns", cfg)).To(gomega.Succeed())
+	policy := &gatewayv1.BackendTLSPolicy{}
+	g.Expect(k8sClient.Get(ctx, key("mlflow"), policy)).To(gomega.Succeed())
+	g.Expect(policy.OwnerReferences).To(gomega.HaveLen(1))
+	caConfigMap := &corev1.ConfigMap{}
+	g.Expect(k8sClient.Get(ctx, key("mlflow-backend-ca"), caConfigMap)).To(gomega.Succeed())
+	g.Expect(caConfigMap.Data).To(gomega.HaveKeyWithValue("ca.crt", "-----BEGIN CERTIFICATE-----"))
+
+	// Without a route the Gateway no longer reaches the Services, so both objects go.
+	mlflow.Spec.Routing = &mlflowv1.RoutingConfig{Enabled: ptr(false)}
+	g.Expect(r.reconcileBackendTLSPolicy(ctx, mlflow, "test-ns", cfg)).To(gomega.Succeed())
+	g.Expect(apierrors.IsNotFound(k8sClient.Get(ctx, key("mlflow"), &gatewayv1.BackendTLSPolicy{}))).To(gomega.BeTrue())
+	g.Expect(apierrors.IsNotFound(k8sClient.Get(ctx, key("mlflow-backend-ca"), &corev1.ConfigMap{}))).To(gomega.BeTrue())
+}
+
+func TestReconcileBackendTLSPolicy_SelfSignedTLS(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(gomega.Succeed())
+	g.Expect(gatewayv1.Install(scheme)).To(gomega.Succeed())
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	r := &MLflowReconciler{
+		Client: k8sClient, Scheme: scheme,
+		HTTPRouteAvailable: true, BackendTLSPolicyAvailable: true, SelfSignedTLS: true,
+	}
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: ResourceName}}
+
+	// Self-signed serving certificates have no service CA to reference.
+	g.Expect(r.reconcileBackendTLSPolicy(ctx, mlflow, "test-ns", &config.OperatorConfig{})).To(gomega.Succeed())
+	err := k8sClient.Get(ctx, types.NamespacedName{Name: "mlflow", Namespace: "test-ns"}, &gatewayv1.BackendTLSPolicy{})
+	g.Expect(apierrors.IsNotFound(err)).To(gomega.BeTrue())
+}
@@ -0,0 +1,292 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	_ "embed"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+const (
+	// RestoreAnnotation names the MLflowRestore that holds the MLflow instance scaled to zero.
+	RestoreAnnotation = "mlflow.opendatahub.io/restore"
+
+	backupCompleteConditionType = "Complete"
+	backupWorkVolumeName        = "backup-data"
+	backupWorkDir               = "/backup"
+	backupModeBackup            = "backup"
+	backupModeRestore           = "restore"
+	backupJobTTLSeconds         = int32(24 * 60 * 60)
+	backupPollInterval          = 15 * time.Second
+	backupWaitInterval          = 30 * time.Second
+
+	backupPythonScriptEnv = "BACKUP_PYTHON_SCRIPT"
+	backupPythonCommand   = `exec python3.12 -c "$BACKUP_PYTHON_SCRIPT"`
+
+	// pg_dump and pg_restore do not understand SQLAlchemy driver suffixes such as
+	// postgresql+psycopg2://, so the scripts strip them before connecting.
+	pgConnectionURI  = `"$(printf '%s' "$MLFLOW_BACKEND_STORE_URI" | sed -E 's#^postgresql\+[^:]*://#postgresql://#')"`
+	pgDumpCommand    = `pg_dump --format=custom --no-owner --no-privileges --file=/backup/backend.dump ` + pgConnectionURI
+	pgRestoreCommand = `pg_restore --clean --if-exists --no-owner --no-privileges --single-transaction --exit-on-error ` +
+		`--dbname=` + pgConnectionURI + ` /backup/backend.dump`
+
+	pgDumpScript = `case "$MLFLOW_BACKEND_STORE_URI" in
+  postgresql*) exec ` + pgDumpCommand + ` ;;
+  *) echo "backend store is not PostgreSQL; skipping pg_dump" ;;
+esac`
+	pgRestoreScript = `if [ -f /backup/backend.dump ]; then exec ` + pgRestoreCommand + `; fi
+echo "no PostgreSQL dump to load"`
+)
+
+//go:embed assets/mlflow_backup.py
+var backupPythonScript string
+
+// dataJob describes a backup or restore Job derived from the running MLflow Deployment.
+type dataJob struct {
+	mode             string
+	name             string
+	location         string
+	includeArtifacts bool
+	envFrom          []corev1.EnvFromSource
+	postgreSQLImage  string
+}
+
+// backupJobName returns the Job name for an MLflowBackup or MLflowRestore, hashing long
+// resource names so the Job name stays a valid label value.
+func backupJobName(mode, name string) string {
+	jobName := ResourceName + "-" + mode + "-" + name
+	if len(jobName) <= 63 {
+		return jobName
+	}
+	sum := sha256.Sum256([]byte(name))
+	suffix := "-" + hex.EncodeToString(sum[:])[:8]
+	return strings.TrimRight(jobName[:63-len(suffix)], "-.") + suffix
+}
+
+// backupLocation is the object storage prefix that holds one MLflowBackup.
+func backupLocation(backup *mlflowv1.MLflowBackup) string {
+	return strings.TrimRight(backup.Spec.Destination.URI, "/") + "/" + backup.Name
+}
+
+// buildDataJob derives a backup or restore Job from the MLflow Deployment so it sees the
+// same backend store credentials, CA bundle, and storage volumes as the server. The
+// PostgreSQL client container runs before the MLflow container for a backup and after it
+// for a restore, exchanging the dump through a shared emptyDir.
+func buildDataJob(deployment *appsv1.Deployment, spec dataJob) (*batchv1.Job, error) {
+	podSpec := deployment.Spec.Template.Spec.DeepCopy()
+	mainContainer := findContainer(podSpec.Containers, "mlflow")
+	if mainContainer == nil {
+		return nil, fmt.Errorf("deployment %s/%s has no mlflow container", deployment.Namespace, deployment.Name)
+	}
+
+	workMount := corev1.VolumeMount{Name: backupWorkVolumeName, MountPath: backupWorkDir}
+	mlflowContainer := corev1.Container{
+		Name:            spec.mode,
+		Image:           mainContainer.Image,
+		ImagePullPolicy: mainContainer.ImagePullPolicy,
+		Command:         []string{"/bin/sh", "-ec"},
+		Args:            []string{backupPythonCommand},
+		Env: append(slices.Clone(mainContainer.Env),
+			corev1.EnvVar{Name: backupPythonScriptEnv, Value: backupPythonScript},
+			corev1.EnvVar{Name: "BACKUP_MODE", Value: spec.mode},
+			corev1.EnvVar{Name: "BACKUP_LOCATION", Value: spec.location},
+			corev1.EnvVar{Name: "BACKUP_INCLUDE_ARTIFACTS", Value: fmt.Sprintf("%t", spec.includeArtifacts)},
+			corev1.EnvVar{Name: "BACKUP_ARTIFACTS_DESTINATION", Value: artifactsDestinationArg(mainContainer.Args)},
+		),
+		EnvFrom:         append(slices.Clone(mainContainer.EnvFrom), spec.envFrom...),
+		VolumeMounts:    append(slices.Clone(mainContainer.VolumeMounts), workMount),
+		Resources:       mainContainer.Resources,
+		SecurityContext: mainContainer.SecurityContext,
+	}
+	mlflowContainer.Resources.Claims = nil
+
+	postgresContainer := corev1.Container{
+		Name:            "pg-dump",
+		Image:           spec.postgreSQLImage,
+		ImagePullPolicy: corev1.PullIfNotPresent,
+		Command:         []string{"/bin/sh", "-ec"},
+		Args:            []string{pgDumpScript},
+		Env:             backendStoreURIEnv(mainContainer.Env),
+		VolumeMounts:    []corev1.VolumeMount{workMount},
+		SecurityContext: mainContainer.SecurityContext.DeepCopy(),
+	}
+
+	initContainers := filterMigrationInitContainers(podSpec.InitContainers)
+	if spec.mode == backupModeRestore {
+		postgresContainer.Name = "pg-restore"
+		postgresContainer.Args = []string{pgRestoreScript}
+		podSpec.InitContainers = append(initContainers, mlflowContainer)
+		podSpec.Containers = []corev1.Container{postgresContainer}
+	} else {
+		podSpec.InitContainers = append(initContainers, postgresContainer)
+		podSpec.Containers = []corev1.Container{mlflowContainer}
+	}
+	podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+		Name:         backupWorkVolumeName,
+		VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+	})
+	podSpec.Volumes = filterVolumes(podSpec.Volumes, usedVolumeNames(*podSpec))
+	podSpec.RestartPolicy = corev1.RestartPolicyNever
+	podSpec.ResourceClaims = nil
+	podSpec.Affinity = dataJobAffinity(deployment, podSpec)
+
+	labels := buildDataJobLabels(deployment.Spec.Template.Labels, spec.mode)
+	backoffLimit := int32(0)
+	ttl := backupJobTTLSeconds
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      spec.name,
+			Namespace: deployment.Namespace,
+			Labels:    labels,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:            &backoffLimit,
+			TTLSecondsAfterFinished: &ttl,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      labels,
+					Annotations: deployment.Spec.Template.Annotations,
+				},
+				Spec: *podSpec,
+			},
+		},
+	}, nil
+}
+
+// buildDataJobLabels drops the app label so Job pods never join the server Service.
+func buildDataJobLabels(templateLabels map[string]string, mode string) map[string]string {
+	labels := make(map[string]string, len(templateLabels)+1)
+	for key, value := range templateLabels {
+		if key == "app" {
+			continue
+		}
+		labels[key] = value
+	}
+	labels["component"] = "mlflow-" + mode
+	return labels
+}
+
+// dataJobAffinity keeps the server's node placement but drops its pod anti-affinity, which
+// targets the server pods. While server pods still run and the Job mounts a PVC, the Job is
+// pinned to their node so ReadWriteOnce volumes can attach.
+func dataJobAffinity(deployment *appsv1.Deployment, podSpec *corev1.PodSpec) *corev1.Affinity {
+	affinity := &corev1.Affinity{}
+	if podSpec.Affinity != nil {
+		affinity.NodeAffinity = podSpec.Affinity.NodeAffinity
+	}
+	mountsClaim := slices.ContainsFunc(podSpec.Volumes, func(volume corev1.Volume) bool {
+		return volume.PersistentVolumeClaim != nil
+	})
+	if mountsClaim && deployment.Status.Replicas > 0 && deployment.Spec.Selector != nil {
+		affinity.PodAffinity = &corev1.PodAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{{
+				LabelSelector: deployment.Spec.Selector.DeepCopy(),
+				TopologyKey:   "kubernetes.io/hostname",
+			}},
+		}
+	}
+	if affinity.NodeAffinity == nil && affinity.PodAffinity == nil {
+		return nil
+	}
+	return affinity
+}
+
+func artifactsDestinationArg(args []string) string {
+	for _, arg := range args {
+		if value, ok := strings.CutPrefix(arg, "--artifacts-destination="); ok {
+			return value
+		}
+	}
+	return ""
+}
+
+func backendStoreURIEnv(env []corev1.EnvVar) []corev1.EnvVar {
+	for _, envVar := range env {
+		if envVar.Name == "MLFLOW_BACKEND_STORE_URI" {
+			return []corev1.EnvVar{envVar}
+		}
+	}
+	return nil
+}
+
+// getDataJob reads a backup or restore Job directly from the API server, because the Job
+// cache only holds migration Jobs. A missing Job returns nil.
+func getDataJob(ctx context.Context, c client.Reader, name, namespace string) (*batchv1.Job, error) {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("batch/v1")
+	obj.SetKind("Job")
+	if err := c.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, obj); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	job := &batchv1.Job{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, job); err != nil {
+		return nil, fmt.Errorf("failed to convert Job %s: %w", name, err)
+	}
+	return job, nil
+}
+
+// dataJobFailureMessage prefers the termination message written by a failed container and
+// falls back to the Job's Failed condition.
+func dataJobFailureMessage(ctx context.Context, c client.Reader, job *batchv1.Job) string {
+	list := &unstructured.UnstructuredList{}
+	list.SetAPIVersion("v1")
+	list.SetKind("PodList")
+	if err := c.List(ctx, list, client.InNamespace(job.Namespace), client.MatchingLabels{"job-name": job.Name}); err == nil {
+		for _, item := range list.Items {
+			pod := &corev1.Pod{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, pod); err != nil {
+				continue
+			}
+			statuses := append(slices.Clone(pod.Status.InitContainerStatuses), pod.Status.ContainerStatuses...)
+			for _, status := range statuses {
+				terminated := status.State.Terminated
+				if terminated == nil || terminated.ExitCode == 0 {
+					continue
+				}
+				if message := strings.TrimSpace(terminated.Message); message != "" {
+					return fmt.Sprintf("container %s failed: %s", status.Name, message)
+				}
+				return fmt.Sprintf("container %s exited with code %d", status.Name, terminated.ExitCode)
+			}
+		}
+	}
+	if condition := jobFailedCondition(job); condition != nil && condition.Message != "" {
+		return condition.Message
+	}
+	return fmt.Sprintf("Job %s failed", job.Name)
+}
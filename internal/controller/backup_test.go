@@ -0,0 +1,394 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func newBackupTestDeployment(replicas int32) *appsv1.Deployment {
+	selector := map[string]string{"app": ResourceName}
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: ResourceName, Namespace: "opendatahub", Labels: selector},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: selector},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": ResourceName, "component": "mlflow"}},
+				Spec: corev1.PodSpec{
+					ServiceAccountName: "mlflow-sa",
+					Affinity: &corev1.Affinity{PodAntiAffinity: &corev1.PodAntiAffinity{
+						PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{{Weight: 100}},
+					}},
+					Containers: []corev1.Container{{
+						Name:  "mlflow",
+						Image: "quay.io/opendatahub/mlflow:main",
+						Args:  []string{"--artifacts-destination=file:///mlflow/artifacts"},
+						Env: []corev1.EnvVar{
+							{Name: "MLFLOW_BACKEND_STORE_URI", Value: "sqlite:////mlflow/mlflow.db"},
+							{Name: "MLFLOW_K8S_AUTH_AUTHORIZATION_MODE", Value: "self_subject_access_review"},
+						},
+						VolumeMounts: []corev1.VolumeMount{{Name: "mlflow-storage", MountPath: "/mlflow"}},
+						Ports:        []corev1.ContainerPort{{Name: "http", ContainerPort: 8443}},
+					}},
+					Volumes: []corev1.Volume{
+						{Name: "mlflow-storage", VolumeSource: corev1.VolumeSource{
+							PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "mlflow-pvc"},
+						}},
+						{Name: "unused", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+					},
+				},
+			},
+		},
+		Status: appsv1.DeploymentStatus{Replicas: replicas},
+	}
+}
+
+func TestBuildDataJob(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	job, err := buildDataJob(newBackupTestDeployment(1), dataJob{
+		mode:             backupModeBackup,
+		name:             "mlflow-backup-nightly",
+		location:         "s3://backups/nightly",
+		includeArtifacts: true,
+		envFrom:          []corev1.EnvFromSource{{SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "creds"}}}},
+		postgreSQLImage:  "quay.io/sclorg/postgresql-16-c9s:latest",
+	})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(job.Namespace).To(gomega.Equal("opendatahub"))
+	g.Expect(job.Labels).NotTo(gomega.HaveKey("app"), "Job pods must not join the server Service")
+	g.Expect(job.Labels).To(gomega.HaveKeyWithValue("component", "mlflow-backup"))
+
+	podSpec := job.Spec.Template.Spec
+	g.Expect(podSpec.RestartPolicy).To(gomega.Equal(corev1.RestartPolicyNever))
+	g.Expect(podSpec.ServiceAccountName).To(gomega.Equal("mlflow-sa"))
+	g.Expect(podSpec.InitContainers).To(gomega.HaveLen(1))
+	pgDump := podSpec.InitContainers[0]
+	g.Expect(pgDump.Name).To(gomega.Equal("pg-dump"))
+	g.Expect(pgDump.Image).To(gomega.Equal("quay.io/sclorg/postgresql-16-c9s:latest"))
+	g.Expect(pgDump.Env).To(gomega.Equal([]corev1.EnvVar{{Name: "MLFLOW_BACKEND_STORE_URI", Value: "sqlite:////mlflow/mlflow.db"}}))
+
+	g.Expect(podSpec.Containers).To(gomega.HaveLen(1))
+	backup := podSpec.Containers[0]
+	g.Expect(backup.Name).To(gomega.Equal("backup"))
+	g.Expect(backup.Image).To(gomega.Equal("quay.io/opendatahub/mlflow:main"))
+	g.Expect(backup.Args).To(gomega.Equal([]string{backupPythonCommand}))
+	g.Expect(backup.Ports).To(gomega.BeEmpty())
+	g.Expect(backup.Env).To(gomega.ContainElements(
+		corev1.EnvVar{Name: "BACKUP_MODE", Value: "backup"},
+		corev1.EnvVar{Name: "BACKUP_LOCATION", Value: "s3://backups/nightly"},
+		corev1.EnvVar{Name: "BACKUP_INCLUDE_ARTIFACTS", Value: "true"},
+		corev1.EnvVar{Name: "BACKUP_ARTIFACTS_DESTINATION", Value: "file:///mlflow/artifacts"},
+		corev1.EnvVar{Name: "MLFLOW_K8S_AUTH_AUTHORIZATION_MODE", Value: "self_subject_access_review"},
+	))
+	g.Expect(backup.EnvFrom).To(gomega.HaveLen(1))
+	g.Expect(backup.VolumeMounts).To(gomega.ContainElement(corev1.VolumeMount{Name: backupWorkVolumeName, MountPath: backupWorkDir}))
+
+	volumeNames := []string{}
+	for _, volume := range podSpec.Volumes {
+		volumeNames = append(volumeNames, volume.Name)
+	}
+	g.Expect(volumeNames).To(gomega.ConsistOf("mlflow-storage", backupWorkVolumeName))
+
+	// The running server holds the PVC, so the Job joins it on the same node and drops the
+	// server's anti-affinity.
+	g.Expect(podSpec.Affinity.PodAntiAffinity).To(gomega.BeNil())
+	g.Expect(podSpec.Affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution).To(gomega.HaveLen(1))
+
+	job, err = buildDataJob(newBackupTestDeployment(0), dataJob{mode: backupModeRestore, name: "mlflow-restore-nightly"})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	podSpec = job.Spec.Template.Spec
+	g.Expect(podSpec.Affinity).To(gomega.BeNil())
+	g.Expect(podSpec.InitContainers).To(gomega.HaveLen(1))
+	g.Expect(podSpec.InitContainers[0].Name).To(gomega.Equal("restore"))
+	g.Expect(podSpec.Containers).To(gomega.HaveLen(1))
+	g.Expect(podSpec.Containers[0].Name).To(gomega.Equal("pg-restore"))
+	g.Expect(podSpec.Containers[0].Args).To(gomega.Equal([]string{pgRestoreScript}))
+
+	deployment := newBackupTestDeployment(0)
+	deployment.Spec.Template.Spec.Containers[0].Name = "server"
+	_, err = buildDataJob(deployment, dataJob{mode: backupModeBackup})
+	g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("no mlflow container")))
+}
+
+func TestBackupJobName(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	g.Expect(backupJobName(backupModeBackup, "nightly")).To(gomega.Equal("mlflow-backup-nightly"))
+	long := strings.Repeat("a", 100)
+	name := backupJobName(backupModeRestore, long)
+	g.Expect(len(name)).To(gomega.BeNumerically("<=", 63))
+	g.Expect(name).To(gomega.HavePrefix("mlflow-restore-aaa"))
+	g.Expect(name).NotTo(gomega.Equal(backupJobName(backupModeRestore, long+"b")))
+}
+
+func newBackupTestScheme(g *gomega.WithT) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(gomega.Succeed())
+	g.Expect(mlflowv1.AddToScheme(scheme)).To(gomega.Succeed())
+	return scheme
+}
+
+func newTestMLflowBackup() *mlflowv1.MLflowBackup {
+	return &mlflowv1.MLflowBackup{
+		ObjectMeta: metav1.ObjectMeta{Name: "nightly", UID: "backup-uid"},
+		Spec: mlflowv1.MLflowBackupSpec{
+			Destination: mlflowv1.BackupDestination{URI: "s3://backups/prod/"},
+		},
+	}
+}
+
+func completeTestJob(ctx context.Context, g *gomega.WithT, c client.Client, name string) {
+	job := &batchv1.Job{}
+	g.Expect(c.Get(ctx, types.NamespacedName{Name: name, Namespace: "opendatahub"}, job)).To(gomega.Succeed())
+	job.Status.Succeeded = 1
+	g.Expect(c.Status().Update(ctx, job)).To(gomega.Succeed())
+}
+
+func TestMLflowBackupReconcile(t *testing.T) {
+	g := gomega.NewWithT(t)
+	scheme := newBackupTestScheme(g)
+
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(newTestMLflowBackup()).
+		WithStatusSubresource(&mlflowv1.MLflowBackup{}, &batchv1.Job{}).
+		Build()
+	r := &MLflowBackupReconciler{Client: k8sClient, Scheme: scheme, Namespace: "opendatahub"}
+	ctx := context.Background()
+	request := ctrl.Request{NamespacedName: types.NamespacedName{Name: "nightly"}}
+
+	result, err := r.Reconcile(ctx, request)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(result.RequeueAfter).To(gomega.Equal(backupWaitInterval))
+	backup := &mlflowv1.MLflowBackup{}
+	g.Expect(k8sClient.Get(ctx, request.NamespacedName, backup)).To(gomega.Succeed())
+	g.Expect(backup.Status.Phase).To(gomega.Equal(mlflowv1.BackupPhasePending))
+
+	g.Expect(k8sClient.Create(ctx, newBackupTestDeployment(1))).To(gomega.Succeed())
+	result, err = r.Reconcile(ctx, request)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(result.RequeueAfter).To(gomega.Equal(backupPollInterval))
+	job := &batchv1.Job{}
+	g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "mlflow-backup-nightly", Namespace: "opendatahub"}, job)).To(gomega.Succeed())
+	g.Expect(metav1.IsControlledBy(job, backup)).To(gomega.BeTrue())
+	g.Expect(k8sClient.Get(ctx, request.NamespacedName, backup)).To(gomega.Succeed())
+	g.Expect(backup.Status.Phase).To(gomega.Equal(mlflowv1.BackupPhaseRunning))
+	g.Expect(backup.Status.Location).To(gomega.Equal("s3://backups/prod/nightly"))
+	g.Expect(backup.Status.StartTime).NotTo(gomega.BeNil())
+
+	completeTestJob(ctx, g, k8sClient, "mlflow-backup-nightly")
+	result, err = r.Reconcile(ctx, request)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(result.RequeueAfter).To(gomega.BeZero())
+	g.Expect(k8sClient.Get(ctx, request.NamespacedName, backup)).To(gomega.Succeed())
+	g.Expect(backup.Status.Phase).To(gomega.Equal(mlflowv1.BackupPhaseSucceeded))
+	g.Expect(backup.Status.CompletionTime).NotTo(gomega.BeNil())
+	g.Expect(meta.IsStatusConditionTrue(backup.Status.Conditions, backupCompleteConditionType)).To(gomega.BeTrue())
+}
+
+func TestMLflowBackupReconcile_JobFailed(t *testing.T) {
+	g := gomega.NewWithT(t)
+	scheme := newBackupTestScheme(g)
+
+	backup := newTestMLflowBackup()
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow-backup-nightly", Namespace: "opendatahub"},
+		Status: batchv1.JobStatus{Conditions: []batchv1.JobCondition{{
+			Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Message: "Job has reached the specified backoff limit",
+		}}},
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow-backup-nightly-x", Namespace: "opendatahub", Labels: map[string]string{"job-name": job.Name}},
+		Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{{
+			Name:  "backup",
+			State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 1, Message: "mysql backend stores are not supported"}},
+		}}},
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(backup, job, pod).
+		WithStatusSubresource(&mlflowv1.MLflowBackup{}).
+		Build()
+	r := &MLflowBackupReconciler{Client: k8sClient, Scheme: scheme, Namespace: "opendatahub"}
+	ctx := context.Background()
+	request := ctrl.Request{NamespacedName: types.NamespacedName{Name: "nightly"}}
+
+	_, err := r.Reconcile(ctx, request)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(k8sClient.Get(ctx, request.NamespacedName, backup)).To(gomega.Succeed())
+	g.Expect(backup.Status.Phase).To(gomega.Equal(mlflowv1.BackupPhaseFailed))
+	complete := meta.FindStatusCondition(backup.Status.Conditions, backupCompleteConditionType)
+	g.Expect(complete.Reason).To(gomega.Equal("BackupFailed"))
+	g.Expect(complete.Message).To(gomega.Equal("container backup failed: mysql backend stores are not supported"))
+}
+
+func TestMLflowBackupReconcile_JobFailedNotifies(t *testing.T) {
+	g := gomega.NewWithT(t)
+	scheme := newBackupTestScheme(g)
+
+	received := make(chan map[string]string, 2)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		payload := map[string]string{}
+		_ = json.Unmarshal(body, &payload)
+		received <- payload
+	}))
+	defer server.Close()
+
+	backup := newTestMLflowBackup()
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow-backup-nightly", Namespace: "opendatahub"},
+		Status: batchv1.JobStatus{Conditions: []batchv1.JobCondition{{
+			Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Message: "Job has reached the specified backoff limit",
+		}}},
+	}
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: ResourceName},
+		Spec: mlflowv1.MLflowSpec{Notifications: &mlflowv1.NotificationsConfig{
+			WebhookURLFrom: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "mlflow-webhook"}, Key: "url"},
+		}},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow-webhook", Namespace: "opendatahub"},
+		Data:       map[string][]byte{"url": []byte(server.URL)},
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(backup, job, mlflow, secret).
+		WithStatusSubresource(&mlflowv1.MLflowBackup{}).
+		Build()
+	r := &MLflowBackupReconciler{Client: k8sClient, Scheme: scheme, Namespace: "opendatahub"}
+	ctx := context.Background()
+	request := ctrl.Request{NamespacedName: types.NamespacedName{Name: "nightly"}}
+
+	_, err := r.Reconcile(ctx, request)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(received).To(gomega.Receive(gomega.And(
+		gomega.HaveKeyWithValue("event", notificationEventBackupFailed),
+		gomega.HaveKeyWithValue("mlflow", ResourceName),
+		gomega.HaveKeyWithValue("reason", "BackupFailed"),
+		gomega.HaveKeyWithValue("message", gomega.HavePrefix("MLflowBackup nightly: ")),
+	)))
+
+	// Failed is terminal, so a later reconcile does not notify again.
+	_, err = r.Reconcile(ctx, request)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Consistently(received, 100*time.Millisecond).ShouldNot(gomega.Receive())
+}
+
+func TestMLflowRestoreReconcile(t *testing.T) {
+	g := gomega.NewWithT(t)
+	scheme := newBackupTestScheme(g)
+
+	backup := newTestMLflowBackup()
+	backup.Status = mlflowv1.MLflowBackupStatus{Phase: mlflowv1.BackupPhaseSucceeded, Location: "s3://backups/prod/nightly"}
+	restore := &mlflowv1.MLflowRestore{
+		ObjectMeta: metav1.ObjectMeta{Name: "restore-nightly", UID: "restore-uid"},
+		Spec:       mlflowv1.MLflowRestoreSpec{BackupName: "nightly"},
+	}
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: ResourceName}}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(backup, restore, mlflow, newBackupTestDeployment(1)).
+		WithStatusSubresource(&mlflowv1.MLflowRestore{}, &batchv1.Job{}, &appsv1.Deployment{}).
+		Build()
+	r := &MLflowRestoreReconciler{Client: k8sClient, Scheme: scheme, Namespace: "opendatahub"}
+	ctx := context.Background()
+	request := ctrl.Request{NamespacedName: types.NamespacedName{Name: "restore-nightly"}}
+	jobKey := types.NamespacedName{Name: "mlflow-restore-restore-nightly", Namespace: "opendatahub"}
+
+	// The restore holds the instance down and waits for the server pods to stop.
+	result, err := r.Reconcile(ctx, request)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(result.RequeueAfter).To(gomega.Equal(restoreScaleDownInterval))
+	g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: ResourceName}, mlflow)).To(gomega.Succeed())
+	g.Expect(mlflow.Annotations).To(gomega.HaveKeyWithValue(RestoreAnnotation, "restore-nightly"))
+	g.Expect(k8sClient.Get(ctx, jobKey, &batchv1.Job{})).NotTo(gomega.Succeed())
+	g.Expect((&MLflowReconciler{Client: k8sClient}).activeRestore(ctx, "restore-nightly")).To(gomega.BeTrue())
+
+	deployment := &appsv1.Deployment{}
+	g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: ResourceName, Namespace: "opendatahub"}, deployment)).To(gomega.Succeed())
+	deployment.Status.Replicas = 0
+	g.Expect(k8sClient.Status().Update(ctx, deployment)).To(gomega.Succeed())
+	_, err = r.Reconcile(ctx, request)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	job := &batchv1.Job{}
+	g.Expect(k8sClient.Get(ctx, jobKey, job)).To(gomega.Succeed())
+	g.Expect(job.Spec.Template.Spec.InitContainers[0].Env).To(gomega.ContainElement(
+		corev1.EnvVar{Name: "BACKUP_LOCATION", Value: "s3://backups/prod/nightly"},
+	))
+
+	completeTestJob(ctx, g, k8sClient, jobKey.Name)
+	_, err = r.Reconcile(ctx, request)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(k8sClient.Get(ctx, request.NamespacedName, restore)).To(gomega.Succeed())
+	g.Expect(restore.Status.Phase).To(gomega.Equal(mlflowv1.BackupPhaseSucceeded))
+	g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: ResourceName}, mlflow)).To(gomega.Succeed())
+	g.Expect(mlflow.Annotations).NotTo(gomega.HaveKey(RestoreAnnotation))
+	g.Expect(mlflow.Annotations).To(gomega.HaveKey(forceMigrateAnnotation), "the restored schema is migrated to the running version")
+}
+
+func TestMLflowRestoreReconcile_WaitsForBackup(t *testing.T) {
+	g := gomega.NewWithT(t)
+	scheme := newBackupTestScheme(g)
+
+	backup := newTestMLflowBackup()
+	restore := &mlflowv1.MLflowRestore{
+		ObjectMeta: metav1.ObjectMeta{Name: "restore-nightly"},
+		Spec:       mlflowv1.MLflowRestoreSpec{BackupName: "nightly", IncludeArtifacts: true},
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(backup, restore, &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: ResourceName}}).
+		WithStatusSubresource(&mlflowv1.MLflowRestore{}, &mlflowv1.MLflowBackup{}).
+		Build()
+	r := &MLflowRestoreReconciler{Client: k8sClient, Scheme: scheme, Namespace: "opendatahub"}
+	ctx := context.Background()
+	request := ctrl.Request{NamespacedName: types.NamespacedName{Name: "restore-nightly"}}
+
+	_, err := r.Reconcile(ctx, request)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(k8sClient.Get(ctx, request.NamespacedName, restore)).To(gomega.Succeed())
+	g.Expect(restore.Status.Phase).To(gomega.Equal(mlflowv1.BackupPhasePending))
+	g.Expect(meta.FindStatusCondition(restore.Status.Conditions, backupCompleteConditionType).Reason).To(gomega.Equal("BackupNotReady"))
+
+	backup.Status.Phase = mlflowv1.BackupPhaseSucceeded
+	g.Expect(k8sClient.Status().Update(ctx, backup)).To(gomega.Succeed())
+	_, err = r.Reconcile(ctx, request)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(k8sClient.Get(ctx, request.NamespacedName, restore)).To(gomega.Succeed())
+	g.Expect(restore.Status.Phase).To(gomega.Equal(mlflowv1.BackupPhaseFailed))
+	g.Expect(meta.FindStatusCondition(restore.Status.Conditions, backupCompleteConditionType).Reason).To(gomega.Equal("BackupHasNoArtifacts"))
+}
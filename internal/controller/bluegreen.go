@@ -0,0 +1,446 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
+)
+
+const (
+	// rollbackAnnotation asks a switched blue/green migration to send traffic back to the
+	// previous revision.
+	rollbackAnnotation = "mlflow.opendatahub.io/rollback"
+
+	defaultBlueGreenReadyTimeout   = 30 * time.Minute
+	defaultBlueGreenRollbackWindow = 30 * time.Minute
+
+	// The copy refuses non-PostgreSQL stores instead of silently starting an empty backend.
+	pgCopyDumpScript = `case "$MLFLOW_BACKEND_STORE_URI" in
+  postgresql*) exec ` + pgDumpCommand + ` ;;
+  *) echo "blue/green data copy requires PostgreSQL backend stores; set copyData to false to skip it" | tee /dev/termination-log >&2; exit 1 ;;
+esac`
+	pgCopyRestoreScript = `exec ` + pgRestoreCommand
+)
+
+func blueGreenStrategyEnabled(mlflow *mlflowv1.MLflow) bool {
+	return mlflow.Spec.UpgradeStrategy != nil && mlflow.Spec.UpgradeStrategy.Type == mlflowv1.UpgradeStrategyBlueGreen
+}
+
+func greenResourceName(mlflow *mlflowv1.MLflow) string {
+	return ResourceName + render.ResourceSuffix(mlflow.Name) + "-green"
+}
+
+func blueGreenJobName(mlflow *mlflowv1.MLflow, revision string) string {
+	return ResourceName + render.ResourceSuffix(mlflow.Name) + "-bluegreen-" + revision[:10]
+}
+
+func blueGreenCopyData(mlflow *mlflowv1.MLflow) bool {
+	if blueGreen := mlflow.Spec.UpgradeStrategy.BlueGreen; blueGreen != nil && blueGreen.CopyData != nil {
+		return *blueGreen.CopyData
+	}
+	return true
+}
+
+func blueGreenReadyTimeout(mlflow *mlflowv1.MLflow) time.Duration {
+	if blueGreen := mlflow.Spec.UpgradeStrategy.BlueGreen; blueGreen != nil && blueGreen.ReadyTimeout != nil {
+		return blueGreen.ReadyTimeout.Duration
+	}
+	return defaultBlueGreenReadyTimeout
+}
+
+func blueGreenRollbackWindow(mlflow *mlflowv1.MLflow) time.Duration {
+	if blueGreen := mlflow.Spec.UpgradeStrategy.BlueGreen; blueGreen != nil && blueGreen.RollbackWindow != nil {
+		return blueGreen.RollbackWindow.Duration
+	}
+	return defaultBlueGreenRollbackWindow
+}
+
+// blueGreenEligible reports whether a blue/green migration can run. Like a canary, it
+// needs the HTTPRoute to move traffic and cannot share a ReadWriteOnce PVC.
+func (r *MLflowReconciler) blueGreenEligible(mlflow *mlflowv1.MLflow, suspended bool) bool {
+	return blueGreenStrategyEnabled(mlflow) && r.HTTPRouteAvailable && routingEnabled(mlflow) &&
+		mlflow.Spec.Storage == nil && !suspended
+}
+
+// blueGreenActive reports whether a blue/green migration holds the stable Deployment. The
+// operator-managed migration is skipped meanwhile: the Job migrates the new backend, and
+// the previous revision must keep its schema, including after a rollback.
+func blueGreenActive(mlflow *mlflowv1.MLflow) bool {
+	return mlflow.Status.BlueGreen != nil
+}
+
+// secondaryRevisionTraffic returns the Service and traffic percentage of a canary or green
+// revision sharing the HTTPRoute with the stable Service.
+func secondaryRevisionTraffic(mlflow *mlflowv1.MLflow) (string, int32) {
+	if weight := canaryTrafficWeight(mlflow); weight > 0 {
+		return canaryResourceName(mlflow), weight
+	}
+	if status := mlflow.Status.BlueGreen; status != nil && status.Phase != mlflowv1.BlueGreenPhaseRolledBack && status.Weight > 0 {
+		return greenResourceName(mlflow), status.Weight
+	}
+	return "", 0
+}
+
+// backendStoreRevision hashes the backend store reference of the mlflow container, so a new
+// URI or a different Secret key starts a migration while other template changes do not.
+func backendStoreRevision(deployment *appsv1.Deployment) (string, error) {
+	container := findContainer(deployment.Spec.Template.Spec.Containers, "mlflow")
+	if container == nil {
+		return "", nil
+	}
+	data, err := json.Marshal(backendStoreURIEnv(container.Env))
+	if err != nil {
+		return "", fmt.Errorf("failed to hash backend store of Deployment %s: %w", deployment.Name, err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:8]), nil
+}
+
+// buildBlueGreenJob builds the Job that prepares the new backend: pg_dump from the live
+// backend and pg_restore into the new one when copyData is set, then the operator-managed
+// migration against the new backend with the new image.
+func buildBlueGreenJob(
+	mlflow *mlflowv1.MLflow,
+	live, desired *appsv1.Deployment,
+	name, postgreSQLImage string,
+) (*batchv1.Job, error) {
+	job, err := buildMigrationJobFromDeployment(mlflow, desired, desired.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	job.Name = name
+	labels := buildDataJobLabels(desired.Spec.Template.Labels, "bluegreen")
+	job.Labels = labels
+	job.Spec.Template.Labels = labels
+	if !blueGreenCopyData(mlflow) {
+		return job, nil
+	}
+
+	liveContainer := findContainer(live.Spec.Template.Spec.Containers, "mlflow")
+	if liveContainer == nil {
+		return nil, fmt.Errorf("deployment %s/%s has no mlflow container", live.Namespace, live.Name)
+	}
+	desiredContainer := findContainer(desired.Spec.Template.Spec.Containers, "mlflow")
+	workMount := corev1.VolumeMount{Name: backupWorkVolumeName, MountPath: backupWorkDir}
+	postgresContainer := func(name, script string, env []corev1.EnvVar) corev1.Container {
+		return corev1.Container{
+			Name:            name,
+			Image:           postgreSQLImage,
+			ImagePullPolicy: corev1.PullIfNotPresent,
+			Command:         []string{"/bin/sh", "-ec"},
+			Args:            []string{script},
+			Env:             env,
+			VolumeMounts:    []corev1.VolumeMount{workMount},
+			SecurityContext: desiredContainer.SecurityContext.DeepCopy(),
+		}
+	}
+	podSpec := &job.Spec.Template.Spec
+	podSpec.InitContainers = append(podSpec.InitContainers,
+		postgresContainer("pg-dump", pgCopyDumpScript, backendStoreURIEnv(liveContainer.Env)),
+		postgresContainer("pg-restore", pgCopyRestoreScript, backendStoreURIEnv(desiredContainer.Env)),
+	)
+	podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+		Name:         backupWorkVolumeName,
+		VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+	})
+	return job, nil
+}
+
+// reconcileBlueGreen runs the BlueGreen upgrade strategy and returns the objects to apply.
+// When the backend store changes, the stable Deployment keeps serving from the old backend
+// while a Job copies and migrates the data into the new one. The mlflow-green revision then
+// starts against the new backend and takes all HTTPRoute traffic once ready. After the
+// rollback window the stable Deployment is updated and the green revision is drained and
+// removed. The returned duration is when to re-evaluate the migration.
+func (r *MLflowReconciler) reconcileBlueGreen(
+	ctx context.Context,
+	mlflow *mlflowv1.MLflow,
+	namespace string,
+	objects []*unstructured.Unstructured,
+	suspended bool,
+	cfg *config.OperatorConfig,
+	now time.Time,
+) ([]*unstructured.Unstructured, time.Duration, error) {
+	if !r.blueGreenEligible(mlflow, suspended) {
+		if mlflow.Status.BlueGreen != nil {
+			if err := r.deleteRevisionObjects(ctx, greenResourceName(mlflow), namespace); err != nil {
+				return nil, 0, err
+			}
+			clearBlueGreenStatus(mlflow)
+		}
+		return objects, 0, nil
+	}
+
+	stableName := ResourceName + render.ResourceSuffix(mlflow.Name)
+	if render.FindRenderedObject(objects, "Deployment", stableName) == nil {
+		return objects, 0, nil
+	}
+	desired, err := renderedDeployment(objects, stableName, namespace)
+	if err != nil {
+		return nil, 0, err
+	}
+	desiredRevision, err := backendStoreRevision(desired)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	live := &appsv1.Deployment{}
+	if err := r.Get(ctx, types.NamespacedName{Name: stableName, Namespace: namespace}, live); err != nil {
+		if !errors.IsNotFound(err) {
+			return nil, 0, fmt.Errorf("failed to get Deployment %s: %w", stableName, err)
+		}
+		// First install: nothing to migrate from.
+		return objects, 0, nil
+	}
+	liveRevision, err := backendStoreRevision(live)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	status := mlflow.Status.BlueGreen
+	if liveRevision == desiredRevision {
+		if status != nil && status.Phase == mlflowv1.BlueGreenPhasePromoting && status.Revision == desiredRevision &&
+			(!deploymentRolledOut(live) || status.Weight > 0) {
+			// Keep the green revision serving until the stable Deployment finishes rolling
+			// out, then drain its route weight before deleting it.
+			if deploymentRolledOut(live) {
+				status.Weight = 0
+				status.Message = "Stable Deployment promoted; draining the green revision"
+			}
+			green, err := buildRevisionObjects(mlflow, objects, greenResourceName(mlflow), nil)
+			if err != nil {
+				return nil, 0, err
+			}
+			return append(objects, green...), canaryRequeueInterval, nil
+		}
+		if status != nil {
+			if err := r.deleteRevisionObjects(ctx, greenResourceName(mlflow), namespace); err != nil {
+				return nil, 0, err
+			}
+			clearBlueGreenStatus(mlflow)
+		}
+		return objects, 0, nil
+	}
+
+	// The backend store changed: hold the stable Deployment on the old backend.
+	held := withoutRenderedObject(objects, "Deployment", stableName)
+	if status != nil && status.Revision == desiredRevision && status.Phase == mlflowv1.BlueGreenPhaseRolledBack {
+		return held, 0, nil
+	}
+	if status == nil || status.Revision != desiredRevision {
+		if status != nil {
+			if err := r.deleteRevisionObjects(ctx, greenResourceName(mlflow), namespace); err != nil {
+				return nil, 0, err
+			}
+		}
+		status = &mlflowv1.BlueGreenStatus{
+			Revision:    desiredRevision,
+			Phase:       mlflowv1.BlueGreenPhaseCopying,
+			JobName:     blueGreenJobName(mlflow, desiredRevision),
+			StartedTime: metav1.NewTime(now),
+			Message:     "Preparing the new backend store",
+		}
+		// A finished Job from an earlier attempt at the same backend must not be reused.
+		stale := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: status.JobName, Namespace: namespace}}
+		if err := r.Delete(ctx, stale, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !errors.IsNotFound(err) {
+			return nil, 0, fmt.Errorf("failed to delete stale blue/green Job: %w", err)
+		}
+		mlflow.Status.BlueGreen = status
+	}
+
+	requeue := canaryRequeueInterval
+	if status.Phase == mlflowv1.BlueGreenPhaseCopying {
+		job, err := getDataJob(ctx, r.Client, status.JobName, namespace)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to get blue/green Job: %w", err)
+		}
+		switch {
+		case job == nil:
+			job, err := buildBlueGreenJob(mlflow, live, desired, status.JobName, render.InstanceImage(mlflow, cfg, cfg.PostgreSQLImage))
+			if err != nil {
+				return nil, 0, err
+			}
+			if err := controllerutil.SetControllerReference(mlflow, job, r.Scheme); err != nil {
+				return nil, 0, fmt.Errorf("set controller reference on blue/green Job: %w", err)
+			}
+			if err := r.Create(ctx, job); err != nil && !errors.IsAlreadyExists(err) {
+				return nil, 0, fmt.Errorf("failed to create blue/green Job: %w", err)
+			}
+			return held, requeue, nil
+		case job.DeletionTimestamp != nil:
+			return held, requeue, nil
+		case isJobFailed(job):
+			return r.rollBackBlueGreen(ctx, mlflow, namespace, held,
+				fmt.Sprintf("preparing the new backend store failed: %s", dataJobFailureMessage(ctx, r.Client, job)))
+		case !isJobSuccessful(job):
+			if now.Sub(status.StartedTime.Time) >= blueGreenReadyTimeout(mlflow) {
+				return r.rollBackBlueGreen(ctx, mlflow, namespace, held,
+					fmt.Sprintf("preparing the new backend store did not finish within %s", blueGreenReadyTimeout(mlflow)))
+			}
+			return held, requeue, nil
+		}
+		status.Phase = mlflowv1.BlueGreenPhaseVerifying
+		status.Message = "Waiting for the green revision to become ready"
+	}
+
+	greenDeployment := &appsv1.Deployment{}
+	err = r.Get(ctx, types.NamespacedName{Name: greenResourceName(mlflow), Namespace: namespace}, greenDeployment)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, 0, fmt.Errorf("failed to get green Deployment: %w", err)
+	}
+	ready := err == nil && deploymentRolledOut(greenDeployment)
+	if ready {
+		greenRevision, err := backendStoreRevision(greenDeployment)
+		if err != nil {
+			return nil, 0, err
+		}
+		ready = greenRevision == desiredRevision
+	}
+
+	switch status.Phase {
+	case mlflowv1.BlueGreenPhaseVerifying:
+		switch {
+		case ready:
+			switched := metav1.NewTime(now)
+			status.Phase = mlflowv1.BlueGreenPhaseSwitched
+			status.Weight = 100
+			status.SwitchedTime = &switched
+			status.Message = fmt.Sprintf("Routing all gateway traffic to the green revision; the previous revision stays up for %s",
+				blueGreenRollbackWindow(mlflow))
+			requeue = blueGreenRollbackWindow(mlflow)
+		case now.Sub(status.StartedTime.Time) >= blueGreenReadyTimeout(mlflow):
+			return r.rollBackBlueGreen(ctx, mlflow, namespace, held,
+				fmt.Sprintf("green revision did not become ready within %s", blueGreenReadyTimeout(mlflow)))
+		}
+	case mlflowv1.BlueGreenPhaseSwitched:
+		elapsed := now.Sub(status.SwitchedTime.Time)
+		switch {
+		case hasRollbackAnnotation(mlflow):
+			if err := r.clearRollbackAnnotation(ctx, mlflow); err != nil {
+				return nil, 0, err
+			}
+			return r.rollBackBlueGreen(ctx, mlflow, namespace, held, "rollback requested through the "+rollbackAnnotation+" annotation")
+		case !ready:
+			return r.rollBackBlueGreen(ctx, mlflow, namespace, held, "green revision failed its readiness checks after the switch")
+		case elapsed < blueGreenRollbackWindow(mlflow):
+			requeue = min(requeue, blueGreenRollbackWindow(mlflow)-elapsed)
+		default:
+			status.Phase = mlflowv1.BlueGreenPhasePromoting
+			status.Message = "Rollback window passed; promoting the green revision to the stable Deployment"
+			recordBlueGreenMigration(mlflow)
+			held = objects
+		}
+	case mlflowv1.BlueGreenPhasePromoting:
+		held = objects
+	}
+
+	green, err := buildRevisionObjects(mlflow, objects, greenResourceName(mlflow), nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	return append(held, green...), requeue, nil
+}
+
+// recordBlueGreenMigration marks the current generation as migrated: the blue/green Job ran
+// the managed migration against the backend the stable Deployment is promoted to.
+func recordBlueGreenMigration(mlflow *mlflowv1.MLflow) {
+	mlflow.Status.Version = config.SupportedMLflowVersion
+	meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+		Type:               migrationConditionType,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: mlflow.Generation,
+		Reason:             migrationReasonSucceeded,
+		Message:            fmt.Sprintf("Blue/green migration for generation %d migrated the new backend store", mlflow.Generation),
+	})
+}
+
+// rollBackBlueGreen removes the green revision, returns all traffic to the stable
+// Deployment, and records the failed backend so it is not retried until it changes again.
+func (r *MLflowReconciler) rollBackBlueGreen(
+	ctx context.Context,
+	mlflow *mlflowv1.MLflow,
+	namespace string,
+	held []*unstructured.Unstructured,
+	message string,
+) ([]*unstructured.Unstructured, time.Duration, error) {
+	logf.FromContext(ctx).Info("Rolling back blue/green migration", "revision", mlflow.Status.BlueGreen.Revision, "reason", message)
+	if err := r.deleteRevisionObjects(ctx, greenResourceName(mlflow), namespace); err != nil {
+		return nil, 0, err
+	}
+	status := mlflow.Status.BlueGreen
+	status.Phase = mlflowv1.BlueGreenPhaseRolledBack
+	status.Weight = 0
+	status.Message = message
+	meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+		Type:    "Degraded",
+		Status:  metav1.ConditionTrue,
+		Reason:  "BlueGreenRolledBack",
+		Message: message + "; the previous revision keeps serving until the backend store changes",
+	})
+	return held, 0, nil
+}
+
+func clearBlueGreenStatus(mlflow *mlflowv1.MLflow) {
+	mlflow.Status.BlueGreen = nil
+	if degraded := meta.FindStatusCondition(mlflow.Status.Conditions, "Degraded"); degraded != nil && degraded.Reason == "BlueGreenRolledBack" {
+		meta.RemoveStatusCondition(&mlflow.Status.Conditions, "Degraded")
+	}
+}
+
+func hasRollbackAnnotation(mlflow *mlflowv1.MLflow) bool {
+	_, ok := mlflow.Annotations[rollbackAnnotation]
+	return ok
+}
+
+func (r *MLflowReconciler) clearRollbackAnnotation(ctx context.Context, mlflow *mlflowv1.MLflow) error {
+	patchBytes, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]any{
+				rollbackAnnotation: nil,
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("marshal rollback annotation clear patch: %w", err)
+	}
+	return r.Patch(
+		ctx,
+		&mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: mlflow.Name}},
+		client.RawPatch(types.MergePatchType, patchBytes),
+	)
+}
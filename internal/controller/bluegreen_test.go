@@ -0,0 +1,251 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
+)
+
+const (
+	blueBackendURI  = "postgresql://mlflow@blue.db:5432/mlflow"
+	greenBackendURI = "postgresql://mlflow@green.db:5432/mlflow"
+)
+
+func blueGreenTestMLflow() *mlflowv1.MLflow {
+	return &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: ResourceName},
+		Spec: mlflowv1.MLflowSpec{
+			UpgradeStrategy: &mlflowv1.UpgradeStrategy{
+				Type: mlflowv1.UpgradeStrategyBlueGreen,
+				BlueGreen: &mlflowv1.BlueGreenStrategy{
+					ReadyTimeout:   &metav1.Duration{Duration: 10 * time.Minute},
+					RollbackWindow: &metav1.Duration{Duration: time.Hour},
+				},
+			},
+		},
+	}
+}
+
+// blueGreenTestObjects returns the canary test objects with the mlflow container pointed at
+// backendURI.
+func blueGreenTestObjects(t *testing.T, backendURI string) []*unstructured.Unstructured {
+	t.Helper()
+	objects := canaryTestObjects(t, "quay.io/opendatahub/mlflow:latest")
+	deployment := render.FindRenderedObject(objects, "Deployment", ResourceName)
+	containers, _, _ := unstructured.NestedSlice(deployment.Object, "spec", "template", "spec", "containers")
+	containers[0].(map[string]interface{})["env"] = []interface{}{
+		map[string]interface{}{"name": "MLFLOW_BACKEND_STORE_URI", "value": backendURI},
+	}
+	if err := unstructured.SetNestedSlice(deployment.Object, containers, "spec", "template", "spec", "containers"); err != nil {
+		t.Fatalf("set containers: %v", err)
+	}
+	return objects
+}
+
+// blueGreenLiveDeployment returns the Deployment rendered for backendURI as a rolled-out
+// live object named name.
+func blueGreenLiveDeployment(t *testing.T, name, backendURI string) *appsv1.Deployment {
+	t.Helper()
+	deployment, err := renderedDeployment(blueGreenTestObjects(t, backendURI), ResourceName, canaryTestNamespace)
+	if err != nil {
+		t.Fatalf("rendered Deployment: %v", err)
+	}
+	deployment.Name = name
+	deployment.Status = appsv1.DeploymentStatus{Replicas: 2, UpdatedReplicas: 2, AvailableReplicas: 2}
+	return deployment
+}
+
+func TestBackendStoreRevision(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	blue, err := backendStoreRevision(blueGreenLiveDeployment(t, ResourceName, blueBackendURI))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	green, err := backendStoreRevision(blueGreenLiveDeployment(t, ResourceName, greenBackendURI))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(blue).To(gomega.HaveLen(16))
+	g.Expect(green).NotTo(gomega.Equal(blue))
+
+	// Other template changes keep the revision.
+	deployment := blueGreenLiveDeployment(t, ResourceName, blueBackendURI)
+	deployment.Spec.Template.Spec.Containers[0].Image = "quay.io/opendatahub/mlflow:new"
+	g.Expect(backendStoreRevision(deployment)).To(gomega.Equal(blue))
+}
+
+func TestBuildBlueGreenJob(t *testing.T) {
+	g := gomega.NewWithT(t)
+	mlflow := blueGreenTestMLflow()
+	live := blueGreenLiveDeployment(t, ResourceName, blueBackendURI)
+	desired := blueGreenLiveDeployment(t, ResourceName, greenBackendURI)
+
+	job, err := buildBlueGreenJob(mlflow, live, desired, "mlflow-bluegreen-test", "postgres:16")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(job.Name).To(gomega.Equal("mlflow-bluegreen-test"))
+	g.Expect(job.Labels).To(gomega.HaveKeyWithValue("component", "mlflow-bluegreen"))
+	g.Expect(job.Labels).NotTo(gomega.HaveKey("app"))
+
+	podSpec := job.Spec.Template.Spec
+	g.Expect(podSpec.InitContainers).To(gomega.HaveLen(2))
+	g.Expect(podSpec.InitContainers[0].Name).To(gomega.Equal("pg-dump"))
+	g.Expect(podSpec.InitContainers[0].Image).To(gomega.Equal("postgres:16"))
+	g.Expect(podSpec.InitContainers[0].Env).To(gomega.ConsistOf(corev1.EnvVar{Name: "MLFLOW_BACKEND_STORE_URI", Value: blueBackendURI}))
+	g.Expect(podSpec.InitContainers[1].Name).To(gomega.Equal("pg-restore"))
+	g.Expect(podSpec.InitContainers[1].Env).To(gomega.ConsistOf(corev1.EnvVar{Name: "MLFLOW_BACKEND_STORE_URI", Value: greenBackendURI}))
+	g.Expect(podSpec.Containers).To(gomega.HaveLen(1))
+	g.Expect(podSpec.Containers[0].Name).To(gomega.Equal(migrationJobContainerName))
+	g.Expect(podSpec.Containers[0].Env).To(gomega.ContainElement(corev1.EnvVar{Name: "MLFLOW_BACKEND_STORE_URI", Value: greenBackendURI}))
+	g.Expect(podSpec.Volumes).To(gomega.ContainElement(gomega.HaveField("Name", backupWorkVolumeName)))
+
+	// Without copyData only the migration runs.
+	mlflow.Spec.UpgradeStrategy.BlueGreen.CopyData = new(bool)
+	job, err = buildBlueGreenJob(mlflow, live, desired, "mlflow-bluegreen-test", "postgres:16")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(job.Spec.Template.Spec.InitContainers).To(gomega.BeEmpty())
+}
+
+func TestReconcileBlueGreenSwitchesAndPromotes(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+
+	mlflow := blueGreenTestMLflow()
+	r := newTestReconciler(t, mlflow.DeepCopy(), blueGreenLiveDeployment(t, ResourceName, blueBackendURI))
+	r.HTTPRouteAvailable = true
+	cfg := &config.OperatorConfig{GatewayName: "gateway", PostgreSQLImage: "postgres:16"}
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	reconcile := func() ([]*unstructured.Unstructured, time.Duration) {
+		t.Helper()
+		applied, requeue, err := r.reconcileBlueGreen(ctx, mlflow, canaryTestNamespace, blueGreenTestObjects(t, greenBackendURI), false, cfg, now)
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		return applied, requeue
+	}
+
+	// A backend change holds the stable Deployment and starts the copy Job.
+	applied, requeue := reconcile()
+	g.Expect(objectNames(applied)).To(gomega.ConsistOf("Service/mlflow", "NetworkPolicy/mlflow"))
+	g.Expect(requeue).To(gomega.Equal(canaryRequeueInterval))
+	status := mlflow.Status.BlueGreen
+	g.Expect(status.Phase).To(gomega.Equal(mlflowv1.BlueGreenPhaseCopying))
+	job := &batchv1.Job{}
+	g.Expect(r.Get(ctx, types.NamespacedName{Name: status.JobName, Namespace: canaryTestNamespace}, job)).To(gomega.Succeed())
+	g.Expect(job.OwnerReferences).To(gomega.HaveLen(1))
+
+	// Once the Job succeeds the green revision is stood up without traffic.
+	job.Status.Succeeded = 1
+	g.Expect(r.Status().Update(ctx, job)).To(gomega.Succeed())
+	applied, _ = reconcile()
+	g.Expect(mlflow.Status.BlueGreen.Phase).To(gomega.Equal(mlflowv1.BlueGreenPhaseVerifying))
+	g.Expect(mlflow.Status.BlueGreen.Weight).To(gomega.BeZero())
+	g.Expect(objectNames(applied)).To(gomega.ConsistOf(
+		"Service/mlflow", "NetworkPolicy/mlflow",
+		"Deployment/mlflow-green", "Service/mlflow-green", "NetworkPolicy/mlflow-green",
+	))
+
+	// A ready green revision takes all gateway traffic.
+	g.Expect(r.Create(ctx, blueGreenLiveDeployment(t, greenResourceName(mlflow), greenBackendURI))).To(gomega.Succeed())
+	_, requeue = reconcile()
+	g.Expect(mlflow.Status.BlueGreen.Phase).To(gomega.Equal(mlflowv1.BlueGreenPhaseSwitched))
+	g.Expect(mlflow.Status.BlueGreen.Weight).To(gomega.Equal(int32(100)))
+	g.Expect(requeue).To(gomega.Equal(time.Hour))
+
+	route := buildHTTPRoute(mlflow, canaryTestNamespace, cfg)
+	backends := route.Spec.Rules[0].BackendRefs
+	g.Expect(backends).To(gomega.HaveLen(2))
+	g.Expect(*backends[0].Weight).To(gomega.BeZero())
+	g.Expect(string(backends[1].Name)).To(gomega.Equal("mlflow-green"))
+	g.Expect(*backends[1].Weight).To(gomega.Equal(int32(100)))
+
+	// After the rollback window the stable Deployment moves to the new backend.
+	now = now.Add(time.Hour)
+	applied, _ = reconcile()
+	g.Expect(mlflow.Status.BlueGreen.Phase).To(gomega.Equal(mlflowv1.BlueGreenPhasePromoting))
+	g.Expect(mlflow.Status.Version).To(gomega.Equal(config.SupportedMLflowVersion))
+	g.Expect(meta.IsStatusConditionTrue(mlflow.Status.Conditions, migrationConditionType)).To(gomega.BeTrue())
+	g.Expect(objectNames(applied)).To(gomega.ContainElements("Deployment/mlflow", "Deployment/mlflow-green"))
+
+	// When the stable Deployment has rolled out, the green revision is drained and removed.
+	g.Expect(r.Update(ctx, blueGreenLiveDeployment(t, ResourceName, greenBackendURI))).To(gomega.Succeed())
+	reconcile()
+	g.Expect(mlflow.Status.BlueGreen.Weight).To(gomega.BeZero())
+
+	applied, requeue = reconcile()
+	g.Expect(mlflow.Status.BlueGreen).To(gomega.BeNil())
+	g.Expect(requeue).To(gomega.BeZero())
+	g.Expect(objectNames(applied)).To(gomega.ConsistOf("Deployment/mlflow", "Service/mlflow", "NetworkPolicy/mlflow"))
+	err := r.Get(ctx, types.NamespacedName{Name: greenResourceName(mlflow), Namespace: canaryTestNamespace}, &appsv1.Deployment{})
+	g.Expect(err).To(gomega.HaveOccurred())
+}
+
+func TestReconcileBlueGreenRollback(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+
+	mlflow := blueGreenTestMLflow()
+	mlflow.Spec.UpgradeStrategy.BlueGreen.CopyData = new(bool)
+	mlflow.Annotations = map[string]string{rollbackAnnotation: ""}
+	r := newTestReconciler(t, mlflow.DeepCopy(),
+		blueGreenLiveDeployment(t, ResourceName, blueBackendURI),
+		blueGreenLiveDeployment(t, greenResourceName(mlflow), greenBackendURI))
+	r.HTTPRouteAvailable = true
+	cfg := &config.OperatorConfig{PostgreSQLImage: "postgres:16"}
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	revision, err := backendStoreRevision(blueGreenLiveDeployment(t, ResourceName, greenBackendURI))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	switched := metav1.NewTime(now)
+	mlflow.Status.BlueGreen = &mlflowv1.BlueGreenStatus{
+		Revision:     revision,
+		Phase:        mlflowv1.BlueGreenPhaseSwitched,
+		Weight:       100,
+		JobName:      blueGreenJobName(mlflow, revision),
+		StartedTime:  switched,
+		SwitchedTime: &switched,
+	}
+
+	// The rollback annotation sends traffic back and is cleared.
+	applied, _, err := r.reconcileBlueGreen(ctx, mlflow, canaryTestNamespace, blueGreenTestObjects(t, greenBackendURI), false, cfg, now)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(mlflow.Status.BlueGreen.Phase).To(gomega.Equal(mlflowv1.BlueGreenPhaseRolledBack))
+	g.Expect(mlflow.Status.BlueGreen.Weight).To(gomega.BeZero())
+	g.Expect(meta.IsStatusConditionTrue(mlflow.Status.Conditions, "Degraded")).To(gomega.BeTrue())
+	g.Expect(objectNames(applied)).To(gomega.ConsistOf("Service/mlflow", "NetworkPolicy/mlflow"))
+	err = r.Get(ctx, types.NamespacedName{Name: greenResourceName(mlflow), Namespace: canaryTestNamespace}, &appsv1.Deployment{})
+	g.Expect(err).To(gomega.HaveOccurred())
+	stored := &mlflowv1.MLflow{}
+	g.Expect(r.Get(ctx, types.NamespacedName{Name: mlflow.Name}, stored)).To(gomega.Succeed())
+	g.Expect(stored.Annotations).NotTo(gomega.HaveKey(rollbackAnnotation))
+	g.Expect(blueGreenActive(mlflow)).To(gomega.BeTrue())
+
+	// Reverting the backend store clears the rollback.
+	applied, _, err = r.reconcileBlueGreen(ctx, mlflow, canaryTestNamespace, blueGreenTestObjects(t, blueBackendURI), false, cfg, now)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(mlflow.Status.BlueGreen).To(gomega.BeNil())
+	g.Expect(meta.FindStatusCondition(mlflow.Status.Conditions, "Degraded")).To(gomega.BeNil())
+	g.Expect(objectNames(applied)).To(gomega.ContainElement("Deployment/mlflow"))
+}
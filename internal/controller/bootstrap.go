@@ -0,0 +1,374 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
+)
+
+const (
+	// bootstrappedConditionType reports whether the objects in spec.bootstrap exist.
+	bootstrappedConditionType = "Bootstrapped"
+
+	bootstrapRetryInterval = time.Minute
+
+	// mlflowResourceDoesNotExist is the MLflow API error code for a missing object.
+	mlflowResourceDoesNotExist = "RESOURCE_DOES_NOT_EXIST"
+)
+
+// mlflowAPIError is an error response of the MLflow REST API.
+type mlflowAPIError struct {
+	status    string
+	ErrorCode string `json:"error_code"`
+	Message   string `json:"message"`
+}
+
+func (e *mlflowAPIError) Error() string {
+	if e.ErrorCode == "" {
+		return e.status
+	}
+	return fmt.Sprintf("%s: %s: %s", e.status, e.ErrorCode, e.Message)
+}
+
+// isMLflowNotFound reports whether err is an MLflow API error for a missing object.
+func isMLflowNotFound(err error) bool {
+	var apiErr *mlflowAPIError
+	return errors.As(err, &apiErr) && apiErr.ErrorCode == mlflowResourceDoesNotExist
+}
+
+// mlflowAPI calls the REST API of an MLflow server as the operator, with the trust and
+// service account token of the deep health check.
+type mlflowAPI struct {
+	client  *http.Client
+	token   string
+	baseURL string
+	// workspace is sent as the workspace header when not empty.
+	workspace string
+}
+
+func (a mlflowAPI) call(ctx context.Context, method, path string, query url.Values, in, out interface{}) error {
+	requestURL := a.baseURL + path
+	if len(query) > 0 {
+		requestURL += "?" + query.Encode()
+	}
+	var body io.Reader
+	if in != nil {
+		payload, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(payload)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, requestURL, body)
+	if err != nil {
+		return err
+	}
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if a.token != "" {
+		req.Header.Set("Authorization", "Bearer "+a.token)
+	}
+	if a.workspace != "" {
+		req.Header.Set(workspaceHeader, a.workspace)
+	}
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s %s: %w", method, path, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	payload, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return fmt.Errorf("%s %s: %w", method, path, err)
+	}
+	if resp.StatusCode >= 300 {
+		apiErr := &mlflowAPIError{status: resp.Status}
+		_ = json.Unmarshal(payload, apiErr)
+		return fmt.Errorf("%s %s: %w", method, path, apiErr)
+	}
+	if out != nil {
+		if err := json.Unmarshal(payload, out); err != nil {
+			return fmt.Errorf("%s %s: decode response: %w", method, path, err)
+		}
+	}
+	return nil
+}
+
+// mlflowTag is a key/value tag in MLflow API requests and responses.
+type mlflowTag struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// mlflowTags returns tags sorted by key, so requests are deterministic.
+func mlflowTags(tags map[string]string) []mlflowTag {
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	result := make([]mlflowTag, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, mlflowTag{Key: key, Value: tags[key]})
+	}
+	return result
+}
+
+type mlflowExperiment struct {
+	ExperimentID   string      `json:"experiment_id"`
+	LifecycleStage string      `json:"lifecycle_stage"`
+	Tags           []mlflowTag `json:"tags"`
+}
+
+// ensureExperiment creates experiment when it does not exist, restores it when it was
+// deleted, and sets the tags whose value differs.
+func ensureExperiment(ctx context.Context, api mlflowAPI, experiment mlflowv1.BootstrapExperiment) error {
+	var found struct {
+		Experiment mlflowExperiment `json:"experiment"`
+	}
+	err := api.call(ctx, http.MethodGet, "/api/2.0/mlflow/experiments/get-by-name",
+		url.Values{"experiment_name": {experiment.Name}}, nil, &found)
+	if isMLflowNotFound(err) {
+		request := map[string]interface{}{"name": experiment.Name, "tags": mlflowTags(experiment.Tags)}
+		if experiment.ArtifactLocation != nil {
+			request["artifact_location"] = *experiment.ArtifactLocation
+		}
+		return api.call(ctx, http.MethodPost, "/api/2.0/mlflow/experiments/create", nil, request, nil)
+	} else if err != nil {
+		return err
+	}
+
+	id := found.Experiment.ExperimentID
+	if found.Experiment.LifecycleStage == "deleted" {
+		if err := api.call(ctx, http.MethodPost, "/api/2.0/mlflow/experiments/restore", nil,
+			map[string]string{"experiment_id": id}, nil); err != nil {
+			return err
+		}
+	}
+	current := map[string]string{}
+	for _, tag := range found.Experiment.Tags {
+		current[tag.Key] = tag.Value
+	}
+	for _, tag := range mlflowTags(experiment.Tags) {
+		if value, ok := current[tag.Key]; ok && value == tag.Value {
+			continue
+		}
+		if err := api.call(ctx, http.MethodPost, "/api/2.0/mlflow/experiments/set-experiment-tag", nil,
+			map[string]string{"experiment_id": id, "key": tag.Key, "value": tag.Value}, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// bootstrapPendingError reports objects of spec.bootstrap that wait for something only users
+// create, such as the model versions that aliases point at.
+type bootstrapPendingError struct {
+	pending []string
+}
+
+func (e *bootstrapPendingError) Error() string {
+	return "waiting for " + strings.Join(e.pending, "; ")
+}
+
+type mlflowAlias struct {
+	Alias   string `json:"alias"`
+	Version string `json:"version"`
+}
+
+type mlflowRegisteredModel struct {
+	Tags    []mlflowTag   `json:"tags"`
+	Aliases []mlflowAlias `json:"aliases"`
+}
+
+// ensureRegisteredModel creates model when it does not exist, sets the tags whose value
+// differs, and points each alias at its version once that version is registered.
+func ensureRegisteredModel(ctx context.Context, api mlflowAPI, model mlflowv1.BootstrapRegisteredModel) error {
+	var found struct {
+		RegisteredModel mlflowRegisteredModel `json:"registered_model"`
+	}
+	err := api.call(ctx, http.MethodGet, "/api/2.0/mlflow/registered-models/get",
+		url.Values{"name": {model.Name}}, nil, &found)
+	if isMLflowNotFound(err) {
+		if err := api.call(ctx, http.MethodPost, "/api/2.0/mlflow/registered-models/create", nil,
+			map[string]interface{}{"name": model.Name, "tags": mlflowTags(model.Tags)}, nil); err != nil {
+			return err
+		}
+		found.RegisteredModel = mlflowRegisteredModel{Tags: mlflowTags(model.Tags)}
+	} else if err != nil {
+		return err
+	}
+
+	current := map[string]string{}
+	for _, tag := range found.RegisteredModel.Tags {
+		current[tag.Key] = tag.Value
+	}
+	for _, tag := range mlflowTags(model.Tags) {
+		if value, ok := current[tag.Key]; ok && value == tag.Value {
+			continue
+		}
+		if err := api.call(ctx, http.MethodPost, "/api/2.0/mlflow/registered-models/set-tag", nil,
+			map[string]string{"name": model.Name, "key": tag.Key, "value": tag.Value}, nil); err != nil {
+			return err
+		}
+	}
+
+	aliases := map[string]string{}
+	for _, alias := range found.RegisteredModel.Aliases {
+		aliases[alias.Alias] = alias.Version
+	}
+	names := make([]string, 0, len(model.Aliases))
+	for alias := range model.Aliases {
+		names = append(names, alias)
+	}
+	slices.Sort(names)
+	var pending []string
+	for _, alias := range names {
+		version := strconv.FormatInt(model.Aliases[alias], 10)
+		if aliases[alias] == version {
+			continue
+		}
+		err := api.call(ctx, http.MethodGet, "/api/2.0/mlflow/model-versions/get",
+			url.Values{"name": {model.Name}, "version": {version}}, nil, nil)
+		if isMLflowNotFound(err) {
+			pending = append(pending, fmt.Sprintf("version %s of registered model %q for alias %q", version, model.Name, alias))
+			continue
+		} else if err != nil {
+			return err
+		}
+		if err := api.call(ctx, http.MethodPost, "/api/2.0/mlflow/registered-models/alias", nil,
+			map[string]string{"name": model.Name, "alias": alias, "version": version}, nil); err != nil {
+			return err
+		}
+	}
+	if len(pending) > 0 {
+		return &bootstrapPendingError{pending: pending}
+	}
+	return nil
+}
+
+// bootstrapWorkspace returns the workspace header for an object of spec.bootstrap, or "" when
+// workspaces are disabled.
+func bootstrapWorkspace(mlflow *mlflowv1.MLflow, kind, name string, workspace *string) (string, error) {
+	if !render.WorkspacesEnabled(mlflow) {
+		return "", nil
+	}
+	if workspace == nil {
+		return "", fmt.Errorf("%s %q needs a workspace, since workspaces are enabled", kind, name)
+	}
+	return *workspace, nil
+}
+
+// bootstrap ensures every object in spec.bootstrap exists on the server. Pending aliases do not
+// stop the remaining objects; they are returned together at the end.
+func (r *MLflowReconciler) bootstrap(ctx context.Context, mlflow *mlflowv1.MLflow) error {
+	api := mlflowAPI{
+		client:  r.healthProber.httpClient(),
+		token:   r.healthProber.token(),
+		baseURL: mlflow.Status.Address.URL,
+	}
+	for _, experiment := range mlflow.Spec.Bootstrap.Experiments {
+		workspace, err := bootstrapWorkspace(mlflow, "experiment", experiment.Name, experiment.Workspace)
+		if err != nil {
+			return err
+		}
+		api.workspace = workspace
+		if err := ensureExperiment(ctx, api, experiment); err != nil {
+			return fmt.Errorf("experiment %q: %w", experiment.Name, err)
+		}
+	}
+	var pending []string
+	for _, model := range mlflow.Spec.Bootstrap.RegisteredModels {
+		workspace, err := bootstrapWorkspace(mlflow, "registered model", model.Name, model.Workspace)
+		if err != nil {
+			return err
+		}
+		api.workspace = workspace
+		var pendingErr *bootstrapPendingError
+		if err := ensureRegisteredModel(ctx, api, model); errors.As(err, &pendingErr) {
+			pending = append(pending, pendingErr.pending...)
+		} else if err != nil {
+			return fmt.Errorf("registered model %q: %w", model.Name, err)
+		}
+	}
+	if len(pending) > 0 {
+		return &bootstrapPendingError{pending: pending}
+	}
+	return nil
+}
+
+// reconcileBootstrap runs spec.bootstrap against a ready server and updates the Bootstrapped
+// condition. It runs once per generation of the MLflow CR, and returns when to retry after a
+// failure, or zero.
+func (r *MLflowReconciler) reconcileBootstrap(ctx context.Context, mlflow *mlflowv1.MLflow) time.Duration {
+	bootstrap := mlflow.Spec.Bootstrap
+	if bootstrap == nil || len(bootstrap.Experiments)+len(bootstrap.RegisteredModels) == 0 {
+		meta.RemoveStatusCondition(&mlflow.Status.Conditions, bootstrappedConditionType)
+		return 0
+	}
+	if condition := meta.FindStatusCondition(mlflow.Status.Conditions, bootstrappedConditionType); condition != nil &&
+		condition.Status == metav1.ConditionTrue && condition.ObservedGeneration == mlflow.Generation {
+		return 0
+	}
+	if mlflow.Status.Address == nil {
+		return 0
+	}
+
+	condition := metav1.Condition{
+		Type:   bootstrappedConditionType,
+		Status: metav1.ConditionTrue,
+		Reason: "BootstrapComplete",
+		Message: fmt.Sprintf("%d experiments and %d registered models exist",
+			len(bootstrap.Experiments), len(bootstrap.RegisteredModels)),
+		ObservedGeneration: mlflow.Generation,
+	}
+	var retryAfter time.Duration
+	var pendingErr *bootstrapPendingError
+	if err := r.bootstrap(ctx, mlflow); errors.As(err, &pendingErr) {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "BootstrapPending"
+		condition.Message = err.Error()
+		retryAfter = bootstrapRetryInterval
+	} else if err != nil {
+		logf.FromContext(ctx).Info("MLflow bootstrap failed", "error", err.Error())
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "BootstrapFailed"
+		condition.Message = err.Error()
+		retryAfter = bootstrapRetryInterval
+	}
+	meta.SetStatusCondition(&mlflow.Status.Conditions, condition)
+	return retryAfter
+}
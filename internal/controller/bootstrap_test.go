@@ -0,0 +1,324 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+// fakeMLflowExperiment is an experiment held by fakeMLflowAPI.
+type fakeMLflowExperiment struct {
+	id               string
+	workspace        string
+	lifecycleStage   string
+	artifactLocation string
+	tags             map[string]string
+}
+
+// fakeMLflowRegisteredModel is a registered model held by fakeMLflowAPI.
+type fakeMLflowRegisteredModel struct {
+	tags     map[string]string
+	aliases  map[string]string
+	versions int
+}
+
+// fakeMLflowAPI serves the experiment and registry endpoints spec.bootstrap uses, keyed by
+// workspace header and name, and records every mutating call.
+type fakeMLflowAPI struct {
+	mu          sync.Mutex
+	experiments map[string]*fakeMLflowExperiment
+	models      map[string]*fakeMLflowRegisteredModel
+	calls       []string
+}
+
+func (f *fakeMLflowAPI) model(workspace, name string) *fakeMLflowRegisteredModel {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.models[workspace+"/"+name]
+}
+
+func (f *fakeMLflowAPI) experiment(workspace, name string) *fakeMLflowExperiment {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.experiments[workspace+"/"+name]
+}
+
+func (f *fakeMLflowAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.experiments == nil {
+		f.experiments = map[string]*fakeMLflowExperiment{}
+	}
+	if f.models == nil {
+		f.models = map[string]*fakeMLflowRegisteredModel{}
+	}
+	workspace := r.Header.Get(workspaceHeader)
+	var body map[string]interface{}
+	_ = json.NewDecoder(r.Body).Decode(&body)
+	str := func(key string) string { s, _ := body[key].(string); return s }
+	byID := func(id string) *fakeMLflowExperiment {
+		for _, experiment := range f.experiments {
+			if experiment.id == id {
+				return experiment
+			}
+		}
+		return nil
+	}
+	if r.Method == http.MethodPost {
+		f.calls = append(f.calls, r.URL.Path)
+	}
+	notFound := func() {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error_code":"RESOURCE_DOES_NOT_EXIST","message":"not found"}`))
+	}
+	modelName := r.URL.Query().Get("name")
+	if modelName == "" {
+		modelName = str("name")
+	}
+	model := f.models[workspace+"/"+modelName]
+
+	switch r.URL.Path {
+	case "/mlflow/api/2.0/mlflow/experiments/get-by-name":
+		experiment := f.experiments[workspace+"/"+r.URL.Query().Get("experiment_name")]
+		if experiment == nil {
+			notFound()
+			return
+		}
+		tags := []mlflowTag{}
+		for key, value := range experiment.tags {
+			tags = append(tags, mlflowTag{Key: key, Value: value})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"experiment": map[string]interface{}{
+			"experiment_id": experiment.id, "lifecycle_stage": experiment.lifecycleStage, "tags": tags,
+		}})
+	case "/mlflow/api/2.0/mlflow/experiments/create":
+		experiment := &fakeMLflowExperiment{
+			id:               fmt.Sprint(len(f.experiments) + 1),
+			workspace:        workspace,
+			lifecycleStage:   "active",
+			artifactLocation: str("artifact_location"),
+			tags:             map[string]string{},
+		}
+		tags, _ := body["tags"].([]interface{})
+		for _, tag := range tags {
+			tag := tag.(map[string]interface{})
+			experiment.tags[tag["key"].(string)] = tag["value"].(string)
+		}
+		f.experiments[workspace+"/"+str("name")] = experiment
+		_ = json.NewEncoder(w).Encode(map[string]string{"experiment_id": experiment.id})
+	case "/mlflow/api/2.0/mlflow/experiments/restore":
+		byID(str("experiment_id")).lifecycleStage = "active"
+		_, _ = w.Write([]byte(`{}`))
+	case "/mlflow/api/2.0/mlflow/experiments/set-experiment-tag":
+		byID(str("experiment_id")).tags[str("key")] = str("value")
+		_, _ = w.Write([]byte(`{}`))
+	case "/mlflow/api/2.0/mlflow/registered-models/get":
+		if model == nil {
+			notFound()
+			return
+		}
+		tags, aliases := []mlflowTag{}, []mlflowAlias{}
+		for key, value := range model.tags {
+			tags = append(tags, mlflowTag{Key: key, Value: value})
+		}
+		for alias, version := range model.aliases {
+			aliases = append(aliases, mlflowAlias{Alias: alias, Version: version})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"registered_model": map[string]interface{}{
+			"name": modelName, "tags": tags, "aliases": aliases,
+		}})
+	case "/mlflow/api/2.0/mlflow/registered-models/create":
+		model = &fakeMLflowRegisteredModel{tags: map[string]string{}, aliases: map[string]string{}}
+		tags, _ := body["tags"].([]interface{})
+		for _, tag := range tags {
+			tag := tag.(map[string]interface{})
+			model.tags[tag["key"].(string)] = tag["value"].(string)
+		}
+		f.models[workspace+"/"+modelName] = model
+		_, _ = w.Write([]byte(`{}`))
+	case "/mlflow/api/2.0/mlflow/registered-models/set-tag":
+		model.tags[str("key")] = str("value")
+		_, _ = w.Write([]byte(`{}`))
+	case "/mlflow/api/2.0/mlflow/model-versions/get":
+		version, _ := strconv.Atoi(r.URL.Query().Get("version"))
+		if model == nil || version > model.versions {
+			notFound()
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	case "/mlflow/api/2.0/mlflow/registered-models/alias":
+		model.aliases[str("alias")] = str("version")
+		_, _ = w.Write([]byte(`{}`))
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func newBootstrapTestReconciler(t *testing.T, handler http.Handler) (*MLflowReconciler, *httptest.Server) {
+	server := httptest.NewTLSServer(handler)
+	t.Cleanup(server.Close)
+	r := &MLflowReconciler{}
+	r.healthProber.client = server.Client()
+	r.healthProber.tokenFile = "/nonexistent"
+	return r, server
+}
+
+func TestReconcileBootstrapExperiments(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+	api := &fakeMLflowAPI{}
+	r, server := newBootstrapTestReconciler(t, api)
+
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: ResourceName, Generation: 1},
+		Spec: mlflowv1.MLflowSpec{Bootstrap: &mlflowv1.BootstrapConfig{
+			Experiments: []mlflowv1.BootstrapExperiment{
+				{
+					Name:             "baseline",
+					Workspace:        ptr("team-a"),
+					ArtifactLocation: ptr("s3://bucket/baseline"),
+					Tags:             map[string]string{"owner": "platform"},
+				},
+				{Name: "baseline", Workspace: ptr("team-b")},
+			},
+		}},
+		Status: mlflowv1.MLflowStatus{Address: &mlflowv1.MLflowAddressStatus{URL: server.URL + StaticPrefix}},
+	}
+
+	g.Expect(r.reconcileBootstrap(ctx, mlflow)).To(gomega.BeZero())
+	condition := meta.FindStatusCondition(mlflow.Status.Conditions, bootstrappedConditionType)
+	g.Expect(condition).NotTo(gomega.BeNil())
+	g.Expect(condition.Status).To(gomega.Equal(metav1.ConditionTrue), condition.Message)
+	created := api.experiment("team-a", "baseline")
+	g.Expect(created).NotTo(gomega.BeNil())
+	g.Expect(created.artifactLocation).To(gomega.Equal("s3://bucket/baseline"))
+	g.Expect(created.tags).To(gomega.Equal(map[string]string{"owner": "platform"}))
+	g.Expect(api.experiment("team-b", "baseline")).NotTo(gomega.BeNil())
+
+	// The same generation is not bootstrapped again.
+	api.calls = nil
+	g.Expect(r.reconcileBootstrap(ctx, mlflow)).To(gomega.BeZero())
+	g.Expect(api.calls).To(gomega.BeEmpty())
+
+	// A deleted experiment is restored, drifted tags are reset, and other tags are kept.
+	created.lifecycleStage = "deleted"
+	created.tags["owner"] = "someone-else"
+	created.tags["note"] = "added by a user"
+	mlflow.Generation = 2
+	g.Expect(r.reconcileBootstrap(ctx, mlflow)).To(gomega.BeZero())
+	g.Expect(created.lifecycleStage).To(gomega.Equal("active"))
+	g.Expect(created.tags).To(gomega.Equal(map[string]string{"owner": "platform", "note": "added by a user"}))
+	g.Expect(api.calls).To(gomega.ConsistOf(
+		"/mlflow/api/2.0/mlflow/experiments/restore",
+		"/mlflow/api/2.0/mlflow/experiments/set-experiment-tag",
+	))
+
+	mlflow.Spec.Bootstrap = nil
+	g.Expect(r.reconcileBootstrap(ctx, mlflow)).To(gomega.BeZero())
+	g.Expect(meta.FindStatusCondition(mlflow.Status.Conditions, bootstrappedConditionType)).To(gomega.BeNil())
+}
+
+func TestReconcileBootstrapFailure(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+	r, server := newBootstrapTestReconciler(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"error_code":"PERMISSION_DENIED","message":"operator cannot create experiments"}`))
+	}))
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: ResourceName, Generation: 1},
+		Spec: mlflowv1.MLflowSpec{Bootstrap: &mlflowv1.BootstrapConfig{
+			Experiments: []mlflowv1.BootstrapExperiment{{Name: "baseline"}},
+		}},
+		Status: mlflowv1.MLflowStatus{Address: &mlflowv1.MLflowAddressStatus{URL: server.URL + StaticPrefix}},
+	}
+
+	// Workspaces are on by default, so the experiment must name one.
+	g.Expect(r.reconcileBootstrap(ctx, mlflow)).To(gomega.Equal(bootstrapRetryInterval))
+	condition := meta.FindStatusCondition(mlflow.Status.Conditions, bootstrappedConditionType)
+	g.Expect(condition.Status).To(gomega.Equal(metav1.ConditionFalse))
+	g.Expect(condition.Message).To(gomega.ContainSubstring("needs a workspace"))
+
+	mlflow.Spec.Bootstrap.Experiments[0].Workspace = ptr("team-a")
+	g.Expect(r.reconcileBootstrap(ctx, mlflow)).To(gomega.Equal(bootstrapRetryInterval))
+	condition = meta.FindStatusCondition(mlflow.Status.Conditions, bootstrappedConditionType)
+	g.Expect(condition.Reason).To(gomega.Equal("BootstrapFailed"))
+	g.Expect(condition.Message).To(gomega.ContainSubstring("PERMISSION_DENIED"))
+}
+
+func TestReconcileBootstrapRegisteredModels(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+	api := &fakeMLflowAPI{}
+	r, server := newBootstrapTestReconciler(t, api)
+
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: ResourceName, Generation: 1},
+		Spec: mlflowv1.MLflowSpec{Bootstrap: &mlflowv1.BootstrapConfig{
+			RegisteredModels: []mlflowv1.BootstrapRegisteredModel{{
+				Name:      "fraud-detector",
+				Workspace: ptr("team-a"),
+				Tags:      map[string]string{"pipeline": "promotion"},
+				Aliases:   map[string]int64{"staging": 2, "production": 1},
+			}},
+		}},
+		Status: mlflowv1.MLflowStatus{Address: &mlflowv1.MLflowAddressStatus{URL: server.URL + StaticPrefix}},
+	}
+
+	// A new model has no versions, so the aliases wait for the pipeline to register them.
+	g.Expect(r.reconcileBootstrap(ctx, mlflow)).To(gomega.Equal(bootstrapRetryInterval))
+	condition := meta.FindStatusCondition(mlflow.Status.Conditions, bootstrappedConditionType)
+	g.Expect(condition.Status).To(gomega.Equal(metav1.ConditionFalse))
+	g.Expect(condition.Reason).To(gomega.Equal("BootstrapPending"))
+	g.Expect(condition.Message).To(gomega.ContainSubstring(`alias "production"`))
+	model := api.model("team-a", "fraud-detector")
+	g.Expect(model).NotTo(gomega.BeNil())
+	g.Expect(model.tags).To(gomega.Equal(map[string]string{"pipeline": "promotion"}))
+
+	model.versions = 1
+	g.Expect(r.reconcileBootstrap(ctx, mlflow)).To(gomega.Equal(bootstrapRetryInterval))
+	g.Expect(model.aliases).To(gomega.Equal(map[string]string{"production": "1"}))
+	condition = meta.FindStatusCondition(mlflow.Status.Conditions, bootstrappedConditionType)
+	g.Expect(condition.Message).To(gomega.ContainSubstring(`alias "staging"`))
+	g.Expect(condition.Message).NotTo(gomega.ContainSubstring(`alias "production"`))
+
+	model.versions = 2
+	g.Expect(r.reconcileBootstrap(ctx, mlflow)).To(gomega.BeZero())
+	g.Expect(model.aliases).To(gomega.Equal(map[string]string{"production": "1", "staging": "2"}))
+	g.Expect(meta.IsStatusConditionTrue(mlflow.Status.Conditions, bootstrappedConditionType)).To(gomega.BeTrue())
+
+	// A moved alias is pointed back at the declared version.
+	model.aliases["production"] = "2"
+	mlflow.Generation = 2
+	api.calls = nil
+	g.Expect(r.reconcileBootstrap(ctx, mlflow)).To(gomega.BeZero())
+	g.Expect(model.aliases["production"]).To(gomega.Equal("1"))
+	g.Expect(api.calls).To(gomega.Equal([]string{"/mlflow/api/2.0/mlflow/registered-models/alias"}))
+}
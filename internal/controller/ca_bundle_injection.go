@@ -0,0 +1,55 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// trustedCABundleInjectionLabel asks the OpenShift Cluster Network Operator to fill a
+	// ConfigMap with the cluster trust bundle, including the proxy CAs, under
+	// injectedCABundleKey. The ConfigMap is created empty and populated asynchronously.
+	trustedCABundleInjectionLabel = "config.openshift.io/inject-trusted-cabundle"
+	injectedCABundleKey           = "ca-bundle.crt"
+
+	// caBundleInjectionPendingReason reports that spec.caBundleConfigMap asks for injection
+	// and the bundle has not been injected yet.
+	caBundleInjectionPendingReason = "CABundleInjectionPending"
+)
+
+// requestsCABundleInjection reports whether obj is labeled for trusted CA bundle injection.
+func requestsCABundleInjection(obj client.Object) bool {
+	return obj.GetLabels()[trustedCABundleInjectionLabel] == "true"
+}
+
+// caBundleInjectionPending reports whether configMap asks for trusted CA bundle injection
+// and has not been injected yet.
+func caBundleInjectionPending(configMap *corev1.ConfigMap) bool {
+	return requestsCABundleInjection(configMap) && configMap.Data[injectedCABundleKey] == ""
+}
+
+// injectedCABundleHash returns the hash of the injected bundle of configMap for the pod
+// template, or "" when the ConfigMap is not injected. User-managed bundles are not hashed,
+// so editing them keeps relying on the watcher sidecar as before.
+func injectedCABundleHash(configMap *corev1.ConfigMap) string {
+	if !requestsCABundleInjection(configMap) || configMap.Data[injectedCABundleKey] == "" {
+		return ""
+	}
+	return certificateHash([]byte(configMap.Data[injectedCABundleKey]))
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestInjectedCABundle(t *testing.T) {
+	g := gomega.NewWithT(t)
+	injected := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "trusted-ca",
+			Labels: map[string]string{trustedCABundleInjectionLabel: "true"},
+		},
+	}
+
+	// Created empty; the Cluster Network Operator fills it in later.
+	g.Expect(caBundleInjectionPending(injected)).To(gomega.BeTrue())
+	g.Expect(injectedCABundleHash(injected)).To(gomega.BeEmpty())
+
+	injected.Data = map[string]string{injectedCABundleKey: "-----BEGIN CERTIFICATE-----\nA\n"}
+	g.Expect(caBundleInjectionPending(injected)).To(gomega.BeFalse())
+	hash := injectedCABundleHash(injected)
+	g.Expect(hash).NotTo(gomega.BeEmpty())
+
+	injected.Data[injectedCABundleKey] = "-----BEGIN CERTIFICATE-----\nB\n"
+	g.Expect(injectedCABundleHash(injected)).NotTo(gomega.Equal(hash))
+
+	// A bundle the user maintains is never waited for nor hashed.
+	userManaged := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "custom-ca"},
+		Data:       map[string]string{"ca.crt": "-----BEGIN CERTIFICATE-----\nC\n"},
+	}
+	g.Expect(caBundleInjectionPending(userManaged)).To(gomega.BeFalse())
+	g.Expect(injectedCABundleHash(userManaged)).To(gomega.BeEmpty())
+}
@@ -0,0 +1,407 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
+)
+
+const (
+	// podTemplateHashAnnotation records the rendered pod template on the stable Deployment so
+	// canary rollouts can tell when the template changed.
+	podTemplateHashAnnotation = "mlflow.opendatahub.io/pod-template-hash"
+	// canaryRequeueInterval is how often an in-progress canary is re-evaluated.
+	canaryRequeueInterval = 10 * time.Second
+
+	defaultCanaryStepDuration = 5 * time.Minute
+	defaultCanaryReadyTimeout = 10 * time.Minute
+)
+
+// defaultCanarySteps are the traffic percentages used when spec.upgradeStrategy.canary.steps
+// is unset.
+var defaultCanarySteps = []int32{10, 50}
+
+func canaryStrategyEnabled(mlflow *mlflowv1.MLflow) bool {
+	return mlflow.Spec.UpgradeStrategy != nil && mlflow.Spec.UpgradeStrategy.Type == mlflowv1.UpgradeStrategyCanary
+}
+
+func canaryResourceName(mlflow *mlflowv1.MLflow) string {
+	return ResourceName + render.ResourceSuffix(mlflow.Name) + "-canary"
+}
+
+func canarySteps(mlflow *mlflowv1.MLflow) []int32 {
+	if canary := mlflow.Spec.UpgradeStrategy.Canary; canary != nil && len(canary.Steps) > 0 {
+		return canary.Steps
+	}
+	return defaultCanarySteps
+}
+
+func canaryStepDuration(mlflow *mlflowv1.MLflow) time.Duration {
+	if canary := mlflow.Spec.UpgradeStrategy.Canary; canary != nil && canary.StepDuration != nil {
+		return canary.StepDuration.Duration
+	}
+	return defaultCanaryStepDuration
+}
+
+func canaryReadyTimeout(mlflow *mlflowv1.MLflow) time.Duration {
+	if canary := mlflow.Spec.UpgradeStrategy.Canary; canary != nil && canary.ReadyTimeout != nil {
+		return canary.ReadyTimeout.Duration
+	}
+	return defaultCanaryReadyTimeout
+}
+
+// canaryTrafficSplit returns spec.routing.trafficSplit, which replaces the timed canary steps
+// with a hand-set weight.
+func canaryTrafficSplit(mlflow *mlflowv1.MLflow) *mlflowv1.TrafficSplitConfig {
+	if mlflow.Spec.Routing == nil {
+		return nil
+	}
+	return mlflow.Spec.Routing.TrafficSplit
+}
+
+// canaryEligible reports whether a canary rollout can run: traffic is only split through the
+// HTTPRoute, and a ReadWriteOnce PVC cannot be shared by two Deployments.
+func (r *MLflowReconciler) canaryEligible(mlflow *mlflowv1.MLflow, suspended bool) bool {
+	return canaryStrategyEnabled(mlflow) && r.HTTPRouteAvailable && routingEnabled(mlflow) &&
+		mlflow.Spec.Storage == nil && !suspended
+}
+
+// canaryTrafficWeight returns the percentage of HTTPRoute traffic routed to the canary.
+func canaryTrafficWeight(mlflow *mlflowv1.MLflow) int32 {
+	status := mlflow.Status.Canary
+	if status == nil || status.Phase == mlflowv1.CanaryPhaseRolledBack {
+		return 0
+	}
+	return status.Weight
+}
+
+// stampPodTemplateHash annotates the rendered stable Deployment with a hash of its pod template.
+func stampPodTemplateHash(objects []*unstructured.Unstructured, deploymentName string) error {
+	deployment := render.FindRenderedObject(objects, "Deployment", deploymentName)
+	if deployment == nil {
+		return nil
+	}
+	template, _, err := unstructured.NestedMap(deployment.Object, "spec", "template")
+	if err != nil {
+		return fmt.Errorf("failed to read pod template of Deployment %s: %w", deploymentName, err)
+	}
+	data, err := json.Marshal(template)
+	if err != nil {
+		return fmt.Errorf("failed to hash pod template of Deployment %s: %w", deploymentName, err)
+	}
+	sum := sha256.Sum256(data)
+	annotations := deployment.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[podTemplateHashAnnotation] = hex.EncodeToString(sum[:8])
+	deployment.SetAnnotations(annotations)
+	return nil
+}
+
+func withoutRenderedObject(objects []*unstructured.Unstructured, kind, name string) []*unstructured.Unstructured {
+	filtered := make([]*unstructured.Unstructured, 0, len(objects))
+	for _, obj := range objects {
+		if obj.GetKind() != kind || obj.GetName() != name {
+			filtered = append(filtered, obj)
+		}
+	}
+	return filtered
+}
+
+// buildCanaryObjects derives the single-replica canary Deployment, Service, and
+// NetworkPolicy from the rendered stable objects.
+func buildCanaryObjects(mlflow *mlflowv1.MLflow, objects []*unstructured.Unstructured) ([]*unstructured.Unstructured, error) {
+	replicas := int64(1)
+	return buildRevisionObjects(mlflow, objects, canaryResourceName(mlflow), &replicas)
+}
+
+// buildRevisionObjects derives a second revision's Deployment, Service, and NetworkPolicy
+// named name from the rendered stable objects. Its pods get their own app label so the
+// stable Service and Deployment selectors exclude them, while object metadata keeps the
+// stable app label the manager cache selects on. A nil replicas keeps the stable count.
+func buildRevisionObjects(
+	mlflow *mlflowv1.MLflow,
+	objects []*unstructured.Unstructured,
+	name string,
+	replicas *int64,
+) ([]*unstructured.Unstructured, error) {
+	stableName := ResourceName + render.ResourceSuffix(mlflow.Name)
+
+	var revision []*unstructured.Unstructured
+	for _, kind := range []string{"Deployment", "Service", "NetworkPolicy"} {
+		stable := render.FindRenderedObject(objects, kind, stableName)
+		if stable == nil {
+			continue
+		}
+		obj := stable.DeepCopy()
+		obj.SetName(name)
+
+		var err error
+		switch kind {
+		case "Deployment":
+			if replicas != nil {
+				err = unstructured.SetNestedField(obj.Object, *replicas, "spec", "replicas")
+			}
+			if err == nil {
+				err = unstructured.SetNestedField(obj.Object, name, "spec", "selector", "matchLabels", "app")
+			}
+			if err == nil {
+				err = unstructured.SetNestedField(obj.Object, name, "spec", "template", "metadata", "labels", "app")
+			}
+		case "Service":
+			// The revision reuses the stable serving certificate; a second serving-cert
+			// annotation would make service-ca fight over the same Secret.
+			annotations := obj.GetAnnotations()
+			delete(annotations, "service.beta.openshift.io/serving-cert-secret-name")
+			obj.SetAnnotations(annotations)
+			unstructured.RemoveNestedField(obj.Object, "spec", "loadBalancerSourceRanges")
+			if err = unstructured.SetNestedField(obj.Object, string(corev1.ServiceTypeClusterIP), "spec", "type"); err == nil {
+				err = unstructured.SetNestedField(obj.Object, name, "spec", "selector", "app")
+			}
+		case "NetworkPolicy":
+			err = unstructured.SetNestedField(obj.Object, name, "spec", "podSelector", "matchLabels", "app")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to build %s %s: %w", name, kind, err)
+		}
+		revision = append(revision, obj)
+	}
+	return revision, nil
+}
+
+// reconcileCanary runs the Canary upgrade strategy and returns the objects to apply. While a
+// rollout is in progress the stable Deployment keeps its live revision and the canary objects
+// are added; after the last traffic step the stable Deployment is updated and the canary is
+// removed once it has rolled out. The returned duration is when to re-evaluate the rollout.
+func (r *MLflowReconciler) reconcileCanary(
+	ctx context.Context,
+	mlflow *mlflowv1.MLflow,
+	namespace string,
+	objects []*unstructured.Unstructured,
+	suspended bool,
+	now time.Time,
+) ([]*unstructured.Unstructured, time.Duration, error) {
+	if !r.canaryEligible(mlflow, suspended) {
+		if mlflow.Status.Canary != nil {
+			if err := r.deleteCanaryObjects(ctx, mlflow, namespace); err != nil {
+				return nil, 0, err
+			}
+			clearCanaryStatus(mlflow)
+		}
+		return objects, 0, nil
+	}
+
+	stableName := ResourceName + render.ResourceSuffix(mlflow.Name)
+	desired := render.FindRenderedObject(objects, "Deployment", stableName)
+	if desired == nil {
+		return objects, 0, nil
+	}
+	desiredRevision := desired.GetAnnotations()[podTemplateHashAnnotation]
+
+	live := &appsv1.Deployment{}
+	if err := r.Get(ctx, types.NamespacedName{Name: stableName, Namespace: namespace}, live); err != nil {
+		if !errors.IsNotFound(err) {
+			return nil, 0, fmt.Errorf("failed to get Deployment %s: %w", stableName, err)
+		}
+		// First install: nothing to canary against.
+		return objects, 0, nil
+	}
+
+	status := mlflow.Status.Canary
+	liveRevision := live.Annotations[podTemplateHashAnnotation]
+	if liveRevision == "" || liveRevision == desiredRevision {
+		if status != nil && status.Phase == mlflowv1.CanaryPhasePromoting && status.Revision == desiredRevision &&
+			(!deploymentRolledOut(live) || status.Weight > 0) {
+			// Keep the canary serving its share until the stable Deployment finishes rolling
+			// out, then drain its route weight before deleting it.
+			if deploymentRolledOut(live) {
+				status.Weight = 0
+				status.Message = "Stable Deployment promoted; draining the canary revision"
+			}
+			canary, err := buildCanaryObjects(mlflow, objects)
+			if err != nil {
+				return nil, 0, err
+			}
+			return append(objects, canary...), canaryRequeueInterval, nil
+		}
+		if status != nil {
+			if err := r.deleteCanaryObjects(ctx, mlflow, namespace); err != nil {
+				return nil, 0, err
+			}
+			clearCanaryStatus(mlflow)
+		}
+		return objects, 0, nil
+	}
+
+	// The pod template changed: hold the stable Deployment on its live revision.
+	held := withoutRenderedObject(objects, "Deployment", stableName)
+	if status != nil && status.Revision == desiredRevision && status.Phase == mlflowv1.CanaryPhaseRolledBack {
+		return held, 0, nil
+	}
+	if status == nil || status.Revision != desiredRevision {
+		status = &mlflowv1.CanaryStatus{
+			Revision:    desiredRevision,
+			Phase:       mlflowv1.CanaryPhaseProgressing,
+			StartedTime: metav1.NewTime(now),
+			Message:     "Waiting for the canary revision to become ready",
+		}
+		mlflow.Status.Canary = status
+	}
+
+	canaryDeployment := &appsv1.Deployment{}
+	err := r.Get(ctx, types.NamespacedName{Name: canaryResourceName(mlflow), Namespace: namespace}, canaryDeployment)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, 0, fmt.Errorf("failed to get canary Deployment: %w", err)
+	}
+	ready := err == nil && canaryDeployment.Annotations[podTemplateHashAnnotation] == desiredRevision &&
+		deploymentRolledOut(canaryDeployment)
+
+	steps := canarySteps(mlflow)
+	split := canaryTrafficSplit(mlflow)
+	requeue := canaryRequeueInterval
+	switch {
+	case !ready && status.Weight > 0:
+		return r.rollBackCanary(ctx, mlflow, namespace, held,
+			fmt.Sprintf("canary revision failed its readiness checks at %d%% traffic", status.Weight))
+	case !ready && now.Sub(status.StartedTime.Time) >= canaryReadyTimeout(mlflow):
+		return r.rollBackCanary(ctx, mlflow, namespace, held,
+			fmt.Sprintf("canary revision did not become ready within %s", canaryReadyTimeout(mlflow)))
+	case !ready:
+		// Wait for the canary pods; they receive no traffic until ready.
+	case split != nil && split.CandidateWeight < 100:
+		if status.StepStartedTime == nil || status.Weight != split.CandidateWeight {
+			setCanaryStep(status, status.Step, split.CandidateWeight, now)
+			status.Message += " as set by spec.routing.trafficSplit"
+		}
+	case split != nil:
+		status.Phase = mlflowv1.CanaryPhasePromoting
+		status.Weight = 100
+		status.Message = "spec.routing.trafficSplit sends all traffic to the canary revision; promoting it to the stable Deployment"
+		held = objects
+	case status.StepStartedTime == nil:
+		setCanaryStep(status, 0, steps[0], now)
+		requeue = canaryStepDuration(mlflow)
+	case now.Sub(status.StepStartedTime.Time) < canaryStepDuration(mlflow):
+		requeue = min(requeue, canaryStepDuration(mlflow)-now.Sub(status.StepStartedTime.Time))
+	case int(status.Step)+1 < len(steps):
+		setCanaryStep(status, status.Step+1, steps[status.Step+1], now)
+		requeue = canaryStepDuration(mlflow)
+	default:
+		status.Phase = mlflowv1.CanaryPhasePromoting
+		status.Message = "Canary revision passed all steps; promoting it to the stable Deployment"
+		held = objects
+	}
+
+	canary, err := buildCanaryObjects(mlflow, objects)
+	if err != nil {
+		return nil, 0, err
+	}
+	return append(held, canary...), requeue, nil
+}
+
+func setCanaryStep(status *mlflowv1.CanaryStatus, step, weight int32, now time.Time) {
+	started := metav1.NewTime(now)
+	status.Step = step
+	status.Weight = weight
+	status.StepStartedTime = &started
+	status.Message = fmt.Sprintf("Routing %d%% of gateway traffic to the canary revision", weight)
+}
+
+// rollBackCanary removes the canary and records the failed revision so it is not retried until
+// the pod template changes again.
+func (r *MLflowReconciler) rollBackCanary(
+	ctx context.Context,
+	mlflow *mlflowv1.MLflow,
+	namespace string,
+	held []*unstructured.Unstructured,
+	message string,
+) ([]*unstructured.Unstructured, time.Duration, error) {
+	logf.FromContext(ctx).Info("Rolling back canary revision", "revision", mlflow.Status.Canary.Revision, "reason", message)
+	if err := r.deleteCanaryObjects(ctx, mlflow, namespace); err != nil {
+		return nil, 0, err
+	}
+	status := mlflow.Status.Canary
+	status.Phase = mlflowv1.CanaryPhaseRolledBack
+	status.Weight = 0
+	status.Message = message
+	meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+		Type:    "Degraded",
+		Status:  metav1.ConditionTrue,
+		Reason:  "CanaryRolledBack",
+		Message: message + "; the previous revision keeps serving until the spec changes",
+	})
+	return held, 0, nil
+}
+
+func clearCanaryStatus(mlflow *mlflowv1.MLflow) {
+	mlflow.Status.Canary = nil
+	if degraded := meta.FindStatusCondition(mlflow.Status.Conditions, "Degraded"); degraded != nil && degraded.Reason == "CanaryRolledBack" {
+		meta.RemoveStatusCondition(&mlflow.Status.Conditions, "Degraded")
+	}
+}
+
+func (r *MLflowReconciler) deleteCanaryObjects(ctx context.Context, mlflow *mlflowv1.MLflow, namespace string) error {
+	return r.deleteRevisionObjects(ctx, canaryResourceName(mlflow), namespace)
+}
+
+// deleteRevisionObjects removes the objects built by buildRevisionObjects.
+func (r *MLflowReconciler) deleteRevisionObjects(ctx context.Context, name, namespace string) error {
+	objectMeta := metav1.ObjectMeta{Name: name, Namespace: namespace}
+	for _, obj := range []client.Object{
+		&appsv1.Deployment{ObjectMeta: objectMeta},
+		&corev1.Service{ObjectMeta: objectMeta},
+		&networkingv1.NetworkPolicy{ObjectMeta: objectMeta},
+	} {
+		if err := r.Delete(ctx, obj); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete %s %T: %w", name, obj, err)
+		}
+	}
+	return nil
+}
+
+// deploymentRolledOut reports whether every desired replica runs the current template and is
+// available.
+func deploymentRolledOut(deployment *appsv1.Deployment) bool {
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+	return deployment.Status.ObservedGeneration >= deployment.Generation &&
+		deployment.Status.UpdatedReplicas >= replicas &&
+		deployment.Status.AvailableReplicas >= replicas &&
+		deployment.Status.Replicas == deployment.Status.UpdatedReplicas
+}
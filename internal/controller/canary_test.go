@@ -0,0 +1,348 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
+)
+
+const canaryTestNamespace = "opendatahub"
+
+func canaryTestMLflow() *mlflowv1.MLflow {
+	return &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: ResourceName},
+		Spec: mlflowv1.MLflowSpec{
+			UpgradeStrategy: &mlflowv1.UpgradeStrategy{
+				Type: mlflowv1.UpgradeStrategyCanary,
+				Canary: &mlflowv1.CanaryStrategy{
+					Steps:        []int32{20, 60},
+					StepDuration: &metav1.Duration{Duration: time.Minute},
+					ReadyTimeout: &metav1.Duration{Duration: 5 * time.Minute},
+				},
+			},
+		},
+	}
+}
+
+// canaryTestObjects returns a minimal rendered Deployment, Service, and NetworkPolicy for image,
+// with the pod template hash stamped.
+func canaryTestObjects(t *testing.T, image string) []*unstructured.Unstructured {
+	t.Helper()
+	labels := map[string]interface{}{"app": ResourceName, "component": "mlflow"}
+	objects := []*unstructured.Unstructured{
+		{Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata":   map[string]interface{}{"name": ResourceName, "namespace": canaryTestNamespace, "labels": labels},
+			"spec": map[string]interface{}{
+				"replicas": int64(2),
+				"selector": map[string]interface{}{"matchLabels": map[string]interface{}{"app": ResourceName}},
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{"labels": map[string]interface{}{"app": ResourceName, "component": "mlflow"}},
+					"spec": map[string]interface{}{"containers": []interface{}{
+						map[string]interface{}{"name": "mlflow", "image": image},
+					}},
+				},
+			},
+		}},
+		{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"metadata": map[string]interface{}{
+				"name": ResourceName, "namespace": canaryTestNamespace, "labels": labels,
+				"annotations": map[string]interface{}{"service.beta.openshift.io/serving-cert-secret-name": TLSSecretName},
+			},
+			"spec": map[string]interface{}{
+				"type":                     "LoadBalancer",
+				"loadBalancerSourceRanges": []interface{}{"10.0.0.0/8"},
+				"selector":                 map[string]interface{}{"app": ResourceName},
+			},
+		}},
+		{Object: map[string]interface{}{
+			"apiVersion": "networking.k8s.io/v1",
+			"kind":       "NetworkPolicy",
+			"metadata":   map[string]interface{}{"name": ResourceName, "namespace": canaryTestNamespace, "labels": labels},
+			"spec": map[string]interface{}{
+				"podSelector": map[string]interface{}{"matchLabels": map[string]interface{}{"app": ResourceName}},
+			},
+		}},
+	}
+	if err := stampPodTemplateHash(objects, ResourceName); err != nil {
+		t.Fatalf("stamp pod template hash: %v", err)
+	}
+	return objects
+}
+
+func renderedRevision(objects []*unstructured.Unstructured) string {
+	return render.FindRenderedObject(objects, "Deployment", ResourceName).GetAnnotations()[podTemplateHashAnnotation]
+}
+
+// liveDeployment returns a rolled-out Deployment carrying revision.
+func liveDeployment(name, revision string, replicas int32) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   canaryTestNamespace,
+			Labels:      map[string]string{"app": ResourceName},
+			Annotations: map[string]string{podTemplateHashAnnotation: revision},
+		},
+		Spec: appsv1.DeploymentSpec{Replicas: &replicas},
+		Status: appsv1.DeploymentStatus{
+			Replicas:          replicas,
+			UpdatedReplicas:   replicas,
+			AvailableReplicas: replicas,
+		},
+	}
+}
+
+func objectNames(objects []*unstructured.Unstructured) []string {
+	names := make([]string, 0, len(objects))
+	for _, obj := range objects {
+		names = append(names, obj.GetKind()+"/"+obj.GetName())
+	}
+	return names
+}
+
+func TestBuildCanaryObjects(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	mlflow := canaryTestMLflow()
+	canary, err := buildCanaryObjects(mlflow, canaryTestObjects(t, "quay.io/opendatahub/mlflow:new"))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(objectNames(canary)).To(gomega.Equal([]string{
+		"Deployment/mlflow-canary", "Service/mlflow-canary", "NetworkPolicy/mlflow-canary",
+	}))
+
+	deployment, service, policy := canary[0], canary[1], canary[2]
+	g.Expect(deployment.GetLabels()).To(gomega.HaveKeyWithValue("app", ResourceName), "metadata keeps the cached app label")
+	replicas, _, _ := unstructured.NestedInt64(deployment.Object, "spec", "replicas")
+	g.Expect(replicas).To(gomega.Equal(int64(1)))
+	podApp, _, _ := unstructured.NestedString(deployment.Object, "spec", "template", "metadata", "labels", "app")
+	g.Expect(podApp).To(gomega.Equal("mlflow-canary"))
+	selectorApp, _, _ := unstructured.NestedString(deployment.Object, "spec", "selector", "matchLabels", "app")
+	g.Expect(selectorApp).To(gomega.Equal("mlflow-canary"))
+
+	g.Expect(service.GetAnnotations()).NotTo(gomega.HaveKey("service.beta.openshift.io/serving-cert-secret-name"))
+	serviceType, _, _ := unstructured.NestedString(service.Object, "spec", "type")
+	g.Expect(serviceType).To(gomega.Equal("ClusterIP"))
+	_, hasRanges, _ := unstructured.NestedSlice(service.Object, "spec", "loadBalancerSourceRanges")
+	g.Expect(hasRanges).To(gomega.BeFalse())
+	serviceSelector, _, _ := unstructured.NestedString(service.Object, "spec", "selector", "app")
+	g.Expect(serviceSelector).To(gomega.Equal("mlflow-canary"))
+
+	policyApp, _, _ := unstructured.NestedString(policy.Object, "spec", "podSelector", "matchLabels", "app")
+	g.Expect(policyApp).To(gomega.Equal("mlflow-canary"))
+}
+
+func TestReconcileCanaryStepsAndPromotes(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+
+	oldRevision := renderedRevision(canaryTestObjects(t, "quay.io/opendatahub/mlflow:old"))
+	r := newTestReconciler(t, liveDeployment(ResourceName, oldRevision, 2))
+	r.HTTPRouteAvailable = true
+	mlflow := canaryTestMLflow()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	objects := canaryTestObjects(t, "quay.io/opendatahub/mlflow:new")
+	newRevision := renderedRevision(objects)
+
+	// A template change starts the canary and holds the stable Deployment.
+	applied, requeue, err := r.reconcileCanary(ctx, mlflow, canaryTestNamespace, objects, false, now)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(objectNames(applied)).To(gomega.ConsistOf(
+		"Service/mlflow", "NetworkPolicy/mlflow",
+		"Deployment/mlflow-canary", "Service/mlflow-canary", "NetworkPolicy/mlflow-canary",
+	))
+	g.Expect(requeue).To(gomega.Equal(canaryRequeueInterval))
+	g.Expect(mlflow.Status.Canary.Revision).To(gomega.Equal(newRevision))
+	g.Expect(mlflow.Status.Canary.Weight).To(gomega.BeZero())
+
+	// Once the canary is ready it gets the first step's traffic.
+	g.Expect(r.Create(ctx, liveDeployment(canaryResourceName(mlflow), newRevision, 1))).To(gomega.Succeed())
+	_, requeue, err = r.reconcileCanary(ctx, mlflow, canaryTestNamespace, canaryTestObjects(t, "quay.io/opendatahub/mlflow:new"), false, now)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(mlflow.Status.Canary.Weight).To(gomega.Equal(int32(20)))
+	g.Expect(requeue).To(gomega.Equal(time.Minute))
+
+	now = now.Add(time.Minute)
+	_, _, err = r.reconcileCanary(ctx, mlflow, canaryTestNamespace, canaryTestObjects(t, "quay.io/opendatahub/mlflow:new"), false, now)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(mlflow.Status.Canary.Step).To(gomega.Equal(int32(1)))
+	g.Expect(mlflow.Status.Canary.Weight).To(gomega.Equal(int32(60)))
+
+	route := buildHTTPRoute(mlflow, canaryTestNamespace, &config.OperatorConfig{GatewayName: "gateway"})
+	backends := route.Spec.Rules[0].BackendRefs
+	g.Expect(backends).To(gomega.HaveLen(2))
+	g.Expect(*backends[0].Weight).To(gomega.Equal(int32(40)))
+	g.Expect(string(backends[1].Name)).To(gomega.Equal("mlflow-canary"))
+	g.Expect(*backends[1].Weight).To(gomega.Equal(int32(60)))
+
+	// After the last step the stable Deployment is updated.
+	now = now.Add(time.Minute)
+	applied, _, err = r.reconcileCanary(ctx, mlflow, canaryTestNamespace, canaryTestObjects(t, "quay.io/opendatahub/mlflow:new"), false, now)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(mlflow.Status.Canary.Phase).To(gomega.Equal(mlflowv1.CanaryPhasePromoting))
+	g.Expect(objectNames(applied)).To(gomega.ContainElement("Deployment/mlflow"))
+
+	// When the stable Deployment has rolled out, the canary is drained and then removed.
+	g.Expect(r.Update(ctx, liveDeployment(ResourceName, newRevision, 2))).To(gomega.Succeed())
+	_, _, err = r.reconcileCanary(ctx, mlflow, canaryTestNamespace, canaryTestObjects(t, "quay.io/opendatahub/mlflow:new"), false, now)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(mlflow.Status.Canary.Weight).To(gomega.BeZero())
+
+	applied, requeue, err = r.reconcileCanary(ctx, mlflow, canaryTestNamespace, canaryTestObjects(t, "quay.io/opendatahub/mlflow:new"), false, now)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(mlflow.Status.Canary).To(gomega.BeNil())
+	g.Expect(requeue).To(gomega.BeZero())
+	g.Expect(objectNames(applied)).To(gomega.ConsistOf("Deployment/mlflow", "Service/mlflow", "NetworkPolicy/mlflow"))
+	err = r.Get(ctx, types.NamespacedName{Name: canaryResourceName(mlflow), Namespace: canaryTestNamespace}, &appsv1.Deployment{})
+	g.Expect(err).To(gomega.HaveOccurred())
+}
+
+func TestReconcileCanaryTrafficSplit(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+
+	oldRevision := renderedRevision(canaryTestObjects(t, "quay.io/opendatahub/mlflow:old"))
+	r := newTestReconciler(t, liveDeployment(ResourceName, oldRevision, 2))
+	r.HTTPRouteAvailable = true
+	mlflow := canaryTestMLflow()
+	mlflow.Spec.Routing = &mlflowv1.RoutingConfig{TrafficSplit: &mlflowv1.TrafficSplitConfig{CandidateWeight: 30}}
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	newRevision := renderedRevision(canaryTestObjects(t, "quay.io/opendatahub/mlflow:new"))
+	g.Expect(r.Create(ctx, liveDeployment(canaryResourceName(mlflow), newRevision, 1))).To(gomega.Succeed())
+
+	// The hand-set weight applies instead of the first step, and stays past the step duration.
+	for range 2 {
+		_, _, err := r.reconcileCanary(ctx, mlflow, canaryTestNamespace, canaryTestObjects(t, "quay.io/opendatahub/mlflow:new"), false, now)
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(mlflow.Status.Canary.Phase).To(gomega.Equal(mlflowv1.CanaryPhaseProgressing))
+		g.Expect(mlflow.Status.Canary.Weight).To(gomega.Equal(int32(30)))
+		now = now.Add(10 * time.Minute)
+	}
+	backends := buildHTTPRoute(mlflow, canaryTestNamespace, &config.OperatorConfig{GatewayName: "gateway"}).Spec.Rules[0].BackendRefs
+	g.Expect(*backends[0].Weight).To(gomega.Equal(int32(70)))
+	g.Expect(*backends[1].Weight).To(gomega.Equal(int32(30)))
+
+	// Sending all traffic to the candidate promotes it.
+	mlflow.Spec.Routing.TrafficSplit.CandidateWeight = 100
+	applied, _, err := r.reconcileCanary(ctx, mlflow, canaryTestNamespace, canaryTestObjects(t, "quay.io/opendatahub/mlflow:new"), false, now)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(mlflow.Status.Canary.Phase).To(gomega.Equal(mlflowv1.CanaryPhasePromoting))
+	g.Expect(mlflow.Status.Canary.Weight).To(gomega.Equal(int32(100)))
+	g.Expect(objectNames(applied)).To(gomega.ContainElement("Deployment/mlflow"))
+
+	// Once the stable Deployment has rolled out, the route returns to 100/0.
+	g.Expect(r.Update(ctx, liveDeployment(ResourceName, newRevision, 2))).To(gomega.Succeed())
+	_, _, err = r.reconcileCanary(ctx, mlflow, canaryTestNamespace, canaryTestObjects(t, "quay.io/opendatahub/mlflow:new"), false, now)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	backends = buildHTTPRoute(mlflow, canaryTestNamespace, &config.OperatorConfig{GatewayName: "gateway"}).Spec.Rules[0].BackendRefs
+	g.Expect(backends).To(gomega.HaveLen(1))
+	g.Expect(string(backends[0].Name)).To(gomega.Equal(ResourceName))
+}
+
+func TestReconcileCanaryRollsBackUnhealthyRevision(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+
+	oldRevision := renderedRevision(canaryTestObjects(t, "quay.io/opendatahub/mlflow:old"))
+	r := newTestReconciler(t, liveDeployment(ResourceName, oldRevision, 2))
+	r.HTTPRouteAvailable = true
+	mlflow := canaryTestMLflow()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	newRevision := renderedRevision(canaryTestObjects(t, "quay.io/opendatahub/mlflow:broken"))
+	unready := liveDeployment(canaryResourceName(mlflow), newRevision, 1)
+	unready.Status.AvailableReplicas = 0
+	g.Expect(r.Create(ctx, unready)).To(gomega.Succeed())
+
+	_, _, err := r.reconcileCanary(ctx, mlflow, canaryTestNamespace, canaryTestObjects(t, "quay.io/opendatahub/mlflow:broken"), false, now)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(mlflow.Status.Canary.Phase).To(gomega.Equal(mlflowv1.CanaryPhaseProgressing))
+
+	now = now.Add(5 * time.Minute)
+	applied, _, err := r.reconcileCanary(ctx, mlflow, canaryTestNamespace, canaryTestObjects(t, "quay.io/opendatahub/mlflow:broken"), false, now)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(mlflow.Status.Canary.Phase).To(gomega.Equal(mlflowv1.CanaryPhaseRolledBack))
+	g.Expect(mlflow.Status.Canary.Message).To(gomega.ContainSubstring("did not become ready"))
+	g.Expect(meta.IsStatusConditionTrue(mlflow.Status.Conditions, "Degraded")).To(gomega.BeTrue())
+	g.Expect(objectNames(applied)).To(gomega.ConsistOf("Service/mlflow", "NetworkPolicy/mlflow"))
+	err = r.Get(ctx, types.NamespacedName{Name: canaryResourceName(mlflow), Namespace: canaryTestNamespace}, &appsv1.Deployment{})
+	g.Expect(err).To(gomega.HaveOccurred())
+
+	// The failed revision is not retried, and the stable Deployment stays held.
+	applied, _, err = r.reconcileCanary(ctx, mlflow, canaryTestNamespace, canaryTestObjects(t, "quay.io/opendatahub/mlflow:broken"), false, now)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(objectNames(applied)).To(gomega.ConsistOf("Service/mlflow", "NetworkPolicy/mlflow"))
+
+	// Reverting the spec to the live revision clears the rollback.
+	_, _, err = r.reconcileCanary(ctx, mlflow, canaryTestNamespace, canaryTestObjects(t, "quay.io/opendatahub/mlflow:old"), false, now)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(mlflow.Status.Canary).To(gomega.BeNil())
+	g.Expect(meta.FindStatusCondition(mlflow.Status.Conditions, "Degraded")).To(gomega.BeNil())
+}
+
+func TestReconcileCanaryFallsBackToRollingUpdate(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+
+	oldRevision := renderedRevision(canaryTestObjects(t, "quay.io/opendatahub/mlflow:old"))
+	r := newTestReconciler(t, liveDeployment(ResourceName, oldRevision, 2))
+	r.HTTPRouteAvailable = true
+	mlflow := canaryTestMLflow()
+	mlflow.Spec.Storage = &mlflowv1.StorageConfig{}
+
+	objects := canaryTestObjects(t, "quay.io/opendatahub/mlflow:new")
+	applied, requeue, err := r.reconcileCanary(ctx, mlflow, canaryTestNamespace, objects, false, time.Now())
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(applied).To(gomega.Equal(objects))
+	g.Expect(requeue).To(gomega.BeZero())
+	g.Expect(mlflow.Status.Canary).To(gomega.BeNil())
+}
+
+func TestClearCanaryStatusKeepsOtherDegradedReasons(t *testing.T) {
+	g := gomega.NewWithT(t)
+	mlflow := canaryTestMLflow()
+	mlflow.Status.Canary = &mlflowv1.CanaryStatus{Phase: mlflowv1.CanaryPhaseProgressing}
+	meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+		Type: "Degraded", Status: metav1.ConditionTrue, Reason: "BlueGreenRolledBack",
+	})
+
+	clearCanaryStatus(mlflow)
+	g.Expect(mlflow.Status.Canary).To(gomega.BeNil())
+	g.Expect(meta.IsStatusConditionTrue(mlflow.Status.Conditions, "Degraded")).To(gomega.BeTrue())
+
+	meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+		Type: "Degraded", Status: metav1.ConditionTrue, Reason: "CanaryRolledBack",
+	})
+	clearCanaryStatus(mlflow)
+	g.Expect(meta.FindStatusCondition(mlflow.Status.Conditions, "Degraded")).To(gomega.BeNil())
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	crcache "sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+const (
+	// ClientServiceAccountName is the per-workspace ServiceAccount for programmatic access.
+	ClientServiceAccountName = "mlflow-client"
+	// ClientTokenSecretName holds the long-lived token of the client ServiceAccount.
+	ClientTokenSecretName = "mlflow-client-token"
+	// IntegrationClusterRoleName grants data-plane access without delete; see
+	// config/rbac/mlflow_integration_role.yaml.
+	IntegrationClusterRoleName = "mlflow-integration"
+)
+
+// clientCredentialsEnabled reports whether spec.clientCredentials asks for per-workspace
+// client credentials.
+func clientCredentialsEnabled(mlflow *mlflowv1.MLflow) bool {
+	return mlflow.Spec.ClientCredentials != nil && mlflow.Spec.ClientCredentials.Enabled
+}
+
+// buildClientCredentialObjects returns the ServiceAccount, token Secret, and RoleBinding that
+// give a workspace just enough access to pass MLflow's SelfSubjectAccessReview checks.
+func buildClientCredentialObjects(mlflowConfig *unstructured.Unstructured) []*unstructured.Unstructured {
+	namespace := mlflowConfig.GetNamespace()
+	newObject := func(apiVersion, kind, name string, content map[string]interface{}) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{Object: content}
+		obj.SetAPIVersion(apiVersion)
+		obj.SetKind(kind)
+		obj.SetName(name)
+		obj.SetNamespace(namespace)
+		obj.SetLabels(map[string]string{"app": ResourceName})
+		obj.SetOwnerReferences([]metav1.OwnerReference{mlflowConfigOwnerReference(mlflowConfig)})
+		return obj
+	}
+
+	serviceAccount := newObject("v1", "ServiceAccount", ClientServiceAccountName, map[string]interface{}{})
+	// The token controller fills in token, ca.crt, and namespace for this Secret type.
+	secret := newObject("v1", "Secret", ClientTokenSecretName, map[string]interface{}{
+		"type": string(corev1.SecretTypeServiceAccountToken),
+	})
+	secret.SetAnnotations(map[string]string{corev1.ServiceAccountNameKey: ClientServiceAccountName})
+	roleBinding := newObject("rbac.authorization.k8s.io/v1", "RoleBinding", ClientServiceAccountName, map[string]interface{}{
+		"roleRef": map[string]interface{}{
+			"apiGroup": "rbac.authorization.k8s.io",
+			"kind":     "ClusterRole",
+			"name":     IntegrationClusterRoleName,
+		},
+		"subjects": []interface{}{
+			map[string]interface{}{
+				"kind":      "ServiceAccount",
+				"name":      ClientServiceAccountName,
+				"namespace": namespace,
+			},
+		},
+	})
+	return []*unstructured.Unstructured{serviceAccount, secret, roleBinding}
+}
+
+// reconcileClientCredentials applies or removes the client credentials of one workspace.
+func (r *MLflowReconciler) reconcileClientCredentials(ctx context.Context, mlflow *mlflowv1.MLflow, mlflowConfig *unstructured.Unstructured) error {
+	objects := buildClientCredentialObjects(mlflowConfig)
+	if clientCredentialsEnabled(mlflow) {
+		for _, obj := range objects {
+			if err := r.applyObject(ctx, obj); err != nil {
+				return fmt.Errorf("failed to apply client credential %s %s in namespace %s: %w",
+					obj.GetKind(), obj.GetName(), obj.GetNamespace(), err)
+			}
+		}
+		return nil
+	}
+
+	// Credentials are disabled: look up the ServiceAccount first so workspaces that never had
+	// credentials cost one read per reconcile instead of three deletes.
+	serviceAccount := &unstructured.Unstructured{}
+	serviceAccount.SetGroupVersionKind(schema.GroupVersionKind{Version: "v1", Kind: "ServiceAccount"})
+	err := r.Get(ctx, types.NamespacedName{Name: ClientServiceAccountName, Namespace: mlflowConfig.GetNamespace()}, serviceAccount)
+	if errors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to get client ServiceAccount in namespace %s: %w", mlflowConfig.GetNamespace(), err)
+	}
+	if !hasMLflowConfigOwner(serviceAccount) {
+		// Not created by the operator; leave user-managed accounts alone.
+		return nil
+	}
+
+	for i := len(objects) - 1; i >= 0; i-- {
+		if err := r.Delete(ctx, objects[i]); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete client credential %s %s in namespace %s: %w",
+				objects[i].GetKind(), objects[i].GetName(), objects[i].GetNamespace(), err)
+		}
+	}
+	logf.FromContext(ctx).Info("Deleted client credentials", "namespace", mlflowConfig.GetNamespace())
+	return nil
+}
+
+func hasMLflowConfigOwner(obj metav1.Object) bool {
+	_, ok := mlflowConfigOwner(obj)
+	return ok
+}
+
+// mlflowConfigOwner returns the MLflowConfig owner reference of a workspace object.
+func mlflowConfigOwner(obj metav1.Object) (metav1.OwnerReference, bool) {
+	for _, ref := range obj.GetOwnerReferences() {
+		if ref.Kind == MLflowConfigCRDName && ref.APIVersion == MLflowConfigGVK.GroupVersion().String() {
+			return ref, true
+		}
+	}
+	return metav1.OwnerReference{}, false
+}
+
+// NewClientTokenWatchCache returns a cache of the workspace client token Secrets. Like the GC
+// RBAC cache, it is restricted to an exact metadata.name field selector so list/watch works
+// with resourceNames-scoped Secret RBAC, and to watchNamespaces when set.
+func NewClientTokenWatchCache(cfg *rest.Config, scheme *runtime.Scheme, watchNamespaces []string) (crcache.Cache, error) {
+	byObject := crcache.ByObject{Field: fields.OneTermEqualSelector("metadata.name", ClientTokenSecretName)}
+	if len(watchNamespaces) > 0 {
+		byObject.Namespaces = make(map[string]crcache.Config, len(watchNamespaces))
+		for _, namespace := range watchNamespaces {
+			byObject.Namespaces[namespace] = crcache.Config{}
+		}
+	}
+	return crcache.New(cfg, crcache.Options{
+		Scheme:           scheme,
+		ByObject:         map[client.Object]crcache.ByObject{&corev1.Secret{}: byObject},
+		DefaultTransform: crcache.TransformStripManagedFields(),
+	})
+}
+
+// clientTokenSecretToMLflowRequests maps a client token Secret back to the MLflowConfig that
+// owns it, so a deleted or rotated token is re-issued right away instead of on the next resync.
+func (r *MLflowReconciler) clientTokenSecretToMLflowRequests(ctx context.Context, secret *metav1.PartialObjectMetadata) []reconcile.Request {
+	if secret.GetName() != ClientTokenSecretName {
+		return nil
+	}
+	ref, ok := mlflowConfigOwner(secret)
+	if !ok {
+		// Not created by the operator; user-managed Secrets are never rewritten.
+		return nil
+	}
+	mlflowConfig := &unstructured.Unstructured{}
+	mlflowConfig.SetGroupVersionKind(MLflowConfigGVK)
+	mlflowConfig.SetName(ref.Name)
+	mlflowConfig.SetNamespace(secret.GetNamespace())
+	return r.mlflowConfigToMLflowRequests(ctx, mlflowConfig)
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func TestReconcileClientCredentials(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(gomega.Succeed())
+	g.Expect(mlflowv1.AddToScheme(scheme)).To(gomega.Succeed())
+
+	mlflowConfig := newTestMLflowConfig("team-a", "mlflow")
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(mlflowConfig).Build()
+	r := &MLflowReconciler{Client: k8sClient, Scheme: scheme, MLflowConfigAvailable: true}
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec:       mlflowv1.MLflowSpec{ClientCredentials: &mlflowv1.ClientCredentialsConfig{Enabled: true}},
+	}
+	ctx := context.Background()
+	key := func(name string) types.NamespacedName { return types.NamespacedName{Name: name, Namespace: "team-a"} }
+
+	g.Expect(r.reconcileWorkspaceConnections(ctx, mlflow)).To(gomega.Succeed())
+
+	g.Expect(k8sClient.Get(ctx, key(ClientServiceAccountName), &corev1.ServiceAccount{})).To(gomega.Succeed())
+	secret := &corev1.Secret{}
+	g.Expect(k8sClient.Get(ctx, key(ClientTokenSecretName), secret)).To(gomega.Succeed())
+	g.Expect(secret.Type).To(gomega.Equal(corev1.SecretTypeServiceAccountToken))
+	g.Expect(secret.Annotations).To(gomega.HaveKeyWithValue(corev1.ServiceAccountNameKey, ClientServiceAccountName))
+	g.Expect(secret.OwnerReferences).To(gomega.ConsistOf(mlflowConfigOwnerReference(mlflowConfig)))
+	roleBinding := &rbacv1.RoleBinding{}
+	g.Expect(k8sClient.Get(ctx, key(ClientServiceAccountName), roleBinding)).To(gomega.Succeed())
+	g.Expect(roleBinding.RoleRef).To(gomega.Equal(rbacv1.RoleRef{
+		APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: IntegrationClusterRoleName,
+	}))
+	g.Expect(roleBinding.Subjects).To(gomega.Equal([]rbacv1.Subject{
+		{Kind: rbacv1.ServiceAccountKind, Name: ClientServiceAccountName, Namespace: "team-a"},
+	}))
+
+	mlflow.Spec.ClientCredentials = nil
+	g.Expect(r.reconcileWorkspaceConnections(ctx, mlflow)).To(gomega.Succeed())
+	for _, obj := range []client.Object{&corev1.ServiceAccount{}, &corev1.Secret{}, &rbacv1.RoleBinding{}} {
+		name := ClientServiceAccountName
+		if _, ok := obj.(*corev1.Secret); ok {
+			name = ClientTokenSecretName
+		}
+		err := k8sClient.Get(ctx, key(name), obj)
+		g.Expect(apierrors.IsNotFound(err)).To(gomega.BeTrue(), "%T should be deleted", obj)
+	}
+
+	// A ServiceAccount the operator did not create is left alone.
+	g.Expect(k8sClient.Create(ctx, &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{Name: ClientServiceAccountName, Namespace: "team-a"},
+	})).To(gomega.Succeed())
+	g.Expect(r.reconcileWorkspaceConnections(ctx, mlflow)).To(gomega.Succeed())
+	g.Expect(k8sClient.Get(ctx, key(ClientServiceAccountName), &corev1.ServiceAccount{})).To(gomega.Succeed())
+}
+
+// secretMetadataOf returns secret as the watches deliver it.
+func secretMetadataOf(secret *corev1.Secret) *metav1.PartialObjectMetadata {
+	metadata := secretMetadata()
+	metadata.ObjectMeta = secret.ObjectMeta
+	return metadata
+}
+
+func TestClientTokenSecretToMLflowRequests(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(gomega.Succeed())
+	g.Expect(mlflowv1.AddToScheme(scheme)).To(gomega.Succeed())
+
+	mlflowConfig := newTestMLflowConfig("team-a", "mlflow")
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec:       mlflowv1.MLflowSpec{ClientCredentials: &mlflowv1.ClientCredentialsConfig{Enabled: true}},
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(mlflowConfig, mlflow).Build()
+	r := &MLflowReconciler{Client: k8sClient, Scheme: scheme, MLflowConfigAvailable: true}
+	ctx := context.Background()
+
+	g.Expect(r.reconcileWorkspaceConnections(ctx, mlflow)).To(gomega.Succeed())
+	secret := &corev1.Secret{}
+	key := types.NamespacedName{Name: ClientTokenSecretName, Namespace: "team-a"}
+	g.Expect(k8sClient.Get(ctx, key, secret)).To(gomega.Succeed())
+	g.Expect(r.clientTokenSecretToMLflowRequests(ctx, secretMetadataOf(secret))).To(gomega.ConsistOf(
+		reconcile.Request{NamespacedName: types.NamespacedName{Name: "mlflow"}},
+	))
+
+	// Deleting the Secret to rotate the token re-issues it on the triggered reconcile, even
+	// though the desired manifest is unchanged.
+	g.Expect(k8sClient.Delete(ctx, secret)).To(gomega.Succeed())
+	g.Expect(r.reconcileWorkspaceConnections(ctx, mlflow)).To(gomega.Succeed())
+	g.Expect(k8sClient.Get(ctx, key, &corev1.Secret{})).To(gomega.Succeed())
+
+	// Secrets the operator did not create, and those outside WatchNamespaces, are ignored.
+	userSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: ClientTokenSecretName, Namespace: "team-b"}}
+	g.Expect(r.clientTokenSecretToMLflowRequests(ctx, secretMetadataOf(userSecret))).To(gomega.BeEmpty())
+	r.WatchNamespaces = []string{"team-b"}
+	g.Expect(r.clientTokenSecretToMLflowRequests(ctx, secretMetadataOf(secret))).To(gomega.BeEmpty())
+}
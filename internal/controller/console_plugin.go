@@ -0,0 +1,239 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	consolev1 "github.com/openshift/api/console/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
+)
+
+const (
+	ConsolePluginCRDName = "ConsolePlugin"
+
+	// ConsolePluginName is the name of the ConsolePlugin. The console loads the plugin under
+	// this name, so it must match the name the plugin image was built with.
+	ConsolePluginName = "mlflow-console-plugin"
+
+	consolePluginPort     = 9443
+	consolePluginCertPath = "/var/cert"
+	// consolePluginProxyAlias is the console proxy path segment the plugin calls the MLflow
+	// API through: /api/proxy/plugin/mlflow-console-plugin/mlflow/.
+	consolePluginProxyAlias = "mlflow"
+)
+
+// IsConsolePluginAvailable checks if the OpenShift ConsolePlugin CRD is available in the cluster using discovery API
+func IsConsolePluginAvailable(discoveryClient discovery.DiscoveryInterface) (bool, error) {
+	return isKindAvailable(discoveryClient, consolev1.GroupVersion, ConsolePluginCRDName)
+}
+
+func consolePluginEnabled(mlflow *mlflowv1.MLflow) bool {
+	return mlflow.Spec.ConsolePlugin != nil && mlflow.Spec.ConsolePlugin.Enabled
+}
+
+// consolePluginResourceName names the plugin Deployment, Service, and serving certificate.
+func consolePluginResourceName(mlflow *mlflowv1.MLflow) string {
+	return ResourceName + render.ResourceSuffix(mlflow.Name) + "-console-plugin"
+}
+
+// buildConsolePluginObjects builds the Deployment and Service that serve the plugin assets and
+// the ConsolePlugin that registers them. Pods get their own app label, like the canary
+// revision, so the MLflow Service and NetworkPolicy do not select them, while object metadata
+// keeps the app label the manager cache selects on.
+func buildConsolePluginObjects(mlflow *mlflowv1.MLflow, namespace string, cfg *config.OperatorConfig) []client.Object {
+	name := consolePluginResourceName(mlflow)
+	tlsSecretName := name + "-tls"
+	labels := map[string]string{"app": ResourceName, "component": "console-plugin"}
+	podLabels := map[string]string{"app": name, "component": "console-plugin"}
+	replicas := int32(1)
+	runAsNonRoot := true
+	allowPrivilegeEscalation := false
+	readOnlyRootFilesystem := true
+
+	deployment := &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": name}},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: podLabels},
+				Spec: corev1.PodSpec{
+					SecurityContext: &corev1.PodSecurityContext{
+						RunAsNonRoot:   &runAsNonRoot,
+						SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+					},
+					Containers: []corev1.Container{{
+						Name:            "console-plugin",
+						Image:           cfg.MirrorImage(cfg.ConsolePluginImage),
+						ImagePullPolicy: corev1.PullIfNotPresent,
+						Ports: []corev1.ContainerPort{{
+							Name: "https", ContainerPort: consolePluginPort, Protocol: corev1.ProtocolTCP,
+						}},
+						ReadinessProbe: &corev1.Probe{
+							ProbeHandler: corev1.ProbeHandler{HTTPGet: &corev1.HTTPGetAction{
+								Path:   "/plugin-manifest.json",
+								Port:   intstr.FromInt32(consolePluginPort),
+								Scheme: corev1.URISchemeHTTPS,
+							}},
+						},
+						Resources: corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{
+								corev1.ResourceCPU:    resource.MustParse("10m"),
+								corev1.ResourceMemory: resource.MustParse("50Mi"),
+							},
+							Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("128Mi")},
+						},
+						SecurityContext: &corev1.SecurityContext{
+							AllowPrivilegeEscalation: &allowPrivilegeEscalation,
+							ReadOnlyRootFilesystem:   &readOnlyRootFilesystem,
+							Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+						},
+						VolumeMounts: []corev1.VolumeMount{{
+							Name: "serving-cert", MountPath: consolePluginCertPath, ReadOnly: true,
+						}},
+					}},
+					Volumes: []corev1.Volume{{
+						Name: "serving-cert",
+						VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{
+							SecretName: tlsSecretName,
+						}},
+					}},
+				},
+			},
+		},
+	}
+
+	service := &corev1.Service{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Service"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    labels,
+			Annotations: map[string]string{
+				"service.beta.openshift.io/serving-cert-secret-name": tlsSecretName,
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{"app": name},
+			Ports: []corev1.ServicePort{{
+				Name: "https", Port: consolePluginPort, TargetPort: intstr.FromInt32(consolePluginPort), Protocol: corev1.ProtocolTCP,
+			}},
+		},
+	}
+
+	// The console proxies plugin API calls to the MLflow Service with the user's token, so
+	// the plugin only shows what the user may read.
+	plugin := &consolev1.ConsolePlugin{
+		TypeMeta:   metav1.TypeMeta{APIVersion: consolev1.GroupVersion.String(), Kind: ConsolePluginCRDName},
+		ObjectMeta: metav1.ObjectMeta{Name: ConsolePluginName, Labels: map[string]string{"app": ResourceName}},
+		Spec: consolev1.ConsolePluginSpec{
+			DisplayName: "MLflow",
+			Backend: consolev1.ConsolePluginBackend{
+				Type: consolev1.Service,
+				Service: &consolev1.ConsolePluginService{
+					Name:      name,
+					Namespace: namespace,
+					Port:      consolePluginPort,
+					BasePath:  "/",
+				},
+			},
+			Proxy: []consolev1.ConsolePluginProxy{{
+				Alias: consolePluginProxyAlias,
+				Endpoint: consolev1.ConsolePluginProxyEndpoint{
+					Type: consolev1.ProxyTypeService,
+					Service: &consolev1.ConsolePluginProxyServiceConfig{
+						Name:      ResourceName + render.ResourceSuffix(mlflow.Name),
+						Namespace: namespace,
+						Port:      mlflowServicePort,
+					},
+				},
+				Authorization: consolev1.UserToken,
+			}},
+			I18n: consolev1.ConsolePluginI18n{LoadType: consolev1.Lazy},
+		},
+	}
+	return []client.Object{deployment, service, plugin}
+}
+
+// reconcileConsolePlugin applies the console plugin objects while spec.consolePlugin is
+// enabled and a plugin image is configured, and deletes them otherwise. Enabling the plugin in
+// the console operator configuration is left to the cluster admin.
+func (r *MLflowReconciler) reconcileConsolePlugin(
+	ctx context.Context,
+	mlflow *mlflowv1.MLflow,
+	namespace string,
+	cfg *config.OperatorConfig,
+) error {
+	log := logf.FromContext(ctx)
+	if !r.ConsolePluginAvailable {
+		return nil
+	}
+
+	objects := buildConsolePluginObjects(mlflow, namespace, cfg)
+	if consolePluginEnabled(mlflow) && cfg.ConsolePluginImage == "" {
+		log.Info("spec.consolePlugin is enabled but no console plugin image is configured, skipping",
+			"env", "RELATED_IMAGE_ODH_MLFLOW_CONSOLE_PLUGIN_IMAGE or CONSOLE_PLUGIN_IMAGE")
+	}
+	if !consolePluginEnabled(mlflow) || cfg.ConsolePluginImage == "" {
+		// The objects are cached, so checking first avoids delete calls on every reconcile.
+		for _, obj := range objects {
+			existing := obj.DeepCopyObject().(client.Object)
+			err := r.Get(ctx, client.ObjectKeyFromObject(obj), existing)
+			if errors.IsNotFound(err) {
+				continue
+			} else if err != nil {
+				return fmt.Errorf("failed to get %s %s: %w", obj.GetObjectKind().GroupVersionKind().Kind, obj.GetName(), err)
+			}
+			if !metav1.IsControlledBy(existing, mlflow) {
+				continue
+			}
+			if err := r.Delete(ctx, existing); err != nil && !errors.IsNotFound(err) {
+				return fmt.Errorf("failed to delete %s %s: %w", obj.GetObjectKind().GroupVersionKind().Kind, obj.GetName(), err)
+			}
+			log.Info("Deleted console plugin object", "kind", obj.GetObjectKind().GroupVersionKind().Kind, "name", obj.GetName())
+		}
+		return nil
+	}
+
+	for _, obj := range objects {
+		propagateMetadata(mlflow, obj)
+		if err := controllerutil.SetControllerReference(mlflow, obj, r.Scheme); err != nil {
+			return fmt.Errorf("failed to set controller reference on %s: %w", obj.GetObjectKind().GroupVersionKind().Kind, err)
+		}
+		if err := r.applyObject(ctx, obj); err != nil {
+			return fmt.Errorf("failed to apply %s %s: %w", obj.GetObjectKind().GroupVersionKind().Kind, obj.GetName(), err)
+		}
+	}
+	log.V(1).Info("Successfully reconciled console plugin", "name", ConsolePluginName)
+	return nil
+}
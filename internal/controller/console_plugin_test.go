@@ -0,0 +1,102 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+	consolev1 "github.com/openshift/api/console/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+)
+
+func TestBuildConsolePluginObjects(t *testing.T) {
+	g := gomega.NewWithT(t)
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: ResourceName}}
+	cfg := &config.OperatorConfig{ConsolePluginImage: "quay.io/example/mlflow-console-plugin:v1"}
+
+	objects := buildConsolePluginObjects(mlflow, "test-ns", cfg)
+	g.Expect(objects).To(gomega.HaveLen(3))
+
+	deployment := objects[0].(*appsv1.Deployment)
+	g.Expect(deployment.Name).To(gomega.Equal("mlflow-console-plugin"))
+	g.Expect(deployment.Labels).To(gomega.HaveKeyWithValue("app", ResourceName))
+	// The plugin pods must not be selected by the MLflow Service.
+	g.Expect(deployment.Spec.Template.Labels).To(gomega.HaveKeyWithValue("app", "mlflow-console-plugin"))
+	g.Expect(deployment.Spec.Template.Spec.Containers[0].Image).To(gomega.Equal(cfg.ConsolePluginImage))
+	g.Expect(deployment.Spec.Template.Spec.Volumes[0].Secret.SecretName).To(gomega.Equal("mlflow-console-plugin-tls"))
+
+	service := objects[1].(*corev1.Service)
+	g.Expect(service.Annotations).To(gomega.HaveKeyWithValue(
+		"service.beta.openshift.io/serving-cert-secret-name", "mlflow-console-plugin-tls"))
+
+	plugin := objects[2].(*consolev1.ConsolePlugin)
+	g.Expect(plugin.Name).To(gomega.Equal(ConsolePluginName))
+	g.Expect(plugin.Spec.Backend.Service.Namespace).To(gomega.Equal("test-ns"))
+	g.Expect(plugin.Spec.Proxy).To(gomega.HaveLen(1))
+	g.Expect(plugin.Spec.Proxy[0].Authorization).To(gomega.Equal(consolev1.UserToken))
+	g.Expect(plugin.Spec.Proxy[0].Endpoint.Service.Name).To(gomega.Equal(ResourceName))
+	g.Expect(plugin.Spec.Proxy[0].Endpoint.Service.Port).To(gomega.Equal(int32(mlflowServicePort)))
+}
+
+func TestReconcileConsolePlugin(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(gomega.Succeed())
+	g.Expect(consolev1.AddToScheme(scheme)).To(gomega.Succeed())
+	g.Expect(mlflowv1.AddToScheme(scheme)).To(gomega.Succeed())
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	r := &MLflowReconciler{Client: k8sClient, Scheme: scheme, ConsolePluginAvailable: true}
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: ResourceName, UID: "uid"},
+		Spec:       mlflowv1.MLflowSpec{ConsolePlugin: &mlflowv1.ConsolePluginConfig{Enabled: true}},
+	}
+	cfg := &config.OperatorConfig{ConsolePluginImage: "quay.io/example/mlflow-console-plugin:v1"}
+	deploymentKey := types.NamespacedName{Name: "mlflow-console-plugin", Namespace: "test-ns"}
+	pluginKey := types.NamespacedName{Name: ConsolePluginName}
+
+	g.Expect(r.reconcileConsolePlugin(ctx, mlflow, "test-ns", cfg)).To(gomega.Succeed())
+	g.Expect(k8sClient.Get(ctx, deploymentKey, &appsv1.Deployment{})).To(gomega.Succeed())
+	g.Expect(k8sClient.Get(ctx, deploymentKey, &corev1.Service{})).To(gomega.Succeed())
+	plugin := &consolev1.ConsolePlugin{}
+	g.Expect(k8sClient.Get(ctx, pluginKey, plugin)).To(gomega.Succeed())
+	g.Expect(plugin.OwnerReferences).To(gomega.HaveLen(1))
+
+	// Without a plugin image there is nothing to serve, so the objects go.
+	g.Expect(r.reconcileConsolePlugin(ctx, mlflow, "test-ns", &config.OperatorConfig{})).To(gomega.Succeed())
+	g.Expect(apierrors.IsNotFound(k8sClient.Get(ctx, pluginKey, &consolev1.ConsolePlugin{}))).To(gomega.BeTrue())
+	g.Expect(apierrors.IsNotFound(k8sClient.Get(ctx, deploymentKey, &appsv1.Deployment{}))).To(gomega.BeTrue())
+
+	g.Expect(r.reconcileConsolePlugin(ctx, mlflow, "test-ns", cfg)).To(gomega.Succeed())
+	mlflow.Spec.ConsolePlugin.Enabled = false
+	g.Expect(r.reconcileConsolePlugin(ctx, mlflow, "test-ns", cfg)).To(gomega.Succeed())
+	g.Expect(apierrors.IsNotFound(k8sClient.Get(ctx, deploymentKey, &corev1.Service{}))).To(gomega.BeTrue())
+	g.Expect(apierrors.IsNotFound(k8sClient.Get(ctx, pluginKey, &consolev1.ConsolePlugin{}))).To(gomega.BeTrue())
+}
@@ -16,9 +16,11 @@ limitations under the License.
 
 package controller
 
+import "github.com/opendatahub-io/mlflow-operator/internal/render"
+
 const (
 	// ResourceName is the base name used for MLflow resources (deployments, services, etc.)
-	ResourceName = "mlflow"
+	ResourceName = render.ResourceName
 	// ClusterRoleName is the name of the shared ClusterRole used by all MLflow instances
 	ClusterRoleName = "mlflow"
 	// ClusterRoleBindingName is the name of the shared ClusterRoleBinding used by all MLflow instances
@@ -26,14 +28,16 @@ const (
 	// GCClusterRBACName is the currently effective singleton GC ClusterRole/ClusterRoleBinding name.
 	GCClusterRBACName = "mlflow-gc"
 	// ServiceAccountName is the name of the service account for MLflow deployments
-	ServiceAccountName = "mlflow-sa"
+	ServiceAccountName = render.ServiceAccountName
 	// GCServiceAccountName is the name of the service account for the GC CronJob
-	GCServiceAccountName = "mlflow-gc-sa"
+	GCServiceAccountName = render.GCServiceAccountName
 	// TLSSecretName is the default name for the TLS secret used by the MLflow server
-	TLSSecretName = "mlflow-tls"
+	TLSSecretName = render.TLSSecretName
 	// StaticPrefix is the URL prefix for MLflow when deployed via the operator
-	StaticPrefix = "/mlflow"
+	StaticPrefix = render.StaticPrefix
+	// defaultGatewayNamespace is the namespace of the Gateway the HttpRoute attaches to by default
+	defaultGatewayNamespace = "openshift-ingress"
 
 	// PlatformTrustedCABundleConfigMapName is the well-known ConfigMap name for platform CA bundle
-	PlatformTrustedCABundleConfigMapName = "odh-trusted-ca-bundle"
+	PlatformTrustedCABundleConfigMapName = render.PlatformTrustedCABundleConfigMapName
 )
@@ -0,0 +1,184 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
+)
+
+// customDomainListenerName is the HTTPS listener of the custom domain Gateway, which the
+// HTTPRoute attaches to by sectionName.
+const customDomainListenerName = "https"
+
+// customDomain returns spec.routing.customDomain, or nil when it is not set.
+func customDomain(mlflow *mlflowv1.MLflow) *mlflowv1.CustomDomainConfig {
+	if mlflow.Spec.Routing == nil {
+		return nil
+	}
+	return mlflow.Spec.Routing.CustomDomain
+}
+
+// customDomainGatewayName names the Gateway serving spec.routing.customDomain.
+func customDomainGatewayName(mlflow *mlflowv1.MLflow) string {
+	return ResourceName + render.ResourceSuffix(mlflow.Name) + "-custom-domain"
+}
+
+// customDomainParentRef returns the parentRef attaching the HTTPRoute to the HTTPS listener of
+// the custom domain Gateway in namespace.
+func customDomainParentRef(mlflow *mlflowv1.MLflow, namespace string) gatewayv1.ParentReference {
+	gatewayNamespace := gatewayv1.Namespace(namespace)
+	sectionName := gatewayv1.SectionName(customDomainListenerName)
+	return gatewayv1.ParentReference{
+		Name:        gatewayv1.ObjectName(customDomainGatewayName(mlflow)),
+		Namespace:   &gatewayNamespace,
+		SectionName: &sectionName,
+	}
+}
+
+// buildCustomDomainGateway constructs the Gateway that terminates TLS for the custom host with
+// the certificate in tlsSecretRef. It lives in the target namespace next to the Secret, so no
+// ReferenceGrant is needed, and only admits routes from that namespace.
+func buildCustomDomainGateway(mlflow *mlflowv1.MLflow, namespace, gatewayClassName string) *gatewayv1.Gateway {
+	domain := customDomain(mlflow)
+	hostname := gatewayv1.Hostname(domain.Host)
+	tlsMode := gatewayv1.TLSModeTerminate
+	fromSame := gatewayv1.NamespacesFromSame
+	return &gatewayv1.Gateway{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "gateway.networking.k8s.io/v1",
+			Kind:       "Gateway",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      customDomainGatewayName(mlflow),
+			Namespace: namespace,
+			Labels:    map[string]string{"app": ResourceName},
+		},
+		Spec: gatewayv1.GatewaySpec{
+			GatewayClassName: gatewayv1.ObjectName(gatewayClassName),
+			Listeners: []gatewayv1.Listener{{
+				Name:     customDomainListenerName,
+				Hostname: &hostname,
+				Port:     443,
+				Protocol: gatewayv1.HTTPSProtocolType,
+				TLS: &gatewayv1.ListenerTLSConfig{
+					Mode: &tlsMode,
+					CertificateRefs: []gatewayv1.SecretObjectReference{{
+						Name: gatewayv1.ObjectName(domain.TLSSecretRef.Name),
+					}},
+				},
+				AllowedRoutes: &gatewayv1.AllowedRoutes{
+					Namespaces: &gatewayv1.RouteNamespaces{From: &fromSame},
+				},
+			}},
+		},
+	}
+}
+
+// customDomainGatewayClass returns the class for the custom domain Gateway: the one set on
+// spec.routing.customDomain, GATEWAY_CLASS_NAME, or the class of the first shared Gateway the
+// HTTPRoute attaches to. It returns "" when none of them is known.
+func (r *MLflowReconciler) customDomainGatewayClass(ctx context.Context, mlflow *mlflowv1.MLflow, cfg *config.OperatorConfig) (string, error) {
+	if domain := customDomain(mlflow); domain.GatewayClassName != nil && *domain.GatewayClassName != "" {
+		return *domain.GatewayClassName, nil
+	}
+	if cfg.GatewayClassName != "" {
+		return cfg.GatewayClassName, nil
+	}
+	for _, parentRef := range buildHTTPRouteParentRefs(mlflow.Spec.Routing, cfg) {
+		gatewayKey := parentGatewayKey(parentRef, "")
+		gateway := &gatewayv1.Gateway{}
+		if err := r.Get(ctx, gatewayKey, gateway); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return "", fmt.Errorf("failed to get Gateway %s: %w", gatewayKey, err)
+		}
+		return string(gateway.Spec.GatewayClassName), nil
+	}
+	return "", nil
+}
+
+// reconcileCustomDomainGateway applies the Gateway for spec.routing.customDomain, or deletes
+// the one the operator created once the custom domain is removed or routing is disabled.
+func (r *MLflowReconciler) reconcileCustomDomainGateway(
+	ctx context.Context,
+	mlflow *mlflowv1.MLflow,
+	namespace string,
+	cfg *config.OperatorConfig,
+) error {
+	log := logf.FromContext(ctx)
+	if !r.HTTPRouteAvailable {
+		if customDomain(mlflow) != nil {
+			log.V(1).Info("Skipping custom domain Gateway - Gateway API is not available in cluster")
+		}
+		return nil
+	}
+
+	name := customDomainGatewayName(mlflow)
+	if customDomain(mlflow) == nil || !routingEnabled(mlflow) {
+		// The Gateway is cached, so checking first avoids a delete call on every reconcile.
+		existing := &gatewayv1.Gateway{}
+		err := r.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace}, existing)
+		if errors.IsNotFound(err) {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("failed to get Gateway %s: %w", name, err)
+		}
+		if !metav1.IsControlledBy(existing, mlflow) {
+			return nil
+		}
+		if err := r.Delete(ctx, existing); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete Gateway %s: %w", name, err)
+		}
+		log.Info("Deleted custom domain Gateway", "name", name)
+		return nil
+	}
+
+	gatewayClassName, err := r.customDomainGatewayClass(ctx, mlflow, cfg)
+	if err != nil {
+		return err
+	}
+	if gatewayClassName == "" {
+		// RouteReady reports the missing Gateway until a class is set.
+		log.Info("No GatewayClass known for the custom domain Gateway, skipping; set spec.routing.customDomain.gatewayClassName",
+			"host", customDomain(mlflow).Host)
+		return nil
+	}
+
+	gateway := buildCustomDomainGateway(mlflow, namespace, gatewayClassName)
+	propagateMetadata(mlflow, gateway)
+	if err := controllerutil.SetControllerReference(mlflow, gateway, r.Scheme); err != nil {
+		return fmt.Errorf("failed to set controller reference on Gateway: %w", err)
+	}
+	if err := r.applyObject(ctx, gateway); err != nil {
+		return fmt.Errorf("failed to apply Gateway %s: %w", name, err)
+	}
+	log.V(1).Info("Successfully reconciled custom domain Gateway", "name", name, "host", customDomain(mlflow).Host)
+	return nil
+}
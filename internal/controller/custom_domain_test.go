@@ -0,0 +1,121 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+)
+
+func customDomainMLflow(hostnames ...string) *mlflowv1.MLflow {
+	return &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: ResourceName, UID: "uid"},
+		Spec: mlflowv1.MLflowSpec{
+			Routing: &mlflowv1.RoutingConfig{
+				Hostnames: hostnames,
+				CustomDomain: &mlflowv1.CustomDomainConfig{
+					Host:         "mlflow.example.com",
+					TLSSecretRef: mlflowv1.SecretReference{Name: "mlflow-example-com-tls"},
+				},
+			},
+		},
+	}
+}
+
+func TestCustomDomainRouting(t *testing.T) {
+	g := gomega.NewWithT(t)
+	cfg := &config.OperatorConfig{GatewayName: "data-science-gateway", MLflowURL: "https://gateway.example.com", MLflowURLConfigured: true}
+	mlflow := customDomainMLflow()
+
+	route := buildHTTPRoute(mlflow, "opendatahub", cfg)
+	g.Expect(route.Spec.ParentRefs).To(gomega.HaveLen(2))
+	g.Expect(route.Spec.ParentRefs[0].Name).To(gomega.Equal(gatewayv1.ObjectName("data-science-gateway")))
+	g.Expect(route.Spec.ParentRefs[1].Name).To(gomega.Equal(gatewayv1.ObjectName("mlflow-custom-domain")))
+	g.Expect(string(*route.Spec.ParentRefs[1].Namespace)).To(gomega.Equal("opendatahub"))
+	g.Expect(string(*route.Spec.ParentRefs[1].SectionName)).To(gomega.Equal(customDomainListenerName))
+	// Without hostnames the route keeps matching every hostname of the shared Gateway.
+	g.Expect(route.Spec.Hostnames).To(gomega.BeEmpty())
+
+	route = buildHTTPRoute(customDomainMLflow("mlflow.apps.example.com"), "opendatahub", cfg)
+	g.Expect(route.Spec.Hostnames).To(gomega.Equal([]gatewayv1.Hostname{"mlflow.apps.example.com", "mlflow.example.com"}))
+	vs := buildVirtualService(customDomainMLflow("mlflow.apps.example.com"), "opendatahub", cfg)
+	hosts, _, _ := unstructured.NestedStringSlice(vs.Object, "spec", "hosts")
+	g.Expect(hosts).To(gomega.Equal([]string{"mlflow.apps.example.com", "mlflow.example.com"}))
+
+	setObservedURLs(mlflow, "opendatahub", true, cfg)
+	g.Expect(mlflow.Status.URL).To(gomega.Equal("https://mlflow.example.com/mlflow"))
+	g.Expect(buildConsoleLink(mlflow, cfg).Spec.Href).To(gomega.Equal("https://mlflow.example.com/mlflow"))
+
+	gateway := buildCustomDomainGateway(mlflow, "opendatahub", "openshift-default")
+	g.Expect(gateway.Name).To(gomega.Equal("mlflow-custom-domain"))
+	g.Expect(gateway.Spec.GatewayClassName).To(gomega.Equal(gatewayv1.ObjectName("openshift-default")))
+	g.Expect(gateway.Spec.Listeners).To(gomega.HaveLen(1))
+	listener := gateway.Spec.Listeners[0]
+	g.Expect(string(*listener.Hostname)).To(gomega.Equal("mlflow.example.com"))
+	g.Expect(listener.Protocol).To(gomega.Equal(gatewayv1.HTTPSProtocolType))
+	g.Expect(*listener.TLS.Mode).To(gomega.Equal(gatewayv1.TLSModeTerminate))
+	g.Expect(listener.TLS.CertificateRefs[0].Name).To(gomega.Equal(gatewayv1.ObjectName("mlflow-example-com-tls")))
+	g.Expect(*listener.AllowedRoutes.Namespaces.From).To(gomega.Equal(gatewayv1.NamespacesFromSame))
+}
+
+func TestReconcileCustomDomainGateway(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(gomega.Succeed())
+	g.Expect(gatewayv1.Install(scheme)).To(gomega.Succeed())
+	g.Expect(mlflowv1.AddToScheme(scheme)).To(gomega.Succeed())
+	shared := &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Name: "data-science-gateway", Namespace: defaultGatewayNamespace},
+		Spec:       gatewayv1.GatewaySpec{GatewayClassName: "data-science-gateway-class"},
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(shared).Build()
+	r := &MLflowReconciler{Client: k8sClient, Scheme: scheme, HTTPRouteAvailable: true}
+	cfg := &config.OperatorConfig{GatewayName: "data-science-gateway"}
+	key := types.NamespacedName{Name: "mlflow-custom-domain", Namespace: "opendatahub"}
+	mlflow := customDomainMLflow()
+
+	// The class defaults to the one of the shared Gateway.
+	g.Expect(r.reconcileCustomDomainGateway(ctx, mlflow, "opendatahub", cfg)).To(gomega.Succeed())
+	gateway := &gatewayv1.Gateway{}
+	g.Expect(k8sClient.Get(ctx, key, gateway)).To(gomega.Succeed())
+	g.Expect(gateway.Spec.GatewayClassName).To(gomega.Equal(gatewayv1.ObjectName("data-science-gateway-class")))
+	g.Expect(metav1.IsControlledBy(gateway, mlflow)).To(gomega.BeTrue())
+
+	class := "custom-class"
+	mlflow.Spec.Routing.CustomDomain.GatewayClassName = &class
+	g.Expect(r.reconcileCustomDomainGateway(ctx, mlflow, "opendatahub", cfg)).To(gomega.Succeed())
+	g.Expect(k8sClient.Get(ctx, key, gateway)).To(gomega.Succeed())
+	g.Expect(gateway.Spec.GatewayClassName).To(gomega.Equal(gatewayv1.ObjectName("custom-class")))
+
+	mlflow.Spec.Routing.CustomDomain = nil
+	g.Expect(r.reconcileCustomDomainGateway(ctx, mlflow, "opendatahub", cfg)).To(gomega.Succeed())
+	g.Expect(apierrors.IsNotFound(k8sClient.Get(ctx, key, &gatewayv1.Gateway{}))).To(gomega.BeTrue())
+}
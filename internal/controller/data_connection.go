@@ -0,0 +1,179 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	crcache "sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// DataConnectionAnnotation on an MLflowConfig names an ODH data connection Secret in the
+	// same namespace to use as the workspace artifact credentials, or DataConnectionDiscover
+	// to pick the namespace's only S3 data connection. Like ClientEnvAnnotation, it is an
+	// annotation because the MLflowConfig schema is owned upstream. A comma-separated list
+	// names several connections in order of precedence; the first usable one is selected.
+	DataConnectionAnnotation = "mlflow.opendatahub.io/data-connection"
+	// DataConnectionDiscover is not a valid Secret name, so it cannot shadow one.
+	DataConnectionDiscover = "*"
+	// DataConnectionReadOnlyAnnotation marks a data connection, e.g. an archive bucket, that
+	// must not back new experiments. Listed read-only connections are skipped.
+	DataConnectionReadOnlyAnnotation = "mlflow.opendatahub.io/read-only"
+
+	// Labels and annotations the ODH dashboard sets on data connection Secrets. Older
+	// dashboards set connection-type, newer ones connection-type-ref.
+	odhManagedLabel                = "opendatahub.io/managed"
+	odhConnectionTypeAnnotation    = "opendatahub.io/connection-type"
+	odhConnectionTypeRefAnnotation = "opendatahub.io/connection-type-ref"
+	odhS3ConnectionType            = "s3"
+)
+
+// isS3DataConnection reports whether secret is an S3 data connection created by the ODH
+// dashboard. Those use the same AWS_* keys as mlflow-artifact-connection, so they can be
+// mirrored without reshaping.
+func isS3DataConnection(secret metav1.Object) bool {
+	if secret.GetLabels()[odhManagedLabel] != "true" {
+		return false
+	}
+	annotations := secret.GetAnnotations()
+	return annotations[odhConnectionTypeAnnotation] == odhS3ConnectionType ||
+		annotations[odhConnectionTypeRefAnnotation] == odhS3ConnectionType
+}
+
+// workspaceDataConnection returns the data connection selected by the DataConnectionAnnotation
+// of mlflowConfig, or nil when the annotation is not set. When the annotation lists several
+// connections, the first one that exists, is an S3 data connection, and is not read-only is
+// selected, so a workspace falls back to the next connection while the preferred one is gone.
+func (r *MLflowReconciler) workspaceDataConnection(ctx context.Context, mlflowConfig *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	value, ok := mlflowConfig.GetAnnotations()[DataConnectionAnnotation]
+	if !ok || strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+	namespace := mlflowConfig.GetNamespace()
+	if strings.TrimSpace(value) == DataConnectionDiscover {
+		return r.discoverDataConnection(ctx, namespace)
+	}
+
+	var names []string
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimSpace(name)
+		if name == DataConnectionDiscover {
+			return nil, fmt.Errorf("%s must be %q alone or a list of Secret names", DataConnectionAnnotation, DataConnectionDiscover)
+		}
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	var skipped []string
+	for i, name := range names {
+		secret := &unstructured.Unstructured{}
+		secret.SetAPIVersion("v1")
+		secret.SetKind("Secret")
+		err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, secret)
+		switch {
+		case errors.IsNotFound(err):
+			skipped = append(skipped, name+" does not exist")
+			continue
+		case err != nil:
+			// Falling back over a transient error could switch the workspace to another bucket.
+			return nil, fmt.Errorf("failed to get data connection %s in namespace %s: %w", name, namespace, err)
+		case !isS3DataConnection(secret):
+			skipped = append(skipped, fmt.Sprintf("%s is not an S3 data connection labeled %s=true", name, odhManagedLabel))
+			continue
+		case secret.GetAnnotations()[DataConnectionReadOnlyAnnotation] == "true":
+			skipped = append(skipped, name+" is read-only")
+			continue
+		}
+		if i > 0 {
+			logf.FromContext(ctx).Info("Using fallback data connection", "namespace", namespace,
+				"dataConnection", name, "skipped", skipped)
+		}
+		return secret, nil
+	}
+	if len(names) == 1 {
+		return nil, fmt.Errorf("data connection %s in namespace %s", skipped[0], namespace)
+	}
+	return nil, fmt.Errorf("no usable data connection in namespace %s: %s", namespace, strings.Join(skipped, "; "))
+}
+
+// discoverDataConnection returns the only S3 data connection in namespace.
+func (r *MLflowReconciler) discoverDataConnection(ctx context.Context, namespace string) (*unstructured.Unstructured, error) {
+	secrets := &unstructured.UnstructuredList{}
+	secrets.SetAPIVersion("v1")
+	secrets.SetKind("SecretList")
+	if err := r.List(ctx, secrets, client.InNamespace(namespace), client.MatchingLabels{odhManagedLabel: "true"}); err != nil {
+		return nil, fmt.Errorf("failed to list data connections in namespace %s: %w", namespace, err)
+	}
+	var found []*unstructured.Unstructured
+	for i := range secrets.Items {
+		if isS3DataConnection(&secrets.Items[i]) {
+			found = append(found, &secrets.Items[i])
+		}
+	}
+	switch len(found) {
+	case 1:
+		return found[0], nil
+	case 0:
+		return nil, fmt.Errorf("no S3 data connection found in namespace %s", namespace)
+	default:
+		return nil, fmt.Errorf("found %d S3 data connections in namespace %s; name one in the %s annotation", len(found), namespace, DataConnectionAnnotation)
+	}
+}
+
+// NewDataConnectionWatchCache returns a cache of the ODH-managed Secrets in workspace
+// namespaces, restricted to watchNamespaces when set.
+func NewDataConnectionWatchCache(cfg *rest.Config, scheme *runtime.Scheme, watchNamespaces []string) (crcache.Cache, error) {
+	byObject := crcache.ByObject{Label: labels.SelectorFromSet(labels.Set{odhManagedLabel: "true"})}
+	if len(watchNamespaces) > 0 {
+		byObject.Namespaces = make(map[string]crcache.Config, len(watchNamespaces))
+		for _, namespace := range watchNamespaces {
+			byObject.Namespaces[namespace] = crcache.Config{}
+		}
+	}
+	return crcache.New(cfg, crcache.Options{
+		Scheme:           scheme,
+		ByObject:         map[client.Object]crcache.ByObject{&corev1.Secret{}: byObject},
+		DefaultTransform: crcache.TransformStripManagedFields(),
+	})
+}
+
+// dataConnectionToMLflowRequests maps a data connection change to the MLflowConfig of its
+// namespace, so edits in the dashboard reach the mirrored artifact Secret right away.
+func (r *MLflowReconciler) dataConnectionToMLflowRequests(ctx context.Context, secret *metav1.PartialObjectMetadata) []reconcile.Request {
+	if !isS3DataConnection(secret) {
+		return nil
+	}
+	mlflowConfig := &unstructured.Unstructured{}
+	mlflowConfig.SetGroupVersionKind(MLflowConfigGVK)
+	mlflowConfig.SetName(ResourceName)
+	mlflowConfig.SetNamespace(secret.GetNamespace())
+	return r.mlflowConfigToMLflowRequests(ctx, mlflowConfig)
+}
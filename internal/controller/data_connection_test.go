@@ -0,0 +1,119 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func newTestDataConnection(namespace, name string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   namespace,
+			Labels:      map[string]string{odhManagedLabel: "true", "opendatahub.io/dashboard": "true"},
+			Annotations: map[string]string{odhConnectionTypeRefAnnotation: "s3"},
+		},
+		Data: map[string][]byte{"AWS_ACCESS_KEY_ID": []byte(name), "AWS_S3_BUCKET": []byte("bucket")},
+	}
+}
+
+func TestReconcileWorkspaceDataConnection(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(gomega.Succeed())
+	g.Expect(mlflowv1.AddToScheme(scheme)).To(gomega.Succeed())
+
+	named := newTestMLflowConfig("team-a", "mlflow")
+	named.SetAnnotations(map[string]string{DataConnectionAnnotation: "aws-connection-team-a"})
+	discovered := newTestMLflowConfig("team-b", "mlflow")
+	discovered.SetAnnotations(map[string]string{DataConnectionAnnotation: DataConnectionDiscover})
+	plain := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "plain", Namespace: "team-a"}}
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "mlflow"}}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		named, discovered, plain, mlflow,
+		newTestDataConnection("team-a", "aws-connection-team-a"),
+		newTestDataConnection("team-b", "aws-connection-team-b"),
+	).Build()
+	r := &MLflowReconciler{Client: k8sClient, Scheme: scheme, MLflowConfigAvailable: true}
+	ctx := context.Background()
+
+	g.Expect(r.reconcileWorkspaceConnections(ctx, mlflow)).To(gomega.Succeed())
+	for namespace, source := range map[string]string{"team-a": "aws-connection-team-a", "team-b": "aws-connection-team-b"} {
+		mirror := &corev1.Secret{}
+		g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: ArtifactConnectionSecretName, Namespace: namespace}, mirror)).To(gomega.Succeed())
+		g.Expect(mirror.Data).To(gomega.HaveKeyWithValue("AWS_ACCESS_KEY_ID", []byte(source)))
+		g.Expect(mirror.Annotations).To(gomega.HaveKeyWithValue(MirroredFromAnnotation, namespace+"/"+source))
+	}
+	g.Expect(r.dataConnectionToMLflowRequests(ctx, secretMetadataOf(newTestDataConnection("team-a", "other")))).To(gomega.HaveLen(1))
+	g.Expect(r.dataConnectionToMLflowRequests(ctx, secretMetadataOf(plain))).To(gomega.BeEmpty())
+
+	// Only ODH data connections may be referenced, and discovery must be unambiguous.
+	named.SetAnnotations(map[string]string{DataConnectionAnnotation: "plain"})
+	_, err := r.workspaceDataConnection(ctx, named)
+	g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("is not an S3 data connection")))
+	g.Expect(k8sClient.Create(ctx, newTestDataConnection("team-b", "second"))).To(gomega.Succeed())
+	_, err = r.workspaceDataConnection(ctx, discovered)
+	g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("found 2 S3 data connections")))
+}
+
+func TestWorkspaceDataConnectionPrecedence(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(gomega.Succeed())
+	archive := newTestDataConnection("team-a", "archive")
+	archive.Annotations[DataConnectionReadOnlyAnnotation] = "true"
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		archive, newTestDataConnection("team-a", "fallback-minio"),
+	).Build()
+	r := &MLflowReconciler{Client: k8sClient, Scheme: scheme}
+	ctx := context.Background()
+
+	// The primary does not exist yet and the archive is read-only, so the fallback is used.
+	mlflowConfig := newTestMLflowConfig("team-a", "mlflow")
+	mlflowConfig.SetAnnotations(map[string]string{DataConnectionAnnotation: "primary-s3, archive, fallback-minio"})
+	selected, err := r.workspaceDataConnection(ctx, mlflowConfig)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(selected.GetName()).To(gomega.Equal("fallback-minio"))
+
+	// Once the primary exists it takes over.
+	g.Expect(k8sClient.Create(ctx, newTestDataConnection("team-a", "primary-s3"))).To(gomega.Succeed())
+	selected, err = r.workspaceDataConnection(ctx, mlflowConfig)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(selected.GetName()).To(gomega.Equal("primary-s3"))
+
+	mlflowConfig.SetAnnotations(map[string]string{DataConnectionAnnotation: "archive,missing"})
+	_, err = r.workspaceDataConnection(ctx, mlflowConfig)
+	g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("archive is read-only; missing does not exist")))
+
+	mlflowConfig.SetAnnotations(map[string]string{DataConnectionAnnotation: "primary-s3,*"})
+	_, err = r.workspaceDataConnection(ctx, mlflowConfig)
+	g.Expect(err).To(gomega.HaveOccurred())
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+const (
+	DataScienceClusterCRDName = "DataScienceCluster"
+	DSCInitializationCRDName  = "DSCInitialization"
+
+	// dscComponentName is the key of the MLflow component under spec.components of the
+	// DataScienceCluster.
+	dscComponentName = "mlflowoperator"
+
+	dscManagementStateManaged = "Managed"
+	dscManagementStateRemoved = "Removed"
+
+	// DataScienceClusterManagedAnnotation marks an MLflow CR created for the DataScienceCluster.
+	// Only CRs carrying it are deleted when the component is set to Removed.
+	DataScienceClusterManagedAnnotation = "mlflow.opendatahub.io/managed-by-datasciencecluster"
+
+	// dataScienceClusterRequestName keys the single work item the controller reconciles:
+	// there is one DataScienceCluster, one DSCInitialization, and one MLflow CR per cluster.
+	dataScienceClusterRequestName = "datasciencecluster"
+)
+
+// DataScienceClusterGVK and DSCInitializationGVK are the ODH platform APIs. They are handled
+// as unstructured to avoid depending on the ODH operator Go module.
+var (
+	DataScienceClusterGVK = schema.GroupVersionKind{
+		Group: "datasciencecluster.opendatahub.io", Version: "v2", Kind: DataScienceClusterCRDName,
+	}
+	DSCInitializationGVK = schema.GroupVersionKind{
+		Group: "dscinitialization.opendatahub.io", Version: "v1", Kind: DSCInitializationCRDName,
+	}
+)
+
+// IsDataScienceClusterAvailable checks if the ODH DataScienceCluster and DSCInitialization CRDs
+// are available in the cluster using discovery API
+func IsDataScienceClusterAvailable(discoveryClient discovery.DiscoveryInterface) (bool, error) {
+	available, err := isKindAvailable(discoveryClient, DataScienceClusterGVK.GroupVersion(), DataScienceClusterCRDName)
+	if err != nil || !available {
+		return false, err
+	}
+	return isKindAvailable(discoveryClient, DSCInitializationGVK.GroupVersion(), DSCInitializationCRDName)
+}
+
+// DataScienceClusterReconciler creates the singleton MLflow CR while the mlflowoperator
+// component of the DataScienceCluster is Managed, and removes the CR it created once the
+// component is Removed. Any other state, or no DataScienceCluster at all, leaves the MLflow
+// CR to its owner.
+type DataScienceClusterReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+	// Namespace is the operator's target namespace. It has to match the applicationsNamespace
+	// of the DSCInitialization for an MLflow CR to be created.
+	Namespace string
+}
+
+// +kubebuilder:rbac:groups=datasciencecluster.opendatahub.io,resources=datascienceclusters,verbs=get;list;watch
+// +kubebuilder:rbac:groups=dscinitialization.opendatahub.io,resources=dscinitializations,verbs=get;list;watch
+
+func (r *DataScienceClusterReconciler) Reconcile(ctx context.Context, _ ctrl.Request) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	dsc, err := r.getSingleton(ctx, DataScienceClusterGVK)
+	if err != nil || dsc == nil {
+		return ctrl.Result{}, err
+	}
+	state, _, _ := unstructured.NestedString(dsc.Object, "spec", "components", dscComponentName, "managementState")
+
+	mlflow := &mlflowv1.MLflow{}
+	err = r.Get(ctx, types.NamespacedName{Name: ResourceName}, mlflow)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return ctrl.Result{}, fmt.Errorf("get MLflow %s: %w", ResourceName, err)
+	}
+	exists := err == nil
+
+	switch state {
+	case dscManagementStateManaged:
+		if exists {
+			return ctrl.Result{}, nil
+		}
+		dsci, err := r.getSingleton(ctx, DSCInitializationGVK)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if dsci != nil {
+			applicationsNamespace, _, _ := unstructured.NestedString(dsci.Object, "spec", "applicationsNamespace")
+			if applicationsNamespace != "" && applicationsNamespace != r.Namespace {
+				// The namespace-scoped RBAC and cache follow the operator namespace, so the
+				// instance cannot be moved to the platform namespace at runtime.
+				log.Error(nil, "Not creating MLflow: the operator namespace differs from the DSCInitialization applicationsNamespace",
+					"namespace", r.Namespace, "applicationsNamespace", applicationsNamespace)
+				return ctrl.Result{}, nil
+			}
+		}
+		if err := r.Create(ctx, defaultDataScienceClusterMLflow()); err != nil && !apierrors.IsAlreadyExists(err) {
+			return ctrl.Result{}, fmt.Errorf("create MLflow %s: %w", ResourceName, err)
+		}
+		log.Info("Created MLflow for the DataScienceCluster", "datasciencecluster", dsc.GetName())
+	case dscManagementStateRemoved:
+		if !exists || mlflow.Annotations[DataScienceClusterManagedAnnotation] != "true" || !mlflow.DeletionTimestamp.IsZero() {
+			return ctrl.Result{}, nil
+		}
+		if err := r.Delete(ctx, mlflow); err != nil && !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, fmt.Errorf("delete MLflow %s: %w", ResourceName, err)
+		}
+		log.Info("Deleted MLflow for the DataScienceCluster", "datasciencecluster", dsc.GetName())
+	}
+	return ctrl.Result{}, nil
+}
+
+// getSingleton returns the first object of the cluster-scoped kind gvk, or nil when there is
+// none. ODH allows only one DataScienceCluster and one DSCInitialization.
+func (r *DataScienceClusterReconciler) getSingleton(ctx context.Context, gvk schema.GroupVersionKind) (*unstructured.Unstructured, error) {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	if err := r.List(ctx, list); err != nil {
+		return nil, fmt.Errorf("list %s: %w", gvk.Kind, err)
+	}
+	if len(list.Items) == 0 {
+		return nil, nil
+	}
+	return &list.Items[0], nil
+}
+
+// defaultDataScienceClusterMLflow returns the MLflow CR created for the DataScienceCluster: a
+// single replica with SQLite metadata and served artifacts on a PVC. Platform defaults from the
+// operator ConfigMap still apply, and the CR is never updated after creation, so admins can
+// edit it freely.
+func defaultDataScienceClusterMLflow() *mlflowv1.MLflow {
+	backendStoreURI := "sqlite:////mlflow/mlflow.db"
+	artifactsDestination := "file:///mlflow/artifacts"
+	serveArtifacts := true
+	return &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        ResourceName,
+			Annotations: map[string]string{DataScienceClusterManagedAnnotation: "true"},
+		},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI:      &backendStoreURI,
+			ArtifactsDestination: &artifactsDestination,
+			ServeArtifacts:       &serveArtifacts,
+			Storage: &mlflowv1.StorageConfig{
+				PersistentVolumeClaimSpec: corev1.PersistentVolumeClaimSpec{
+					AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+					Resources: corev1.VolumeResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Gi")},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *DataScienceClusterReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	enqueue := handler.EnqueueRequestsFromMapFunc(func(context.Context, client.Object) []reconcile.Request {
+		return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: dataScienceClusterRequestName}}}
+	})
+	dsc := &unstructured.Unstructured{}
+	dsc.SetGroupVersionKind(DataScienceClusterGVK)
+	dsci := &unstructured.Unstructured{}
+	dsci.SetGroupVersionKind(DSCInitializationGVK)
+
+	// The MLflow CR is watched too, so a CR deleted while the component is Managed comes back.
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("datasciencecluster").
+		Watches(dsc, enqueue).
+		Watches(dsci, enqueue).
+		Watches(&mlflowv1.MLflow{}, enqueue).
+		Complete(r)
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func newDataScienceCluster(state string) *unstructured.Unstructured {
+	dsc := &unstructured.Unstructured{}
+	dsc.SetGroupVersionKind(DataScienceClusterGVK)
+	dsc.SetName("default-dsc")
+	_ = unstructured.SetNestedField(dsc.Object, state, "spec", "components", dscComponentName, "managementState")
+	return dsc
+}
+
+func newDSCInitialization(applicationsNamespace string) *unstructured.Unstructured {
+	dsci := &unstructured.Unstructured{}
+	dsci.SetGroupVersionKind(DSCInitializationGVK)
+	dsci.SetName("default-dsci")
+	_ = unstructured.SetNestedField(dsci.Object, applicationsNamespace, "spec", "applicationsNamespace")
+	return dsci
+}
+
+func newDataScienceClusterTestReconciler(t *testing.T, objs ...client.Object) *DataScienceClusterReconciler {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := mlflowv1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	return &DataScienceClusterReconciler{
+		Client:    fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build(),
+		Scheme:    scheme,
+		Namespace: "opendatahub",
+	}
+}
+
+func TestDataScienceClusterReconcile(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+	key := types.NamespacedName{Name: ResourceName}
+
+	dsc := newDataScienceCluster(dscManagementStateManaged)
+	r := newDataScienceClusterTestReconciler(t, dsc, newDSCInitialization("opendatahub"))
+
+	_, err := r.Reconcile(ctx, ctrl.Request{})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	mlflow := &mlflowv1.MLflow{}
+	g.Expect(r.Get(ctx, key, mlflow)).To(gomega.Succeed())
+	g.Expect(mlflow.Annotations).To(gomega.HaveKeyWithValue(DataScienceClusterManagedAnnotation, "true"))
+	g.Expect(*mlflow.Spec.BackendStoreURI).To(gomega.Equal("sqlite:////mlflow/mlflow.db"))
+
+	// Unmanaged hands the CR over to the admin.
+	g.Expect(unstructured.SetNestedField(dsc.Object, "Unmanaged", "spec", "components", dscComponentName, "managementState")).To(gomega.Succeed())
+	g.Expect(r.Update(ctx, dsc)).To(gomega.Succeed())
+	_, err = r.Reconcile(ctx, ctrl.Request{})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(r.Get(ctx, key, &mlflowv1.MLflow{})).To(gomega.Succeed())
+
+	g.Expect(unstructured.SetNestedField(dsc.Object, dscManagementStateRemoved, "spec", "components", dscComponentName, "managementState")).To(gomega.Succeed())
+	g.Expect(r.Update(ctx, dsc)).To(gomega.Succeed())
+	_, err = r.Reconcile(ctx, ctrl.Request{})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(apierrors.IsNotFound(r.Get(ctx, key, &mlflowv1.MLflow{}))).To(gomega.BeTrue())
+}
+
+func TestDataScienceClusterReconcile_KeepsUserCreatedMLflow(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+	userMLflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: ResourceName}}
+	r := newDataScienceClusterTestReconciler(t, newDataScienceCluster(dscManagementStateRemoved), userMLflow)
+
+	_, err := r.Reconcile(ctx, ctrl.Request{})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(r.Get(ctx, types.NamespacedName{Name: ResourceName}, &mlflowv1.MLflow{})).To(gomega.Succeed())
+}
+
+func TestDataScienceClusterReconcile_NamespaceMismatch(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+	r := newDataScienceClusterTestReconciler(t, newDataScienceCluster(dscManagementStateManaged), newDSCInitialization("redhat-ods-applications"))
+
+	// The instance would land outside the namespace the DSCInitialization declares.
+	_, err := r.Reconcile(ctx, ctrl.Request{})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(apierrors.IsNotFound(r.Get(ctx, types.NamespacedName{Name: ResourceName}, &mlflowv1.MLflow{}))).To(gomega.BeTrue())
+}
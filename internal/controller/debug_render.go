@@ -0,0 +1,236 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+// DebugRenderPath is where the metrics server serves RenderRecorder.
+const DebugRenderPath = "/debug/render"
+
+const redactedValue = "<redacted>"
+
+// sensitiveEnvName matches environment variable names whose literal values are redacted.
+var sensitiveEnvName = regexp.MustCompile(`(?i)(password|passwd|secret|token|credential|api_?key|access_?key)`)
+
+// RenderRecorder keeps the latest render of each MLflow instance and serves it, redacted, for
+// support. Renders served from the render cache are not recorded again, since their output
+// is the recorded one.
+type RenderRecorder struct {
+	// Output, when set, also receives every recorded render as the YAML stream ServeHTTP
+	// serves, followed by a document separator. --dev points it at stdout.
+	Output io.Writer
+
+	mu      sync.Mutex
+	records map[string]renderRecord
+}
+
+type renderRecord struct {
+	at           time.Time
+	chartVersion string
+	values       map[string]interface{}
+	objects      []*unstructured.Unstructured
+	err          string
+}
+
+// record stores a render of the named instance. values may be nil when the spec could not be
+// converted, and objects is nil when the render failed with err.
+func (r *RenderRecorder) record(name, chartVersion string, values map[string]interface{}, objects []*unstructured.Unstructured, err error) {
+	if r == nil {
+		return
+	}
+	record := renderRecord{
+		at:           time.Now(),
+		chartVersion: chartVersion,
+		values:       values,
+		objects:      copyObjects(objects),
+	}
+	if err != nil {
+		record.err = err.Error()
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.records == nil {
+		r.records = map[string]renderRecord{}
+	}
+	r.records[name] = record
+	if r.Output != nil {
+		// Holding the lock keeps concurrent renders from interleaving their streams.
+		if stream, err := record.yamlStream(name); err == nil {
+			_, _ = r.Output.Write(append(stream, "---\n"...))
+		}
+	}
+}
+
+func (r *RenderRecorder) forget(name string) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.records, name)
+}
+
+func (r *RenderRecorder) get(name string) (renderRecord, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	record, ok := r.records[name]
+	return record, ok
+}
+
+// ServeHTTP writes the latest render of ?name= (default mlflow) as a YAML stream: a document
+// with the render time, chart version, any error, and the Helm values, followed by one
+// document per rendered object. Secret data, credentials in URLs, and literal values of
+// sensitive environment variables are redacted.
+func (r *RenderRecorder) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	name := req.URL.Query().Get("name")
+	if name == "" {
+		name = ResourceName
+	}
+	record, ok := r.get(name)
+	if !ok {
+		http.Error(w, fmt.Sprintf("no render recorded for MLflow %q", name), http.StatusNotFound)
+		return
+	}
+
+	stream, err := record.yamlStream(name)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode render: %v", err), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/yaml")
+	_, _ = w.Write(stream)
+}
+
+// yamlStream encodes record as the redacted YAML stream ServeHTTP serves, with objects sorted
+// by kind and name.
+func (record renderRecord) yamlStream(name string) ([]byte, error) {
+	header := map[string]interface{}{
+		"name":         name,
+		"renderedAt":   record.at.UTC().Format(time.RFC3339),
+		"chartVersion": record.chartVersion,
+		"values":       redactDebugValue(jsonRoundTrip(record.values)),
+	}
+	if record.err != "" {
+		header["error"] = record.err
+	}
+	documents := []interface{}{header}
+	objects := copyObjects(record.objects)
+	slices.SortStableFunc(objects, func(a, b *unstructured.Unstructured) int {
+		return strings.Compare(a.GetKind()+"/"+a.GetName(), b.GetKind()+"/"+b.GetName())
+	})
+	for _, obj := range objects {
+		if obj.GetKind() == "Secret" {
+			redactSecretData(obj.Object)
+		}
+		documents = append(documents, redactDebugValue(obj.Object))
+	}
+
+	var out bytes.Buffer
+	for i, document := range documents {
+		data, err := yaml.Marshal(document)
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 {
+			out.WriteString("---\n")
+		}
+		out.Write(data)
+	}
+	return out.Bytes(), nil
+}
+
+// jsonRoundTrip converts typed values, such as []string or map[string]string, into the generic
+// form redactDebugValue walks.
+func jsonRoundTrip(values map[string]interface{}) interface{} {
+	data, err := json.Marshal(values)
+	if err != nil {
+		return nil
+	}
+	var generic interface{}
+	if err := json.Unmarshal(data, &generic); err != nil {
+		return nil
+	}
+	return generic
+}
+
+// redactSecretData replaces every value of a Secret object's data and stringData, keeping the
+// keys.
+func redactSecretData(obj map[string]interface{}) {
+	for _, field := range []string{"data", "stringData"} {
+		data, ok := obj[field].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for key := range data {
+			data[key] = redactedValue
+		}
+	}
+}
+
+// redactDebugValue returns a copy of v with passwords in URLs and the value of sensitive
+// {name, value} environment entries redacted.
+func redactDebugValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			out[key] = redactDebugValue(value)
+		}
+		if name, ok := v["name"].(string); ok && sensitiveEnvName.MatchString(name) {
+			if _, ok := v["value"].(string); ok {
+				out["value"] = redactedValue
+			}
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, value := range v {
+			out[i] = redactDebugValue(value)
+		}
+		return out
+	case string:
+		if !strings.Contains(v, "@") {
+			return v
+		}
+		if u, err := url.Parse(v); err == nil && u.User != nil {
+			if _, hasPassword := u.User.Password(); hasPassword {
+				return u.Redacted()
+			}
+		}
+		return v
+	}
+	return v
+}
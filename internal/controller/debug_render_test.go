@@ -0,0 +1,143 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
+)
+
+func TestRedactDebugValue(t *testing.T) {
+	g := gomega.NewWithT(t)
+	values := map[string]interface{}{
+		"backendStore": map[string]interface{}{
+			"uri": "postgresql://mlflow:hunter2@db:5432/mlflow",
+		},
+		"artifacts": "s3://bucket/mlflow",
+		"env": []interface{}{
+			map[string]interface{}{"name": "AWS_SECRET_ACCESS_KEY", "value": "abc"},
+			map[string]interface{}{"name": "DB_PASSWORD", "valueFrom": map[string]interface{}{"secretKeyRef": map[string]interface{}{"name": "db"}}},
+			map[string]interface{}{"name": "MLFLOW_PATH_PREFIX", "value": "/mlflow"},
+		},
+	}
+
+	redacted := redactDebugValue(jsonRoundTrip(values)).(map[string]interface{})
+	g.Expect(redacted["backendStore"]).To(gomega.HaveKeyWithValue("uri", "postgresql://mlflow:xxxxx@db:5432/mlflow"))
+	g.Expect(redacted["artifacts"]).To(gomega.Equal("s3://bucket/mlflow"))
+	env := redacted["env"].([]interface{})
+	g.Expect(env[0]).To(gomega.HaveKeyWithValue("value", redactedValue))
+	// References to Secrets carry no secret material and are kept.
+	g.Expect(env[1]).To(gomega.HaveKey("valueFrom"))
+	g.Expect(env[2]).To(gomega.HaveKeyWithValue("value", "/mlflow"))
+
+	// The input is not modified.
+	g.Expect(values["backendStore"]).To(gomega.HaveKeyWithValue("uri", "postgresql://mlflow:hunter2@db:5432/mlflow"))
+}
+
+func TestRenderRecorder(t *testing.T) {
+	g := gomega.NewWithT(t)
+	recorder := &RenderRecorder{}
+	secret := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata":   map[string]interface{}{"name": "mlflow-db"},
+		"data":       map[string]interface{}{"password": "aHVudGVyMg=="},
+	}}
+	service := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Service",
+		"metadata":   map[string]interface{}{"name": "mlflow"},
+	}}
+	recorder.record(ResourceName, "1.2.3", map[string]interface{}{"replicas": 2}, []*unstructured.Unstructured{service, secret}, nil)
+
+	serve := func(method, target string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		recorder.ServeHTTP(rec, httptest.NewRequest(method, target, nil))
+		return rec
+	}
+
+	rec := serve(http.MethodGet, DebugRenderPath)
+	g.Expect(rec.Code).To(gomega.Equal(http.StatusOK))
+	body := rec.Body.String()
+	g.Expect(body).To(gomega.ContainSubstring("chartVersion: 1.2.3"))
+	g.Expect(body).To(gomega.ContainSubstring("replicas: 2"))
+	g.Expect(body).To(gomega.ContainSubstring("password: <redacted>"))
+	g.Expect(body).NotTo(gomega.ContainSubstring("aHVudGVyMg=="))
+	// Objects are sorted by kind, so the Secret comes before the Service.
+	g.Expect(body).To(gomega.MatchRegexp(`(?s)kind: Secret.*---\n.*kind: Service`))
+	// The recorded Secret itself is left intact.
+	g.Expect(secret.Object["data"]).To(gomega.HaveKeyWithValue("password", "aHVudGVyMg=="))
+
+	g.Expect(serve(http.MethodGet, DebugRenderPath+"?name=other").Code).To(gomega.Equal(http.StatusNotFound))
+	g.Expect(serve(http.MethodPost, DebugRenderPath).Code).To(gomega.Equal(http.StatusMethodNotAllowed))
+
+	// A failed render replaces the previous one and reports its error.
+	recorder.record(ResourceName, "1.2.3", nil, nil, errors.New("failed to render templates: boom"))
+	body = serve(http.MethodGet, DebugRenderPath).Body.String()
+	g.Expect(body).To(gomega.ContainSubstring("error: 'failed to render templates: boom'"))
+	g.Expect(body).NotTo(gomega.ContainSubstring("kind: Service"))
+
+	recorder.forget(ResourceName)
+	g.Expect(serve(http.MethodGet, DebugRenderPath).Code).To(gomega.Equal(http.StatusNotFound))
+}
+
+func TestRenderRecorderOutput(t *testing.T) {
+	g := gomega.NewWithT(t)
+	var out strings.Builder
+	recorder := &RenderRecorder{Output: &out}
+	secret := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata":   map[string]interface{}{"name": "mlflow-db"},
+		"data":       map[string]interface{}{"password": "aHVudGVyMg=="},
+	}}
+
+	recorder.record(ResourceName, "1.2.3", nil, []*unstructured.Unstructured{secret}, nil)
+	recorder.record(ResourceName, "1.2.4", nil, []*unstructured.Unstructured{secret}, nil)
+	g.Expect(out.String()).To(gomega.MatchRegexp(`(?s)chartVersion: 1\.2\.3.*kind: Secret.*---\n.*chartVersion: 1\.2\.4.*---\n$`))
+	g.Expect(out.String()).To(gomega.ContainSubstring("password: <redacted>"))
+	g.Expect(out.String()).NotTo(gomega.ContainSubstring("aHVudGVyMg=="))
+}
+
+func TestRenderChart_RecordsRender(t *testing.T) {
+	g := gomega.NewWithT(t)
+	r := &MLflowReconciler{RenderRecorder: &RenderRecorder{}}
+	renderer := render.NewHelmRenderer("../../charts/mlflow")
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec:       mlflowv1.MLflowSpec{BackendStoreURI: ptr(testBackendStoreURI)},
+	}
+
+	_, _, err := r.renderChart(renderer, mlflow, "test-ns", render.RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	record, ok := r.RenderRecorder.get("mlflow")
+	g.Expect(ok).To(gomega.BeTrue())
+	g.Expect(record.err).To(gomega.BeEmpty())
+	g.Expect(record.values).NotTo(gomega.BeEmpty())
+	g.Expect(findObject(record.objects, deploymentKind, "mlflow")).NotTo(gomega.BeNil())
+	g.Expect(record.chartVersion).To(gomega.Equal(renderer.ChartVersion()))
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+// dryRunError collects the objects the API server rejected in a dry-run apply.
+type dryRunError struct {
+	problems []string
+}
+
+func (e *dryRunError) Error() string {
+	return fmt.Sprintf("dry run rejected %d object(s): %s", len(e.problems), strings.Join(e.problems, "; "))
+}
+
+// dryRunRenderedObjects sets owner references on every rendered object and submits each one as
+// a dry-run server-side apply, so admission webhooks, quotas, and schema validation see the
+// whole set before anything is changed. Objects unchanged since their last apply are skipped,
+// which keeps a steady-state reconcile free of extra requests. Every rejection is collected
+// into one *dryRunError; failing to set an owner reference aborts immediately instead. The live
+// objects read along the way are recorded in reads so the apply pass does not read them again.
+func (r *MLflowReconciler) dryRunRenderedObjects(ctx context.Context, mlflow *mlflowv1.MLflow, objects []*unstructured.Unstructured, reads *liveReads) error {
+	var (
+		mu       sync.Mutex
+		problems []string
+	)
+	renderedRoles := map[string]bool{}
+	for _, obj := range objects {
+		if obj.GetKind() == "Role" || obj.GetKind() == "ClusterRole" {
+			renderedRoles[obj.GetKind()+"/"+obj.GetName()] = true
+		}
+	}
+
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(maxConcurrentApplies)
+	for _, obj := range objects {
+		group.Go(func() error {
+			if err := r.setRenderedObjectOwner(groupCtx, mlflow, obj); err != nil {
+				return err
+			}
+			// The API server resolves a binding's role to check for privilege escalation, which
+			// fails while that role is still waiting for its own wave. The real apply covers it.
+			if bindsRenderedRole(obj, renderedRoles) {
+				return nil
+			}
+			if err := r.dryRunObject(groupCtx, obj, reads); err != nil {
+				mu.Lock()
+				problems = append(problems, fmt.Sprintf("%s/%s: %v", obj.GetKind(), obj.GetName(), err))
+				mu.Unlock()
+			}
+			return nil
+		})
+	}
+	if err := group.Wait(); err != nil {
+		return err
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	// Goroutines finish in any order; sort so the condition message is stable.
+	slices.Sort(problems)
+	return &dryRunError{problems: problems}
+}
+
+// bindsRenderedRole reports whether obj is a RoleBinding or ClusterRoleBinding whose roleRef is
+// one of renderedRoles, keyed by "Kind/name".
+func bindsRenderedRole(obj *unstructured.Unstructured, renderedRoles map[string]bool) bool {
+	if obj.GetKind() != "RoleBinding" && obj.GetKind() != "ClusterRoleBinding" {
+		return false
+	}
+	kind, _, _ := unstructured.NestedString(obj.Object, "roleRef", "kind")
+	name, _, _ := unstructured.NestedString(obj.Object, "roleRef", "name")
+	return renderedRoles[kind+"/"+name]
+}
+
+// dryRunObject submits obj as a dry-run server-side apply unless applyObject would skip it.
+// The live object it reads is recorded in reads for the apply pass.
+func (r *MLflowReconciler) dryRunObject(ctx context.Context, obj *unstructured.Unstructured, reads *liveReads) error {
+	live, err := r.readLive(ctx, obj, nil)
+	reads.put(obj, live, err)
+
+	// applyObject never patches an existing PVC, since its spec is immutable.
+	if obj.GetKind() == "PersistentVolumeClaim" {
+		if err == nil {
+			return nil
+		} else if !errors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	candidate := obj.DeepCopy()
+	hash, err := stampManifestHash(candidate)
+	if err != nil {
+		return err
+	}
+	if live != nil && r.liveObjectInSync(candidate, live, hash) {
+		return nil
+	}
+	start := time.Now()
+	err = r.Patch(ctx, candidate, client.Apply, client.ForceOwnership, client.FieldOwner("mlflow-operator"), client.DryRunAll) //nolint:staticcheck // matches applyObject
+	observeApply(obj.GetKind(), true, time.Since(start).Seconds(), err)
+	return err
+}
+
+// isDryRunError reports whether err is a rejection collected by dryRunRenderedObjects.
+func isDryRunError(err error) bool {
+	_, ok := err.(*dryRunError)
+	return ok
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+const (
+	// externallyReachableConditionType reports the result of the status.url check.
+	externallyReachableConditionType = "ExternallyReachable"
+
+	defaultExternalReachabilityInterval = 5 * time.Minute
+)
+
+// reachabilityProber checks the published URL the way an external client reaches it: through
+// DNS, the Gateway, and the Gateway's certificate. Results are rate limited per instance, since every
+// reconcile of a ready instance reaches the check.
+type reachabilityProber struct {
+	// client performs check requests; nil uses one that trusts only the system roots, the same
+	// trust a browser outside the cluster has.
+	client *http.Client
+
+	clientOnce    sync.Once
+	defaultClient *http.Client
+
+	mu         sync.Mutex
+	lastChecks map[string]reachabilityCheck
+}
+
+// reachabilityCheck records when a URL was last checked for an instance.
+type reachabilityCheck struct {
+	url string
+	at  time.Time
+}
+
+func externalReachabilityCheckEnabled(mlflow *mlflowv1.MLflow) bool {
+	return mlflow.Spec.ExternalReachabilityCheck != nil && mlflow.Spec.ExternalReachabilityCheck.Enabled
+}
+
+func externalReachabilityInterval(mlflow *mlflowv1.MLflow) time.Duration {
+	if check := mlflow.Spec.ExternalReachabilityCheck; check != nil && check.Interval != nil && check.Interval.Duration > 0 {
+		return check.Interval.Duration
+	}
+	return defaultExternalReachabilityInterval
+}
+
+// checkExternalReachability updates the ExternallyReachable condition of a ready instance and
+// returns when the next check is due, or zero when none is scheduled. A check runs when the
+// interval has passed or status.url has changed since the last one.
+func (r *MLflowReconciler) checkExternalReachability(ctx context.Context, mlflow *mlflowv1.MLflow, now time.Time) time.Duration {
+	prober := &r.reachabilityProber
+	if !externalReachabilityCheckEnabled(mlflow) {
+		meta.RemoveStatusCondition(&mlflow.Status.Conditions, externallyReachableConditionType)
+		prober.forget(mlflow.Name)
+		return 0
+	}
+	if mlflow.Status.URL == "" {
+		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+			Type:               externallyReachableConditionType,
+			Status:             metav1.ConditionUnknown,
+			Reason:             "URLNotPublished",
+			Message:            "status.url is not published; routing is disabled or the base URL is not configured",
+			ObservedGeneration: mlflow.Generation,
+		})
+		prober.forget(mlflow.Name)
+		return 0
+	}
+
+	interval := externalReachabilityInterval(mlflow)
+	if last, ok := prober.last(mlflow.Name); ok && last.url == mlflow.Status.URL &&
+		meta.FindStatusCondition(mlflow.Status.Conditions, externallyReachableConditionType) != nil {
+		if remaining := last.at.Add(interval).Sub(now); remaining > 0 {
+			return remaining
+		}
+	}
+
+	condition := metav1.Condition{
+		Type:               externallyReachableConditionType,
+		Status:             metav1.ConditionTrue,
+		Reason:             "Reachable",
+		Message:            fmt.Sprintf("%s answered the health check", mlflow.Status.URL),
+		ObservedGeneration: mlflow.Generation,
+	}
+	if err := prober.Probe(ctx, mlflow.Status.URL); err != nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "Unreachable"
+		condition.Message = err.Error()
+	}
+	meta.SetStatusCondition(&mlflow.Status.Conditions, condition)
+	prober.record(mlflow.Name, reachabilityCheck{url: mlflow.Status.URL, at: now})
+	return interval
+}
+
+// Probe requests <baseURL>/health. An authentication challenge from a proxy in front of MLflow
+// still counts as reachable: DNS, TLS, and the Gateway all worked. A 404 does not, because it
+// usually means the Gateway has no route for the path.
+func (p *reachabilityProber) Probe(ctx context.Context, baseURL string) error {
+	requestURL := strings.TrimRight(baseURL, "/") + "/health"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := p.httpClient().Do(req)
+	if err != nil {
+		return fmt.Errorf("GET %s: %w", requestURL, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch {
+	case resp.StatusCode < 400:
+		return nil
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return nil
+	}
+	return fmt.Errorf("GET %s returned %s", requestURL, resp.Status)
+}
+
+func (p *reachabilityProber) httpClient() *http.Client {
+	if p.client != nil {
+		return p.client
+	}
+	p.clientOnce.Do(func() {
+		p.defaultClient = &http.Client{Timeout: healthProbeTimeout}
+	})
+	return p.defaultClient
+}
+
+func (p *reachabilityProber) last(name string) (reachabilityCheck, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	check, ok := p.lastChecks[name]
+	return check, ok
+}
+
+func (p *reachabilityProber) record(name string, check reachabilityCheck) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.lastChecks == nil {
+		p.lastChecks = map[string]reachabilityCheck{}
+	}
+	p.lastChecks[name] = check
+}
+
+func (p *reachabilityProber) forget(name string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.lastChecks, name)
+}
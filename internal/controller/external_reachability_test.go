@@ -0,0 +1,94 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func TestCheckExternalReachability(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	status := http.StatusOK
+	requests := 0
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requests++
+		g.Expect(req.URL.Path).To(gomega.Equal("/mlflow/health"))
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	r := &MLflowReconciler{reachabilityProber: reachabilityProber{client: server.Client()}}
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{ExternalReachabilityCheck: &mlflowv1.ExternalReachabilityCheckConfig{
+			Enabled:  true,
+			Interval: &metav1.Duration{Duration: time.Minute},
+		}},
+		Status: mlflowv1.MLflowStatus{URL: server.URL + "/mlflow"},
+	}
+	ctx := context.Background()
+	now := time.Now()
+
+	g.Expect(r.checkExternalReachability(ctx, mlflow, now)).To(gomega.Equal(time.Minute))
+	g.Expect(meta.IsStatusConditionTrue(mlflow.Status.Conditions, externallyReachableConditionType)).To(gomega.BeTrue())
+
+	// Within the interval the previous result stands.
+	status = http.StatusNotFound
+	g.Expect(r.checkExternalReachability(ctx, mlflow, now.Add(20*time.Second))).To(gomega.Equal(40 * time.Second))
+	g.Expect(requests).To(gomega.Equal(1))
+
+	g.Expect(r.checkExternalReachability(ctx, mlflow, now.Add(time.Minute))).To(gomega.Equal(time.Minute))
+	condition := meta.FindStatusCondition(mlflow.Status.Conditions, externallyReachableConditionType)
+	g.Expect(condition.Status).To(gomega.Equal(metav1.ConditionFalse))
+	g.Expect(condition.Reason).To(gomega.Equal("Unreachable"))
+	g.Expect(condition.Message).To(gomega.ContainSubstring("404"))
+
+	// An authentication challenge from a proxy in front of MLflow proves the route works.
+	status = http.StatusUnauthorized
+	g.Expect(r.checkExternalReachability(ctx, mlflow, now.Add(2*time.Minute))).To(gomega.Equal(time.Minute))
+	g.Expect(meta.IsStatusConditionTrue(mlflow.Status.Conditions, externallyReachableConditionType)).To(gomega.BeTrue())
+
+	mlflow.Status.URL = ""
+	g.Expect(r.checkExternalReachability(ctx, mlflow, now.Add(3*time.Minute))).To(gomega.BeZero())
+	g.Expect(meta.FindStatusCondition(mlflow.Status.Conditions, externallyReachableConditionType).Reason).To(gomega.Equal("URLNotPublished"))
+
+	mlflow.Spec.ExternalReachabilityCheck.Enabled = false
+	g.Expect(r.checkExternalReachability(ctx, mlflow, now.Add(4*time.Minute))).To(gomega.BeZero())
+	g.Expect(meta.FindStatusCondition(mlflow.Status.Conditions, externallyReachableConditionType)).To(gomega.BeNil())
+}
+
+func TestReachabilityProberRejectsUntrustedCertificate(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {}))
+	defer server.Close()
+
+	// The default client trusts only the system roots, so the test server's certificate fails.
+	err := (&reachabilityProber{}).Probe(context.Background(), server.URL)
+	g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("certificate")))
+}
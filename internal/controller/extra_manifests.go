@@ -0,0 +1,65 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
+)
+
+// pruneExtraManifests deletes objects that were rendered from spec.extraManifests by the
+// previous apply but no longer are. Objects the chart stops rendering are left to their own
+// cleanup.
+func (r *MLflowReconciler) pruneExtraManifests(ctx context.Context, mlflow *mlflowv1.MLflow, previous []mlflowv1.ManagedResource) error {
+	current := make(map[mlflowv1.ManagedResource]bool, len(mlflow.Status.Inventory))
+	for _, resource := range mlflow.Status.Inventory {
+		resource.UID = ""
+		current[resource] = true
+	}
+	for _, resource := range previous {
+		resource.UID = ""
+		if current[resource] {
+			continue
+		}
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion(resource.APIVersion)
+		obj.SetKind(resource.Kind)
+		if err := r.Get(ctx, types.NamespacedName{Name: resource.Name, Namespace: resource.Namespace}, obj); err != nil {
+			if errors.IsNotFound(err) || meta.IsNoMatchError(err) {
+				continue
+			}
+			return fmt.Errorf("get %s/%s: %w", resource.Kind, resource.Name, err)
+		}
+		if obj.GetLabels()[render.ExtraManifestLabel] == "" || !metav1.IsControlledBy(obj, mlflow) {
+			continue
+		}
+		if err := r.Delete(ctx, obj, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("delete %s/%s: %w", resource.Kind, resource.Name, err)
+		}
+	}
+	return nil
+}
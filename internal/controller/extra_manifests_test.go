@@ -0,0 +1,67 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
+)
+
+func TestPruneExtraManifests(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: ResourceName, UID: "uid"}}
+	owner := []metav1.OwnerReference{{
+		APIVersion: mlflowv1.GroupVersion.String(), Kind: "MLflow", Name: ResourceName, UID: "uid", Controller: ptr(true),
+	}}
+	removed := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+		Name: "removed", Namespace: canaryTestNamespace, OwnerReferences: owner,
+		Labels: map[string]string{render.ExtraManifestLabel: "true"},
+	}}
+	kept := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+		Name: "kept", Namespace: canaryTestNamespace, OwnerReferences: owner,
+		Labels: map[string]string{render.ExtraManifestLabel: "true"},
+	}}
+	chartObject := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+		Name: "chart", Namespace: canaryTestNamespace, OwnerReferences: owner,
+	}}
+	r := newTestReconciler(t, mlflow.DeepCopy(), removed, kept, chartObject)
+	inventoryEntry := func(name string) mlflowv1.ManagedResource {
+		return mlflowv1.ManagedResource{APIVersion: "v1", Kind: "ConfigMap", Namespace: canaryTestNamespace, Name: name}
+	}
+	previous := []mlflowv1.ManagedResource{inventoryEntry("removed"), inventoryEntry("kept"), inventoryEntry("chart")}
+	mlflow.Status.Inventory = []mlflowv1.ManagedResource{inventoryEntry("kept")}
+
+	g.Expect(r.pruneExtraManifests(ctx, mlflow, previous)).To(gomega.Succeed())
+	getConfigMap := func(name string) error {
+		return r.Get(ctx, types.NamespacedName{Name: name, Namespace: canaryTestNamespace}, &corev1.ConfigMap{})
+	}
+	g.Expect(apierrors.IsNotFound(getConfigMap("removed"))).To(gomega.BeTrue())
+	g.Expect(getConfigMap("kept")).To(gomega.Succeed())
+	// Objects the chart stops rendering have their own cleanup.
+	g.Expect(getConfigMap("chart")).To(gomega.Succeed())
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+const (
+	// failureBackoffBase is the requeue delay after the first render or apply failure.
+	failureBackoffBase = 5 * time.Second
+	// failureBackoffMax caps the requeue delay of repeated failures.
+	failureBackoffMax = 5 * time.Minute
+)
+
+// reconcileFailureReasons are the Degraded reasons owned by recordReconcileFailure. Other
+// Degraded reasons, such as CanaryRolledBack, are left alone on success.
+var reconcileFailureReasons = map[string]bool{"RenderFailed": true, "InvalidChartValues": true, "ApplyFailed": true, "DryRunFailed": true, "FleetApplyFailed": true, "AdoptionFailed": true}
+
+// failureBackoff returns the requeue delay after the given number of consecutive failures:
+// failureBackoffBase doubled per failure, capped at failureBackoffMax.
+func failureBackoff(failures int32) time.Duration {
+	backoff := failureBackoffBase
+	for i := int32(1); i < failures && backoff < failureBackoffMax; i++ {
+		backoff *= 2
+	}
+	return min(backoff, failureBackoffMax)
+}
+
+// errorClass names the kind of failure for the Degraded message: the API status reason for
+// API server errors, otherwise "Internal" for chart and conversion errors.
+func errorClass(err error) string {
+	if reason := errors.ReasonForError(err); reason != metav1.StatusReasonUnknown {
+		return string(reason)
+	}
+	return "Internal"
+}
+
+// recordReconcileFailure counts a render or apply failure, sets Degraded, and returns how long
+// to wait before retrying. The reconciler returns the delay instead of the error, so the failing
+// chart or API server is retried on this schedule rather than the workqueue's faster one.
+func recordReconcileFailure(mlflow *mlflowv1.MLflow, reason string, err error) time.Duration {
+	mlflow.Status.ConsecutiveFailures++
+	failures := mlflow.Status.ConsecutiveFailures
+	backoff := failureBackoff(failures)
+	meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+		Type:   "Degraded",
+		Status: metav1.ConditionTrue,
+		Reason: reason,
+		Message: fmt.Sprintf("%d consecutive failures (%s), retrying in %s: %v",
+			failures, errorClass(err), backoff, err),
+	})
+	return backoff
+}
+
+// clearReconcileFailures resets the failure count after a successful apply.
+func clearReconcileFailures(mlflow *mlflowv1.MLflow) {
+	mlflow.Status.ConsecutiveFailures = 0
+	if degraded := meta.FindStatusCondition(mlflow.Status.Conditions, "Degraded"); degraded != nil && reconcileFailureReasons[degraded.Reason] {
+		meta.RemoveStatusCondition(&mlflow.Status.Conditions, "Degraded")
+	}
+}
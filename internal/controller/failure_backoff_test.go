@@ -0,0 +1,72 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func TestFailureBackoff(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	g.Expect(failureBackoff(1)).To(gomega.Equal(5 * time.Second))
+	g.Expect(failureBackoff(2)).To(gomega.Equal(10 * time.Second))
+	g.Expect(failureBackoff(4)).To(gomega.Equal(40 * time.Second))
+	g.Expect(failureBackoff(7)).To(gomega.Equal(failureBackoffMax))
+	g.Expect(failureBackoff(1000)).To(gomega.Equal(failureBackoffMax))
+}
+
+func TestRecordReconcileFailure(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "mlflow"}}
+	forbidden := apierrors.NewForbidden(schema.GroupResource{Resource: "deployments"}, "mlflow", errors.New("denied"))
+
+	g.Expect(recordReconcileFailure(mlflow, "ApplyFailed", forbidden)).To(gomega.Equal(5 * time.Second))
+	g.Expect(recordReconcileFailure(mlflow, "ApplyFailed", forbidden)).To(gomega.Equal(10 * time.Second))
+	g.Expect(mlflow.Status.ConsecutiveFailures).To(gomega.Equal(int32(2)))
+	degraded := meta.FindStatusCondition(mlflow.Status.Conditions, "Degraded")
+	g.Expect(degraded).NotTo(gomega.BeNil())
+	g.Expect(degraded.Reason).To(gomega.Equal("ApplyFailed"))
+	g.Expect(degraded.Message).To(gomega.HavePrefix("2 consecutive failures (Forbidden), retrying in 10s"))
+
+	recordReconcileFailure(mlflow, "RenderFailed", errors.New("template error"))
+	degraded = meta.FindStatusCondition(mlflow.Status.Conditions, "Degraded")
+	g.Expect(degraded.Reason).To(gomega.Equal("RenderFailed"))
+	g.Expect(degraded.Message).To(gomega.ContainSubstring("(Internal)"))
+
+	clearReconcileFailures(mlflow)
+	g.Expect(mlflow.Status.ConsecutiveFailures).To(gomega.BeZero())
+	g.Expect(meta.FindStatusCondition(mlflow.Status.Conditions, "Degraded")).To(gomega.BeNil())
+
+	// A canary rollback keeps its Degraded condition.
+	meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+		Type: "Degraded", Status: metav1.ConditionTrue, Reason: "CanaryRolledBack", Message: "rolled back",
+	})
+	clearReconcileFailures(mlflow)
+	g.Expect(meta.FindStatusCondition(mlflow.Status.Conditions, "Degraded")).NotTo(gomega.BeNil())
+}
@@ -0,0 +1,393 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
+)
+
+const (
+	ManifestWorkCRDName      = "ManifestWork"
+	PlacementDecisionCRDName = "PlacementDecision"
+
+	// placementLabelKey labels each PlacementDecision with the name of its Placement.
+	placementLabelKey = "cluster.open-cluster-management.io/placement"
+)
+
+// ManifestWorkGVK and PlacementDecisionGVK are the Open Cluster Management hub APIs used by
+// spec.fleet. They are handled as unstructured, like the Istio kinds, to avoid depending on
+// the OCM Go modules.
+var (
+	ManifestWorkGVK      = schema.GroupVersionKind{Group: "work.open-cluster-management.io", Version: "v1", Kind: ManifestWorkCRDName}
+	PlacementDecisionGVK = schema.GroupVersionKind{Group: "cluster.open-cluster-management.io", Version: "v1beta1", Kind: PlacementDecisionCRDName}
+)
+
+// IsOpenClusterManagementAvailable checks if the cluster is an Open Cluster Management hub
+// serving both the ManifestWork and PlacementDecision APIs using discovery API
+func IsOpenClusterManagementAvailable(discoveryClient discovery.DiscoveryInterface) (bool, error) {
+	available, err := isKindAvailable(discoveryClient, ManifestWorkGVK.GroupVersion(), ManifestWorkCRDName)
+	if err != nil || !available {
+		return false, err
+	}
+	return isKindAvailable(discoveryClient, PlacementDecisionGVK.GroupVersion(), PlacementDecisionCRDName)
+}
+
+// fleetClusters returns the managed clusters currently chosen by the Placement of fleet,
+// sorted by name. A Placement spreads its decisions over several PlacementDecisions.
+func (r *MLflowReconciler) fleetClusters(ctx context.Context, fleet *mlflowv1.FleetConfig) ([]string, error) {
+	decisions := &unstructured.UnstructuredList{}
+	decisions.SetGroupVersionKind(PlacementDecisionGVK.GroupVersion().WithKind(PlacementDecisionCRDName + "List"))
+	if err := r.List(ctx, decisions,
+		client.InNamespace(fleet.PlacementRef.Namespace),
+		client.MatchingLabels{placementLabelKey: fleet.PlacementRef.Name},
+	); err != nil {
+		return nil, fmt.Errorf("failed to list PlacementDecisions of Placement %s/%s: %w",
+			fleet.PlacementRef.Namespace, fleet.PlacementRef.Name, err)
+	}
+
+	var clusters []string
+	for _, decision := range decisions.Items {
+		entries, _, _ := unstructured.NestedSlice(decision.Object, "status", "decisions")
+		for _, entry := range entries {
+			if fields, ok := entry.(map[string]interface{}); ok {
+				if name, _ := fields["clusterName"].(string); name != "" {
+					clusters = append(clusters, name)
+				}
+			}
+		}
+	}
+	slices.Sort(clusters)
+	return slices.Compact(clusters), nil
+}
+
+// buildManifestWork wraps the rendered objects in the ManifestWork for one managed cluster.
+// The work lives in the cluster's namespace on the hub, where its work agent picks it up.
+// The target namespace is created first and orphaned on delete, so removing a cluster from
+// the Placement removes the instance but never the namespace and whatever else runs in it.
+func buildManifestWork(mlflow *mlflowv1.MLflow, cluster, targetNamespace string, objects []*unstructured.Unstructured) *unstructured.Unstructured {
+	name := ResourceName + render.ResourceSuffix(mlflow.Name)
+
+	manifests := make([]interface{}, 0, len(objects)+1)
+	manifests = append(manifests, map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Namespace",
+		"metadata":   map[string]interface{}{"name": targetNamespace},
+	})
+	for _, obj := range objects {
+		manifest := obj.DeepCopy()
+		delete(manifest.Object, "status")
+		manifests = append(manifests, manifest.Object)
+	}
+
+	work := &unstructured.Unstructured{}
+	work.SetGroupVersionKind(ManifestWorkGVK)
+	work.SetName(name)
+	work.SetNamespace(cluster)
+	work.SetLabels(map[string]string{"app": ResourceName})
+	work.Object["spec"] = map[string]interface{}{
+		"workload": map[string]interface{}{"manifests": manifests},
+		"deleteOption": map[string]interface{}{
+			"propagationPolicy": "SelectivelyOrphan",
+			"selectivelyOrphans": map[string]interface{}{
+				"orphaningRules": []interface{}{
+					map[string]interface{}{"group": "", "resource": "namespaces", "name": targetNamespace},
+				},
+			},
+		},
+		// Report the server Deployment's replicas back to the hub for status.fleet.
+		"manifestConfigs": []interface{}{
+			map[string]interface{}{
+				"resourceIdentifier": map[string]interface{}{
+					"group":     "apps",
+					"resource":  "deployments",
+					"namespace": targetNamespace,
+					"name":      name,
+				},
+				"feedbackRules": []interface{}{
+					map[string]interface{}{"type": "WellKnownStatus"},
+				},
+			},
+		},
+	}
+	return work
+}
+
+// manifestWorkClusterStatus summarizes the status the work agent reported on work.
+func manifestWorkClusterStatus(work *unstructured.Unstructured, deploymentName string, desiredReplicas int32) mlflowv1.FleetClusterStatus {
+	status := mlflowv1.FleetClusterStatus{
+		ClusterName: work.GetNamespace(),
+		Applied:     metav1.ConditionUnknown,
+		Available:   metav1.ConditionUnknown,
+	}
+	conditions, _, _ := unstructured.NestedSlice(work.Object, "status", "conditions")
+	for _, entry := range conditions {
+		condition, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		conditionStatus, _ := condition["status"].(string)
+		message, _ := condition["message"].(string)
+		switch condition["type"] {
+		case "Applied":
+			status.Applied = metav1.ConditionStatus(conditionStatus)
+			if status.Applied == metav1.ConditionFalse {
+				status.Message = message
+			}
+		case "Available":
+			status.Available = metav1.ConditionStatus(conditionStatus)
+			if status.Available == metav1.ConditionFalse && status.Message == "" {
+				status.Message = message
+			}
+		}
+	}
+
+	manifests, _, _ := unstructured.NestedSlice(work.Object, "status", "resourceStatus", "manifests")
+	for _, entry := range manifests {
+		manifest, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		kind, _, _ := unstructured.NestedString(manifest, "resourceMeta", "kind")
+		name, _, _ := unstructured.NestedString(manifest, "resourceMeta", "name")
+		if kind != "Deployment" || name != deploymentName {
+			continue
+		}
+		values, _, _ := unstructured.NestedSlice(manifest, "statusFeedback", "values")
+		for _, value := range values {
+			if fields, ok := value.(map[string]interface{}); ok && fields["name"] == "ReadyReplicas" {
+				ready, _, _ := unstructured.NestedInt64(fields, "fieldValue", "integer")
+				status.ReadyReplicas = int32(ready)
+			}
+		}
+	}
+
+	if status.Message == "" && status.Available == metav1.ConditionTrue && status.ReadyReplicas < desiredReplicas {
+		status.Message = fmt.Sprintf("%d of %d replicas ready", status.ReadyReplicas, desiredReplicas)
+	}
+	return status
+}
+
+func fleetClusterReady(status mlflowv1.FleetClusterStatus, desiredReplicas int32) bool {
+	return status.Available == metav1.ConditionTrue && status.ReadyReplicas >= desiredReplicas
+}
+
+// renderedReplicas returns the replicas of the rendered server Deployment.
+func renderedReplicas(objects []*unstructured.Unstructured, deploymentName string) int32 {
+	for _, obj := range objects {
+		if obj.GetKind() == "Deployment" && obj.GetName() == deploymentName {
+			if replicas, found, _ := unstructured.NestedInt64(obj.Object, "spec", "replicas"); found {
+				return int32(replicas)
+			}
+		}
+	}
+	return 1
+}
+
+// reconcileFleet delivers the rendered objects to the managed clusters selected by
+// spec.fleet instead of applying them locally, and reports each cluster in status.fleet.
+// Routing, blue/green and canary rollouts, and managed migrations only run on the local
+// cluster and are skipped.
+func (r *MLflowReconciler) reconcileFleet(ctx context.Context, mlflow *mlflowv1.MLflow, targetNamespace string, objects []*unstructured.Unstructured, chartVersion string) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	if !r.OpenClusterManagementAvailable {
+		message := "spec.fleet requires the Open Cluster Management ManifestWork and PlacementDecision APIs; run the operator on the OCM hub"
+		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+			Type:               "Available",
+			Status:             metav1.ConditionFalse,
+			Reason:             "FleetUnavailable",
+			Message:            message,
+			ObservedGeneration: mlflow.Generation,
+		})
+		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+			Type:               "Progressing",
+			Status:             metav1.ConditionFalse,
+			Reason:             "FleetUnavailable",
+			Message:            message,
+			ObservedGeneration: mlflow.Generation,
+		})
+		if err := r.updateStatus(ctx, mlflow); err != nil {
+			log.Error(err, "Failed to update MLflow status")
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
+	}
+
+	works, err := r.applyManifestWorks(ctx, mlflow, targetNamespace, objects)
+	if err != nil {
+		log.Error(err, "Failed to deliver MLflow to managed clusters")
+		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+			Type:    "Available",
+			Status:  metav1.ConditionFalse,
+			Reason:  "FleetApplyFailed",
+			Message: fmt.Sprintf("Failed to deliver to managed clusters: %v", err),
+		})
+		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+			Type:    "Progressing",
+			Status:  metav1.ConditionFalse,
+			Reason:  "FleetApplyFailed",
+			Message: fmt.Sprintf("Failed to deliver to managed clusters: %v", err),
+		})
+		backoff := recordReconcileFailure(mlflow, "FleetApplyFailed", err)
+		if statusErr := r.updateStatus(ctx, mlflow); statusErr != nil {
+			log.Error(statusErr, "Failed to update MLflow status")
+		}
+		return ctrl.Result{RequeueAfter: backoff}, nil
+	}
+	recordAppliedManifests(mlflow, chartVersion, len(objects), metav1.Now())
+	mlflow.Status.Inventory = buildInventory(works)
+	clearReconcileFailures(mlflow)
+
+	deploymentName := ResourceName + render.ResourceSuffix(mlflow.Name)
+	desiredReplicas := renderedReplicas(objects, deploymentName)
+	clusters := make([]mlflowv1.FleetClusterStatus, 0, len(works))
+	ready := 0
+	for _, work := range works {
+		status := manifestWorkClusterStatus(work, deploymentName, desiredReplicas)
+		if fleetClusterReady(status, desiredReplicas) {
+			ready++
+		}
+		clusters = append(clusters, status)
+	}
+	slices.SortFunc(clusters, func(a, b mlflowv1.FleetClusterStatus) int {
+		return cmp.Compare(a.ClusterName, b.ClusterName)
+	})
+	mlflow.Status.Fleet = clusters
+	setFleetConditions(mlflow, ready, len(clusters))
+
+	if err := r.updateStatus(ctx, mlflow); err != nil {
+		log.Error(err, "Failed to update MLflow status")
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// applyManifestWorks applies one ManifestWork per selected cluster and deletes the works of
+// clusters the Placement no longer selects. It returns the applied works with their status.
+func (r *MLflowReconciler) applyManifestWorks(ctx context.Context, mlflow *mlflowv1.MLflow, targetNamespace string, objects []*unstructured.Unstructured) ([]*unstructured.Unstructured, error) {
+	clusters, err := r.fleetClusters(ctx, mlflow.Spec.Fleet)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.deleteManifestWorks(ctx, mlflow, clusters); err != nil {
+		return nil, err
+	}
+
+	works := make([]*unstructured.Unstructured, 0, len(clusters))
+	for _, cluster := range clusters {
+		work := buildManifestWork(mlflow, cluster, targetNamespace, objects)
+		propagateMetadata(mlflow, work)
+		if err := controllerutil.SetControllerReference(mlflow, work, r.Scheme); err != nil {
+			return nil, fmt.Errorf("failed to set controller reference on ManifestWork: %w", err)
+		}
+		if err := r.applyObject(ctx, work); err != nil {
+			return nil, fmt.Errorf("failed to apply ManifestWork %s/%s: %w", cluster, work.GetName(), err)
+		}
+		// Skipped applies leave the work without its status, so read it back.
+		live := &unstructured.Unstructured{}
+		live.SetGroupVersionKind(ManifestWorkGVK)
+		if err := r.Get(ctx, types.NamespacedName{Name: work.GetName(), Namespace: cluster}, live); err != nil {
+			return nil, fmt.Errorf("failed to get ManifestWork %s/%s: %w", cluster, work.GetName(), err)
+		}
+		works = append(works, live)
+	}
+	return works, nil
+}
+
+// deleteManifestWorks deletes the ManifestWorks of mlflow outside the keep clusters. Nil
+// keep deletes all of them, which removes the instance from every managed cluster.
+func (r *MLflowReconciler) deleteManifestWorks(ctx context.Context, mlflow *mlflowv1.MLflow, keep []string) error {
+	works := &unstructured.UnstructuredList{}
+	works.SetGroupVersionKind(ManifestWorkGVK.GroupVersion().WithKind(ManifestWorkCRDName + "List"))
+	if err := r.List(ctx, works, client.MatchingLabels{"app": ResourceName}); err != nil {
+		return fmt.Errorf("failed to list ManifestWorks: %w", err)
+	}
+	for i := range works.Items {
+		work := &works.Items[i]
+		if !metav1.IsControlledBy(work, mlflow) || slices.Contains(keep, work.GetNamespace()) {
+			continue
+		}
+		if err := r.Delete(ctx, work); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete ManifestWork %s/%s: %w", work.GetNamespace(), work.GetName(), err)
+		}
+		logf.FromContext(ctx).Info("Deleted ManifestWork", "cluster", work.GetNamespace(), "name", work.GetName())
+	}
+	return nil
+}
+
+func setFleetConditions(mlflow *mlflowv1.MLflow, ready, total int) {
+	available := metav1.Condition{Type: "Available", ObservedGeneration: mlflow.Generation}
+	progressing := metav1.Condition{Type: "Progressing", ObservedGeneration: mlflow.Generation}
+	switch {
+	case total == 0:
+		available.Status, available.Reason = metav1.ConditionFalse, "NoClustersSelected"
+		available.Message = fmt.Sprintf("Placement %s/%s selects no managed clusters",
+			mlflow.Spec.Fleet.PlacementRef.Namespace, mlflow.Spec.Fleet.PlacementRef.Name)
+		progressing.Status, progressing.Reason, progressing.Message = metav1.ConditionFalse, available.Reason, available.Message
+	case ready == total:
+		available.Status, available.Reason = metav1.ConditionTrue, "FleetAvailable"
+		available.Message = fmt.Sprintf("MLflow is available on all %d managed clusters", total)
+		progressing.Status, progressing.Reason, progressing.Message = metav1.ConditionFalse, available.Reason, available.Message
+	default:
+		available.Status, available.Reason = metav1.ConditionFalse, "FleetProgressing"
+		available.Message = fmt.Sprintf("MLflow is available on %d of %d managed clusters", ready, total)
+		progressing.Status, progressing.Reason, progressing.Message = metav1.ConditionTrue, available.Reason, available.Message
+	}
+	meta.SetStatusCondition(&mlflow.Status.Conditions, available)
+	meta.SetStatusCondition(&mlflow.Status.Conditions, progressing)
+}
+
+// placementDecisionToMLflowRequests maps a PlacementDecision to the MLflow whose spec.fleet
+// names its Placement, so clusters joining or leaving the Placement are picked up.
+func (r *MLflowReconciler) placementDecisionToMLflowRequests(ctx context.Context, obj client.Object) []reconcile.Request {
+	placement := obj.GetLabels()[placementLabelKey]
+	if placement == "" {
+		return nil
+	}
+	mlflows := &mlflowv1.MLflowList{}
+	if err := r.List(ctx, mlflows); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to list MLflows for PlacementDecision watch")
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, mlflow := range mlflows.Items {
+		if fleet := mlflow.Spec.Fleet; fleet != nil &&
+			fleet.PlacementRef.Namespace == obj.GetNamespace() && fleet.PlacementRef.Name == placement {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: mlflow.Name}})
+		}
+	}
+	return requests
+}
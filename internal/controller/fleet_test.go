@@ -0,0 +1,192 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func placementDecision(namespace, name, placement string, clusters ...string) *unstructured.Unstructured {
+	decisions := make([]interface{}, 0, len(clusters))
+	for _, cluster := range clusters {
+		decisions = append(decisions, map[string]interface{}{"clusterName": cluster, "reason": ""})
+	}
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(PlacementDecisionGVK)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	obj.SetLabels(map[string]string{placementLabelKey: placement})
+	obj.Object["status"] = map[string]interface{}{"decisions": decisions}
+	return obj
+}
+
+func TestBuildManifestWork(t *testing.T) {
+	g := gomega.NewWithT(t)
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: ResourceName}}
+	deployment := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       deploymentKind,
+		"metadata":   map[string]interface{}{"name": "mlflow", "namespace": "opendatahub"},
+		"status":     map[string]interface{}{"readyReplicas": int64(1)},
+	}}
+
+	work := buildManifestWork(mlflow, "spoke-1", "opendatahub", []*unstructured.Unstructured{deployment})
+	g.Expect(work.GetNamespace()).To(gomega.Equal("spoke-1"))
+	g.Expect(work.GetName()).To(gomega.Equal("mlflow"))
+	manifests, _, _ := unstructured.NestedSlice(work.Object, "spec", "workload", "manifests")
+	g.Expect(manifests).To(gomega.HaveLen(2))
+	g.Expect(manifests[0]).To(gomega.HaveKeyWithValue("kind", "Namespace"))
+	g.Expect(manifests[1]).To(gomega.HaveKeyWithValue("kind", deploymentKind))
+	g.Expect(manifests[1]).NotTo(gomega.HaveKey("status"))
+	// The rendered object itself is not modified.
+	g.Expect(deployment.Object).To(gomega.HaveKey("status"))
+
+	rules, _, _ := unstructured.NestedSlice(work.Object, "spec", "deleteOption", "selectivelyOrphans", "orphaningRules")
+	g.Expect(rules).To(gomega.ConsistOf(gomega.HaveKeyWithValue("name", "opendatahub")))
+}
+
+func TestManifestWorkClusterStatus(t *testing.T) {
+	g := gomega.NewWithT(t)
+	work := &unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "mlflow", "namespace": "spoke-1"},
+		"status": map[string]interface{}{
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Applied", "status": "True"},
+				map[string]interface{}{"type": "Available", "status": "True"},
+			},
+			"resourceStatus": map[string]interface{}{"manifests": []interface{}{
+				map[string]interface{}{
+					"resourceMeta": map[string]interface{}{"kind": deploymentKind, "name": "mlflow"},
+					"statusFeedback": map[string]interface{}{"values": []interface{}{
+						map[string]interface{}{"name": "ReadyReplicas", "fieldValue": map[string]interface{}{"type": "Integer", "integer": int64(1)}},
+					}},
+				},
+			}},
+		},
+	}}
+
+	status := manifestWorkClusterStatus(work, "mlflow", 2)
+	g.Expect(status.ClusterName).To(gomega.Equal("spoke-1"))
+	g.Expect(status.Applied).To(gomega.Equal(metav1.ConditionTrue))
+	g.Expect(status.ReadyReplicas).To(gomega.Equal(int32(1)))
+	g.Expect(status.Message).To(gomega.Equal("1 of 2 replicas ready"))
+	g.Expect(fleetClusterReady(status, 2)).To(gomega.BeFalse())
+	g.Expect(fleetClusterReady(status, 1)).To(gomega.BeTrue())
+
+	// Without a report from the work agent the cluster is not ready.
+	status = manifestWorkClusterStatus(&unstructured.Unstructured{Object: map[string]interface{}{}}, "mlflow", 1)
+	g.Expect(status.Available).To(gomega.Equal(metav1.ConditionUnknown))
+	g.Expect(fleetClusterReady(status, 1)).To(gomega.BeFalse())
+}
+
+func TestReconcileFleet(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: ResourceName, UID: "uid"},
+		Spec: mlflowv1.MLflowSpec{Fleet: &mlflowv1.FleetConfig{
+			PlacementRef: mlflowv1.FleetPlacementReference{Name: "mlflow", Namespace: "fleet"},
+		}},
+	}
+	stale := &unstructured.Unstructured{}
+	stale.SetGroupVersionKind(ManifestWorkGVK)
+	stale.SetNamespace("spoke-old")
+	stale.SetName("mlflow")
+	stale.SetLabels(map[string]string{"app": ResourceName})
+	stale.SetOwnerReferences([]metav1.OwnerReference{{
+		APIVersion: mlflowv1.GroupVersion.String(), Kind: "MLflow", Name: ResourceName, UID: "uid", Controller: ptr(true),
+	}})
+
+	scheme := runtime.NewScheme()
+	g.Expect(mlflowv1.AddToScheme(scheme)).To(gomega.Succeed())
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(mlflow.DeepCopy(), stale,
+			placementDecision("fleet", "mlflow-decision-1", "mlflow", "spoke-1"),
+			placementDecision("fleet", "mlflow-decision-2", "mlflow", "spoke-2"),
+			placementDecision("fleet", "other-decision-1", "other", "spoke-3"),
+		).
+		WithStatusSubresource(&mlflowv1.MLflow{}).
+		Build()
+	r := &MLflowReconciler{Client: k8sClient, Scheme: scheme, OpenClusterManagementAvailable: true}
+	getWork := func(cluster string) error {
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(ManifestWorkGVK)
+		return k8sClient.Get(ctx, types.NamespacedName{Name: "mlflow", Namespace: cluster}, obj)
+	}
+
+	deployment := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       deploymentKind,
+		"metadata":   map[string]interface{}{"name": "mlflow", "namespace": "opendatahub"},
+		"spec":       map[string]interface{}{"replicas": int64(1)},
+	}}
+	_, err := r.reconcileFleet(ctx, mlflow, "opendatahub", []*unstructured.Unstructured{deployment}, "1.0.0")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	g.Expect(getWork("spoke-1")).To(gomega.Succeed())
+	g.Expect(getWork("spoke-2")).To(gomega.Succeed())
+	g.Expect(apierrors.IsNotFound(getWork("spoke-3"))).To(gomega.BeTrue())
+	g.Expect(apierrors.IsNotFound(getWork("spoke-old"))).To(gomega.BeTrue())
+
+	g.Expect(mlflow.Status.Fleet).To(gomega.HaveLen(2))
+	g.Expect(mlflow.Status.Fleet[0].ClusterName).To(gomega.Equal("spoke-1"))
+	g.Expect(mlflow.Status.Fleet[0].Available).To(gomega.Equal(metav1.ConditionUnknown))
+	g.Expect(mlflow.Status.Inventory).To(gomega.HaveLen(2))
+	available := meta.FindStatusCondition(mlflow.Status.Conditions, "Available")
+	g.Expect(available).NotTo(gomega.BeNil())
+	g.Expect(available.Reason).To(gomega.Equal("FleetProgressing"))
+	g.Expect(available.Message).To(gomega.Equal("MLflow is available on 0 of 2 managed clusters"))
+
+	// Leaving fleet mode removes the instance from every managed cluster.
+	g.Expect(r.deleteManifestWorks(ctx, mlflow, nil)).To(gomega.Succeed())
+	g.Expect(apierrors.IsNotFound(getWork("spoke-1"))).To(gomega.BeTrue())
+	g.Expect(apierrors.IsNotFound(getWork("spoke-2"))).To(gomega.BeTrue())
+}
+
+func TestReconcileFleet_OpenClusterManagementUnavailable(t *testing.T) {
+	g := gomega.NewWithT(t)
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: ResourceName},
+		Spec: mlflowv1.MLflowSpec{Fleet: &mlflowv1.FleetConfig{
+			PlacementRef: mlflowv1.FleetPlacementReference{Name: "mlflow", Namespace: "fleet"},
+		}},
+	}
+	scheme := runtime.NewScheme()
+	g.Expect(mlflowv1.AddToScheme(scheme)).To(gomega.Succeed())
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(mlflow.DeepCopy()).WithStatusSubresource(&mlflowv1.MLflow{}).Build()
+	r := &MLflowReconciler{Client: k8sClient, Scheme: scheme}
+
+	_, err := r.reconcileFleet(context.Background(), mlflow, "opendatahub", nil, "1.0.0")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	available := meta.FindStatusCondition(mlflow.Status.Conditions, "Available")
+	g.Expect(available).NotTo(gomega.BeNil())
+	g.Expect(available.Reason).To(gomega.Equal("FleetUnavailable"))
+}
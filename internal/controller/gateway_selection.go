@@ -0,0 +1,117 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+)
+
+// gatewaySelectionEnabled reports whether cfg asks for the default Gateway to be found by
+// label or class rather than taken from GatewayName.
+func gatewaySelectionEnabled(cfg *config.OperatorConfig) bool {
+	return cfg.GatewaySelector != "" || cfg.GatewayClassName != ""
+}
+
+// withSelectedGateway returns cfg with GatewayName and GatewayNamespace set to the Gateway
+// matching GATEWAY_SELECTOR and GATEWAY_CLASS_NAME in any namespace. Gateways are ordered by
+// namespace and name and the first one wins, so every reconcile picks the same Gateway.
+// When nothing matches, cfg is returned unchanged and the route keeps using GATEWAY_NAME.
+func (r *MLflowReconciler) withSelectedGateway(ctx context.Context, cfg *config.OperatorConfig) (*config.OperatorConfig, error) {
+	if !gatewaySelectionEnabled(cfg) || !r.HTTPRouteAvailable {
+		return cfg, nil
+	}
+	selector, err := labels.Parse(cfg.GatewaySelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid GATEWAY_SELECTOR %q: %w", cfg.GatewaySelector, err)
+	}
+
+	gateways := &gatewayv1.GatewayList{}
+	if err := r.List(ctx, gateways, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, fmt.Errorf("failed to list Gateways: %w", err)
+	}
+	selected := selectGateway(gateways.Items, cfg.GatewayClassName)
+	if selected == nil {
+		logf.FromContext(ctx).Info("No Gateway matches GATEWAY_SELECTOR and GATEWAY_CLASS_NAME, using GATEWAY_NAME",
+			"selector", cfg.GatewaySelector, "gatewayClassName", cfg.GatewayClassName, "gatewayName", cfg.GatewayName)
+		return cfg, nil
+	}
+
+	resolved := *cfg
+	resolved.GatewayName = selected.Name
+	resolved.GatewayNamespace = selected.Namespace
+	return &resolved, nil
+}
+
+// selectGateway returns the first Gateway of gatewayClassName, or of any class when it is
+// empty, by namespace and then name. Gateways being deleted are skipped.
+func selectGateway(gateways []gatewayv1.Gateway, gatewayClassName string) *gatewayv1.Gateway {
+	var candidates []*gatewayv1.Gateway
+	for i := range gateways {
+		gateway := &gateways[i]
+		if gateway.DeletionTimestamp != nil {
+			continue
+		}
+		if gatewayClassName != "" && string(gateway.Spec.GatewayClassName) != gatewayClassName {
+			continue
+		}
+		candidates = append(candidates, gateway)
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].Namespace != candidates[j].Namespace {
+			return candidates[i].Namespace < candidates[j].Namespace
+		}
+		return candidates[i].Name < candidates[j].Name
+	})
+	return candidates[0]
+}
+
+// gatewaySelectionMatches reports whether gateway is a candidate for the Gateway selection in
+// cfg, so the Gateway watch can re-reconcile when a matching Gateway appears or changes.
+func gatewaySelectionMatches(cfg *config.OperatorConfig, gateway *gatewayv1.Gateway) bool {
+	if !gatewaySelectionEnabled(cfg) {
+		return false
+	}
+	selector, err := labels.Parse(cfg.GatewaySelector)
+	if err != nil || !selector.Matches(labels.Set(gateway.Labels)) {
+		return false
+	}
+	return cfg.GatewayClassName == "" || string(gateway.Spec.GatewayClassName) == cfg.GatewayClassName
+}
+
+// setSelectedGatewayStatus records the selected Gateway in status.gateway while the HTTPRoute
+// attaches to it, and clears it otherwise.
+func setSelectedGatewayStatus(mlflow *mlflowv1.MLflow, httpRouteAvailable bool, cfg *config.OperatorConfig) {
+	if !httpRouteAvailable || !routingEnabled(mlflow) || cfg.GatewayNamespace == "" ||
+		(mlflow.Spec.Routing != nil && len(mlflow.Spec.Routing.Gateways) > 0) {
+		mlflow.Status.Gateway = nil
+		return
+	}
+	mlflow.Status.Gateway = &mlflowv1.SelectedGateway{Name: cfg.GatewayName, Namespace: cfg.GatewayNamespace}
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+)
+
+func selectionTestGateway(namespace, name, class string, labels map[string]string) *gatewayv1.Gateway {
+	return &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels},
+		Spec:       gatewayv1.GatewaySpec{GatewayClassName: gatewayv1.ObjectName(class)},
+	}
+}
+
+func TestWithSelectedGateway(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := gatewayv1.Install(scheme); err != nil {
+		t.Fatalf("add Gateway API scheme: %v", err)
+	}
+	defaultLabel := map[string]string{"gateway.opendatahub.io/default": "true"}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		selectionTestGateway("istio-ingress", "public", "istio", defaultLabel),
+		selectionTestGateway("envoy-gateway", "public", "envoy", defaultLabel),
+		selectionTestGateway("envoy-gateway", "internal", "envoy", nil),
+	).Build()
+	reconciler := &MLflowReconciler{Client: client, Scheme: scheme, HTTPRouteAvailable: true}
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		cfg           *config.OperatorConfig
+		wantName      string
+		wantNamespace string
+	}{
+		{
+			name:     "name only",
+			cfg:      &config.OperatorConfig{GatewayName: "data-science-gateway"},
+			wantName: "data-science-gateway",
+		},
+		{
+			name:          "selector picks the first namespace",
+			cfg:           &config.OperatorConfig{GatewayName: "data-science-gateway", GatewaySelector: "gateway.opendatahub.io/default=true"},
+			wantName:      "public",
+			wantNamespace: "envoy-gateway",
+		},
+		{
+			name: "selector and class",
+			cfg: &config.OperatorConfig{
+				GatewayName:      "data-science-gateway",
+				GatewaySelector:  "gateway.opendatahub.io/default=true",
+				GatewayClassName: "istio",
+			},
+			wantName:      "public",
+			wantNamespace: "istio-ingress",
+		},
+		{
+			name:          "class without selector",
+			cfg:           &config.OperatorConfig{GatewayName: "data-science-gateway", GatewayClassName: "envoy"},
+			wantName:      "internal",
+			wantNamespace: "envoy-gateway",
+		},
+		{
+			name:     "no match keeps the name",
+			cfg:      &config.OperatorConfig{GatewayName: "data-science-gateway", GatewayClassName: "cilium"},
+			wantName: "data-science-gateway",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := reconciler.withSelectedGateway(ctx, tt.cfg)
+			if err != nil {
+				t.Fatalf("withSelectedGateway() error = %v", err)
+			}
+			if got.GatewayName != tt.wantName || got.GatewayNamespace != tt.wantNamespace {
+				t.Errorf("withSelectedGateway() = %s/%s, want %s/%s", got.GatewayNamespace, got.GatewayName, tt.wantNamespace, tt.wantName)
+			}
+		})
+	}
+
+	if _, err := reconciler.withSelectedGateway(ctx, &config.OperatorConfig{GatewaySelector: "a in (b"}); err == nil {
+		t.Error("withSelectedGateway() accepted an invalid selector")
+	}
+}
+
+func TestSelectedGatewayRouting(t *testing.T) {
+	cfg := &config.OperatorConfig{GatewayName: "public", GatewayNamespace: "envoy-gateway"}
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: ResourceName}}
+
+	parentRefs := buildHTTPRouteParentRefs(mlflow.Spec.Routing, cfg)
+	if len(parentRefs) != 1 || parentGatewayKey(parentRefs[0], "").String() != "envoy-gateway/public" {
+		t.Fatalf("parentRefs = %+v, want the selected Gateway", parentRefs)
+	}
+
+	setSelectedGatewayStatus(mlflow, true, cfg)
+	if mlflow.Status.Gateway == nil || *mlflow.Status.Gateway != (mlflowv1.SelectedGateway{Name: "public", Namespace: "envoy-gateway"}) {
+		t.Fatalf("status.gateway = %+v, want the selected Gateway", mlflow.Status.Gateway)
+	}
+
+	// Explicit spec.routing.gateways replace the selection.
+	namespace := "openshift-ingress"
+	mlflow.Spec.Routing = &mlflowv1.RoutingConfig{Gateways: []mlflowv1.GatewayReference{{Name: "internal", Namespace: &namespace}}}
+	setSelectedGatewayStatus(mlflow, true, cfg)
+	if mlflow.Status.Gateway != nil {
+		t.Fatalf("status.gateway = %+v, want it cleared", mlflow.Status.Gateway)
+	}
+}
+
+func TestGatewaySelectionMatches(t *testing.T) {
+	cfg := &config.OperatorConfig{GatewaySelector: "gateway.opendatahub.io/default=true", GatewayClassName: "envoy"}
+	if !gatewaySelectionMatches(cfg, selectionTestGateway("ns", "gw", "envoy", map[string]string{"gateway.opendatahub.io/default": "true"})) {
+		t.Error("gatewaySelectionMatches() = false for a labeled Gateway of the class")
+	}
+	if gatewaySelectionMatches(cfg, selectionTestGateway("ns", "gw", "istio", map[string]string{"gateway.opendatahub.io/default": "true"})) {
+		t.Error("gatewaySelectionMatches() = true for another class")
+	}
+	if gatewaySelectionMatches(&config.OperatorConfig{}, selectionTestGateway("ns", "gw", "envoy", nil)) {
+		t.Error("gatewaySelectionMatches() = true without a selection")
+	}
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+)
+
+// withGatewayURL returns cfg with MLflowURL taken from the first parent Gateway of the
+// HTTPRoute that names a host, so status.url and the ConsoleLink follow the Gateway without
+// operator configuration. A configured MLFLOW_URL wins; a URL discovered from the ingress
+// domain does not, since the Gateway's own hostname is more precise.
+func (r *MLflowReconciler) withGatewayURL(ctx context.Context, mlflow *mlflowv1.MLflow, cfg *config.OperatorConfig) (*config.OperatorConfig, error) {
+	if (cfg.MLflowURLConfigured && !cfg.MLflowURLDiscovered) || !r.HTTPRouteAvailable || !routingEnabled(mlflow) {
+		return cfg, nil
+	}
+	for _, parentRef := range buildHTTPRouteParentRefs(mlflow.Spec.Routing, cfg) {
+		gatewayKey := parentGatewayKey(parentRef, "")
+		gateway := &gatewayv1.Gateway{}
+		if err := r.Get(ctx, gatewayKey, gateway); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to get Gateway %s: %w", gatewayKey, err)
+		}
+		if url := gatewayURL(gateway, sectionNameOf(parentRef)); url != "" {
+			derived := *cfg
+			derived.MLflowURL = url
+			derived.MLflowURLConfigured = true
+			derived.MLflowURLDiscovered = true
+			return &derived, nil
+		}
+	}
+	return cfg, nil
+}
+
+// gatewayURL returns the base URL clients reach gateway at, preferring HTTPS listeners. The
+// host is the listener hostname, or the Gateway's first status address when the listener
+// has no hostname or a wildcard one. Only the listener named sectionName is considered when
+// it is set. It returns "" when the Gateway does not expose a usable host yet.
+func gatewayURL(gateway *gatewayv1.Gateway, sectionName string) string {
+	var listeners []gatewayv1.Listener
+	for _, protocol := range []gatewayv1.ProtocolType{gatewayv1.HTTPSProtocolType, gatewayv1.HTTPProtocolType} {
+		for _, listener := range gateway.Spec.Listeners {
+			if listener.Protocol == protocol && (sectionName == "" || string(listener.Name) == sectionName) {
+				listeners = append(listeners, listener)
+			}
+		}
+	}
+	if len(listeners) == 0 {
+		return ""
+	}
+
+	for _, listener := range listeners {
+		if listener.Hostname != nil && *listener.Hostname != "" && !strings.HasPrefix(string(*listener.Hostname), "*") {
+			return listenerURL(listener, string(*listener.Hostname))
+		}
+	}
+	for _, address := range gateway.Status.Addresses {
+		if address.Value != "" {
+			return listenerURL(listeners[0], address.Value)
+		}
+	}
+	return ""
+}
+
+// listenerURL joins host with the scheme of listener and its port, leaving out the default
+// port of the scheme.
+func listenerURL(listener gatewayv1.Listener, host string) string {
+	scheme, defaultPort := "https", gatewayv1.PortNumber(443)
+	if listener.Protocol == gatewayv1.HTTPProtocolType {
+		scheme, defaultPort = "http", 80
+	}
+	if listener.Port == defaultPort || listener.Port == 0 {
+		if strings.Contains(host, ":") {
+			// An IPv6 address.
+			host = "[" + host + "]"
+		}
+		return scheme + "://" + host
+	}
+	return scheme + "://" + net.JoinHostPort(host, strconv.Itoa(int(listener.Port)))
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+const (
+	// healthProbeTimeout bounds each request of a deep health check.
+	healthProbeTimeout = 5 * time.Second
+	// healthCheckFailedReason is the Available condition reason for a failed deep health check.
+	healthCheckFailedReason = "HealthCheckFailed"
+
+	serviceAccountTokenFile = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	// serviceCAFile is mounted into every pod on OpenShift and signs the MLflow serving certificate.
+	serviceCAFile = "/var/run/secrets/kubernetes.io/serviceaccount/service-ca.crt"
+)
+
+// healthProber verifies that a ready MLflow server can serve API requests, not just that its
+// process is up.
+type healthProber struct {
+	// client performs probe requests; nil builds one that trusts the system roots and the
+	// OpenShift service CA.
+	client *http.Client
+	// tokenFile holds the bearer token sent with API requests; empty uses the operator's
+	// service account token.
+	tokenFile string
+
+	clientOnce    sync.Once
+	defaultClient *http.Client
+}
+
+// deepHealthCheckEnabled reports whether spec.deepHealthCheck asks for a deep health check.
+// It defaults to on where the operator trusts the serving certificate: OpenShift's service CA,
+// unless strict mesh mTLS would reject the operator, which runs outside the mesh.
+func deepHealthCheckEnabled(mlflow *mlflowv1.MLflow, isOpenShift bool) bool {
+	if mlflow.Spec.DeepHealthCheck != nil {
+		return *mlflow.Spec.DeepHealthCheck
+	}
+	return isOpenShift && !serviceMeshStrict(mlflow)
+}
+
+// Probe checks <baseURL>/health, then runs a one-result experiment search so a server that
+// cannot reach its backend store fails the check. Authorization errors on the search still
+// count as healthy: they prove the server is answering API requests, and the operator is not
+// expected to hold MLflow permissions.
+func (p *healthProber) Probe(ctx context.Context, baseURL string) error {
+	if err := p.get(ctx, baseURL+"/health", false); err != nil {
+		return err
+	}
+	return p.get(ctx, baseURL+"/api/2.0/mlflow/experiments/search?max_results=1", true)
+}
+
+func (p *healthProber) get(ctx context.Context, requestURL string, authenticated bool) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return err
+	}
+	if authenticated {
+		if token := p.token(); token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+	}
+
+	resp, err := p.httpClient().Do(req)
+	if err != nil {
+		return fmt.Errorf("GET %s: %w", requestURL, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch {
+	case resp.StatusCode < 300:
+		return nil
+	case authenticated && resp.StatusCode < 500:
+		return nil
+	}
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	return fmt.Errorf("GET %s returned %s: %s", requestURL, resp.Status, strings.TrimSpace(string(body)))
+}
+
+func (p *healthProber) token() string {
+	tokenFile := p.tokenFile
+	if tokenFile == "" {
+		tokenFile = serviceAccountTokenFile
+	}
+	// Read on every probe: projected service account tokens rotate.
+	token, err := os.ReadFile(tokenFile)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(token))
+}
+
+func (p *healthProber) httpClient() *http.Client {
+	if p.client != nil {
+		return p.client
+	}
+	p.clientOnce.Do(func() {
+		roots, err := x509.SystemCertPool()
+		if err != nil {
+			roots = x509.NewCertPool()
+		}
+		if serviceCA, err := os.ReadFile(serviceCAFile); err == nil {
+			roots.AppendCertsFromPEM(serviceCA)
+		}
+		p.defaultClient = &http.Client{
+			Timeout: healthProbeTimeout,
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{RootCAs: roots, MinVersion: tls.VersionTLS12},
+			},
+		}
+	})
+	return p.defaultClient
+}
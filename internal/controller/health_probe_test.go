@@ -0,0 +1,92 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func TestHealthProberProbe(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("operator-token\n"), 0o600); err != nil {
+		t.Fatalf("write token: %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		healthStatus int
+		searchStatus int
+		wantErr      string
+	}{
+		{name: "healthy", healthStatus: http.StatusOK, searchStatus: http.StatusOK},
+		{name: "search forbidden still proves the server answers", healthStatus: http.StatusOK, searchStatus: http.StatusForbidden},
+		{name: "process unhealthy", healthStatus: http.StatusServiceUnavailable, searchStatus: http.StatusOK, wantErr: "/mlflow/health returned 503"},
+		{name: "backend store unreachable", healthStatus: http.StatusOK, searchStatus: http.StatusInternalServerError, wantErr: "experiments/search"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			var searchAuthorization string
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/mlflow/health":
+					w.WriteHeader(tt.healthStatus)
+				case "/mlflow/api/2.0/mlflow/experiments/search":
+					searchAuthorization = r.Header.Get("Authorization")
+					w.WriteHeader(tt.searchStatus)
+					_, _ = w.Write([]byte(`{"error_code":"INTERNAL_ERROR","message":"could not connect to server"}`))
+				default:
+					http.NotFound(w, r)
+				}
+			}))
+			defer server.Close()
+
+			prober := &healthProber{client: server.Client(), tokenFile: tokenFile}
+			err := prober.Probe(context.Background(), server.URL+StaticPrefix)
+			if tt.wantErr != "" {
+				g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring(tt.wantErr)))
+				return
+			}
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(searchAuthorization).To(gomega.Equal("Bearer operator-token"))
+		})
+	}
+}
+
+func TestDeepHealthCheckEnabled(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: ResourceName}}
+	g.Expect(deepHealthCheckEnabled(mlflow, true)).To(gomega.BeTrue())
+	g.Expect(deepHealthCheckEnabled(mlflow, false)).To(gomega.BeFalse())
+
+	mlflow.Spec.DeepHealthCheck = ptr(false)
+	g.Expect(deepHealthCheckEnabled(mlflow, true)).To(gomega.BeFalse())
+	mlflow.Spec.DeepHealthCheck = ptr(true)
+	g.Expect(deepHealthCheckEnabled(mlflow, false)).To(gomega.BeTrue())
+}
@@ -17,18 +17,13 @@ limitations under the License.
 package controller
 
 import (
-	"strconv"
-
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
 const (
 	deploymentKind      = "Deployment"
 	testBackendStoreURI = "postgresql://db-host:5432/mlflow"
-
-	// CA bundle test constants - these match values from values.yaml and deployment.yaml
-	caCombinedVolume = "combined-ca-bundle"
-	caCombinedBundle = "/etc/pki/tls/certs/combined/ca-bundle.crt"
+	caCombinedBundle    = "/etc/pki/tls/certs/combined/ca-bundle.crt"
 )
 
 func findObject(objs []*unstructured.Unstructured, kind, name string) *unstructured.Unstructured {
@@ -40,94 +35,6 @@ func findObject(objs []*unstructured.Unstructured, kind, name string) *unstructu
 	return nil
 }
 
-func collectEgressPorts(egressRules []interface{}) []int64 {
-	var ports []int64
-	for _, rule := range egressRules {
-		ruleMap, ok := rule.(map[string]interface{})
-		if !ok {
-			continue
-		}
-		rulePorts, ok := ruleMap["ports"].([]interface{})
-		if !ok {
-			continue
-		}
-		for _, p := range rulePorts {
-			portMap, ok := p.(map[string]interface{})
-			if !ok {
-				continue
-			}
-			if port, ok := parsePortValue(portMap["port"]); ok {
-				ports = append(ports, port)
-			}
-		}
-	}
-	return ports
-}
-
-func findEgressRulesByPort(egressRules []interface{}, port int64) []map[string]interface{} {
-	var matches []map[string]interface{}
-	for _, rule := range egressRules {
-		ruleMap, ok := rule.(map[string]interface{})
-		if !ok {
-			continue
-		}
-		rulePorts, ok := ruleMap["ports"].([]interface{})
-		if !ok {
-			continue
-		}
-		for _, p := range rulePorts {
-			portMap, ok := p.(map[string]interface{})
-			if !ok {
-				continue
-			}
-			if rulePort, ok := parsePortValue(portMap["port"]); ok && rulePort == port {
-				matches = append(matches, ruleMap)
-				break
-			}
-		}
-	}
-	return matches
-}
-
-func collectRulePorts(rule map[string]interface{}) []int64 {
-	var ports []int64
-	rulePorts, ok := rule["ports"].([]interface{})
-	if !ok {
-		return ports
-	}
-	for _, p := range rulePorts {
-		portMap, ok := p.(map[string]interface{})
-		if !ok {
-			continue
-		}
-		if port, ok := parsePortValue(portMap["port"]); ok {
-			ports = append(ports, port)
-		}
-	}
-	return ports
-}
-
-func parsePortValue(v interface{}) (int64, bool) {
-	switch port := v.(type) {
-	case int:
-		return int64(port), true
-	case int32:
-		return int64(port), true
-	case int64:
-		return port, true
-	case float64:
-		return int64(port), true
-	case string:
-		parsed, err := strconv.ParseInt(port, 10, 64)
-		if err != nil {
-			return 0, false
-		}
-		return parsed, true
-	default:
-		return 0, false
-	}
-}
-
 func ptr[T any](v T) *T {
 	return &v
 }
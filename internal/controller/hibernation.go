@@ -0,0 +1,237 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+// hibernationSearchHorizon bounds how far the schedule evaluation looks backward and
+// forward. Eight days covers every weekly window plus a day of slack.
+const hibernationSearchHorizon = 8 * 24 * time.Hour
+
+// cronSchedule is a parsed 5-field cron expression.
+type cronSchedule struct {
+	minute, hour, dom, month, dow [64]bool
+	domRestricted, dowRestricted  bool
+}
+
+var (
+	cronMonthNames = map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}
+	cronDayNames = map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}
+)
+
+// parseCronSchedule parses a standard 5-field cron expression supporting "*", lists,
+// ranges, steps, and three-letter month/day names.
+func parseCronSchedule(expr string) (*cronSchedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron expression %q must have 5 fields, got %d", expr, len(fields))
+	}
+
+	s := &cronSchedule{}
+	specs := []struct {
+		set        *[64]bool
+		restricted *bool
+		min, max   int
+		names      map[string]int
+	}{
+		{set: &s.minute, min: 0, max: 59},
+		{set: &s.hour, min: 0, max: 23},
+		{set: &s.dom, restricted: &s.domRestricted, min: 1, max: 31},
+		{set: &s.month, min: 1, max: 12, names: cronMonthNames},
+		{set: &s.dow, restricted: &s.dowRestricted, min: 0, max: 7, names: cronDayNames},
+	}
+	for i, spec := range specs {
+		if err := parseCronField(fields[i], spec.set, spec.min, spec.max, spec.names); err != nil {
+			return nil, fmt.Errorf("cron expression %q: %w", expr, err)
+		}
+		if spec.restricted != nil {
+			*spec.restricted = fields[i] != "*" && !strings.HasPrefix(fields[i], "*/")
+		}
+	}
+	// Both 0 and 7 mean Sunday.
+	if s.dow[7] {
+		s.dow[0] = true
+	}
+	return s, nil
+}
+
+func parseCronField(field string, set *[64]bool, minVal, maxVal int, names map[string]int) error {
+	for _, part := range strings.Split(field, ",") {
+		rangePart, step := part, 1
+		if idx := strings.Index(part, "/"); idx >= 0 {
+			var err error
+			step, err = strconv.Atoi(part[idx+1:])
+			if err != nil || step <= 0 {
+				return fmt.Errorf("invalid step in %q", part)
+			}
+			rangePart = part[:idx]
+		}
+
+		lo, hi := minVal, maxVal
+		if rangePart != "*" {
+			bounds := strings.SplitN(rangePart, "-", 2)
+			var err error
+			if lo, err = parseCronValue(bounds[0], names); err != nil {
+				return err
+			}
+			hi = lo
+			if len(bounds) == 2 {
+				if hi, err = parseCronValue(bounds[1], names); err != nil {
+					return err
+				}
+			} else if step > 1 {
+				// "a/n" means every n starting at a.
+				hi = maxVal
+			}
+		}
+		if lo < minVal || hi > maxVal || lo > hi {
+			return fmt.Errorf("value %q out of range %d-%d", part, minVal, maxVal)
+		}
+		for v := lo; v <= hi; v += step {
+			set[v] = true
+		}
+	}
+	return nil
+}
+
+func parseCronValue(value string, names map[string]int) (int, error) {
+	if v, ok := names[strings.ToLower(value)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", value)
+	}
+	return v, nil
+}
+
+// matches reports whether t (truncated to the minute) satisfies the schedule.
+// As in standard cron, when both day-of-month and day-of-week are restricted a
+// time matches if either of them does.
+func (s *cronSchedule) matches(t time.Time) bool {
+	if !s.minute[t.Minute()] || !s.hour[t.Hour()] || !s.month[int(t.Month())] {
+		return false
+	}
+	domMatch := s.dom[t.Day()]
+	dowMatch := s.dow[int(t.Weekday())]
+	if s.domRestricted && s.dowRestricted {
+		return domMatch || dowMatch
+	}
+	return domMatch && dowMatch
+}
+
+// previous returns the latest matching minute at or before t within the search horizon.
+func (s *cronSchedule) previous(t time.Time) (time.Time, bool) {
+	t = t.Truncate(time.Minute)
+	for limit := t.Add(-hibernationSearchHorizon); !t.Before(limit); t = t.Add(-time.Minute) {
+		if s.matches(t) {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// next returns the earliest matching minute strictly after t within the search horizon.
+func (s *cronSchedule) next(t time.Time) (time.Time, bool) {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	for limit := t.Add(hibernationSearchHorizon); t.Before(limit); t = t.Add(time.Minute) {
+		if s.matches(t) {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// hibernationState is the evaluated spec.hibernation schedule at a point in time.
+type hibernationState struct {
+	// Active is true while any hibernation window is open.
+	Active bool
+	// NextTransition is the next time any window opens or closes; zero if none is
+	// found within the search horizon.
+	NextTransition time.Time
+}
+
+// evaluateHibernation reports whether spec.hibernation places the instance in a
+// hibernation window at now, and when the state next changes.
+func evaluateHibernation(mlflow *mlflowv1.MLflow, now time.Time) (hibernationState, error) {
+	state := hibernationState{}
+	hibernation := mlflow.Spec.Hibernation
+	if hibernation == nil || len(hibernation.Schedule) == 0 {
+		return state, nil
+	}
+
+	loc := time.UTC
+	if hibernation.TimeZone != nil && *hibernation.TimeZone != "" {
+		var err error
+		if loc, err = time.LoadLocation(*hibernation.TimeZone); err != nil {
+			return state, fmt.Errorf("invalid hibernation timeZone %q: %w", *hibernation.TimeZone, err)
+		}
+	}
+	now = now.In(loc)
+
+	for i, window := range hibernation.Schedule {
+		start, err := parseCronSchedule(window.Start)
+		if err != nil {
+			return state, fmt.Errorf("invalid hibernation.schedule[%d].start: %w", i, err)
+		}
+		end, err := parseCronSchedule(window.End)
+		if err != nil {
+			return state, fmt.Errorf("invalid hibernation.schedule[%d].end: %w", i, err)
+		}
+
+		// A window is open when its most recent start is later than its most recent end.
+		lastStart, started := start.previous(now)
+		lastEnd, ended := end.previous(now)
+		if started && (!ended || lastStart.After(lastEnd)) {
+			state.Active = true
+		}
+
+		for _, sched := range []*cronSchedule{start, end} {
+			if t, ok := sched.next(now); ok && (state.NextTransition.IsZero() || t.Before(state.NextTransition)) {
+				state.NextTransition = t
+			}
+		}
+	}
+	return state, nil
+}
+
+// hibernationResult requeues the reconcile at the next hibernation window transition
+// so the instance is suspended and resumed on schedule without an external trigger.
+func hibernationResult(state hibernationState, now time.Time) ctrl.Result {
+	if state.NextTransition.IsZero() {
+		return ctrl.Result{}
+	}
+	requeueAfter := state.NextTransition.Sub(now)
+	if requeueAfter < time.Second {
+		requeueAfter = time.Second
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func TestParseCronSchedule(t *testing.T) {
+	tests := []struct {
+		expr    string
+		at      time.Time
+		want    bool
+		wantErr bool
+	}{
+		{expr: "0 19 * * 1-5", at: time.Date(2026, 10, 14, 19, 0, 0, 0, time.UTC), want: true},  // Wednesday
+		{expr: "0 19 * * 1-5", at: time.Date(2026, 10, 17, 19, 0, 0, 0, time.UTC), want: false}, // Saturday
+		{expr: "0 19 * * MON-FRI", at: time.Date(2026, 10, 16, 19, 0, 0, 0, time.UTC), want: true},
+		{expr: "*/15 * * * *", at: time.Date(2026, 10, 14, 3, 45, 0, 0, time.UTC), want: true},
+		{expr: "*/15 * * * *", at: time.Date(2026, 10, 14, 3, 46, 0, 0, time.UTC), want: false},
+		{expr: "0 0 * * 7", at: time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC), want: true}, // Sunday as 7
+		{expr: "0 0 1 * 1", at: time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC), want: true}, // dom or dow
+		{expr: "0 0 1 jan *", at: time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC), want: true},
+		{expr: "0 19 * *", wantErr: true},
+		{expr: "61 * * * *", wantErr: true},
+		{expr: "0 5-2 * * *", wantErr: true},
+		{expr: "*/0 * * * *", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			sched, err := parseCronSchedule(tt.expr)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseCronSchedule(%q) expected error", tt.expr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseCronSchedule(%q) error = %v", tt.expr, err)
+			}
+			if got := sched.matches(tt.at); got != tt.want {
+				t.Errorf("matches(%s) = %v, want %v", tt.at, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvaluateHibernation(t *testing.T) {
+	weeknights := &mlflowv1.HibernationConfig{
+		Schedule: []mlflowv1.HibernationWindow{{Start: "0 19 * * 1-5", End: "0 7 * * 1-5"}},
+	}
+
+	tests := []struct {
+		name        string
+		hibernation *mlflowv1.HibernationConfig
+		now         time.Time
+		wantActive  bool
+		wantNext    time.Time
+		wantErr     bool
+	}{
+		{
+			name: "no hibernation configured",
+			now:  time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:        "working hours",
+			hibernation: weeknights,
+			now:         time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC),
+			wantNext:    time.Date(2026, 10, 14, 19, 0, 0, 0, time.UTC),
+		},
+		{
+			name:        "weeknight",
+			hibernation: weeknights,
+			now:         time.Date(2026, 10, 14, 23, 30, 0, 0, time.UTC),
+			wantActive:  true,
+			wantNext:    time.Date(2026, 10, 15, 7, 0, 0, 0, time.UTC),
+		},
+		{
+			name:        "friday night stays hibernated through the weekend",
+			hibernation: weeknights,
+			now:         time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC),
+			wantActive:  true,
+			wantNext:    time.Date(2026, 10, 19, 7, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "time zone shifts the window",
+			hibernation: &mlflowv1.HibernationConfig{
+				Schedule: weeknights.Schedule,
+				TimeZone: ptr("America/New_York"),
+			},
+			// 20:00 UTC is 16:00 in New York (EDT).
+			now:      time.Date(2026, 10, 14, 20, 0, 0, 0, time.UTC),
+			wantNext: time.Date(2026, 10, 14, 23, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "invalid time zone",
+			hibernation: &mlflowv1.HibernationConfig{
+				Schedule: weeknights.Schedule,
+				TimeZone: ptr("Mars/Olympus_Mons"),
+			},
+			now:     time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC),
+			wantErr: true,
+		},
+		{
+			name: "invalid cron expression",
+			hibernation: &mlflowv1.HibernationConfig{
+				Schedule: []mlflowv1.HibernationWindow{{Start: "0 25 * * *", End: "0 7 * * *"}},
+			},
+			now:     time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mlflow := &mlflowv1.MLflow{Spec: mlflowv1.MLflowSpec{Hibernation: tt.hibernation}}
+			state, err := evaluateHibernation(mlflow, tt.now)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("evaluateHibernation() expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("evaluateHibernation() error = %v", err)
+			}
+			if state.Active != tt.wantActive {
+				t.Errorf("Active = %v, want %v", state.Active, tt.wantActive)
+			}
+			if !state.NextTransition.Equal(tt.wantNext) {
+				t.Errorf("NextTransition = %v, want %v", state.NextTransition, tt.wantNext)
+			}
+		})
+	}
+}
+
+func TestHibernationResult(t *testing.T) {
+	now := time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC)
+	if got := hibernationResult(hibernationState{}, now); got.RequeueAfter != 0 {
+		t.Errorf("RequeueAfter without transition = %v, want 0", got.RequeueAfter)
+	}
+	got := hibernationResult(hibernationState{NextTransition: now.Add(7 * time.Hour)}, now)
+	if got.RequeueAfter != 7*time.Hour {
+		t.Errorf("RequeueAfter = %v, want 7h", got.RequeueAfter)
+	}
+}
@@ -0,0 +1,322 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
+)
+
+const (
+	// imageDigestCacheTTL bounds how long a resolved tag is reused before the registry is
+	// asked again, so periodic resyncs do not hit the registry on every reconcile.
+	imageDigestCacheTTL = 5 * time.Minute
+	// imageDigestTimeout bounds a single registry round trip.
+	imageDigestTimeout = 10 * time.Second
+
+	dockerHubRegistry    = "docker.io"
+	dockerHubAPIRegistry = "registry-1.docker.io"
+)
+
+// manifestAcceptTypes asks for the multi-arch index first so the resolved digest is the
+// same one `docker pull <tag>` records, not a single-platform manifest.
+var manifestAcceptTypes = []string{
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+}
+
+// imageReference is a parsed container image reference.
+type imageReference struct {
+	registry   string
+	repository string
+	tag        string
+	digest     string
+}
+
+// parseImageReference splits an image reference into registry, repository, tag and digest,
+// applying the Docker Hub defaults for unqualified names.
+func parseImageReference(image string) (imageReference, error) {
+	ref := imageReference{}
+	name := image
+	if at := strings.Index(name, "@"); at >= 0 {
+		ref.digest = name[at+1:]
+		name = name[:at]
+	}
+	if slash, colon := strings.LastIndex(name, "/"), strings.LastIndex(name, ":"); colon > slash {
+		ref.tag = name[colon+1:]
+		name = name[:colon]
+	}
+	if name == "" {
+		return imageReference{}, fmt.Errorf("invalid image reference %q", image)
+	}
+
+	first, rest, found := strings.Cut(name, "/")
+	if found && (strings.ContainsAny(first, ".:") || first == "localhost") {
+		ref.registry = first
+		ref.repository = rest
+	} else {
+		ref.registry = dockerHubRegistry
+		ref.repository = name
+	}
+	if ref.registry == dockerHubRegistry && !strings.Contains(ref.repository, "/") {
+		ref.repository = "library/" + ref.repository
+	}
+	if ref.tag == "" && ref.digest == "" {
+		ref.tag = "latest"
+	}
+	return ref, nil
+}
+
+type cachedDigest struct {
+	digest  string
+	expires time.Time
+}
+
+// imageDigestResolver resolves image tags to manifest digests, and images to the
+// architectures they are published for, through the registry's distribution API, using
+// anonymous bearer tokens where the registry requires them.
+type imageDigestResolver struct {
+	// client performs registry requests; nil uses a client with imageDigestTimeout.
+	client *http.Client
+	// now is overridable for tests; nil uses time.Now.
+	now func() time.Time
+
+	mu        sync.Mutex
+	cache     map[string]cachedDigest
+	archCache map[string]cachedArchitectures
+}
+
+// Resolve returns image pinned to its current digest. References that already carry a
+// digest are returned unchanged.
+func (r *imageDigestResolver) Resolve(ctx context.Context, image string) (string, error) {
+	ref, err := parseImageReference(image)
+	if err != nil {
+		return "", err
+	}
+	if ref.digest != "" {
+		return image, nil
+	}
+
+	// Keep the name as written so the rendered image differs only by the pinned digest.
+	nameOnly := image
+	if slash, colon := strings.LastIndex(image, "/"), strings.LastIndex(image, ":"); colon > slash {
+		nameOnly = image[:colon]
+	}
+
+	if digest, ok := r.cached(image); ok {
+		return nameOnly + "@" + digest, nil
+	}
+	digest, err := r.fetchDigest(ctx, ref)
+	if err != nil {
+		return "", fmt.Errorf("resolve digest for %s: %w", image, err)
+	}
+	r.store(image, digest)
+	return nameOnly + "@" + digest, nil
+}
+
+func (r *imageDigestResolver) cached(image string) (string, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	entry, ok := r.cache[image]
+	if !ok || !r.currentTime().Before(entry.expires) {
+		return "", false
+	}
+	return entry.digest, true
+}
+
+func (r *imageDigestResolver) store(image, digest string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.cache == nil {
+		r.cache = make(map[string]cachedDigest)
+	}
+	r.cache[image] = cachedDigest{digest: digest, expires: r.currentTime().Add(imageDigestCacheTTL)}
+}
+
+func (r *imageDigestResolver) currentTime() time.Time {
+	if r.now != nil {
+		return r.now()
+	}
+	return time.Now()
+}
+
+func (r *imageDigestResolver) httpClient() *http.Client {
+	if r.client != nil {
+		return r.client
+	}
+	return &http.Client{Timeout: imageDigestTimeout}
+}
+
+// registryURL returns the distribution API URL for a path under the reference's repository.
+func (ref imageReference) registryURL(path string) string {
+	host := ref.registry
+	if host == dockerHubRegistry {
+		host = dockerHubAPIRegistry
+	}
+	return fmt.Sprintf("https://%s/v2/%s/%s", host, ref.repository, path)
+}
+
+func (r *imageDigestResolver) fetchDigest(ctx context.Context, ref imageReference) (string, error) {
+	manifestURL := ref.registryURL("manifests/" + ref.tag)
+
+	resp, err := r.registryRequest(ctx, http.MethodHead, manifestURL)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("registry returned %s for %s", resp.Status, manifestURL)
+	}
+	digest := resp.Header.Get("Docker-Content-Digest")
+	if !strings.HasPrefix(digest, "sha256:") {
+		return "", fmt.Errorf("registry did not report a sha256 digest for %s", manifestURL)
+	}
+	return digest, nil
+}
+
+// registryRequest sends a distribution API request, retrying once with an anonymous token
+// when the registry challenges the first attempt.
+func (r *imageDigestResolver) registryRequest(ctx context.Context, method, requestURL string) (*http.Response, error) {
+	resp, err := r.sendRegistryRequest(ctx, method, requestURL, "")
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+	challenge := resp.Header.Get("WWW-Authenticate")
+	_ = resp.Body.Close()
+	token, err := r.anonymousToken(ctx, challenge)
+	if err != nil {
+		return nil, err
+	}
+	return r.sendRegistryRequest(ctx, method, requestURL, token)
+}
+
+func (r *imageDigestResolver) sendRegistryRequest(ctx context.Context, method, requestURL, token string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, requestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", strings.Join(manifestAcceptTypes, ", "))
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	return r.httpClient().Do(req)
+}
+
+// anonymousToken exchanges a Bearer WWW-Authenticate challenge for an anonymous pull token.
+func (r *imageDigestResolver) anonymousToken(ctx context.Context, challenge string) (string, error) {
+	scheme, params, _ := strings.Cut(challenge, " ")
+	if !strings.EqualFold(scheme, "Bearer") {
+		return "", fmt.Errorf("unsupported registry auth challenge %q", challenge)
+	}
+	fields := map[string]string{}
+	for _, part := range strings.Split(params, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if ok {
+			fields[key] = strings.Trim(value, `"`)
+		}
+	}
+	realm := fields["realm"]
+	if realm == "" {
+		return "", fmt.Errorf("registry auth challenge has no realm: %q", challenge)
+	}
+	tokenURL, err := url.Parse(realm)
+	if err != nil {
+		return "", fmt.Errorf("invalid registry auth realm %q: %w", realm, err)
+	}
+	query := tokenURL.Query()
+	for _, key := range []string{"service", "scope"} {
+		if fields[key] != "" {
+			query.Set(key, fields[key])
+		}
+	}
+	tokenURL.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, tokenURL.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := r.httpClient().Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("registry token endpoint returned %s", resp.Status)
+	}
+
+	var body struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", fmt.Errorf("decode registry token: %w", err)
+	}
+	if body.Token != "" {
+		return body.Token, nil
+	}
+	if body.AccessToken != "" {
+		return body.AccessToken, nil
+	}
+	return "", fmt.Errorf("registry token endpoint returned no token")
+}
+
+// resolveImageDigest returns the digest-pinned MLflow image when spec.image.resolveDigest is
+// enabled, or "" to render the configured image as-is.
+func (r *MLflowReconciler) resolveImageDigest(ctx context.Context, mlflow *mlflowv1.MLflow, cfg *config.OperatorConfig) (string, error) {
+	if mlflow.Spec.Image == nil || mlflow.Spec.Image.ResolveDigest == nil || !*mlflow.Spec.Image.ResolveDigest {
+		return "", nil
+	}
+	image, err := render.EffectiveMLflowImage(mlflow, cfg)
+	if err != nil {
+		return "", err
+	}
+	resolved, err := r.imageResolver.Resolve(ctx, image)
+	if err != nil {
+		return "", err
+	}
+	if previous := mlflow.Status.Image; strings.Contains(previous, "@") && previous != resolved {
+		logf.FromContext(ctx).Info("MLflow image digest changed, rolling out", "image", resolved, "previous", previous)
+	}
+	return resolved, nil
+}
+
+// imageDigestPollInterval returns when spec.image.digestPollInterval wants the image resolved
+// again, or zero when it is only resolved on reconciles caused by other changes.
+func imageDigestPollInterval(mlflow *mlflowv1.MLflow) time.Duration {
+	image := mlflow.Spec.Image
+	if image == nil || image.ResolveDigest == nil || !*image.ResolveDigest ||
+		image.DigestPollInterval == nil || image.DigestPollInterval.Duration <= 0 {
+		return 0
+	}
+	// Polling more often would only ever see the cached digest.
+	return max(image.DigestPollInterval.Duration, imageDigestCacheTTL)
+}
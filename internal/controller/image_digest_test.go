@@ -0,0 +1,192 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
+)
+
+const testImageDigest = "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+func TestParseImageReference(t *testing.T) {
+	tests := []struct {
+		image string
+		want  imageReference
+	}{
+		{
+			image: "quay.io/opendatahub/mlflow:main",
+			want:  imageReference{registry: "quay.io", repository: "opendatahub/mlflow", tag: "main"},
+		},
+		{
+			image: "localhost:5000/mlflow",
+			want:  imageReference{registry: "localhost:5000", repository: "mlflow", tag: "latest"},
+		},
+		{
+			image: "python:3.12",
+			want:  imageReference{registry: "docker.io", repository: "library/python", tag: "3.12"},
+		},
+		{
+			image: "quay.io/opendatahub/mlflow@" + testImageDigest,
+			want:  imageReference{registry: "quay.io", repository: "opendatahub/mlflow", digest: testImageDigest},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.image, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			got, err := parseImageReference(tt.image)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+// newTestRegistry serves a single manifest behind anonymous bearer-token auth and counts
+// manifest requests.
+func newTestRegistry(t *testing.T, manifestRequests *int) *httptest.Server {
+	t.Helper()
+	var server *httptest.Server
+	server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/token":
+			if r.URL.Query().Get("scope") != "repository:opendatahub/mlflow:pull" {
+				http.Error(w, "bad scope", http.StatusBadRequest)
+				return
+			}
+			_, _ = fmt.Fprint(w, `{"token":"anonymous"}`)
+		case r.URL.Path == "/v2/opendatahub/mlflow/manifests/main":
+			*manifestRequests++
+			if r.Header.Get("Authorization") != "Bearer anonymous" {
+				w.Header().Set("WWW-Authenticate", fmt.Sprintf(
+					`Bearer realm="%s/token",service="test-registry",scope="repository:opendatahub/mlflow:pull"`, server.URL))
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			if !strings.Contains(r.Header.Get("Accept"), "application/vnd.oci.image.index.v1+json") {
+				http.Error(w, "missing index accept type", http.StatusNotAcceptable)
+				return
+			}
+			w.Header().Set("Docker-Content-Digest", testImageDigest)
+			w.WriteHeader(http.StatusOK)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestImageDigestResolverResolvesAndCachesTags(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	var manifestRequests int
+	server := newTestRegistry(t, &manifestRequests)
+	host := strings.TrimPrefix(server.URL, "https://")
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	resolver := &imageDigestResolver{client: server.Client(), now: func() time.Time { return now }}
+	image := host + "/opendatahub/mlflow:main"
+
+	resolved, err := resolver.Resolve(context.Background(), image)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(resolved).To(gomega.Equal(host + "/opendatahub/mlflow@" + testImageDigest))
+	// One challenged request plus one authorized retry.
+	g.Expect(manifestRequests).To(gomega.Equal(2))
+
+	_, err = resolver.Resolve(context.Background(), image)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(manifestRequests).To(gomega.Equal(2), "cached digest should skip the registry")
+
+	now = now.Add(imageDigestCacheTTL)
+	_, err = resolver.Resolve(context.Background(), image)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(manifestRequests).To(gomega.Equal(4), "expired cache entry should query the registry again")
+}
+
+func TestImageDigestResolverReportsRegistryErrors(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	var manifestRequests int
+	server := newTestRegistry(t, &manifestRequests)
+	host := strings.TrimPrefix(server.URL, "https://")
+
+	resolver := &imageDigestResolver{client: server.Client()}
+	_, err := resolver.Resolve(context.Background(), host+"/opendatahub/missing:main")
+	g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("404")))
+
+	pinned := host + "/opendatahub/mlflow@" + testImageDigest
+	resolved, err := resolver.Resolve(context.Background(), pinned)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(resolved).To(gomega.Equal(pinned))
+}
+
+func TestResolveImageDigestOnlyWhenEnabled(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	var manifestRequests int
+	server := newTestRegistry(t, &manifestRequests)
+	host := strings.TrimPrefix(server.URL, "https://")
+
+	r := &MLflowReconciler{imageResolver: imageDigestResolver{client: server.Client()}}
+	cfg := &config.OperatorConfig{MLflowImage: host + "/opendatahub/mlflow:main"}
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "mlflow"}}
+
+	resolved, err := r.resolveImageDigest(context.Background(), mlflow, cfg)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(resolved).To(gomega.BeEmpty())
+	g.Expect(manifestRequests).To(gomega.Equal(0))
+
+	mlflow.Spec.Image = &mlflowv1.ImageConfig{ResolveDigest: ptr(true)}
+	resolved, err = r.resolveImageDigest(context.Background(), mlflow, cfg)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(resolved).To(gomega.Equal(host + "/opendatahub/mlflow@" + testImageDigest))
+
+	renderer := render.NewHelmRenderer("../../charts/mlflow")
+	mlflow.Spec.BackendStoreURI = ptr(testBackendStoreURI)
+	values, err := renderer.BuildValues(mlflow, "test-ns", render.RenderOptions{ResolvedImage: resolved}, cfg)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(values["image"]).To(gomega.HaveKeyWithValue("name", resolved))
+}
+
+func TestImageDigestPollInterval(t *testing.T) {
+	g := gomega.NewWithT(t)
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "mlflow"}}
+	g.Expect(imageDigestPollInterval(mlflow)).To(gomega.BeZero())
+
+	// The interval only applies together with resolveDigest.
+	mlflow.Spec.Image = &mlflowv1.ImageConfig{DigestPollInterval: &metav1.Duration{Duration: time.Hour}}
+	g.Expect(imageDigestPollInterval(mlflow)).To(gomega.BeZero())
+
+	mlflow.Spec.Image.ResolveDigest = ptr(true)
+	g.Expect(imageDigestPollInterval(mlflow)).To(gomega.Equal(time.Hour))
+
+	mlflow.Spec.Image.DigestPollInterval.Duration = time.Minute
+	g.Expect(imageDigestPollInterval(mlflow)).To(gomega.Equal(imageDigestCacheTTL))
+}
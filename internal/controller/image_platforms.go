@@ -0,0 +1,188 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"time"
+
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
+)
+
+// clusterArchitectures are the node architectures ODH clusters run on. An image published
+// for all of them needs no architecture affinity.
+var clusterArchitectures = []string{"amd64", "arm64", "ppc64le", "s390x"}
+
+type cachedArchitectures struct {
+	architectures []string
+	err           error
+	expires       time.Time
+}
+
+// imageManifest holds the fields of an image index or manifest needed to find its platforms.
+type imageManifest struct {
+	Manifests []struct {
+		Platform *struct {
+			Architecture string `json:"architecture"`
+			OS           string `json:"os"`
+		} `json:"platform,omitempty"`
+	} `json:"manifests,omitempty"`
+	Config *struct {
+		Digest string `json:"digest"`
+	} `json:"config,omitempty"`
+}
+
+// Architectures returns the sorted linux architectures image is published for. When the
+// registry cannot be reached, the last known result is reused until it can be refreshed.
+func (r *imageDigestResolver) Architectures(ctx context.Context, image string) ([]string, error) {
+	ref, err := parseImageReference(image)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	entry, found := r.archCache[image]
+	r.mu.Unlock()
+	if found && r.currentTime().Before(entry.expires) {
+		return entry.architectures, entry.err
+	}
+
+	architectures, err := r.fetchArchitectures(ctx, ref)
+	if err != nil {
+		if found && entry.err == nil {
+			// Keep scheduling stable across transient registry outages.
+			r.storeArchitectures(image, cachedArchitectures{architectures: entry.architectures})
+			return entry.architectures, nil
+		}
+		// Failures are cached too, so unreachable registries do not slow every reconcile.
+		err = fmt.Errorf("inspect architectures for %s: %w", image, err)
+		r.storeArchitectures(image, cachedArchitectures{err: err})
+		return nil, err
+	}
+	r.storeArchitectures(image, cachedArchitectures{architectures: architectures})
+	return architectures, nil
+}
+
+func (r *imageDigestResolver) storeArchitectures(image string, entry cachedArchitectures) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.archCache == nil {
+		r.archCache = make(map[string]cachedArchitectures)
+	}
+	entry.expires = r.currentTime().Add(imageDigestCacheTTL)
+	r.archCache[image] = entry
+}
+
+func (r *imageDigestResolver) fetchArchitectures(ctx context.Context, ref imageReference) ([]string, error) {
+	reference := ref.digest
+	if reference == "" {
+		reference = ref.tag
+	}
+	manifest := imageManifest{}
+	if err := r.getRegistryJSON(ctx, ref.registryURL("manifests/"+reference), &manifest); err != nil {
+		return nil, err
+	}
+
+	var architectures []string
+	switch {
+	case len(manifest.Manifests) > 0:
+		for _, entry := range manifest.Manifests {
+			// Attestation manifests are listed with an "unknown" platform.
+			if entry.Platform == nil || entry.Platform.OS != "linux" || entry.Platform.Architecture == "" {
+				continue
+			}
+			if !slices.Contains(architectures, entry.Platform.Architecture) {
+				architectures = append(architectures, entry.Platform.Architecture)
+			}
+		}
+	case manifest.Config != nil && manifest.Config.Digest != "":
+		// Single-platform manifests record their architecture in the config blob.
+		imageConfig := struct {
+			Architecture string `json:"architecture"`
+		}{}
+		if err := r.getRegistryJSON(ctx, ref.registryURL("blobs/"+manifest.Config.Digest), &imageConfig); err != nil {
+			return nil, err
+		}
+		if imageConfig.Architecture != "" {
+			architectures = append(architectures, imageConfig.Architecture)
+		}
+	}
+	if len(architectures) == 0 {
+		return nil, fmt.Errorf("registry manifest lists no linux platforms")
+	}
+	sort.Strings(architectures)
+	return architectures, nil
+}
+
+func (r *imageDigestResolver) getRegistryJSON(ctx context.Context, requestURL string, into interface{}) error {
+	resp, err := r.registryRequest(ctx, http.MethodGet, requestURL)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("registry returned %s for %s", resp.Status, requestURL)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(into); err != nil {
+		return fmt.Errorf("decode %s: %w", requestURL, err)
+	}
+	return nil
+}
+
+// architectureAffinityEnabled reports whether spec.image.architectureAffinity allows the
+// operator to constrain scheduling by image architecture. It is on by default.
+func architectureAffinityEnabled(mlflow *mlflowv1.MLflow) bool {
+	return mlflow.Spec.Image == nil || mlflow.Spec.Image.ArchitectureAffinity == nil || *mlflow.Spec.Image.ArchitectureAffinity
+}
+
+// imageArchitectures returns the architectures MLflow pods must be scheduled on, or nil when
+// the image covers every cluster architecture, the behavior is disabled, or the image
+// cannot be inspected. Inspection failures never block a reconcile.
+func (r *MLflowReconciler) imageArchitectures(ctx context.Context, mlflow *mlflowv1.MLflow, cfg *config.OperatorConfig, resolvedImage string) []string {
+	if !architectureAffinityEnabled(mlflow) {
+		return nil
+	}
+	image := resolvedImage
+	if image == "" {
+		var err error
+		if image, err = render.EffectiveMLflowImage(mlflow, cfg); err != nil {
+			return nil
+		}
+	}
+
+	architectures, err := r.imageResolver.Architectures(ctx, image)
+	if err != nil {
+		logf.FromContext(ctx).Info("Could not inspect MLflow image architectures; rendering without architecture affinity",
+			"image", image, "error", err.Error())
+		return nil
+	}
+	for _, arch := range clusterArchitectures {
+		if !slices.Contains(architectures, arch) {
+			return architectures
+		}
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+)
+
+const testConfigDigest = "sha256:fedcba9876543210fedcba9876543210fedcba9876543210fedcba9876543210"
+
+// newTestPlatformRegistry serves a multi-arch index under :multi, a single-platform
+// manifest under :single, and every other tag as missing.
+func newTestPlatformRegistry(t *testing.T, manifestRequests *int) *httptest.Server {
+	t.Helper()
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/opendatahub/mlflow/manifests/multi":
+			*manifestRequests++
+			_, _ = fmt.Fprint(w, `{"manifests":[
+				{"platform":{"architecture":"arm64","os":"linux"}},
+				{"platform":{"architecture":"amd64","os":"linux"}},
+				{"platform":{"architecture":"unknown","os":"unknown"}}]}`)
+		case "/v2/opendatahub/mlflow/manifests/single":
+			*manifestRequests++
+			_, _ = fmt.Fprintf(w, `{"config":{"digest":%q}}`, testConfigDigest)
+		case "/v2/opendatahub/mlflow/blobs/" + testConfigDigest:
+			_, _ = fmt.Fprint(w, `{"architecture":"amd64","os":"linux"}`)
+		default:
+			*manifestRequests++
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestImageArchitecturesFromIndexAndManifest(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	var manifestRequests int
+	server := newTestPlatformRegistry(t, &manifestRequests)
+	host := strings.TrimPrefix(server.URL, "https://")
+	resolver := &imageDigestResolver{client: server.Client()}
+
+	architectures, err := resolver.Architectures(context.Background(), host+"/opendatahub/mlflow:multi")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(architectures).To(gomega.Equal([]string{"amd64", "arm64"}))
+
+	architectures, err = resolver.Architectures(context.Background(), host+"/opendatahub/mlflow:single")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(architectures).To(gomega.Equal([]string{"amd64"}))
+}
+
+func TestImageArchitecturesCachesResultsAndFailures(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	var manifestRequests int
+	server := newTestPlatformRegistry(t, &manifestRequests)
+	host := strings.TrimPrefix(server.URL, "https://")
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	resolver := &imageDigestResolver{client: server.Client(), now: func() time.Time { return now }}
+
+	_, err := resolver.Architectures(context.Background(), host+"/opendatahub/mlflow:missing")
+	g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("404")))
+	_, err = resolver.Architectures(context.Background(), host+"/opendatahub/mlflow:missing")
+	g.Expect(err).To(gomega.HaveOccurred())
+	g.Expect(manifestRequests).To(gomega.Equal(1), "failures should be cached")
+
+	image := host + "/opendatahub/mlflow:multi"
+	_, err = resolver.Architectures(context.Background(), image)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(manifestRequests).To(gomega.Equal(2))
+
+	// An unreachable registry keeps the last known architectures.
+	server.Close()
+	now = now.Add(imageDigestCacheTTL)
+	architectures, err := resolver.Architectures(context.Background(), image)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(architectures).To(gomega.Equal([]string{"amd64", "arm64"}))
+}
+
+func TestReconcilerImageArchitectures(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	var manifestRequests int
+	server := newTestPlatformRegistry(t, &manifestRequests)
+	host := strings.TrimPrefix(server.URL, "https://")
+
+	r := &MLflowReconciler{imageResolver: imageDigestResolver{client: server.Client()}}
+	cfg := &config.OperatorConfig{MLflowImage: host + "/opendatahub/mlflow:multi"}
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "mlflow"}}
+
+	g.Expect(r.imageArchitectures(context.Background(), mlflow, cfg, "")).To(gomega.Equal([]string{"amd64", "arm64"}))
+	g.Expect(r.imageArchitectures(context.Background(), mlflow, cfg, host+"/opendatahub/mlflow:missing")).To(gomega.BeNil())
+
+	mlflow.Spec.Image = &mlflowv1.ImageConfig{ArchitectureAffinity: ptr(false)}
+	g.Expect(r.imageArchitectures(context.Background(), mlflow, cfg, "")).To(gomega.BeNil())
+}
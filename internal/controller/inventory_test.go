@@ -0,0 +1,52 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func TestBuildInventory(t *testing.T) {
+	g := gomega.NewWithT(t)
+	object := func(apiVersion, kind, namespace, name, uid string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion(apiVersion)
+		obj.SetKind(kind)
+		obj.SetNamespace(namespace)
+		obj.SetName(name)
+		obj.SetUID(types.UID(uid))
+		return obj
+	}
+
+	inventory := buildInventory([]*unstructured.Unstructured{
+		object("v1", "Service", "opendatahub", "mlflow", "3"),
+		object("apps/v1", "Deployment", "opendatahub", "mlflow", "1"),
+		object("rbac.authorization.k8s.io/v1", "ClusterRole", "", "mlflow", "2"),
+	})
+	g.Expect(inventory).To(gomega.Equal([]mlflowv1.ManagedResource{
+		{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "ClusterRole", Name: "mlflow", UID: "2"},
+		{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "opendatahub", Name: "mlflow", UID: "1"},
+		{APIVersion: "v1", Kind: "Service", Namespace: "opendatahub", Name: "mlflow", UID: "3"},
+	}))
+	g.Expect(buildInventory(nil)).To(gomega.BeEmpty())
+}
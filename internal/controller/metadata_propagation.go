@@ -0,0 +1,84 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+// unpropagatedPrefixes are key prefixes never copied from the MLflow resource: kubectl's
+// bookkeeping and the operator's own control annotations only mean something on the CR.
+var unpropagatedPrefixes = []string{"kubectl.kubernetes.io/", "mlflow.opendatahub.io/"}
+
+// selectPropagated returns the entries of source selected by selectors, where each selector is
+// an exact key or a prefix ending in "*".
+func selectPropagated(source map[string]string, selectors []string) map[string]string {
+	if len(source) == 0 || len(selectors) == 0 {
+		return nil
+	}
+	selected := make(map[string]string)
+	for key, value := range source {
+		if hasAnyPrefix(key, unpropagatedPrefixes) {
+			continue
+		}
+		for _, selector := range selectors {
+			prefix, isPrefix := strings.CutSuffix(selector, "*")
+			if key == selector || (isPrefix && strings.HasPrefix(key, prefix)) {
+				selected[key] = value
+				break
+			}
+		}
+	}
+	return selected
+}
+
+func hasAnyPrefix(key string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// propagateMetadata copies the labels and annotations selected by spec.propagateLabels and
+// spec.propagateAnnotations onto obj. Keys obj already sets are kept, so the operator's own
+// labels, such as the app label the caches select on, always win.
+func propagateMetadata(mlflow *mlflowv1.MLflow, obj metav1.Object) {
+	if labels := selectPropagated(mlflow.Labels, mlflow.Spec.PropagateLabels); len(labels) > 0 {
+		obj.SetLabels(mergeMissing(obj.GetLabels(), labels))
+	}
+	if annotations := selectPropagated(mlflow.Annotations, mlflow.Spec.PropagateAnnotations); len(annotations) > 0 {
+		obj.SetAnnotations(mergeMissing(obj.GetAnnotations(), annotations))
+	}
+}
+
+func mergeMissing(existing, additions map[string]string) map[string]string {
+	if existing == nil {
+		existing = make(map[string]string, len(additions))
+	}
+	for key, value := range additions {
+		if _, ok := existing[key]; !ok {
+			existing[key] = value
+		}
+	}
+	return existing
+}
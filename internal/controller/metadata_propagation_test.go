@@ -0,0 +1,73 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func TestPropagateMetadata(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "mlflow",
+			Labels: map[string]string{
+				"cost-center":                  "ml-platform",
+				"finops.example.com/team":      "data-science",
+				"finops.example.com/env":       "prod",
+				"app":                          "not-mlflow",
+				"unselected":                   "value",
+				"mlflow.opendatahub.io/tenant": "internal",
+			},
+			Annotations: map[string]string{
+				"owner.example.com/contact":                        "ml-team@example.com",
+				"kubectl.kubernetes.io/last-applied-configuration": "{}",
+			},
+		},
+		Spec: mlflowv1.MLflowSpec{
+			PropagateLabels:      []string{"cost-center", "finops.example.com/*", "app", "mlflow.opendatahub.io/*"},
+			PropagateAnnotations: []string{"*"},
+		},
+	}
+	obj := newApplyOrderTestObject("apps/v1", "Deployment", "mlflow")
+	obj.SetLabels(map[string]string{"app": "mlflow"})
+
+	propagateMetadata(mlflow, obj)
+
+	g.Expect(obj.GetLabels()).To(gomega.Equal(map[string]string{
+		"app":                     "mlflow",
+		"cost-center":             "ml-platform",
+		"finops.example.com/team": "data-science",
+		"finops.example.com/env":  "prod",
+	}), "operator labels win and unselected or reserved keys stay on the CR")
+	g.Expect(obj.GetAnnotations()).To(gomega.Equal(map[string]string{
+		"owner.example.com/contact": "ml-team@example.com",
+	}))
+
+	mlflow.Spec.PropagateLabels = nil
+	mlflow.Spec.PropagateAnnotations = nil
+	untouched := newApplyOrderTestObject("v1", "Service", "mlflow")
+	propagateMetadata(mlflow, untouched)
+	g.Expect(untouched.GetLabels()).To(gomega.BeNil())
+	g.Expect(untouched.GetAnnotations()).To(gomega.BeNil())
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// Reconciler performance metrics, served on the operator's /metrics endpoint
+// alongside the controller-runtime defaults.
+var (
+	renderDurationSeconds = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "mlflow_operator_render_duration_seconds",
+		Help:    "Time taken to render the MLflow Helm chart.",
+		Buckets: prometheus.ExponentialBuckets(0.005, 2, 12),
+	})
+	renderedManifests = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "mlflow_operator_rendered_manifests",
+		Help:    "Number of manifests produced by a chart render.",
+		Buckets: prometheus.LinearBuckets(5, 5, 10),
+	})
+	applyDurationSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "mlflow_operator_apply_duration_seconds",
+		Help:    "Latency of server-side apply requests for managed objects.",
+		Buckets: prometheus.ExponentialBuckets(0.005, 2, 12),
+	}, []string{"kind", "result"})
+	dryRunDurationSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "mlflow_operator_dry_run_duration_seconds",
+		Help:    "Latency of dry-run server-side apply requests that validate rendered objects before applying them.",
+		Buckets: prometheus.ExponentialBuckets(0.005, 2, 12),
+	}, []string{"kind", "result"})
+	applyErrorsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "mlflow_operator_apply_errors_total",
+		Help: "Number of failed server-side apply requests for managed objects, by kind and failure reason.",
+	}, []string{"kind", "reason", "dry_run"})
+	applySkippedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "mlflow_operator_apply_skipped_total",
+		Help: "Number of server-side apply requests skipped because the live object already matched.",
+	}, []string{"kind"})
+	renderCacheHits = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "mlflow_operator_render_cache_hits_total",
+		Help: "Number of reconciles that reused cached rendered manifests instead of rendering the chart.",
+	})
+)
+
+// Usage statistics of MLflow instances with spec.usageStatistics, as reported in status.usage.
+var (
+	usageExperiments = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mlflow_operator_usage_experiments",
+		Help: "Number of active experiments across all workspaces of an MLflow instance.",
+	}, []string{"name"})
+	usageRunsLast24h = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mlflow_operator_usage_runs_last_24h",
+		Help: "Number of active runs started in the last 24 hours across all workspaces of an MLflow instance.",
+	}, []string{"name"})
+	usageRegisteredModels = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mlflow_operator_usage_registered_models",
+		Help: "Number of registered models across all workspaces of an MLflow instance.",
+	}, []string{"name"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		renderDurationSeconds, renderedManifests, applyDurationSeconds, dryRunDurationSeconds,
+		applyErrorsTotal, applySkippedTotal, renderCacheHits,
+		usageExperiments, usageRunsLast24h, usageRegisteredModels,
+	)
+}
+
+// applyResult returns the result label for an apply attempt.
+func applyResult(err error) string {
+	if err != nil {
+		return "error"
+	}
+	return "success"
+}
+
+// observeApply records the latency and, on failure, the failure reason of one server-side
+// apply request for an object of kind.
+func observeApply(kind string, dryRun bool, seconds float64, err error) {
+	duration := applyDurationSeconds
+	if dryRun {
+		duration = dryRunDurationSeconds
+	}
+	duration.WithLabelValues(kind, applyResult(err)).Observe(seconds)
+	if err != nil {
+		applyErrorsTotal.WithLabelValues(kind, applyErrorReason(err), strconv.FormatBool(dryRun)).Inc()
+	}
+}
+
+// applyErrorReason classifies an apply failure for the reason label. Admission webhooks are
+// told apart from the API server's own rejections, since a slow or failing webhook is the
+// usual cause of apply errors that only affect one kind.
+func applyErrorReason(err error) string {
+	message := err.Error()
+	switch {
+	case strings.Contains(message, "failed calling webhook"):
+		return "WebhookFailed"
+	case strings.Contains(message, "admission webhook") && strings.Contains(message, "denied the request"):
+		return "WebhookDenied"
+	case errors.Is(err, context.DeadlineExceeded) || apierrors.IsTimeout(err) || apierrors.IsServerTimeout(err):
+		return "Timeout"
+	}
+	if reason := apierrors.ReasonForError(err); reason != "" {
+		return string(reason)
+	}
+	return "Unknown"
+}
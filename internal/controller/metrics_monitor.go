@@ -0,0 +1,85 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
+)
+
+func alertRulesName(mlflow *mlflowv1.MLflow) string {
+	return "mlflow-alerts" + render.ResourceSuffix(mlflow.Name)
+}
+
+func metricsMonitorName(mlflow *mlflowv1.MLflow) string {
+	return "mlflow-metrics-monitor" + render.ResourceSuffix(mlflow.Name)
+}
+
+// deleteStaleMetricsMonitor removes the ServiceMonitor after switching to spec.metrics.podMonitor,
+// and the PodMonitor after switching back, so Prometheus never scrapes the pods twice.
+func (r *MLflowReconciler) deleteStaleMetricsMonitor(ctx context.Context, mlflow *mlflowv1.MLflow, namespace string) error {
+	var stale client.Object
+	switch {
+	case render.PodMonitorEnabled(mlflow, r.PodMonitorAvailable):
+		if !r.ServiceMonitorAvailable {
+			return nil
+		}
+		stale = &monitoringv1.ServiceMonitor{}
+	case r.PodMonitorAvailable:
+		stale = &monitoringv1.PodMonitor{}
+	default:
+		return nil
+	}
+
+	// The cached lookup keeps this free while nothing is stale.
+	err := r.Get(ctx, types.NamespacedName{Name: metricsMonitorName(mlflow), Namespace: namespace}, stale)
+	if errors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to get metrics monitor %T: %w", stale, err)
+	}
+	if err := r.Delete(ctx, stale); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete metrics monitor %T: %w", stale, err)
+	}
+	logf.FromContext(ctx).Info("Deleted stale metrics monitor", "name", stale.GetName(), "kind", fmt.Sprintf("%T", stale))
+	return nil
+}
+
+// deleteAlertRules removes the default PrometheusRule after spec.alerts is disabled.
+func (r *MLflowReconciler) deleteAlertRules(ctx context.Context, mlflow *mlflowv1.MLflow, namespace string) error {
+	rule := &monitoringv1.PrometheusRule{}
+	err := r.Get(ctx, types.NamespacedName{Name: alertRulesName(mlflow), Namespace: namespace}, rule)
+	if errors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to get PrometheusRule: %w", err)
+	}
+	if err := r.Delete(ctx, rule); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete PrometheusRule: %w", err)
+	}
+	logf.FromContext(ctx).Info("Deleted alerting rules", "name", rule.Name)
+	return nil
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func TestDeleteStaleMetricsMonitor(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(monitoringv1.AddToScheme(scheme)).To(gomega.Succeed())
+	objectMeta := metav1.ObjectMeta{Name: "mlflow-metrics-monitor", Namespace: "test-ns"}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&monitoringv1.ServiceMonitor{ObjectMeta: objectMeta},
+		&monitoringv1.PodMonitor{ObjectMeta: objectMeta},
+	).Build()
+	r := &MLflowReconciler{Client: k8sClient, Scheme: scheme, ServiceMonitorAvailable: true, PodMonitorAvailable: true}
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "mlflow"}}
+	ctx := context.Background()
+	key := types.NamespacedName{Name: "mlflow-metrics-monitor", Namespace: "test-ns"}
+
+	g.Expect(r.deleteStaleMetricsMonitor(ctx, mlflow, "test-ns")).To(gomega.Succeed())
+	g.Expect(apierrors.IsNotFound(k8sClient.Get(ctx, key, &monitoringv1.PodMonitor{}))).To(gomega.BeTrue())
+	g.Expect(k8sClient.Get(ctx, key, &monitoringv1.ServiceMonitor{})).To(gomega.Succeed())
+
+	mlflow.Spec.Metrics = &mlflowv1.MetricsConfig{PodMonitor: &mlflowv1.PodMonitorConfig{Enabled: true}}
+	g.Expect(r.deleteStaleMetricsMonitor(ctx, mlflow, "test-ns")).To(gomega.Succeed())
+	g.Expect(apierrors.IsNotFound(k8sClient.Get(ctx, key, &monitoringv1.ServiceMonitor{}))).To(gomega.BeTrue())
+	g.Expect(r.deleteStaleMetricsMonitor(ctx, mlflow, "test-ns")).To(gomega.Succeed())
+}
+
+func TestDeleteAlertRules(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(monitoringv1.AddToScheme(scheme)).To(gomega.Succeed())
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&monitoringv1.PrometheusRule{ObjectMeta: metav1.ObjectMeta{Name: "mlflow-alerts", Namespace: "test-ns"}},
+	).Build()
+	r := &MLflowReconciler{Client: k8sClient, Scheme: scheme, PrometheusRuleAvailable: true}
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "mlflow"}}
+	ctx := context.Background()
+
+	g.Expect(r.deleteAlertRules(ctx, mlflow, "test-ns")).To(gomega.Succeed())
+	err := k8sClient.Get(ctx, types.NamespacedName{Name: "mlflow-alerts", Namespace: "test-ns"}, &monitoringv1.PrometheusRule{})
+	g.Expect(apierrors.IsNotFound(err)).To(gomega.BeTrue())
+	g.Expect(r.deleteAlertRules(ctx, mlflow, "test-ns")).To(gomega.Succeed())
+}
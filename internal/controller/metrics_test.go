@@ -0,0 +1,220 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
+)
+
+// histogramSamples returns the total sample count and sum of a registered histogram
+// family, optionally restricted to series carrying the given label values.
+func histogramSamples(t *testing.T, name string, labels map[string]string) (uint64, float64) {
+	t.Helper()
+	families, err := metrics.Registry.Gather()
+	if err != nil {
+		t.Fatalf("gather metrics: %v", err)
+	}
+	var count uint64
+	var sum float64
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+	series:
+		for _, m := range family.GetMetric() {
+			for _, lp := range m.GetLabel() {
+				if want, ok := labels[lp.GetName()]; ok && want != lp.GetValue() {
+					continue series
+				}
+			}
+			count += m.GetHistogram().GetSampleCount()
+			sum += m.GetHistogram().GetSampleSum()
+		}
+	}
+	return count, sum
+}
+
+// labeledCounterValue returns the sum of a registered counter family over the series carrying the
+// given label values.
+func labeledCounterValue(t *testing.T, name string, labels map[string]string) float64 {
+	t.Helper()
+	families, err := metrics.Registry.Gather()
+	if err != nil {
+		t.Fatalf("gather metrics: %v", err)
+	}
+	var value float64
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+	series:
+		for _, m := range family.GetMetric() {
+			for _, lp := range m.GetLabel() {
+				if want, ok := labels[lp.GetName()]; ok && want != lp.GetValue() {
+					continue series
+				}
+			}
+			value += m.GetCounter().GetValue()
+		}
+	}
+	return value
+}
+
+func TestRenderChartRecordsMetrics(t *testing.T) {
+	beforeRenders, _ := histogramSamples(t, "mlflow_operator_render_duration_seconds", nil)
+	beforeManifests, beforeManifestSum := histogramSamples(t, "mlflow_operator_rendered_manifests", nil)
+
+	reconciler := &MLflowReconciler{}
+	objs, _, err := reconciler.renderChart(render.NewHelmRenderer("../../charts/mlflow"), &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec:       mlflowv1.MLflowSpec{BackendStoreURI: ptr(testBackendStoreURI)},
+	}, "test-ns", render.RenderOptions{}, nil)
+	if err != nil {
+		t.Fatalf("renderChart() error = %v", err)
+	}
+
+	renders, _ := histogramSamples(t, "mlflow_operator_render_duration_seconds", nil)
+	if renders != beforeRenders+1 {
+		t.Errorf("render duration samples = %d, want %d", renders, beforeRenders+1)
+	}
+	manifests, manifestSum := histogramSamples(t, "mlflow_operator_rendered_manifests", nil)
+	if manifests != beforeManifests+1 || manifestSum-beforeManifestSum != float64(len(objs)) {
+		t.Errorf("rendered manifests samples = %d (sum delta %v), want %d (sum delta %d)",
+			manifests, manifestSum-beforeManifestSum, beforeManifests+1, len(objs))
+	}
+}
+
+func TestApplyObjectRecordsMetrics(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add core scheme: %v", err)
+	}
+	applyErr := errors.New("apply rejected")
+	c := fake.NewClientBuilder().WithScheme(scheme).WithInterceptorFuncs(interceptor.Funcs{
+		Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+			return applyErr
+		},
+	}).Build()
+	reconciler := &MLflowReconciler{Client: c, Scheme: scheme}
+
+	labels := map[string]string{"kind": "ConfigMap", "result": "error"}
+	before, _ := histogramSamples(t, "mlflow_operator_apply_duration_seconds", labels)
+
+	cm := &corev1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{Name: "metrics-test", Namespace: "test-ns"},
+	}
+	if err := reconciler.applyObject(context.Background(), cm); !errors.Is(err, applyErr) {
+		t.Fatalf("applyObject() error = %v, want %v", err, applyErr)
+	}
+
+	after, _ := histogramSamples(t, "mlflow_operator_apply_duration_seconds", labels)
+	if after != before+1 {
+		t.Errorf("apply duration samples for %v = %d, want %d", labels, after, before+1)
+	}
+}
+
+func TestDryRunObjectRecordsMetrics(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add core scheme: %v", err)
+	}
+	denied := apierrors.NewForbidden(schema.GroupResource{Resource: "configmaps"}, "metrics-test",
+		errors.New(`admission webhook "validate.example.com" denied the request: not allowed`))
+	c := fake.NewClientBuilder().WithScheme(scheme).WithInterceptorFuncs(interceptor.Funcs{
+		Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+			return denied
+		},
+	}).Build()
+	reconciler := &MLflowReconciler{Client: c, Scheme: scheme}
+
+	durationLabels := map[string]string{"kind": "ConfigMap", "result": "error"}
+	errorLabels := map[string]string{"kind": "ConfigMap", "reason": "WebhookDenied", "dry_run": "true"}
+	beforeDryRuns, _ := histogramSamples(t, "mlflow_operator_dry_run_duration_seconds", durationLabels)
+	beforeApplies, _ := histogramSamples(t, "mlflow_operator_apply_duration_seconds", durationLabels)
+	beforeErrors := labeledCounterValue(t, "mlflow_operator_apply_errors_total", errorLabels)
+
+	cm := &unstructured.Unstructured{}
+	cm.SetAPIVersion("v1")
+	cm.SetKind("ConfigMap")
+	cm.SetName("metrics-test")
+	cm.SetNamespace("test-ns")
+	if err := reconciler.dryRunObject(context.Background(), cm, nil); !errors.Is(err, denied) {
+		t.Fatalf("dryRunObject() error = %v, want %v", err, denied)
+	}
+
+	if after, _ := histogramSamples(t, "mlflow_operator_dry_run_duration_seconds", durationLabels); after != beforeDryRuns+1 {
+		t.Errorf("dry-run duration samples = %d, want %d", after, beforeDryRuns+1)
+	}
+	if after, _ := histogramSamples(t, "mlflow_operator_apply_duration_seconds", durationLabels); after != beforeApplies {
+		t.Errorf("apply duration samples = %d, want %d; dry runs must not count as applies", after, beforeApplies)
+	}
+	if after := labeledCounterValue(t, "mlflow_operator_apply_errors_total", errorLabels); after != beforeErrors+1 {
+		t.Errorf("apply errors for %v = %v, want %v", errorLabels, after, beforeErrors+1)
+	}
+}
+
+func TestApplyErrorReason(t *testing.T) {
+	configMaps := schema.GroupResource{Resource: "configmaps"}
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{
+			name: "webhook call failed",
+			err: apierrors.NewInternalError(errors.New(`failed calling webhook "validate.example.com": ` +
+				`context deadline exceeded`)),
+			want: "WebhookFailed",
+		},
+		{
+			name: "webhook denied",
+			err: apierrors.NewForbidden(configMaps, "mlflow",
+				errors.New(`admission webhook "validate.example.com" denied the request: not allowed`)),
+			want: "WebhookDenied",
+		},
+		{name: "client timeout", err: fmt.Errorf("patch: %w", context.DeadlineExceeded), want: "Timeout"},
+		{name: "server timeout", err: apierrors.NewServerTimeout(configMaps, "patch", 1), want: "Timeout"},
+		{name: "invalid", err: apierrors.NewInvalid(schema.GroupKind{Kind: "ConfigMap"}, "mlflow", nil), want: "Invalid"},
+		{name: "conflict", err: apierrors.NewConflict(configMaps, "mlflow", errors.New("modified")), want: "Conflict"},
+		{name: "not an API error", err: errors.New("connection refused"), want: "Unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := applyErrorReason(tt.err); got != tt.want {
+				t.Errorf("applyErrorReason() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
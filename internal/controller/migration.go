@@ -42,6 +42,8 @@ import (
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
 )
 
 const (
@@ -80,9 +82,6 @@ const (
 // be embedded safely in a DNS label for migration Job names.
 var versionKeyPattern = regexp.MustCompile(`[^a-zA-Z0-9]+`)
 
-// SupportedMLflowVersion is injected via -ldflags from config/component_metadata.yaml.
-var SupportedMLflowVersion string
-
 //go:embed assets/mlflow_db_migrate.py
 var migrationPythonScript string
 
@@ -151,7 +150,7 @@ func migrationRequested(mlflow *mlflowv1.MLflow) bool {
 		return true
 	}
 
-	if mlflow.Status.Version != SupportedMLflowVersion {
+	if mlflow.Status.Version != config.SupportedMLflowVersion {
 		return true
 	}
 
@@ -166,6 +165,13 @@ func migrationRequested(mlflow *mlflowv1.MLflow) bool {
 	return false
 }
 
+// managedMigrationInGeneration reports whether an operator-managed migration was requested or
+// has run for the current generation. It stays true after the migration succeeds, so pod
+// settings derived from it do not change again until the next generation.
+func managedMigrationInGeneration(mlflow *mlflowv1.MLflow) bool {
+	return migrationRequested(mlflow) || currentGenerationMigrationCondition(mlflow) != nil
+}
+
 func migrationMode(mlflow *mlflowv1.MLflow) mlflowv1.MLflowMigrateMode {
 	if mlflow.Spec.Migration == nil || mlflow.Spec.Migration.Mode == "" {
 		return mlflowv1.MLflowMigrateAutomatic
@@ -174,11 +180,11 @@ func migrationMode(mlflow *mlflowv1.MLflow) mlflowv1.MLflowMigrateMode {
 }
 
 func supportedVersionEarlierThanStatusVersion(mlflow *mlflowv1.MLflow) bool {
-	if mlflow.Status.Version == "" || SupportedMLflowVersion == "" {
+	if mlflow.Status.Version == "" || config.SupportedMLflowVersion == "" {
 		return false
 	}
 
-	supportedVersion, err := semver.NewVersion(strings.TrimPrefix(SupportedMLflowVersion, "v"))
+	supportedVersion, err := semver.NewVersion(strings.TrimPrefix(config.SupportedMLflowVersion, "v"))
 	if err != nil {
 		return false
 	}
@@ -190,13 +196,13 @@ func supportedVersionEarlierThanStatusVersion(mlflow *mlflowv1.MLflow) bool {
 }
 
 func migrationJobName(mlflow *mlflowv1.MLflow) string {
-	versionKey := strings.TrimPrefix(strings.ToLower(versionKeyPattern.ReplaceAllString(SupportedMLflowVersion, "")), "v")
+	versionKey := strings.TrimPrefix(strings.ToLower(versionKeyPattern.ReplaceAllString(config.SupportedMLflowVersion, "")), "v")
 	if versionKey == "" {
 		versionKey = "unknown"
 	}
 
 	suffix := fmt.Sprintf("-mg-%s-g%d", versionKey, mlflow.Generation)
-	base := ResourceName + getResourceSuffix(mlflow.Name)
+	base := ResourceName + render.ResourceSuffix(mlflow.Name)
 	if len(base) > 63-len(suffix) {
 		base = base[:63-len(suffix)]
 	}
@@ -251,7 +257,7 @@ func isJobFinished(job *batchv1.Job) bool {
 func migrationFailureMessageForExitCode(exitCode int32) (string, bool) {
 	switch exitCode {
 	case migrationScriptExitCodeVersionMismatch:
-		return fmt.Sprintf("migration image reports an unexpected MLflow version; expected %s", SupportedMLflowVersion), true
+		return fmt.Sprintf("migration image reports an unexpected MLflow version; expected %s", config.SupportedMLflowVersion), true
 	case migrationScriptExitCodeUnsupportedBackend:
 		return "operator-managed migration only supports SQL backend store URIs", true
 	case migrationScriptExitCodeUnsupportedRegistry:
@@ -345,7 +351,7 @@ func describeMigrationTrigger(mlflow *mlflowv1.MLflow) migrationTrigger {
 	default:
 		return migrationTrigger{
 			kind:   "version-upgrade",
-			detail: fmt.Sprintf("status.version=%q does not match supported version %s", mlflow.Status.Version, SupportedMLflowVersion),
+			detail: fmt.Sprintf("status.version=%q does not match supported version %s", mlflow.Status.Version, config.SupportedMLflowVersion),
 		}
 	}
 }
@@ -498,13 +504,13 @@ func (r *MLflowReconciler) markMigrationSuccessful(ctx context.Context, mlflow *
 	}
 
 	currentCondition := currentGenerationMigrationCondition(mlflow)
-	if mlflow.Status.Version == SupportedMLflowVersion &&
+	if mlflow.Status.Version == config.SupportedMLflowVersion &&
 		currentCondition != nil &&
 		currentCondition.Status == metav1.ConditionTrue {
 		return nil
 	}
 
-	mlflow.Status.Version = SupportedMLflowVersion
+	mlflow.Status.Version = config.SupportedMLflowVersion
 	meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
 		Type:               migrationConditionType,
 		Status:             metav1.ConditionTrue,
@@ -565,7 +571,7 @@ func buildMigrationJobFromDeployment(mlflow *mlflowv1.MLflow, deployment *appsv1
 	})
 	jobContainer.Env = append(jobContainer.Env, corev1.EnvVar{
 		Name:  supportedVersionEnvName,
-		Value: SupportedMLflowVersion,
+		Value: config.SupportedMLflowVersion,
 	})
 
 	podSpec.Containers = []corev1.Container{*jobContainer}
@@ -662,7 +668,7 @@ func (r *MLflowReconciler) handleMigration(ctx context.Context, mlflow *mlflowv1
 		log.Info(
 			"Recorded MLflow version is newer than this operator's supported version",
 			"statusVersion", mlflow.Status.Version,
-			"supportedVersion", SupportedMLflowVersion,
+			"supportedVersion", config.SupportedMLflowVersion,
 			"generation", mlflow.Generation,
 		)
 	}
@@ -679,7 +685,7 @@ func (r *MLflowReconciler) handleMigration(ctx context.Context, mlflow *mlflowv1
 		"statusVersion", mlflow.Status.Version,
 	)
 
-	deploymentName := ResourceName + getResourceSuffix(mlflow.Name)
+	deploymentName := ResourceName + render.ResourceSuffix(mlflow.Name)
 	deployment, err := renderedDeployment(objects, deploymentName, namespace)
 	if err != nil {
 		return ctrl.Result{}, true, err
@@ -34,6 +34,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
 )
 
 var _ = Describe("Migration reconcile", func() {
@@ -61,9 +63,9 @@ var _ = Describe("Migration reconcile", func() {
 				Replicas:        &replicas,
 				ServeArtifacts:  &serveArtifacts,
 				BackendStoreURI: &backendStoreURI,
-				Storage: &corev1.PersistentVolumeClaimSpec{
+				Storage: &mlflowv1.StorageConfig{PersistentVolumeClaimSpec: corev1.PersistentVolumeClaimSpec{
 					AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
-				},
+				}},
 			},
 		}
 	}
@@ -128,7 +130,10 @@ var _ = Describe("Migration reconcile", func() {
 		Expect(err).NotTo(HaveOccurred())
 
 		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName}, updatedMLflow)).To(Succeed())
-		Expect(updatedMLflow.Status.Version).To(Equal(SupportedMLflowVersion))
+		Expect(updatedMLflow.Status.Version).To(Equal(config.SupportedMLflowVersion))
+		Expect(updatedMLflow.Status.Replicas).To(Equal(int32(1)))
+		Expect(updatedMLflow.Status.ReadyReplicas).To(Equal(int32(1)))
+		Expect(updatedMLflow.Status.Image).To(Equal(findContainer(deployment.Spec.Template.Spec.Containers, "mlflow").Image))
 		migrationCondition = apimeta.FindStatusCondition(updatedMLflow.Status.Conditions, migrationConditionType)
 		Expect(migrationCondition).NotTo(BeNil())
 		Expect(migrationCondition.Status).To(Equal(metav1.ConditionTrue))
@@ -191,7 +196,7 @@ var _ = Describe("Migration reconcile", func() {
 
 		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName}, updatedMLflow)).To(Succeed())
 		Expect(updatedMLflow.Annotations).NotTo(HaveKey(forceMigrateAnnotation))
-		Expect(updatedMLflow.Status.Version).To(Equal(SupportedMLflowVersion))
+		Expect(updatedMLflow.Status.Version).To(Equal(config.SupportedMLflowVersion))
 		migrationCondition := apimeta.FindStatusCondition(updatedMLflow.Status.Conditions, migrationConditionType)
 		Expect(migrationCondition).NotTo(BeNil())
 		Expect(migrationCondition.Status).To(Equal(metav1.ConditionTrue))
@@ -676,8 +681,8 @@ var _ = Describe("Migration reconcile", func() {
 		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName}, mlflow)).To(Succeed())
 
 		reconciler := newReconciler(namespace)
-		renderer := NewHelmRenderer("../../charts/mlflow")
-		objects, err := renderer.RenderChart(mlflow, namespace, RenderOptions{}, nil)
+		renderer := render.NewHelmRenderer("../../charts/mlflow")
+		objects, err := renderer.RenderChart(mlflow, namespace, render.RenderOptions{}, nil)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(reconciler.applyRenderedObjects(ctx, mlflow, objects)).To(Succeed())
 
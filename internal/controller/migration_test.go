@@ -34,6 +34,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
 )
 
 type listErrorClient struct {
@@ -63,7 +65,7 @@ func TestMigrationRequested(t *testing.T) {
 		{
 			name: "automatic skips when supported version already recorded",
 			mlflow: &mlflowv1.MLflow{
-				Status: mlflowv1.MLflowStatus{Version: SupportedMLflowVersion},
+				Status: mlflowv1.MLflowStatus{Version: config.SupportedMLflowVersion},
 			},
 			want: false,
 		},
@@ -81,7 +83,7 @@ func TestMigrationRequested(t *testing.T) {
 				Spec: mlflowv1.MLflowSpec{
 					Migration: &mlflowv1.MLflowMigrationConfig{Mode: mlflowv1.MLflowMigrateAlways},
 				},
-				Status: mlflowv1.MLflowStatus{Version: SupportedMLflowVersion},
+				Status: mlflowv1.MLflowStatus{Version: config.SupportedMLflowVersion},
 			},
 			want: true,
 		},
@@ -93,7 +95,7 @@ func TestMigrationRequested(t *testing.T) {
 					Migration: &mlflowv1.MLflowMigrationConfig{Mode: mlflowv1.MLflowMigrateAlways},
 				},
 				Status: mlflowv1.MLflowStatus{
-					Version: SupportedMLflowVersion,
+					Version: config.SupportedMLflowVersion,
 					Conditions: []metav1.Condition{{
 						Type:               migrationConditionType,
 						Status:             metav1.ConditionTrue,
@@ -158,7 +160,7 @@ func TestMigrationRequested(t *testing.T) {
 				ObjectMeta: metav1.ObjectMeta{
 					Annotations: map[string]string{forceMigrateAnnotation: ""},
 				},
-				Status: mlflowv1.MLflowStatus{Version: SupportedMLflowVersion},
+				Status: mlflowv1.MLflowStatus{Version: config.SupportedMLflowVersion},
 			},
 			want: true,
 		},
@@ -174,6 +176,29 @@ func TestMigrationRequested(t *testing.T) {
 	}
 }
 
+func TestManagedMigrationInGeneration(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	migrated := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Generation: 3},
+		Status: mlflowv1.MLflowStatus{
+			Version: config.SupportedMLflowVersion,
+			Conditions: []metav1.Condition{{
+				Type:               migrationConditionType,
+				Status:             metav1.ConditionTrue,
+				ObservedGeneration: 3,
+				Reason:             "MigrationSucceeded",
+			}},
+		},
+	}
+	g.Expect(migrationRequested(migrated)).To(gomega.BeFalse())
+	g.Expect(managedMigrationInGeneration(migrated)).To(gomega.BeTrue(), "a finished migration still counts for its generation")
+
+	migrated.Generation = 4
+	g.Expect(managedMigrationInGeneration(migrated)).To(gomega.BeFalse())
+
+	g.Expect(managedMigrationInGeneration(&mlflowv1.MLflow{})).To(gomega.BeTrue(), "first boot requests a migration")
+}
 func TestMigrationConditionWasForceTriggered(t *testing.T) {
 	t.Parallel()
 
@@ -222,7 +247,7 @@ func TestMigrationConditionWasForceTriggered(t *testing.T) {
 
 func TestBuildMigrationJobFromDeployment(t *testing.T) {
 	g := gomega.NewWithT(t)
-	renderer := NewHelmRenderer("../../charts/mlflow")
+	renderer := render.NewHelmRenderer("../../charts/mlflow")
 
 	objs, err := renderer.RenderChart(&mlflowv1.MLflow{
 		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
@@ -235,7 +260,7 @@ func TestBuildMigrationJobFromDeployment(t *testing.T) {
 				LocalObjectReference: corev1.LocalObjectReference{Name: "registry-credentials"},
 				Key:                  "registry-store-uri",
 			},
-			Storage:           &corev1.PersistentVolumeClaimSpec{},
+			Storage:           &mlflowv1.StorageConfig{},
 			CABundleConfigMap: &mlflowv1.CABundleConfigMapSpec{Name: "custom-ca"},
 			PodLabels: map[string]string{
 				"team": "ml-platform",
@@ -254,7 +279,7 @@ func TestBuildMigrationJobFromDeployment(t *testing.T) {
 				}},
 			},
 		},
-	}, "test-ns", RenderOptions{PlatformTrustedCABundleExists: true}, nil)
+	}, "test-ns", render.RenderOptions{PlatformTrustedCABundleExists: true}, nil)
 	g.Expect(err).NotTo(gomega.HaveOccurred())
 
 	deployment, err := renderedDeployment(objs, "mlflow", "test-ns")
@@ -302,7 +327,7 @@ func TestBuildMigrationJobFromDeployment(t *testing.T) {
 	g.Expect(envByName["MIGRATION_PYTHON_SCRIPT"].Value).To(gomega.ContainSubstring("registry_uri != backend_uri"))
 	g.Expect(envByName).To(gomega.HaveKeyWithValue(supportedVersionEnvName, corev1.EnvVar{
 		Name:  supportedVersionEnvName,
-		Value: SupportedMLflowVersion,
+		Value: config.SupportedMLflowVersion,
 	}))
 
 	mountNames := make([]string, 0, len(container.VolumeMounts))
@@ -358,7 +383,7 @@ func TestSupportedVersionEarlierThanStatusVersion(t *testing.T) {
 		{
 			name: "returns false when versions match",
 			mlflow: &mlflowv1.MLflow{
-				Status: mlflowv1.MLflowStatus{Version: SupportedMLflowVersion},
+				Status: mlflowv1.MLflowStatus{Version: config.SupportedMLflowVersion},
 			},
 			want: false,
 		},
@@ -475,7 +500,7 @@ func TestMigrationFailureMessageForExitCode(t *testing.T) {
 		{
 			name:     "version mismatch",
 			exitCode: migrationScriptExitCodeVersionMismatch,
-			want:     "migration image reports an unexpected MLflow version; expected " + SupportedMLflowVersion,
+			want:     "migration image reports an unexpected MLflow version; expected " + config.SupportedMLflowVersion,
 			ok:       true,
 		},
 		{
@@ -19,14 +19,17 @@ package controller
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	consolev1 "github.com/openshift/api/console/v1"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"golang.org/x/sync/errgroup"
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -35,7 +38,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/rest"
-	"k8s.io/client-go/util/retry"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
 	controllerbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
 	crcache "sigs.k8s.io/controller-runtime/pkg/cache"
@@ -51,6 +54,7 @@ import (
 	modulev1alpha1 "github.com/opendatahub-io/mlflow-operator/api/mlflowoperator/v1alpha1"
 	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
 	"github.com/opendatahub-io/mlflow-operator/internal/config"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
 )
 
 const (
@@ -65,17 +69,98 @@ type MLflowReconciler struct {
 	ChartPath               string
 	ConsoleLinkAvailable    bool
 	HTTPRouteAvailable      bool
+	VirtualServiceAvailable bool
 	ServiceMonitorAvailable bool
-	GCRBACWatchCache        crcache.Cache
+	PodMonitorAvailable     bool
+	PrometheusRuleAvailable bool
+	ServiceMeshAvailable    bool
+	MLflowConfigAvailable   bool
+	// BackendTLSPolicyAvailable reports whether Gateway API serves the BackendTLSPolicy API that
+	// makes the Gateway verify the MLflow serving certificate.
+	BackendTLSPolicyAvailable bool
+	// Namespaced confines the operator to namespace-scoped objects in Namespace (see
+	// WATCH_NAMESPACE): the chart renders Roles instead of cluster RBAC, and the ConsoleLink,
+	// the target namespace check, and the cluster proxy lookup are skipped.
+	Namespaced bool
+	// ConsolePluginAvailable reports whether the OpenShift console serves the ConsolePlugin API
+	// that spec.consolePlugin registers the MLflow console plugin through.
+	ConsolePluginAvailable bool
+	// RateLimitPolicyAvailable reports whether Kuadrant serves the RateLimitPolicy API that
+	// enforces spec.server.rateLimit.
+	RateLimitPolicyAvailable bool
+	// OpenClusterManagementAvailable reports whether the cluster is an Open Cluster Management
+	// hub serving the ManifestWork and PlacementDecision APIs used by spec.fleet.
+	OpenClusterManagementAvailable bool
+	// SelfSignedTLS makes the operator issue the TLS Secret itself with a self-signed
+	// certificate, for clusters with neither the OpenShift service CA nor cert-manager.
+	SelfSignedTLS bool
+	// WatchNamespaces limits the namespaces scanned for MLflowConfig workspaces. Empty means
+	// all namespaces.
+	WatchNamespaces  []string
+	GCRBACWatchCache crcache.Cache
+	// ClientTokenWatchCache caches the workspace client token Secrets; see
+	// NewClientTokenWatchCache. Nil skips the watch.
+	ClientTokenWatchCache crcache.Cache
+	// DataConnectionWatchCache caches the ODH data connection Secrets of workspaces; see
+	// NewDataConnectionWatchCache. Nil skips the watch.
+	DataConnectionWatchCache crcache.Cache
+	// ServerPodWatchCache caches the MLflow server pods; see NewServerPodWatchCache. Nil skips
+	// the watch, so stuck containers are only reported on the next Deployment change.
+	ServerPodWatchCache crcache.Cache
+	// LogApplyDiffs logs the fields each apply changes and records them as an event on the
+	// changed object; see reportApplyDiff.
+	LogApplyDiffs bool
+	// Recorder emits the LogApplyDiffs events. Nil only logs.
+	Recorder events.EventRecorder
+	// ReconcileTracker records finished reconciles for the manager's instances readiness
+	// check. Nil disables the check and its periodic resync.
+	ReconcileTracker *ReconcileTracker
+	// RenderRecorder keeps the latest chart render of each instance for the debug render
+	// endpoint. Nil records nothing.
+	RenderRecorder *RenderRecorder
+
+	renderCache      renderCache
+	appliedObjects   appliedObjects
+	appliedRevisions appliedRevisions
+	writtenStatuses  writtenStatuses
+	imageResolver    imageDigestResolver
+	healthProber     healthProber
+	notifier         webhookNotifier
+
+	reachabilityProber reachabilityProber
+	usageCollector     usageCollector
 }
 
 // +kubebuilder:rbac:groups=config.openshift.io,resources=apiservers,verbs=get;list;watch
+// +kubebuilder:rbac:groups=config.openshift.io,resources=proxies,verbs=get
+// +kubebuilder:rbac:groups=config.openshift.io,resources=ingresses,verbs=get
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=servicecidrs,verbs=list
 // +kubebuilder:rbac:groups=mlflow.opendatahub.io,resources=mlflows,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=mlflow.opendatahub.io,resources=mlflows/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=mlflow.opendatahub.io,resources=mlflows/finalizers,verbs=update
-// +kubebuilder:rbac:groups="",resources=secrets,resourceNames=mlflow-artifact-connection,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,resourceNames=mlflow-artifact-connection,verbs=get;list;watch;patch;delete
+// Data connection Secrets have user-chosen names, so MLflowConfig data connections need read
+// access to all workspace Secrets; see DataConnectionAnnotation.
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
 // +kubebuilder:rbac:groups=mlflow.kubeflow.org,resources=mlflowconfigs,verbs=get;list;watch
+// Workspace connection ConfigMaps are published in every namespace with an MLflowConfig.
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=create
+// +kubebuilder:rbac:groups="",resources=configmaps,resourceNames=mlflow-connection,verbs=get;patch
+// Optional client credentials (spec.clientCredentials) live next to the connection ConfigMap.
+// +kubebuilder:rbac:groups="",resources=serviceaccounts;secrets,verbs=create
+// +kubebuilder:rbac:groups="",resources=serviceaccounts,resourceNames=mlflow-client,verbs=get;patch;delete
+// +kubebuilder:rbac:groups="",resources=secrets,resourceNames=mlflow-client-token,verbs=get;list;watch;patch;delete
+// The self-signed serving certificate is issued where no cluster issuer is available.
+// +kubebuilder:rbac:groups="",resources=secrets,resourceNames=mlflow-tls,verbs=get;patch
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=rolebindings,verbs=create
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=rolebindings,resourceNames=mlflow-client,verbs=get;patch;delete
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles,resourceNames=mlflow-integration,verbs=bind
+// Groups listed in spec.accessRoles.groups are bound to the access roles in each workspace.
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=rolebindings,resourceNames=mlflow-viewers;mlflow-editors;mlflow-admins,verbs=get;patch;delete
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles,resourceNames=mlflow-viewer;mlflow-editor;mlflow-admin,verbs=bind
 // +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+// Apply diff events (--log-apply-diffs) are recorded on objects in any managed namespace.
+// +kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
 // Shared server RBAC objects are statically named `mlflow` and watched through metadata.name
 // field selectors so list/watch remains compatible with resourceNames-scoped authorization.
 // +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles,verbs=create
@@ -87,8 +172,20 @@ type MLflowReconciler struct {
 // is added or when `mlflow gc` stops relying on artifact-proxy authorization.
 // +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles,resourceNames=mlflow-gc,verbs=list;watch;update;patch;delete
 // +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterrolebindings,resourceNames=mlflow-gc,verbs=list;watch;update;patch;delete
+// Per-instance access roles grant MLflow permissions the operator does not hold, so they need escalate.
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles,resourceNames=mlflow-viewer;mlflow-editor;mlflow-admin,verbs=get;update;patch;delete;escalate
 // +kubebuilder:rbac:groups=console.openshift.io,resources=consolelinks,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=console.openshift.io,resources=consoleplugins,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gateways,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=referencegrants,verbs=get;list;create;update;patch;delete
+// +kubebuilder:rbac:groups=networking.istio.io,resources=virtualservices;destinationrules,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=security.istio.io,resources=peerauthentications,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=backendtlspolicies,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=kuadrant.io,resources=ratelimitpolicies,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=work.open-cluster-management.io,resources=manifestworks,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=cluster.open-cluster-management.io,resources=placementdecisions,verbs=get;list;watch
+// +kubebuilder:rbac:groups=mlflow.kubeflow.org,resources=experiments;registeredmodels,verbs=get;list;create;update
 //
 // Namespace-scoped permissions (serviceaccounts, secrets, services, persistentvolumeclaims, deployments, networkpolicies)
 // are granted via the Role in config/rbac/namespace_role.yaml instead of the ClusterRole above.
@@ -105,12 +202,25 @@ func (r *MLflowReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	if err != nil {
 		if errors.IsNotFound(err) {
 			log.Info("MLflow resource not found. Ignoring since object must be deleted")
+			r.renderCache.forget(req.Name)
+			r.RenderRecorder.forget(req.Name)
+			r.appliedRevisions.forget(req.Name)
+			r.writtenStatuses.forget(req.Name)
+			r.ReconcileTracker.forget(req.Name)
+			r.usageCollector.forget(req.Name)
 			return ctrl.Result{}, nil
 		}
 		log.Error(err, "Failed to get MLflow")
 		return ctrl.Result{}, err
 	}
 
+	result, err := r.reconcileMLflow(ctx, mlflow)
+	return r.ReconcileTracker.observe(mlflow.Name, result), err
+}
+
+func (r *MLflowReconciler) reconcileMLflow(ctx context.Context, mlflow *mlflowv1.MLflow) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
 	cfg, err := r.resolveOperatorConfig(ctx)
 	if err != nil {
 		log.Error(err, "Failed to resolve operator configuration")
@@ -128,23 +238,77 @@ func (r *MLflowReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	} else if handled {
 		return result, nil
 	}
+	if cfg, err = r.withGatewayURL(ctx, mlflow, cfg); err != nil {
+		log.Error(err, "Failed to read the external URL from the Gateway")
+		return ctrl.Result{}, err
+	}
+
+	// Fill omitted spec fields from admin-managed platform defaults for this reconcile only.
+	defaults, err := r.loadPlatformDefaults(ctx)
+	if err != nil {
+		log.Error(err, "Failed to load platform defaults")
+		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+			Type:    "Available",
+			Status:  metav1.ConditionFalse,
+			Reason:  "InvalidPlatformDefaults",
+			Message: err.Error(),
+		})
+		if statusErr := r.updateStatus(ctx, mlflow); statusErr != nil {
+			log.Error(statusErr, "Failed to update MLflow status")
+		}
+		return ctrl.Result{}, err
+	}
+	applyPlatformDefaults(&mlflow.Spec, defaults)
+	applyFeatureGates(&mlflow.Spec, cfg)
 
 	targetNamespace := cfg.ApplicationsNamespace
-	mlflow.Status.Address = buildStatusAddress(mlflow.Name, targetNamespace)
+	mlflow.Status.Address = buildStatusAddress(mlflow, targetNamespace)
+
+	// A missing or unsuitable namespace otherwise surfaces as apply errors or pods that are
+	// never admitted.
+	namespace, problem, err := r.checkTargetNamespace(ctx, mlflow, targetNamespace)
+	if err != nil {
+		log.Error(err, "Failed to check target namespace")
+		return ctrl.Result{}, err
+	}
+	if problem != "" {
+		log.Info("Target namespace not ready", "namespace", targetNamespace, "problem", problem)
+		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+			Type:    "Available",
+			Status:  metav1.ConditionFalse,
+			Reason:  targetNamespaceNotReadyReason,
+			Message: problem,
+		})
+		if statusErr := r.updateStatus(ctx, mlflow); statusErr != nil {
+			log.Error(statusErr, "Failed to update MLflow status")
+		}
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+	setS3TLSVerificationCondition(mlflow, namespace)
 
 	// Clean up GC resources when garbage collection is disabled.
 	if mlflow.Spec.GarbageCollection == nil {
-		gcSuffix := "-gc" + getResourceSuffix(mlflow.Name)
-		gcResources := []struct {
+		gcSuffix := "-gc" + render.ResourceSuffix(mlflow.Name)
+		type gcResource struct {
 			obj  client.Object
 			kind string
 			name string
 			ns   string
-		}{
+		}
+		gcResources := []gcResource{
 			{&batchv1.CronJob{}, "CronJob", ResourceName + gcSuffix, targetNamespace},
 			{&corev1.ServiceAccount{}, "ServiceAccount", GCServiceAccountName, targetNamespace},
-			{&rbacv1.ClusterRoleBinding{}, "ClusterRoleBinding", ResourceName + gcSuffix, ""},
-			{&rbacv1.ClusterRole{}, "ClusterRole", ResourceName + gcSuffix, ""},
+		}
+		if r.Namespaced {
+			gcResources = append(gcResources,
+				gcResource{&rbacv1.RoleBinding{}, "RoleBinding", ResourceName + gcSuffix, targetNamespace},
+				gcResource{&rbacv1.Role{}, "Role", ResourceName + gcSuffix, targetNamespace},
+			)
+		} else {
+			gcResources = append(gcResources,
+				gcResource{&rbacv1.ClusterRoleBinding{}, "ClusterRoleBinding", ResourceName + gcSuffix, ""},
+				gcResource{&rbacv1.ClusterRole{}, "ClusterRole", ResourceName + gcSuffix, ""},
+			)
 		}
 		for _, res := range gcResources {
 			existing := res.obj.DeepCopyObject().(client.Object)
@@ -162,6 +326,7 @@ func (r *MLflowReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	}
 
 	// Validate user-provided CA bundle ConfigMap if specified
+	caBundleHash := ""
 	if mlflow.Spec.CABundleConfigMap != nil {
 		customCABundleConfigMap := &corev1.ConfigMap{}
 		err = r.Get(ctx, types.NamespacedName{
@@ -182,11 +347,35 @@ func (r *MLflowReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 				Reason:  "CABundleConfigMapError",
 				Message: msg,
 			})
-			if statusErr := r.Status().Update(ctx, mlflow); statusErr != nil {
+			if statusErr := r.updateStatus(ctx, mlflow); statusErr != nil {
 				log.Error(statusErr, "Failed to update MLflow status")
 			}
 			return ctrl.Result{}, fmt.Errorf("%s", msg)
 		}
+		if caBundleInjectionPending(customCABundleConfigMap) {
+			// The ConfigMap watch reconciles again once the bundle is injected.
+			msg := fmt.Sprintf("Waiting for the trusted CA bundle to be injected into ConfigMap %q (labeled %s=true)",
+				mlflow.Spec.CABundleConfigMap.Name, trustedCABundleInjectionLabel)
+			log.Info(msg)
+			meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+				Type:    "Available",
+				Status:  metav1.ConditionFalse,
+				Reason:  caBundleInjectionPendingReason,
+				Message: msg,
+			})
+			meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+				Type:    "Progressing",
+				Status:  metav1.ConditionTrue,
+				Reason:  caBundleInjectionPendingReason,
+				Message: msg,
+			})
+			if err := r.updateStatus(ctx, mlflow); err != nil {
+				log.Error(err, "Failed to update MLflow status")
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{}, nil
+		}
+		caBundleHash = injectedCABundleHash(customCABundleConfigMap)
 		log.V(1).Info("Found custom CA bundle ConfigMap",
 			"configmap", mlflow.Spec.CABundleConfigMap.Name,
 			"namespace", targetNamespace)
@@ -214,86 +403,371 @@ func (r *MLflowReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 			Reason:  "PlatformCABundleError",
 			Message: msg,
 		})
-		if statusErr := r.Status().Update(ctx, mlflow); statusErr != nil {
+		if statusErr := r.updateStatus(ctx, mlflow); statusErr != nil {
 			log.Error(statusErr, "Failed to update MLflow status")
 		}
 		return ctrl.Result{}, fmt.Errorf("%s", msg)
 	}
 
+	if _, err := render.ResolveVersionImage(mlflow, cfg); err != nil {
+		log.Error(err, "Unsupported MLflow version requested")
+		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+			Type:    "Available",
+			Status:  metav1.ConditionFalse,
+			Reason:  unsupportedVersionReason,
+			Message: err.Error(),
+		})
+		if statusErr := r.updateStatus(ctx, mlflow); statusErr != nil {
+			log.Error(statusErr, "Failed to update MLflow status")
+		}
+		return ctrl.Result{}, err
+	}
+
+	// The running objects are left as they are; only a spec change can resolve this.
+	if err := checkImageVersionCompatibility(mlflow); err != nil {
+		log.Info("Incompatible MLflow image, not rolling out", "reason", err.Error())
+		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+			Type:    "Available",
+			Status:  metav1.ConditionFalse,
+			Reason:  incompatibleVersionReason,
+			Message: err.Error(),
+		})
+		if statusErr := r.updateStatus(ctx, mlflow); statusErr != nil {
+			log.Error(statusErr, "Failed to update MLflow status")
+			return ctrl.Result{}, statusErr
+		}
+		return ctrl.Result{}, nil
+	}
+
+	resolvedImage, err := r.resolveImageDigest(ctx, mlflow, cfg)
+	if err != nil {
+		log.Error(err, "Failed to resolve MLflow image digest")
+		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+			Type:    "Available",
+			Status:  metav1.ConditionFalse,
+			Reason:  "ImageResolutionFailed",
+			Message: err.Error(),
+		})
+		if statusErr := r.updateStatus(ctx, mlflow); statusErr != nil {
+			log.Error(statusErr, "Failed to update MLflow status")
+		}
+		return ctrl.Result{}, err
+	}
+
+	imageArchitectures := r.imageArchitectures(ctx, mlflow, cfg, resolvedImage)
+
+	proxy, err := r.clusterProxy(ctx, cfg)
+	if err != nil {
+		log.Error(err, "Failed to read cluster proxy settings")
+		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+			Type:    "Available",
+			Status:  metav1.ConditionFalse,
+			Reason:  "ClusterProxyError",
+			Message: err.Error(),
+		})
+		if statusErr := r.updateStatus(ctx, mlflow); statusErr != nil {
+			log.Error(statusErr, "Failed to update MLflow status")
+		}
+		return ctrl.Result{}, err
+	}
+
+	// Evaluate scheduled hibernation windows; spec.suspend always takes precedence.
+	hibernation, err := evaluateHibernation(mlflow, time.Now())
+	if err != nil {
+		log.Error(err, "Invalid hibernation schedule")
+		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+			Type:    "Available",
+			Status:  metav1.ConditionFalse,
+			Reason:  "InvalidHibernationSchedule",
+			Message: err.Error(),
+		})
+		if statusErr := r.updateStatus(ctx, mlflow); statusErr != nil {
+			log.Error(statusErr, "Failed to update MLflow status")
+		}
+		return ctrl.Result{}, err
+	}
+	restoring := mlflow.Annotations[RestoreAnnotation]
+	if restoring != "" && !r.activeRestore(ctx, restoring) {
+		restoring = ""
+	}
+	suspended := specSuspended(mlflow) || hibernation.Active || restoring != ""
+
+	tlsCertificateHash, tlsRenewAfter, err := r.ensureSelfSignedTLSSecret(ctx, mlflow, targetNamespace, time.Now())
+	if err != nil {
+		log.Error(err, "Failed to issue self-signed TLS certificate")
+		return ctrl.Result{}, err
+	}
+
+	baseValues, err := r.resolveValuesFrom(ctx, mlflow, targetNamespace)
+	if err != nil {
+		log.Error(err, "Failed to read spec.valuesFrom")
+		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+			Type:    "Available",
+			Status:  metav1.ConditionFalse,
+			Reason:  valuesFromErrorReason,
+			Message: err.Error(),
+		})
+		if statusErr := r.updateStatus(ctx, mlflow); statusErr != nil {
+			log.Error(statusErr, "Failed to update MLflow status")
+		}
+		return ctrl.Result{}, err
+	}
+
 	// Render the Helm chart
 	helmChartPath := r.ChartPath
 	if helmChartPath == "" {
 		helmChartPath = chartPath
 	}
-	renderer := NewHelmRenderer(helmChartPath)
-	renderOpts := RenderOptions{
+	renderer := render.NewHelmRenderer(helmChartPath)
+	renderer.Log = log.WithName("helm")
+	renderOpts := render.RenderOptions{
 		PlatformTrustedCABundleExists: platformCABundleExists,
 		// If ConsoleLink is available, we can assume we are on OpenShift
 		IsOpenShift:             r.ConsoleLinkAvailable,
 		ServiceMonitorAvailable: r.ServiceMonitorAvailable,
+		PodMonitorAvailable:     r.PodMonitorAvailable,
+		PrometheusRuleAvailable: r.PrometheusRuleAvailable,
+		Suspended:               suspended,
+		ResolvedImage:           resolvedImage,
+		ImageArchitectures:      imageArchitectures,
+		ManagedMigration:        managedMigrationInGeneration(mlflow),
+		Proxy:                   proxy,
+		TLSCertificateHash:      tlsCertificateHash,
+		CABundleHash:            caBundleHash,
+		Namespaced:              r.Namespaced,
+		BaseValues:              baseValues,
+	}
+	objects, chartVersion, err := r.renderChart(renderer, mlflow, targetNamespace, renderOpts, cfg)
+	if err == nil && canaryStrategyEnabled(mlflow) {
+		err = stampPodTemplateHash(objects, ResourceName+render.ResourceSuffix(mlflow.Name))
 	}
-	objects, err := renderer.RenderChart(mlflow, targetNamespace, renderOpts, cfg)
 	if err != nil {
 		log.Error(err, "Failed to render Helm chart")
+		reason := "RenderFailed"
+		if render.IsChartValuesError(err) {
+			reason = "InvalidChartValues"
+		}
 		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
 			Type:    "Available",
 			Status:  metav1.ConditionFalse,
-			Reason:  "RenderFailed",
+			Reason:  reason,
 			Message: fmt.Sprintf("Failed to render Helm chart: %v", err),
 		})
 		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
 			Type:    "Progressing",
 			Status:  metav1.ConditionFalse,
-			Reason:  "RenderFailed",
+			Reason:  reason,
 			Message: fmt.Sprintf("Failed to render Helm chart: %v", err),
 		})
+		backoff := recordReconcileFailure(mlflow, reason, err)
 		if statusErr := r.updateStatus(ctx, mlflow); statusErr != nil {
-			log.Error(statusErr, "Failed to update MLflow status after retries")
+			log.Error(statusErr, "Failed to update MLflow status")
+		}
+		return ctrl.Result{RequeueAfter: backoff}, nil
+	}
+	for _, obj := range objects {
+		propagateMetadata(mlflow, obj)
+	}
+	if mlflow.Spec.Fleet != nil {
+		return r.reconcileFleet(ctx, mlflow, targetNamespace, objects, chartVersion)
+	}
+
+	objects, blueGreenRequeue, err := r.reconcileBlueGreen(ctx, mlflow, targetNamespace, objects, renderOpts.Suspended, cfg, time.Now())
+	if err != nil {
+		log.Error(err, "Failed to reconcile blue/green migration")
+		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+			Type:    "Available",
+			Status:  metav1.ConditionFalse,
+			Reason:  "BlueGreenFailed",
+			Message: fmt.Sprintf("Failed to reconcile blue/green migration: %v", err),
+		})
+		if statusErr := r.updateStatus(ctx, mlflow); statusErr != nil {
+			log.Error(statusErr, "Failed to update MLflow status")
 		}
 		return ctrl.Result{}, err
 	}
 
-	if result, handled, err := r.handleMigration(ctx, mlflow, targetNamespace, objects); err != nil {
+	// Migrations are deferred while suspended; they run on the next reconcile after resume.
+	if renderOpts.Suspended {
+		log.V(1).Info("MLflow instance suspended, skipping migration handling")
+	} else if blueGreenActive(mlflow) {
+		log.V(1).Info("Blue/green migration in progress, skipping migration handling")
+	} else if result, handled, err := r.handleSQLiteMigration(ctx, mlflow, targetNamespace, objects, time.Now()); err != nil {
+		log.Error(err, "Failed to reconcile SQLite migration")
+		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+			Type:    "Available",
+			Status:  metav1.ConditionFalse,
+			Reason:  sqliteMigrationFailedReason,
+			Message: fmt.Sprintf("Failed to reconcile SQLite migration: %v", err),
+		})
+		if statusErr := r.updateStatus(ctx, mlflow); statusErr != nil {
+			log.Error(statusErr, "Failed to update MLflow status")
+		}
+		return ctrl.Result{}, err
+	} else if handled {
+		return result, nil
+	} else if result, handled, err := r.handleMigration(ctx, mlflow, targetNamespace, objects); err != nil {
 		log.Error(err, "Failed to reconcile migration")
 		if statusErr := r.recordMigrationError(ctx, mlflow, "MigrationError", fmt.Sprintf("Failed to reconcile migration: %v", err)); statusErr != nil {
-			log.Error(statusErr, "Failed to update MLflow status after retries")
+			log.Error(statusErr, "Failed to update MLflow status")
 		}
 		return ctrl.Result{}, err
 	} else if handled {
 		return result, nil
 	}
 
+	objects, canaryRequeue, err := r.reconcileCanary(ctx, mlflow, targetNamespace, objects, renderOpts.Suspended, time.Now())
+	if err != nil {
+		log.Error(err, "Failed to reconcile canary rollout")
+		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+			Type:    "Available",
+			Status:  metav1.ConditionFalse,
+			Reason:  "CanaryFailed",
+			Message: fmt.Sprintf("Failed to reconcile canary rollout: %v", err),
+		})
+		if statusErr := r.updateStatus(ctx, mlflow); statusErr != nil {
+			log.Error(statusErr, "Failed to update MLflow status")
+		}
+		return ctrl.Result{}, err
+	}
+
+	objects, heldForTLS, err := r.holdDeploymentsForTLSSecret(ctx, mlflow, targetNamespace, objects)
+	if err != nil {
+		log.Error(err, "Failed to check TLS Secret")
+		return ctrl.Result{}, err
+	}
+
+	// Quota admission rejects pods and PVCs long after the apply succeeds, so check first and
+	// keep the live objects until the namespace has room.
+	quotaProblems, err := r.checkNamespaceQuota(ctx, targetNamespace, objects)
+	if err != nil {
+		log.Error(err, "Failed to check namespace quota")
+		return ctrl.Result{}, err
+	}
+	setQuotaExceededCondition(mlflow, quotaProblems)
+	if len(quotaProblems) > 0 {
+		log.Info("Target namespace quota exceeded, not applying", "namespace", targetNamespace, "problems", quotaProblems)
+		if statusErr := r.updateStatus(ctx, mlflow); statusErr != nil {
+			log.Error(statusErr, "Failed to update MLflow status")
+		}
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+
+	if adoptionRequested(mlflow) {
+		adopted, err := r.adoptRenderedObjects(ctx, mlflow, objects)
+		if err != nil {
+			log.Error(err, "Failed to adopt existing objects")
+			meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+				Type:    "Available",
+				Status:  metav1.ConditionFalse,
+				Reason:  adoptionFailedReason,
+				Message: fmt.Sprintf("Failed to adopt existing resources: %v", err),
+			})
+			backoff := recordReconcileFailure(mlflow, adoptionFailedReason, err)
+			if statusErr := r.updateStatus(ctx, mlflow); statusErr != nil {
+				log.Error(statusErr, "Failed to update MLflow status")
+			}
+			return ctrl.Result{RequeueAfter: backoff}, nil
+		}
+		if len(adopted) > 0 {
+			log.Info("Adopting existing objects", "objects", adopted)
+		}
+	}
+
 	if err := r.applyRenderedObjects(ctx, mlflow, objects); err != nil {
 		log.Error(err, "Failed to apply rendered objects")
+		reason := "ApplyFailed"
+		if isDryRunError(err) {
+			reason = "DryRunFailed"
+		}
 		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
 			Type:    "Available",
 			Status:  metav1.ConditionFalse,
-			Reason:  "ApplyFailed",
+			Reason:  reason,
 			Message: fmt.Sprintf("Failed to apply resources: %v", err),
 		})
 		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
 			Type:    "Progressing",
 			Status:  metav1.ConditionFalse,
-			Reason:  "ApplyFailed",
+			Reason:  reason,
 			Message: fmt.Sprintf("Failed to apply resources: %v", err),
 		})
+		backoff := recordReconcileFailure(mlflow, reason, err)
 		if statusErr := r.updateStatus(ctx, mlflow); statusErr != nil {
-			log.Error(statusErr, "Failed to update MLflow status after retries")
+			log.Error(statusErr, "Failed to update MLflow status")
 		}
+		return ctrl.Result{RequeueAfter: backoff}, nil
+	}
+	recordAppliedManifests(mlflow, chartVersion, len(objects), metav1.Now())
+	previousInventory := mlflow.Status.Inventory
+	mlflow.Status.Inventory = buildInventory(objects)
+	clearReconcileFailures(mlflow)
+	if adoptionRequested(mlflow) {
+		if err := r.clearAdoptAnnotation(ctx, mlflow); err != nil {
+			log.Error(err, "Failed to clear adopt annotation")
+			return ctrl.Result{}, err
+		}
+	}
+	if manifests, ok := r.appliedRevisions.get(mlflow.Name); ok {
+		revision, err := r.recordRevision(ctx, mlflow, targetNamespace, chartVersion, manifests)
+		if err != nil {
+			// The history is for auditing and must not block the rollout.
+			log.Error(err, "Failed to record manifest revision")
+		} else {
+			mlflow.Status.Revision = revision
+		}
+	}
+
+	if !render.ArtifactsServerEnabled(mlflow) {
+		if err := r.deleteDerivedServerObjects(ctx, render.ArtifactsServerResourceName(mlflow), targetNamespace); err != nil {
+			log.Error(err, "Failed to remove artifacts server")
+			return ctrl.Result{}, err
+		}
+	}
+	if err := r.deleteStaleMetricsMonitor(ctx, mlflow, targetNamespace); err != nil {
+		log.Error(err, "Failed to remove stale metrics monitor")
+		return ctrl.Result{}, err
+	}
+	if err := r.pruneExtraManifests(ctx, mlflow, previousInventory); err != nil {
+		log.Error(err, "Failed to remove extra manifests")
 		return ctrl.Result{}, err
 	}
+	if r.OpenClusterManagementAvailable {
+		if err := r.deleteManifestWorks(ctx, mlflow, nil); err != nil {
+			log.Error(err, "Failed to remove ManifestWorks")
+			return ctrl.Result{}, err
+		}
+		mlflow.Status.Fleet = nil
+	}
+	if r.PrometheusRuleAvailable && !render.AlertsEnabled(mlflow, true) {
+		if err := r.deleteAlertRules(ctx, mlflow, targetNamespace); err != nil {
+			log.Error(err, "Failed to remove alerting rules")
+			return ctrl.Result{}, err
+		}
+	}
+	if !render.JSONLoggingEnabled(mlflow) {
+		if err := r.deleteLoggingConfigMap(ctx, mlflow, targetNamespace); err != nil {
+			log.Error(err, "Failed to remove logging configuration")
+			return ctrl.Result{}, err
+		}
+	}
+	if !render.RegistryServerEnabled(mlflow) {
+		if err := r.deleteDerivedServerObjects(ctx, render.RegistryServerResourceName(mlflow), targetNamespace); err != nil {
+			log.Error(err, "Failed to remove registry server")
+			return ctrl.Result{}, err
+		}
+	}
 
-	// Reconcile ConsoleLink (if available in cluster)
-	if err := r.reconcileConsoleLink(ctx, mlflow, cfg); err != nil {
-		log.Error(err, "Failed to reconcile ConsoleLink")
+	if err := r.reconcileCustomDomainGateway(ctx, mlflow, targetNamespace, cfg); err != nil {
+		log.Error(err, "Failed to reconcile custom domain Gateway")
 		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
 			Type:    "Available",
 			Status:  metav1.ConditionFalse,
-			Reason:  "ConsoleLinkFailed",
-			Message: fmt.Sprintf("Failed to reconcile ConsoleLink: %v", err),
+			Reason:  "CustomDomainGatewayFailed",
+			Message: fmt.Sprintf("Failed to reconcile custom domain Gateway: %v", err),
 		})
 		if statusErr := r.updateStatus(ctx, mlflow); statusErr != nil {
-			log.Error(statusErr, "Failed to update MLflow status after retries")
+			log.Error(statusErr, "Failed to update MLflow status")
 		}
 		return ctrl.Result{}, err
 	}
@@ -309,15 +783,125 @@ func (r *MLflowReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 			Message: fmt.Sprintf("Failed to reconcile HttpRoute: %v", err),
 		})
 		if statusErr := r.updateStatus(ctx, mlflow); statusErr != nil {
-			log.Error(statusErr, "Failed to update MLflow status after retries")
+			log.Error(statusErr, "Failed to update MLflow status")
+		}
+		return ctrl.Result{}, err
+	}
+
+	if err := r.reconcileBackendTLSPolicy(ctx, mlflow, targetNamespace, cfg); err != nil {
+		log.Error(err, "Failed to reconcile BackendTLSPolicy")
+		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+			Type:    "Available",
+			Status:  metav1.ConditionFalse,
+			Reason:  "BackendTLSPolicyFailed",
+			Message: fmt.Sprintf("Failed to reconcile BackendTLSPolicy: %v", err),
+		})
+		if statusErr := r.updateStatus(ctx, mlflow); statusErr != nil {
+			log.Error(statusErr, "Failed to update MLflow status")
 		}
 		return ctrl.Result{}, err
 	}
 
-	setObservedURLs(mlflow, targetNamespace, r.HTTPRouteAvailable, cfg)
+	if err := r.reconcileConsolePlugin(ctx, mlflow, targetNamespace, cfg); err != nil {
+		log.Error(err, "Failed to reconcile console plugin")
+		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+			Type:    "Available",
+			Status:  metav1.ConditionFalse,
+			Reason:  "ConsolePluginFailed",
+			Message: fmt.Sprintf("Failed to reconcile console plugin: %v", err),
+		})
+		if statusErr := r.updateStatus(ctx, mlflow); statusErr != nil {
+			log.Error(statusErr, "Failed to update MLflow status")
+		}
+		return ctrl.Result{}, err
+	}
+
+	if err := r.reconcileRateLimit(ctx, mlflow, targetNamespace); err != nil {
+		log.Error(err, "Failed to reconcile RateLimitPolicy")
+		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+			Type:    "Available",
+			Status:  metav1.ConditionFalse,
+			Reason:  "RateLimitPolicyFailed",
+			Message: fmt.Sprintf("Failed to reconcile RateLimitPolicy: %v", err),
+		})
+		if statusErr := r.updateStatus(ctx, mlflow); statusErr != nil {
+			log.Error(statusErr, "Failed to update MLflow status")
+		}
+		return ctrl.Result{}, err
+	}
+
+	// Reconcile Istio VirtualService (only when Gateway API is not available)
+	if err := r.reconcileVirtualService(ctx, mlflow, targetNamespace, cfg); err != nil {
+		setObservedURLs(mlflow, targetNamespace, false, cfg)
+		log.Error(err, "Failed to reconcile VirtualService")
+		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+			Type:    "Available",
+			Status:  metav1.ConditionFalse,
+			Reason:  "VirtualServiceFailed",
+			Message: fmt.Sprintf("Failed to reconcile VirtualService: %v", err),
+		})
+		if statusErr := r.updateStatus(ctx, mlflow); statusErr != nil {
+			log.Error(statusErr, "Failed to update MLflow status")
+		}
+		return ctrl.Result{}, err
+	}
+
+	if err := r.reconcileServiceMesh(ctx, mlflow, targetNamespace); err != nil {
+		log.Error(err, "Failed to reconcile service mesh objects")
+		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+			Type:    "Available",
+			Status:  metav1.ConditionFalse,
+			Reason:  "ServiceMeshFailed",
+			Message: fmt.Sprintf("Failed to reconcile service mesh objects: %v", err),
+		})
+		if statusErr := r.updateStatus(ctx, mlflow); statusErr != nil {
+			log.Error(statusErr, "Failed to update MLflow status")
+		}
+		return ctrl.Result{}, err
+	}
+
+	setObservedURLs(mlflow, targetNamespace, r.publicRouteAvailable(mlflow), cfg)
+	setSelectedGatewayStatus(mlflow, r.HTTPRouteAvailable, cfg)
+	if err := r.setRouteReadyCondition(ctx, mlflow, targetNamespace); err != nil {
+		log.Error(err, "Failed to evaluate HttpRoute status")
+	}
+
+	// Connection info is best-effort: a workspace without it can still be configured by hand.
+	if err := r.reconcileWorkspaceConnections(ctx, mlflow); err != nil {
+		log.Error(err, "Failed to publish workspace connection ConfigMaps")
+	}
+	if err := r.reconcileWorkspaceRoutes(ctx, mlflow, targetNamespace, cfg); err != nil {
+		log.Error(err, "Failed to reconcile workspace routes")
+	}
+
+	if renderOpts.Suspended {
+		message := "MLflow instance is suspended; the deployment is scaled to zero replicas"
+		switch {
+		case specSuspended(mlflow):
+		case restoring != "":
+			message = fmt.Sprintf("MLflow instance is scaled to zero while MLflowRestore %s runs", restoring)
+		default:
+			message = "MLflow instance is hibernated by spec.hibernation; the deployment is scaled to zero replicas"
+		}
+		setSuspendedConditions(mlflow, message)
+		if err := r.reconcileConsoleLink(ctx, mlflow, cfg); err != nil {
+			return ctrl.Result{}, r.failConsoleLink(ctx, mlflow, err)
+		}
+		if err := r.updateStatus(ctx, mlflow); err != nil {
+			log.Error(err, "Failed to update MLflow status")
+			return ctrl.Result{}, err
+		}
+		log.Info("MLflow instance suspended", "hibernationWindow", hibernation.Active, "restore", restoring)
+		if restoring != "" {
+			// Resume promptly if the restore is deleted before it releases the instance.
+			return ctrl.Result{RequeueAfter: backupWaitInterval}, nil
+		}
+		return hibernationResult(hibernation, time.Now()), nil
+	}
+	clearSuspendedCondition(mlflow)
 
 	// Get deployment name using the resource suffix
-	deploymentName := ResourceName + getResourceSuffix(mlflow.Name)
+	deploymentName := ResourceName + render.ResourceSuffix(mlflow.Name)
 
 	// Check deployment readiness
 	deployment := &appsv1.Deployment{}
@@ -327,8 +911,29 @@ func (r *MLflowReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 			log.Error(err, "Failed to get Deployment")
 			return ctrl.Result{}, err
 		}
-		// Deployment not created yet, requeue
-		return ctrl.Result{RequeueAfter: 5 * time.Second}, nil
+		if len(heldForTLS) > 0 {
+			log.Info("Waiting for TLS Secret before creating Deployments", "secret", TLSSecretName, "deployments", heldForTLS)
+			message := meta.FindStatusCondition(mlflow.Status.Conditions, tlsSecretPendingConditionType).Message
+			meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+				Type:    "Available",
+				Status:  metav1.ConditionFalse,
+				Reason:  tlsSecretPendingReason,
+				Message: message,
+			})
+			meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+				Type:    "Progressing",
+				Status:  metav1.ConditionTrue,
+				Reason:  tlsSecretPendingReason,
+				Message: message,
+			})
+			if err := r.updateStatus(ctx, mlflow); err != nil {
+				log.Error(err, "Failed to update MLflow status")
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{RequeueAfter: tlsSecretPollInterval}, nil
+		}
+		// Not in the cache yet; the Deployment watch reconciles again once it is.
+		return ctrl.Result{}, nil
 	}
 
 	// Check if deployment is ready
@@ -337,11 +942,39 @@ func (r *MLflowReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	if deployment.Spec.Replicas != nil {
 		desiredReplicas = *deployment.Spec.Replicas
 	}
+	mlflow.Status.Replicas = desiredReplicas
+	mlflow.Status.ReadyReplicas = deployment.Status.ReadyReplicas
+	if container := findContainer(deployment.Spec.Template.Spec.Containers, "mlflow"); container != nil {
+		mlflow.Status.Image = container.Image
+	}
 
 	// Only mark as ready if:
 	// 1. Desired replicas > 0 (not scaled down)
 	// 2. All desired replicas are ready
-	if desiredReplicas > 0 && deployment.Status.ReadyReplicas >= desiredReplicas {
+	deploymentReady := desiredReplicas > 0 && deployment.Status.ReadyReplicas >= desiredReplicas
+	var requeueAfter, bootstrapRequeue, usageRequeue time.Duration
+	var healthErr error
+	if deploymentReady && deepHealthCheckEnabled(mlflow, r.ConsoleLinkAvailable) && mlflow.Status.Address != nil {
+		healthErr = r.healthProber.Probe(ctx, mlflow.Status.Address.URL)
+	}
+
+	if healthErr != nil {
+		log.Info("MLflow deep health check failed", "error", healthErr.Error())
+		message := fmt.Sprintf("MLflow deployment is ready but failed its health check: %v", healthErr)
+		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+			Type:    "Available",
+			Status:  metav1.ConditionFalse,
+			Reason:  healthCheckFailedReason,
+			Message: message,
+		})
+		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+			Type:    "Progressing",
+			Status:  metav1.ConditionTrue,
+			Reason:  healthCheckFailedReason,
+			Message: message,
+		})
+		requeueAfter = 10 * time.Second
+	} else if deploymentReady {
 		migrationJob := &batchv1.Job{}
 		jobErr := r.Get(ctx, types.NamespacedName{Name: migrationJobName(mlflow), Namespace: targetNamespace}, migrationJob)
 		switch {
@@ -368,16 +1001,26 @@ func (r *MLflowReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 			Reason:  "ReconcileComplete",
 			Message: "MLflow reconciliation completed successfully",
 		})
+		requeueAfter = r.checkExternalReachability(ctx, mlflow, time.Now())
+		bootstrapRequeue = r.reconcileBootstrap(ctx, mlflow)
+		usageRequeue = r.reconcileUsageStatistics(ctx, mlflow, time.Now())
 	} else {
 		// Deployment not ready yet
 		message := fmt.Sprintf("MLflow deployment not ready: %d/%d replicas ready", deployment.Status.ReadyReplicas, desiredReplicas)
+		reason := "DeploymentNotReady"
 		if desiredReplicas == 0 {
 			message = "MLflow deployment scaled to zero replicas"
+		} else if failure, err := r.deploymentPodFailure(ctx, deployment); err != nil {
+			log.Error(err, "Failed to inspect MLflow pods")
+		} else if failure != nil {
+			// Name the container error instead of only the ready count.
+			reason = failure.reason
+			message = fmt.Sprintf("%s: %s", message, failure.message)
 		}
 		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
 			Type:    "Available",
 			Status:  metav1.ConditionFalse,
-			Reason:  "DeploymentNotReady",
+			Reason:  reason,
 			Message: message,
 		})
 		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
@@ -386,48 +1029,86 @@ func (r *MLflowReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 			Reason:  "DeploymentProgressing",
 			Message: message,
 		})
-		// Keep requeuing until ready
-		if err := r.updateStatus(ctx, mlflow); err != nil {
-			log.Error(err, "Failed to update MLflow status after retries")
-			return ctrl.Result{}, err
-		}
-		return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+		// No polling: the owned Deployment and the server pod watch trigger the next reconcile.
+	}
+
+	// The ConsoleLink follows the conditions set above, so it only points at a serving URL.
+	if err := r.reconcileConsoleLink(ctx, mlflow, cfg); err != nil {
+		return ctrl.Result{}, r.failConsoleLink(ctx, mlflow, err)
 	}
 
 	if err := r.updateStatus(ctx, mlflow); err != nil {
-		log.Error(err, "Failed to update MLflow status after retries")
+		log.Error(err, "Failed to update MLflow status")
 		return ctrl.Result{}, err
 	}
+	if healthErr != nil {
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
 
-	log.Info("Successfully reconciled MLflow")
-	return ctrl.Result{}, nil
+	if deploymentReady {
+		log.Info("Successfully reconciled MLflow")
+	}
+	result := hibernationResult(hibernation, time.Now())
+	requeues := []time.Duration{
+		canaryRequeue, blueGreenRequeue, requeueAfter, bootstrapRequeue, usageRequeue, tlsRenewAfter,
+		imageDigestPollInterval(mlflow),
+	}
+	for _, after := range requeues {
+		if after > 0 && (result.RequeueAfter == 0 || after < result.RequeueAfter) {
+			result.RequeueAfter = after
+		}
+	}
+	return result, nil
 }
 
 // applyObject applies a single Kubernetes object using Server-Side Apply
 func (r *MLflowReconciler) applyObject(ctx context.Context, obj client.Object) error {
+	return r.applyObjectWithReads(ctx, obj, nil)
+}
+
+// applyObjectWithReads is applyObject reusing the live object the dry-run pass recorded in reads.
+func (r *MLflowReconciler) applyObjectWithReads(ctx context.Context, obj client.Object, reads *liveReads) error {
 	log := logf.FromContext(ctx)
+	live, liveErr := r.readLive(ctx, obj, reads)
 
 	// Special handling for PVCs - check if it exists first since specs are immutable
 	if obj.GetObjectKind().GroupVersionKind().Kind == "PersistentVolumeClaim" {
-		existing := obj.DeepCopyObject().(client.Object)
-		err := r.Get(ctx, client.ObjectKeyFromObject(obj), existing)
-		if err == nil {
+		if liveErr == nil {
 			// PVC already exists, skip to avoid immutability errors
 			log.V(1).Info("PVC already exists, skipping (PVC specs are immutable)", "name", obj.GetName(), "namespace", obj.GetNamespace())
+			obj.SetUID(live.GetUID())
 			return nil
-		} else if !errors.IsNotFound(err) {
-			return err
+		} else if !errors.IsNotFound(liveErr) {
+			return liveErr
 		}
 		// PVC doesn't exist, fall through to create it via SSA
 	}
 
+	// Skip the patch entirely when the live object still matches the last manifest we applied
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	hash, err := stampManifestHash(obj)
+	if err != nil {
+		return err
+	}
+	if live != nil && r.liveObjectInSync(obj, live, hash) {
+		applySkippedTotal.WithLabelValues(gvk.Kind).Inc()
+		log.V(1).Info("Object unchanged, skipping apply", "kind", gvk.Kind, "name", obj.GetName(), "namespace", obj.GetNamespace())
+		return nil
+	}
+
+	r.reportApplyDiff(ctx, obj)
+
 	// Use Server-Side Apply - the API server handles all the merge logic
 	// This avoids unnecessary updates when only metadata changes
-	err := r.Patch(ctx, obj, client.Apply, client.ForceOwnership, client.FieldOwner("mlflow-operator")) //nolint:staticcheck // pre-existing, tracked separately
+	start := time.Now()
+	err = r.Patch(ctx, obj, client.Apply, client.ForceOwnership, client.FieldOwner("mlflow-operator")) //nolint:staticcheck // pre-existing, tracked separately
+	observeApply(gvk.Kind, false, time.Since(start).Seconds(), err)
 	if err != nil {
-		log.Error(err, "Failed to apply object", "kind", obj.GetObjectKind().GroupVersionKind().Kind, "name", obj.GetName(), "namespace", obj.GetNamespace())
+		log.Error(err, "Failed to apply object", "kind", gvk.Kind, "name", obj.GetName(), "namespace", obj.GetNamespace())
 		return err
 	}
+	obj.GetObjectKind().SetGroupVersionKind(gvk)
+	r.appliedObjects.record(obj, hash)
 
 	log.V(1).Info("Applied object", "kind", obj.GetObjectKind().GroupVersionKind().Kind, "name", obj.GetName(), "namespace", obj.GetNamespace())
 	return nil
@@ -440,32 +1121,72 @@ func (r *MLflowReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	if r.GCRBACWatchCache == nil {
 		return fmt.Errorf("GCRBACWatchCache must be configured")
 	}
+	helmChartPath := r.ChartPath
+	if helmChartPath == "" {
+		helmChartPath = chartPath
+	}
+	if err := render.ValidateChart(helmChartPath); err != nil {
+		return err
+	}
 
 	builder := ctrl.NewControllerManagedBy(mgr).
-		For(&mlflowv1.MLflow{}).
+		// Status-only updates to the MLflow CR never change rendered output.
+		For(&mlflowv1.MLflow{}, controllerbuilder.WithPredicates(mlflowChangedPredicate())).
 		Owns(&appsv1.Deployment{}).
 		Owns(&batchv1.Job{}).
 		Owns(&batchv1.CronJob{}).
-		Owns(&corev1.Secret{}).
-		Owns(&corev1.Service{}).
-		Owns(&corev1.ServiceAccount{}).
-		Owns(&corev1.PersistentVolumeClaim{}).
-		// For shared cluster-scoped RBAC objects, we use Watches instead of Owns because:
-		// 1. The shared objects can have multiple non-controller owner references (one per MLflow instance)
-		// 2. Owns() only triggers on controller owner references
-		// This handler enqueues all MLflow instances listed in the owner references.
-		Watches(&rbacv1.ClusterRole{}, handler.EnqueueRequestsFromMapFunc(r.sharedClusterRoleToMLflowRequests)).
-		Watches(&rbacv1.ClusterRoleBinding{}, handler.EnqueueRequestsFromMapFunc(r.sharedClusterRoleBindingToMLflowRequests)).
+		// Secrets and ServiceAccounts only trigger reconciles, so only their metadata is cached.
+		Owns(&corev1.Secret{}, controllerbuilder.OnlyMetadata, controllerbuilder.WithPredicates(managedObjectPredicate())).
+		Owns(&corev1.Service{}, controllerbuilder.WithPredicates(managedObjectPredicate())).
+		Owns(&corev1.ServiceAccount{}, controllerbuilder.OnlyMetadata, controllerbuilder.WithPredicates(managedObjectPredicate())).
+		Owns(&corev1.PersistentVolumeClaim{}, controllerbuilder.WithPredicates(managedObjectPredicate())).
 		// Watch platform CA bundle ConfigMap to trigger reconciliation when it appears/disappears
 		// Note: We don't restart pods on content changes - kubelet automatically updates mounted ConfigMaps
-		// This watch ensures we update the Deployment spec when the ConfigMap existence changes
+		// This watch ensures we update the Deployment spec when the ConfigMap existence changes.
+		// The runtime operator ConfigMap is watched too so setting changes apply without a restart.
+		// ConfigMaps labeled for trusted CA bundle injection are watched so an instance waiting
+		// for injection proceeds, and injected bundle changes roll the pods. The service CA
+		// ConfigMap is copied for the BackendTLSPolicy, so its rotation is followed too.
 		Watches(
 			&corev1.ConfigMap{},
 			handler.EnqueueRequestsFromMapFunc(r.configMapToMLflowRequests),
 			controllerbuilder.WithPredicates(predicate.NewPredicateFuncs(func(obj client.Object) bool {
-				return obj.GetName() == PlatformTrustedCABundleConfigMapName
+				return obj.GetName() == PlatformTrustedCABundleConfigMapName ||
+					obj.GetName() == config.RuntimeConfigMapName ||
+					(r.BackendTLSPolicyAvailable && obj.GetName() == render.ServiceCABundleConfigMapName) ||
+					requestsCABundleInjection(obj)
 			})),
 		)
+	if r.Namespaced {
+		// The chart renders Roles and RoleBindings in the target namespace instead.
+		builder = builder.
+			Owns(&rbacv1.Role{}, controllerbuilder.WithPredicates(managedObjectPredicate())).
+			Owns(&rbacv1.RoleBinding{}, controllerbuilder.WithPredicates(managedObjectPredicate()))
+	} else {
+		// For shared cluster-scoped RBAC objects, we use Watches instead of Owns because:
+		// 1. The shared objects can have multiple non-controller owner references (one per MLflow instance)
+		// 2. Owns() only triggers on controller owner references
+		// This handler enqueues all MLflow instances listed in the owner references.
+		builder = builder.
+			Watches(&rbacv1.ClusterRole{}, handler.EnqueueRequestsFromMapFunc(r.sharedClusterRoleToMLflowRequests)).
+			Watches(&rbacv1.ClusterRoleBinding{}, handler.EnqueueRequestsFromMapFunc(r.sharedClusterRoleBindingToMLflowRequests))
+	}
+	// spec.valuesFrom sources re-render the chart when they change. As above, Secrets are only
+	// cached when labeled app=mlflow; others are re-read on each reconcile.
+	builder = builder.
+		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(r.valuesSourceToMLflowRequests("ConfigMap"))).
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.valuesSourceToMLflowRequests("Secret")),
+			controllerbuilder.OnlyMetadata,
+		)
+	// The spec.workspaceArtifactCredentials source Secret is only cached, and so only synced
+	// on change, when it carries the app=mlflow label; otherwise it is re-read on each reconcile.
+	builder = builder.Watches(
+		&corev1.Secret{},
+		handler.EnqueueRequestsFromMapFunc(r.artifactCredentialsSourceToMLflowRequests),
+		controllerbuilder.OnlyMetadata,
+	)
 	if config.GetConfig().EnableMLflowOperatorModuleController {
 		builder = builder.Watches(
 			&modulev1alpha1.MLflowOperator{},
@@ -473,46 +1194,136 @@ func (r *MLflowReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		)
 	}
 
-	// Use a separate raw source for `mlflow-gc` RBAC watches instead of widening the main cache.
-	// This is a workaround for two Kubernetes/controller-runtime constraints:
-	// 1. tight resourceNames-scoped RBAC for list/watch only works when the watch is restricted to
-	//    an exact metadata.name field selector; and
-	// 2. the main cache can only carry one selector per GVK, which is already used for the shared
-	//    `mlflow` ClusterRole/ClusterRoleBinding objects.
-	// The dedicated cache lets us watch the singleton `mlflow-gc` objects too without reopening
-	// broad label-scoped RBAC on all ClusterRoles/ClusterRoleBindings.
-	builder = builder.
-		WatchesRawSource(
-			source.Kind(
-				r.GCRBACWatchCache,
-				&rbacv1.ClusterRole{},
-				handler.TypedEnqueueRequestsFromMapFunc(r.gcClusterRoleToMLflowRequests),
-			),
-		).
-		WatchesRawSource(
+	if !r.Namespaced {
+		// Use a separate raw source for `mlflow-gc` RBAC watches instead of widening the main cache.
+		// This is a workaround for two Kubernetes/controller-runtime constraints:
+		// 1. tight resourceNames-scoped RBAC for list/watch only works when the watch is restricted to
+		//    an exact metadata.name field selector; and
+		// 2. the main cache can only carry one selector per GVK, which is already used for the shared
+		//    `mlflow` ClusterRole/ClusterRoleBinding objects.
+		// The dedicated cache lets us watch the singleton `mlflow-gc` objects too without reopening
+		// broad label-scoped RBAC on all ClusterRoles/ClusterRoleBindings.
+		builder = builder.
+			WatchesRawSource(
+				source.Kind(
+					r.GCRBACWatchCache,
+					&rbacv1.ClusterRole{},
+					handler.TypedEnqueueRequestsFromMapFunc(r.gcClusterRoleToMLflowRequests),
+				),
+			).
+			WatchesRawSource(
+				source.Kind(
+					r.GCRBACWatchCache,
+					&rbacv1.ClusterRoleBinding{},
+					handler.TypedEnqueueRequestsFromMapFunc(r.gcClusterRoleBindingToMLflowRequests),
+				),
+			)
+	}
+
+	// Readiness is driven by the owned Deployment; the pod watch only adds container failures.
+	if r.ServerPodWatchCache != nil {
+		builder = builder.WatchesRawSource(
 			source.Kind(
-				r.GCRBACWatchCache,
-				&rbacv1.ClusterRoleBinding{},
-				handler.TypedEnqueueRequestsFromMapFunc(r.gcClusterRoleBindingToMLflowRequests),
+				r.ServerPodWatchCache,
+				&corev1.Pod{},
+				handler.TypedEnqueueRequestsFromMapFunc(r.serverPodToMLflowRequests),
+				podFailureChangedPredicate(),
 			),
 		)
+	}
 
 	// Conditionally watch ConsoleLink if available in the cluster
-	if r.ConsoleLinkAvailable {
+	if r.ConsoleLinkAvailable && !r.Namespaced {
 		log.Info("ConsoleLink CRD available, adding to watch list")
 		builder = builder.Owns(&consolev1.ConsoleLink{})
 	} else {
 		log.Info("ConsoleLink CRD not available, skipping watch")
 	}
+	if r.ConsolePluginAvailable {
+		log.Info("ConsolePlugin CRD available, adding to watch list")
+		builder = builder.Owns(&consolev1.ConsolePlugin{})
+	}
 
 	// Conditionally watch HTTPRoute if available in the cluster
 	if r.HTTPRouteAvailable {
 		log.Info("HTTPRoute CRD available, adding to watch list")
-		builder = builder.Owns(&gatewayv1.HTTPRoute{})
+		builder = builder.Owns(&gatewayv1.HTTPRoute{}).
+			// Parent Gateways feed the RouteReady condition.
+			Watches(&gatewayv1.Gateway{}, handler.EnqueueRequestsFromMapFunc(r.gatewayToMLflowRequests))
 	} else {
 		log.Info("HTTPRoute CRD not available, skipping watch")
 	}
 
+	// Watch Istio routing objects only when they are the active routing backend
+	if r.usesVirtualServiceRouting() {
+		log.Info("VirtualService CRD available without HTTPRoute, adding Istio routing objects to watch list")
+		virtualService := &unstructured.Unstructured{}
+		virtualService.SetGroupVersionKind(VirtualServiceGVK)
+		destinationRule := &unstructured.Unstructured{}
+		destinationRule.SetGroupVersionKind(DestinationRuleGVK)
+		builder = builder.Owns(virtualService).Owns(destinationRule)
+	}
+	if r.BackendTLSPolicyAvailable {
+		log.Info("BackendTLSPolicy CRD available, adding to watch list")
+		builder = builder.Owns(&gatewayv1.BackendTLSPolicy{})
+	}
+	if r.RateLimitPolicyAvailable {
+		log.Info("RateLimitPolicy CRD available, adding to watch list")
+		rateLimitPolicy := &unstructured.Unstructured{}
+		rateLimitPolicy.SetGroupVersionKind(RateLimitPolicyGVK)
+		builder = builder.Owns(rateLimitPolicy)
+	}
+	if r.OpenClusterManagementAvailable {
+		log.Info("Open Cluster Management APIs available, adding ManifestWorks and PlacementDecisions to watch list")
+		manifestWork := &unstructured.Unstructured{}
+		manifestWork.SetGroupVersionKind(ManifestWorkGVK)
+		placementDecision := &unstructured.Unstructured{}
+		placementDecision.SetGroupVersionKind(PlacementDecisionGVK)
+		builder = builder.Owns(manifestWork).
+			Watches(placementDecision, handler.EnqueueRequestsFromMapFunc(r.placementDecisionToMLflowRequests))
+	}
+	if r.ServiceMeshAvailable {
+		log.Info("PeerAuthentication CRD available, adding service mesh objects to watch list")
+		peerAuthentication := &unstructured.Unstructured{}
+		peerAuthentication.SetGroupVersionKind(PeerAuthenticationGVK)
+		builder = builder.Owns(peerAuthentication)
+		if !r.usesVirtualServiceRouting() {
+			destinationRule := &unstructured.Unstructured{}
+			destinationRule.SetGroupVersionKind(DestinationRuleGVK)
+			builder = builder.Owns(destinationRule)
+		}
+	}
+
+	// Watch MLflowConfig singletons so new workspaces get their connection ConfigMap
+	if r.MLflowConfigAvailable {
+		log.Info("MLflowConfig CRD available, adding to watch list")
+		mlflowConfig := &unstructured.Unstructured{}
+		mlflowConfig.SetGroupVersionKind(MLflowConfigGVK)
+		builder = builder.Watches(mlflowConfig, handler.EnqueueRequestsFromMapFunc(r.mlflowConfigToMLflowRequests))
+		// Token Secrets are deleted or invalidated to rotate client credentials without any
+		// MLflowConfig change.
+		if r.ClientTokenWatchCache != nil {
+			builder = builder.WatchesRawSource(
+				source.Kind(
+					r.ClientTokenWatchCache,
+					secretMetadata(),
+					handler.TypedEnqueueRequestsFromMapFunc(r.clientTokenSecretToMLflowRequests),
+				),
+			)
+		}
+		if r.DataConnectionWatchCache != nil {
+			builder = builder.WatchesRawSource(
+				source.Kind(
+					r.DataConnectionWatchCache,
+					secretMetadata(),
+					handler.TypedEnqueueRequestsFromMapFunc(r.dataConnectionToMLflowRequests),
+				),
+			)
+		}
+	} else {
+		log.Info("MLflowConfig CRD not available, skipping watch")
+	}
+
 	// Conditionally watch ServiceMonitor if available in the cluster
 	if r.ServiceMonitorAvailable {
 		log.Info("ServiceMonitor CRD available, adding to watch list")
@@ -520,31 +1331,71 @@ func (r *MLflowReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	} else {
 		log.Info("ServiceMonitor CRD not available, skipping watch")
 	}
+	if r.PodMonitorAvailable {
+		log.Info("PodMonitor CRD available, adding to watch list")
+		builder = builder.Owns(&monitoringv1.PodMonitor{})
+	}
+	if r.PrometheusRuleAvailable {
+		log.Info("PrometheusRule CRD available, adding to watch list")
+		builder = builder.Owns(&monitoringv1.PrometheusRule{})
+	}
 
 	return builder.Complete(r)
 }
 
 func (r *MLflowReconciler) applyRenderedObjects(ctx context.Context, mlflow *mlflowv1.MLflow, objects []*unstructured.Unstructured) error {
-	log := logf.FromContext(ctx)
-	for _, obj := range objects {
-		if obj.GetKind() != "Namespace" {
-			if isSharedRBACObject(obj) {
-				if err := r.appendOwnerReference(ctx, mlflow, obj); err != nil {
-					log.Error(err, "Failed to append owner reference", "object", obj.GetKind(), "name", obj.GetName())
-					return fmt.Errorf("append owner reference to %s/%s: %w", obj.GetKind(), obj.GetName(), err)
-				}
-			} else {
-				if err := controllerutil.SetControllerReference(mlflow, obj, r.Scheme); err != nil {
-					log.Error(err, "Failed to set controller reference", "object", obj.GetKind(), "name", obj.GetName())
-					return fmt.Errorf("set controller reference on %s/%s: %w", obj.GetKind(), obj.GetName(), err)
+	// Validate the whole set first so one rejected object does not leave the rest half-applied
+	// and the user sees every problem at once.
+	reads := &liveReads{}
+	if err := r.dryRunRenderedObjects(ctx, mlflow, objects, reads); err != nil {
+		return err
+	}
+	// Patches overwrite objects with the server response, so keep the manifests for the revision.
+	revision := copyObjects(objects)
+	var (
+		mu      sync.Mutex
+		applied []*unstructured.Unstructured
+	)
+	for _, wave := range groupApplyWaves(objects) {
+		group, groupCtx := errgroup.WithContext(ctx)
+		group.SetLimit(maxConcurrentApplies)
+		for _, obj := range wave {
+			group.Go(func() error {
+				if err := r.applyObjectWithReads(groupCtx, obj, reads); err != nil {
+					logf.FromContext(groupCtx).Error(err, "Failed to apply object", "kind", obj.GetKind(), "name", obj.GetName())
+					return fmt.Errorf("apply %s/%s: %w", obj.GetKind(), obj.GetName(), err)
 				}
-			}
+				mu.Lock()
+				applied = append(applied, obj)
+				mu.Unlock()
+				return nil
+			})
 		}
+		if err := group.Wait(); err != nil {
+			return r.rollbackPartialApply(ctx, mlflow, applied, err)
+		}
+	}
+	r.appliedRevisions.put(mlflow.Name, revision)
+	return nil
+}
 
-		if err := r.applyObject(ctx, obj); err != nil {
-			log.Error(err, "Failed to apply object", "kind", obj.GetKind(), "name", obj.GetName())
-			return fmt.Errorf("apply %s/%s: %w", obj.GetKind(), obj.GetName(), err)
+// setRenderedObjectOwner makes mlflow the controller of obj, or one of the owners of a shared
+// RBAC object.
+func (r *MLflowReconciler) setRenderedObjectOwner(ctx context.Context, mlflow *mlflowv1.MLflow, obj *unstructured.Unstructured) error {
+	log := logf.FromContext(ctx)
+	if obj.GetKind() == "Namespace" {
+		return nil
+	}
+	if isSharedRBACObject(obj) {
+		if err := r.appendOwnerReference(ctx, mlflow, obj); err != nil {
+			log.Error(err, "Failed to append owner reference", "object", obj.GetKind(), "name", obj.GetName())
+			return fmt.Errorf("append owner reference to %s/%s: %w", obj.GetKind(), obj.GetName(), err)
 		}
+		return nil
+	}
+	if err := controllerutil.SetControllerReference(mlflow, obj, r.Scheme); err != nil {
+		log.Error(err, "Failed to set controller reference", "object", obj.GetKind(), "name", obj.GetName())
+		return fmt.Errorf("set controller reference on %s/%s: %w", obj.GetKind(), obj.GetName(), err)
 	}
 	return nil
 }
@@ -585,7 +1436,7 @@ func (r *MLflowReconciler) configMapToMLflowRequests(ctx context.Context, obj cl
 
 	requests := make([]reconcile.Request, 0, len(mlflowList.Items))
 	for _, mlflow := range mlflowList.Items {
-		log.V(1).Info("Enqueueing MLflow reconciliation due to platform CA bundle change",
+		log.V(1).Info("Enqueueing MLflow reconciliation due to ConfigMap change",
 			"mlflow", mlflow.Name,
 			"configmap", obj.GetName(),
 			"configmap-namespace", obj.GetNamespace())
@@ -624,19 +1475,107 @@ func (r *MLflowReconciler) mlflowOperatorToMLflowRequests(ctx context.Context, o
 	return requests
 }
 
-// updateStatus updates the MLflow status with retry on conflict
-func (r *MLflowReconciler) updateStatus(ctx context.Context, mlflow *mlflowv1.MLflow) error {
-	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
-		// Get the latest version before updating
-		latest := &mlflowv1.MLflow{}
-		if err := r.Get(ctx, types.NamespacedName{Name: mlflow.Name, Namespace: mlflow.Namespace}, latest); err != nil {
-			return err
-		}
-		// Copy the status from our in-memory version to the latest version
-		latest.Status = mlflow.Status
-		// Update the status
-		return r.Status().Update(ctx, latest)
+// failConsoleLink records a failed ConsoleLink reconcile in the Available condition and
+// returns cause for the caller to surface.
+func (r *MLflowReconciler) failConsoleLink(ctx context.Context, mlflow *mlflowv1.MLflow, cause error) error {
+	log := logf.FromContext(ctx)
+	log.Error(cause, "Failed to reconcile ConsoleLink")
+	meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+		Type:    "Available",
+		Status:  metav1.ConditionFalse,
+		Reason:  "ConsoleLinkFailed",
+		Message: fmt.Sprintf("Failed to reconcile ConsoleLink: %v", cause),
 	})
+	if err := r.updateStatus(ctx, mlflow); err != nil {
+		log.Error(err, "Failed to update MLflow status")
+	}
+	return cause
+}
+
+// updateStatus writes the computed status when it differs from the current one, then sends
+// notifications for the state transitions it recorded. The cache can lag behind the previous
+// write, so the status this reconciler last wrote takes precedence over the cached one as the
+// comparison base, the patch base, and the previous state for notifications. The operator is
+// the only writer of MLflow status, so the merge patch carries no resourceVersion.
+func (r *MLflowReconciler) updateStatus(ctx context.Context, mlflow *mlflowv1.MLflow) error {
+	latest := &mlflowv1.MLflow{}
+	if err := r.Get(ctx, types.NamespacedName{Name: mlflow.Name, Namespace: mlflow.Namespace}, latest); err != nil {
+		return err
+	}
+	if written, ok := r.writtenStatuses.get(latest); ok {
+		latest.Status = *written
+	}
+	previous := latest.Status.DeepCopy()
+	if equality.Semantic.DeepEqual(latest.Status, mlflow.Status) {
+		return nil
+	}
+	patch := client.MergeFrom(latest.DeepCopy())
+	latest.Status = mlflow.Status
+	if err := r.Status().Patch(ctx, latest, patch); err != nil {
+		// The patch may or may not have landed; fall back to the cache until the next write.
+		r.writtenStatuses.forget(mlflow.Name)
+		return err
+	}
+	r.writtenStatuses.put(latest)
+	r.notifyStatusTransitions(ctx, mlflow, previous)
+	return nil
+}
+
+// writtenStatuses remembers the status each MLflow instance last had written, keyed by name
+// and guarded by UID so a recreated instance does not inherit its predecessor's status.
+type writtenStatuses struct {
+	mu      sync.Mutex
+	entries map[string]writtenStatus
+}
+
+type writtenStatus struct {
+	uid    types.UID
+	status *mlflowv1.MLflowStatus
+}
+
+func (w *writtenStatuses) get(mlflow *mlflowv1.MLflow) (*mlflowv1.MLflowStatus, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	entry, ok := w.entries[mlflow.Name]
+	if !ok || entry.uid != mlflow.UID {
+		return nil, false
+	}
+	return entry.status.DeepCopy(), true
+}
+
+func (w *writtenStatuses) put(mlflow *mlflowv1.MLflow) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.entries == nil {
+		w.entries = make(map[string]writtenStatus)
+	}
+	w.entries[mlflow.Name] = writtenStatus{uid: mlflow.UID, status: mlflow.Status.DeepCopy()}
+}
+
+func (w *writtenStatuses) forget(name string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.entries, name)
+}
+
+// recordAppliedManifests records which chart build produced the applied resources.
+// lastAppliedTime only moves when the applied generation, chart version, or resource
+// count changes, so identical resyncs do not produce a status write (and a new watch
+// event) on every reconcile.
+func recordAppliedManifests(mlflow *mlflowv1.MLflow, chartVersion string, resourceCount int, now metav1.Time) {
+	status := &mlflow.Status
+	count := int32(resourceCount)
+	if status.LastAppliedTime == nil ||
+		status.ObservedGeneration != mlflow.Generation ||
+		status.HelmChartVersion != chartVersion ||
+		status.AppliedResourceCount != count {
+		status.LastAppliedTime = &now
+	}
+	status.ObservedGeneration = mlflow.Generation
+	status.HelmChartVersion = chartVersion
+	status.AppliedResourceCount = count
 }
 
 // appendOwnerReference appends an owner reference to the object without removing existing ones.
@@ -722,5 +1661,6 @@ func NewGCRBACWatchCache(cfg *rest.Config, scheme *runtime.Scheme) (crcache.Cach
 			&rbacv1.ClusterRole{}:        {Field: gcClusterRBACFieldSelector},
 			&rbacv1.ClusterRoleBinding{}: {Field: gcClusterRBACFieldSelector},
 		},
+		DefaultTransform: crcache.TransformStripManagedFields(),
 	})
 }
@@ -17,10 +17,21 @@ limitations under the License.
 package controller
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
 )
@@ -140,3 +151,165 @@ func TestSharedRBACObjectToMLflowRequests(t *testing.T) {
 		t.Fatalf("sharedRBACObjectToMLflowRequests() for GC = %#v, want single request for mlflow-a", gcRequests)
 	}
 }
+
+func TestRecordAppliedManifests(t *testing.T) {
+	first := metav1.NewTime(time.Date(2026, 10, 14, 8, 0, 0, 0, time.UTC))
+	later := metav1.NewTime(first.Add(time.Hour))
+
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "mlflow", Generation: 1}}
+	recordAppliedManifests(mlflow, "0.1.0", 9, first)
+	if mlflow.Status.HelmChartVersion != "0.1.0" || mlflow.Status.AppliedResourceCount != 9 || mlflow.Status.ObservedGeneration != 1 {
+		t.Fatalf("unexpected status after first apply: %+v", mlflow.Status)
+	}
+	if mlflow.Status.LastAppliedTime == nil || !mlflow.Status.LastAppliedTime.Equal(&first) {
+		t.Fatalf("lastAppliedTime = %v, want %v", mlflow.Status.LastAppliedTime, first)
+	}
+
+	// An identical resync keeps the timestamp so the status write is a no-op.
+	recordAppliedManifests(mlflow, "0.1.0", 9, later)
+	if !mlflow.Status.LastAppliedTime.Equal(&first) {
+		t.Errorf("lastAppliedTime moved on identical resync: %v", mlflow.Status.LastAppliedTime)
+	}
+
+	// A new generation moves it.
+	mlflow.Generation = 2
+	recordAppliedManifests(mlflow, "0.1.0", 9, later)
+	if !mlflow.Status.LastAppliedTime.Equal(&later) || mlflow.Status.ObservedGeneration != 2 {
+		t.Errorf("lastAppliedTime = %v observedGeneration = %d, want %v and 2",
+			mlflow.Status.LastAppliedTime, mlflow.Status.ObservedGeneration, later)
+	}
+}
+
+func TestUpdateStatusSkipsNoOpWrites(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	if err := mlflowv1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: ResourceName}}
+	writes := 0
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(mlflow.DeepCopy()).WithStatusSubresource(&mlflowv1.MLflow{}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			SubResourceUpdate: func(ctx context.Context, c client.Client, subResource string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+				t.Errorf("unexpected status update of %s", obj.GetName())
+				return c.SubResource(subResource).Update(ctx, obj, opts...)
+			},
+			SubResourcePatch: func(ctx context.Context, c client.Client, subResource string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+				writes++
+				return c.SubResource(subResource).Patch(ctx, obj, patch, opts...)
+			},
+		}).Build()
+	r := &MLflowReconciler{Client: k8sClient, Scheme: scheme}
+
+	meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+		Type:               "Available",
+		Status:             metav1.ConditionTrue,
+		Reason:             "DeploymentReady",
+		LastTransitionTime: metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+	})
+	mlflow.Status.URL = "https://mlflow.example.com/mlflow"
+	if err := r.updateStatus(ctx, mlflow); err != nil {
+		t.Fatal(err)
+	}
+	if writes != 1 {
+		t.Fatalf("status writes = %d, want 1", writes)
+	}
+
+	// Recomputing the same status must not write again.
+	if err := r.updateStatus(ctx, mlflow.DeepCopy()); err != nil {
+		t.Fatal(err)
+	}
+	if writes != 1 {
+		t.Fatalf("status writes after a no-op update = %d, want 1", writes)
+	}
+
+	// Cleared fields are removed by the patch.
+	mlflow.Status.URL = ""
+	if err := r.updateStatus(ctx, mlflow); err != nil {
+		t.Fatal(err)
+	}
+	live := &mlflowv1.MLflow{}
+	if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(mlflow), live); err != nil {
+		t.Fatal(err)
+	}
+	if writes != 2 || live.Status.URL != "" || len(live.Status.Conditions) != 1 {
+		t.Fatalf("writes = %d, live status = %+v", writes, live.Status)
+	}
+}
+
+func TestUpdateStatusIgnoresStaleCache(t *testing.T) {
+	ctx := context.Background()
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requests.Add(1)
+	}))
+	defer server.Close()
+
+	available := func(status metav1.ConditionStatus) mlflowv1.MLflowStatus {
+		return *testStatusWithConditions(metav1.Condition{
+			Type:               "Available",
+			Status:             status,
+			Reason:             "Deployment",
+			LastTransitionTime: metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+		})
+	}
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: ResourceName, UID: "mlflow-uid"},
+		Spec: mlflowv1.MLflowSpec{Notifications: &mlflowv1.NotificationsConfig{
+			WebhookURLFrom: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "mlflow-webhook"}, Key: "url"},
+		}},
+		Status: available(metav1.ConditionTrue),
+	}
+	// The cache never catches up: every read returns the initial status.
+	stale := mlflow.Status.DeepCopy()
+	writes := 0
+	r := newTestReconcilerFrom(t, fake.NewClientBuilder().
+		WithObjects(mlflow.DeepCopy(), &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "mlflow-webhook", Namespace: "test-ns"},
+			Data:       map[string][]byte{"url": []byte(server.URL)},
+		}).
+		WithStatusSubresource(&mlflowv1.MLflow{}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+				if err := c.Get(ctx, key, obj, opts...); err != nil {
+					return err
+				}
+				if cached, ok := obj.(*mlflowv1.MLflow); ok {
+					cached.Status = *stale.DeepCopy()
+				}
+				return nil
+			},
+			SubResourcePatch: func(ctx context.Context, c client.Client, subResource string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+				writes++
+				return c.SubResource(subResource).Patch(ctx, obj, patch, opts...)
+			},
+		}))
+	r.Namespace = "test-ns"
+
+	mlflow.Status = available(metav1.ConditionFalse)
+	if err := r.updateStatus(ctx, mlflow.DeepCopy()); err != nil {
+		t.Fatal(err)
+	}
+	if writes != 1 || requests.Load() != 1 {
+		t.Fatalf("writes = %d, notifications = %d, want 1 and 1", writes, requests.Load())
+	}
+
+	// The stale cache still shows Available=True, but the status is already written and the
+	// transition already reported.
+	if err := r.updateStatus(ctx, mlflow.DeepCopy()); err != nil {
+		t.Fatal(err)
+	}
+	if writes != 1 || requests.Load() != 1 {
+		t.Fatalf("writes = %d, notifications = %d after an unchanged update, want 1 and 1", writes, requests.Load())
+	}
+
+	// Going back to the stale status is a real change and must be written.
+	mlflow.Status = available(metav1.ConditionTrue)
+	if err := r.updateStatus(ctx, mlflow.DeepCopy()); err != nil {
+		t.Fatal(err)
+	}
+	if writes != 2 || requests.Load() != 2 {
+		t.Fatalf("writes = %d, notifications = %d after recovery, want 2 and 2", writes, requests.Load())
+	}
+}
@@ -35,6 +35,7 @@ import (
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
 )
 
 var _ = Describe("MLflow Controller", func() {
@@ -77,20 +78,20 @@ var _ = Describe("MLflow Controller", func() {
 							return &val
 						}(),
 						// Storage is required when using sqlite backend
-						Storage: &corev1.PersistentVolumeClaimSpec{
+						Storage: &mlflowv1.StorageConfig{PersistentVolumeClaimSpec: corev1.PersistentVolumeClaimSpec{
 							AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
 							Resources: corev1.VolumeResourceRequirements{
 								Requests: corev1.ResourceList{
 									corev1.ResourceStorage: resource.MustParse("1Gi"),
 								},
 							},
-						},
+						}},
 					},
 				}
 				Expect(k8sClient.Create(ctx, mlflowResource)).To(Succeed())
 			}
 			Expect(k8sClient.Get(ctx, typeNamespacedName, mlflow)).To(Succeed())
-			mlflow.Status.Version = SupportedMLflowVersion
+			mlflow.Status.Version = config.SupportedMLflowVersion
 			Expect(k8sClient.Status().Update(ctx, mlflow)).To(Succeed())
 		})
 
@@ -430,5 +431,125 @@ var _ = Describe("MLflow Controller", func() {
 			Expect(errors.IsInvalid(err)).To(BeTrue())
 			Expect(err.Error()).To(ContainSubstring("MLFLOW_SERVER_DISABLE_SECURITY_MIDDLEWARE"))
 		})
+
+		It("rejects artifacts.s3.endpointURL together with an MLFLOW_S3_ENDPOINT_URL env var", func() {
+			artifactRoot := "s3://bucket/artifacts"
+			endpointURL := "https://minio.minio.svc:9000"
+			mlflow := &mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: resourceName,
+				},
+				Spec: mlflowv1.MLflowSpec{
+					DefaultArtifactRoot: &artifactRoot,
+					BackendStoreURI:     &pgStoreURI,
+					Artifacts: &mlflowv1.ArtifactsConfig{
+						S3: &mlflowv1.S3ArtifactsConfig{EndpointURL: &endpointURL},
+					},
+					Env: []corev1.EnvVar{
+						{
+							Name:  "MLFLOW_S3_ENDPOINT_URL",
+							Value: endpointURL,
+						},
+					},
+				},
+			}
+			err := k8sClient.Create(ctx, mlflow)
+			Expect(errors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("mutually exclusive"))
+		})
+
+		It("rejects the postgres:// backend store scheme with a hint", func() {
+			artifactRoot := "s3://bucket/artifacts"
+			postgresURI := "postgres://user@db:5432/mlflow"
+			mlflow := &mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: resourceName,
+				},
+				Spec: mlflowv1.MLflowSpec{
+					DefaultArtifactRoot: &artifactRoot,
+					BackendStoreURI:     &postgresURI,
+				},
+			}
+			err := k8sClient.Create(ctx, mlflow)
+			Expect(errors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("use postgresql:// instead"))
+			Expect(err.Error()).NotTo(ContainSubstring("must start with"))
+		})
+
+		It("rejects unsupported artifact URI schemes", func() {
+			gcsRoot := "gcs://bucket/artifacts"
+			mlflow := &mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: resourceName,
+				},
+				Spec: mlflowv1.MLflowSpec{
+					DefaultArtifactRoot: &gcsRoot,
+					BackendStoreURI:     &pgStoreURI,
+				},
+			}
+			err := k8sClient.Create(ctx, mlflow)
+			Expect(errors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("use gs:// for Google Cloud Storage"))
+
+			typoRoot := "s3:/bucket/artifacts"
+			mlflow.Spec.DefaultArtifactRoot = &typoRoot
+			err = k8sClient.Create(ctx, mlflow)
+			Expect(errors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("defaultArtifactRoot must start with s3://"))
+		})
+
+		It("rejects more than one replica or worker with a SQLite backend store", func() {
+			sqliteURI := "sqlite:////mlflow/mlflow.db"
+			serveArtifactsTrue := true
+			replicas := int32(2)
+			mlflow := &mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: resourceName,
+				},
+				Spec: mlflowv1.MLflowSpec{
+					ServeArtifacts:  &serveArtifactsTrue,
+					BackendStoreURI: &sqliteURI,
+					Storage:         &mlflowv1.StorageConfig{Ephemeral: true},
+					Replicas:        &replicas,
+				},
+			}
+			err := k8sClient.Create(ctx, mlflow)
+			Expect(errors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("replicas must be 1 with a file-based backend or registry store"))
+
+			mlflow.Spec.Replicas = nil
+			mlflow.Spec.Workers = &replicas
+			err = k8sClient.Create(ctx, mlflow)
+			Expect(errors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("workers must be 1 with a file-based backend or registry store"))
+
+			mlflow.Spec.BackendStoreURI = &pgStoreURI
+			mlflow.Spec.Replicas = &replicas
+			Expect(k8sClient.Create(ctx, mlflow)).To(Succeed(), "a database server can be shared by several replicas")
+		})
+
+		It("rejects ephemeral storage combined with separate volumes", func() {
+			sqliteURI := "sqlite:////mlflow/mlflow.db"
+			serveArtifactsTrue := true
+			mlflow := &mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: resourceName,
+				},
+				Spec: mlflowv1.MLflowSpec{
+					ServeArtifacts:  &serveArtifactsTrue,
+					BackendStoreURI: &sqliteURI,
+					Storage: &mlflowv1.StorageConfig{
+						Ephemeral: true,
+						Database:  &mlflowv1.StorageVolume{},
+					},
+				},
+			}
+			err := k8sClient.Create(ctx, mlflow)
+			Expect(errors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("ephemeral storage cannot be combined"))
+
+			mlflow.Spec.Storage.Database = nil
+			Expect(k8sClient.Create(ctx, mlflow)).To(Succeed(), "ephemeral storage satisfies the sqlite storage requirement")
+		})
 	})
 })
@@ -0,0 +1,221 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+// MLflowBackupReconciler runs one Job per MLflowBackup that snapshots the MLflow backend
+// store, and optionally local artifacts, to object storage.
+type MLflowBackupReconciler struct {
+	client.Client
+	Scheme    *runtime.Scheme
+	Namespace string
+
+	// mlflow shares operator config resolution with the tracking server reconciler.
+	mlflow *MLflowReconciler
+}
+
+// +kubebuilder:rbac:groups=mlflow.opendatahub.io,resources=mlflowbackups,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=mlflow.opendatahub.io,resources=mlflowbackups/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=mlflow.opendatahub.io,resources=mlflowbackups/finalizers,verbs=update
+//
+// Backup Jobs live in the applications namespace and are covered by the Role in
+// config/rbac/namespace_role.yaml.
+
+func (r *MLflowBackupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	backup := &mlflowv1.MLflowBackup{}
+	if err := r.Get(ctx, req.NamespacedName, backup); err != nil {
+		if errors.IsNotFound(err) {
+			log.Info("MLflowBackup resource not found. Ignoring since object must be deleted")
+			return ctrl.Result{}, nil
+		}
+		log.Error(err, "Failed to get MLflowBackup")
+		return ctrl.Result{}, err
+	}
+	if backupPhaseTerminal(backup.Status.Phase) {
+		return ctrl.Result{}, nil
+	}
+
+	cfg, err := r.shared().resolveOperatorConfig(ctx)
+	if err != nil {
+		log.Error(err, "Failed to resolve operator configuration")
+		return ctrl.Result{}, err
+	}
+	namespace := cfg.ApplicationsNamespace
+
+	jobName := backupJobName(backupModeBackup, backup.Name)
+	job, err := getDataJob(ctx, r.Client, jobName, namespace)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to get backup Job: %w", err)
+	}
+
+	switch {
+	case job == nil:
+		deployment := &appsv1.Deployment{}
+		err := r.Get(ctx, types.NamespacedName{Name: ResourceName, Namespace: namespace}, deployment)
+		if errors.IsNotFound(err) {
+			setBackupPhase(&backup.Status.Phase, &backup.Status.Conditions, mlflowv1.BackupPhasePending,
+				"MLflowUnavailable", fmt.Sprintf("Waiting for the MLflow Deployment in namespace %s", namespace))
+			return ctrl.Result{RequeueAfter: backupWaitInterval}, r.updateStatus(ctx, backup)
+		}
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to get MLflow Deployment: %w", err)
+		}
+
+		job, err = buildDataJob(deployment, dataJob{
+			mode:             backupModeBackup,
+			name:             jobName,
+			location:         backupLocation(backup),
+			includeArtifacts: backup.Spec.IncludeArtifacts,
+			envFrom:          backup.Spec.Destination.EnvFrom,
+			postgreSQLImage:  cfg.MirrorImage(cfg.PostgreSQLImage),
+		})
+		if err != nil {
+			return ctrl.Result{}, r.failStatus(ctx, backup, "InvalidDeployment", err.Error())
+		}
+		if err := controllerutil.SetControllerReference(backup, job, r.Scheme); err != nil {
+			return ctrl.Result{}, fmt.Errorf("set controller reference on backup Job: %w", err)
+		}
+		if err := r.Create(ctx, job); err != nil && !errors.IsAlreadyExists(err) {
+			return ctrl.Result{}, fmt.Errorf("failed to create backup Job: %w", err)
+		}
+		log.Info("Created backup Job", "job", jobName, "location", backupLocation(backup))
+		now := metav1.Now()
+		backup.Status.StartTime = &now
+		backup.Status.Location = backupLocation(backup)
+		setBackupPhase(&backup.Status.Phase, &backup.Status.Conditions, mlflowv1.BackupPhaseRunning,
+			"BackupRunning", fmt.Sprintf("Backup Job %s is running", jobName))
+	case isJobSuccessful(job):
+		completed := metav1.Now()
+		backup.Status.CompletionTime = &completed
+		setBackupPhase(&backup.Status.Phase, &backup.Status.Conditions, mlflowv1.BackupPhaseSucceeded,
+			"BackupSucceeded", fmt.Sprintf("Backup written to %s", backup.Status.Location))
+		log.Info("Backup succeeded", "location", backup.Status.Location)
+		return ctrl.Result{}, r.updateStatus(ctx, backup)
+	case isJobFailed(job):
+		return ctrl.Result{}, r.failStatus(ctx, backup, "BackupFailed", dataJobFailureMessage(ctx, r.Client, job))
+	default:
+		setBackupPhase(&backup.Status.Phase, &backup.Status.Conditions, mlflowv1.BackupPhaseRunning,
+			"BackupRunning", fmt.Sprintf("Backup Job %s is running", jobName))
+	}
+
+	if err := r.updateStatus(ctx, backup); err != nil {
+		log.Error(err, "Failed to update MLflowBackup status")
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: backupPollInterval}, nil
+}
+
+// shared returns the tracking server reconciler used for config resolution.
+func (r *MLflowBackupReconciler) shared() *MLflowReconciler {
+	if r.mlflow == nil {
+		r.mlflow = &MLflowReconciler{Client: r.Client, Scheme: r.Scheme, Namespace: r.Namespace}
+	}
+	return r.mlflow
+}
+
+// failStatus moves the backup to the terminal Failed phase.
+func (r *MLflowBackupReconciler) failStatus(ctx context.Context, backup *mlflowv1.MLflowBackup, reason, message string) error {
+	completed := metav1.Now()
+	backup.Status.CompletionTime = &completed
+	setBackupPhase(&backup.Status.Phase, &backup.Status.Conditions, mlflowv1.BackupPhaseFailed, reason, message)
+	logf.FromContext(ctx).Info("Backup failed", "reason", reason, "message", message)
+	if err := r.updateStatus(ctx, backup); err != nil {
+		return err
+	}
+	r.notifyFailed(ctx, backup, reason, message)
+	return nil
+}
+
+// notifyFailed posts a BackupFailed event to the notification webhook of the MLflow instance
+// the backup was taken from. Failed is terminal and never reconciled again, so the event is
+// sent once per backup.
+func (r *MLflowBackupReconciler) notifyFailed(ctx context.Context, backup *mlflowv1.MLflowBackup, reason, message string) {
+	mlflow := &mlflowv1.MLflow{}
+	if err := r.Get(ctx, types.NamespacedName{Name: ResourceName}, mlflow); err != nil {
+		if !errors.IsNotFound(err) {
+			logf.FromContext(ctx).Error(err, "Skipping backup failure notification")
+		}
+		return
+	}
+	r.shared().notify(ctx, mlflow, []notificationEvent{{
+		Event:   notificationEventBackupFailed,
+		Reason:  reason,
+		Message: fmt.Sprintf("MLflowBackup %s: %s", backup.Name, message),
+	}})
+}
+
+// updateStatus updates the MLflowBackup status with retry on conflict
+func (r *MLflowBackupReconciler) updateStatus(ctx context.Context, backup *mlflowv1.MLflowBackup) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &mlflowv1.MLflowBackup{}
+		if err := r.Get(ctx, types.NamespacedName{Name: backup.Name}, latest); err != nil {
+			return err
+		}
+		latest.Status = backup.Status
+		return r.Status().Update(ctx, latest)
+	})
+}
+
+func backupPhaseTerminal(phase mlflowv1.BackupPhase) bool {
+	return phase == mlflowv1.BackupPhaseSucceeded || phase == mlflowv1.BackupPhaseFailed
+}
+
+// setBackupPhase records phase and the matching Complete condition, which MLflowBackup and
+// MLflowRestore share.
+func setBackupPhase(phase *mlflowv1.BackupPhase, conditions *[]metav1.Condition, next mlflowv1.BackupPhase, reason, message string) {
+	*phase = next
+	status := metav1.ConditionFalse
+	if next == mlflowv1.BackupPhaseSucceeded {
+		status = metav1.ConditionTrue
+	}
+	meta.SetStatusCondition(conditions, metav1.Condition{
+		Type:    backupCompleteConditionType,
+		Status:  status,
+		Reason:  reason,
+		Message: message,
+	})
+}
+
+// SetupWithManager sets up the controller with the Manager. Jobs are polled rather than
+// watched because the Job cache only holds migration Jobs.
+func (r *MLflowBackupReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.shared()
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&mlflowv1.MLflowBackup{}).
+		Named("mlflowbackup").
+		Complete(r)
+}
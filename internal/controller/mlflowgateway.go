@@ -0,0 +1,127 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+)
+
+const (
+	// GatewayResourceName names the MLflow AI Gateway Deployment, Service, ConfigMap,
+	// ServiceAccount, and HTTPRoute.
+	GatewayResourceName = "mlflow-gateway"
+	// GatewayPathPrefix is the public path the gateway HTTPRoute serves.
+	GatewayPathPrefix = "/" + GatewayResourceName
+
+	gatewayChartPath   = "charts/mlflow-gateway"
+	gatewayServicePort = 5000
+)
+
+// gatewayRoutingEnabled reports whether the gateway should be published through an HTTPRoute.
+// Unlike the tracking server, routing is opt-in because the gateway does not authenticate.
+func gatewayRoutingEnabled(gateway *mlflowv1.MLflowGateway) bool {
+	return gateway.Spec.Routing != nil &&
+		(gateway.Spec.Routing.Enabled == nil || *gateway.Spec.Routing.Enabled)
+}
+
+// buildGatewayHTTPRoute publishes the gateway Service under GatewayPathPrefix. The prefix is
+// stripped because the gateway server, unlike the tracking server, has no static prefix option.
+func buildGatewayHTTPRoute(gateway *mlflowv1.MLflowGateway, namespace string, cfg *config.OperatorConfig) *gatewayv1.HTTPRoute {
+	pathPrefix := GatewayPathPrefix
+	replacePrefix := "/"
+	pathMatchType := gatewayv1.PathMatchPathPrefix
+	servicePort := gatewayv1.PortNumber(gatewayServicePort)
+
+	return &gatewayv1.HTTPRoute{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "gateway.networking.k8s.io/v1",
+			Kind:       "HTTPRoute",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      GatewayResourceName,
+			Namespace: namespace,
+			Labels: map[string]string{
+				"app":       ResourceName,
+				"component": GatewayResourceName,
+			},
+		},
+		Spec: gatewayv1.HTTPRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{
+				ParentRefs: buildHTTPRouteParentRefs(gateway.Spec.Routing, cfg),
+			},
+			Rules: []gatewayv1.HTTPRouteRule{
+				{
+					Matches: []gatewayv1.HTTPRouteMatch{
+						{
+							Path: &gatewayv1.HTTPPathMatch{
+								Type:  &pathMatchType,
+								Value: &pathPrefix,
+							},
+						},
+					},
+					Filters: []gatewayv1.HTTPRouteFilter{
+						{
+							Type: gatewayv1.HTTPRouteFilterURLRewrite,
+							URLRewrite: &gatewayv1.HTTPURLRewriteFilter{
+								Path: &gatewayv1.HTTPPathModifier{
+									Type:               gatewayv1.PrefixMatchHTTPPathModifier,
+									ReplacePrefixMatch: &replacePrefix,
+								},
+							},
+						},
+					},
+					BackendRefs: []gatewayv1.HTTPBackendRef{
+						{
+							BackendRef: gatewayv1.BackendRef{
+								BackendObjectReference: gatewayv1.BackendObjectReference{
+									Name: gatewayv1.ObjectName(GatewayResourceName),
+									Port: &servicePort,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// buildGatewayStatusAddress returns the in-cluster URL of the gateway Service.
+func buildGatewayStatusAddress(namespace string) *mlflowv1.MLflowAddressStatus {
+	if namespace == "" {
+		return nil
+	}
+	return &mlflowv1.MLflowAddressStatus{
+		URL: fmt.Sprintf("http://%s.%s.svc:%d", GatewayResourceName, namespace, gatewayServicePort),
+	}
+}
+
+// buildGatewayStatusURL returns the public gateway URL when it is routed and the base URL is known.
+func buildGatewayStatusURL(baseURL string, baseURLConfigured bool) string {
+	baseURL = strings.TrimRight(baseURL, "/")
+	if baseURL == "" || !baseURLConfigured {
+		return ""
+	}
+	return baseURL + GatewayPathPrefix
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	controllerbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
+)
+
+// MLflowGatewayReconciler reconciles the MLflowGateway singleton, which runs the MLflow AI
+// Gateway next to the tracking server in the operator's applications namespace.
+type MLflowGatewayReconciler struct {
+	client.Client
+	Scheme             *runtime.Scheme
+	Namespace          string
+	ChartPath          string
+	HTTPRouteAvailable bool
+
+	// mlflow shares operator config resolution and the hash-skipping apply path with the
+	// tracking server reconciler.
+	mlflow *MLflowReconciler
+}
+
+// +kubebuilder:rbac:groups=mlflow.opendatahub.io,resources=mlflowgateways,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=mlflow.opendatahub.io,resources=mlflowgateways/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=mlflow.opendatahub.io,resources=mlflowgateways/finalizers,verbs=update
+//
+// The gateway's Deployment, Service, ConfigMap, and ServiceAccount live in the applications
+// namespace and are covered by the Role in config/rbac/namespace_role.yaml.
+
+func (r *MLflowGatewayReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	gateway := &mlflowv1.MLflowGateway{}
+	if err := r.Get(ctx, req.NamespacedName, gateway); err != nil {
+		if errors.IsNotFound(err) {
+			log.Info("MLflowGateway resource not found. Ignoring since object must be deleted")
+			return ctrl.Result{}, nil
+		}
+		log.Error(err, "Failed to get MLflowGateway")
+		return ctrl.Result{}, err
+	}
+
+	cfg, err := r.shared().resolveOperatorConfig(ctx)
+	if err != nil {
+		log.Error(err, "Failed to resolve operator configuration")
+		return ctrl.Result{}, err
+	}
+	namespace := cfg.ApplicationsNamespace
+
+	chartPath := r.ChartPath
+	if chartPath == "" {
+		chartPath = gatewayChartPath
+	}
+	objects, err := render.NewHelmRenderer(chartPath).RenderGatewayChart(gateway, namespace, cfg)
+	if err != nil {
+		log.Error(err, "Failed to render MLflowGateway chart")
+		return ctrl.Result{}, r.failStatus(ctx, gateway, "RenderFailed", fmt.Sprintf("Failed to render gateway manifests: %v", err), err)
+	}
+
+	for _, obj := range objects {
+		if err := controllerutil.SetControllerReference(gateway, obj, r.Scheme); err != nil {
+			return ctrl.Result{}, fmt.Errorf("set controller reference on %s/%s: %w", obj.GetKind(), obj.GetName(), err)
+		}
+		if err := r.shared().applyObject(ctx, obj); err != nil {
+			applyErr := fmt.Errorf("apply %s/%s: %w", obj.GetKind(), obj.GetName(), err)
+			return ctrl.Result{}, r.failStatus(ctx, gateway, "ApplyFailed", fmt.Sprintf("Failed to apply gateway manifests: %v", applyErr), applyErr)
+		}
+	}
+
+	if err := r.reconcileGatewayHTTPRoute(ctx, gateway, namespace, cfg); err != nil {
+		return ctrl.Result{}, r.failStatus(ctx, gateway, "HttpRouteFailed", fmt.Sprintf("Failed to reconcile gateway HttpRoute: %v", err), err)
+	}
+
+	gateway.Status.Address = buildGatewayStatusAddress(namespace)
+	gateway.Status.URL = ""
+	if r.HTTPRouteAvailable && gatewayRoutingEnabled(gateway) {
+		gateway.Status.URL = buildGatewayStatusURL(cfg.MLflowURL, cfg.MLflowURLConfigured)
+	}
+	gateway.Status.ObservedGeneration = gateway.Generation
+
+	deployment := &appsv1.Deployment{}
+	err = r.Get(ctx, types.NamespacedName{Name: GatewayResourceName, Namespace: namespace}, deployment)
+	if err != nil && !errors.IsNotFound(err) {
+		return ctrl.Result{}, fmt.Errorf("failed to get gateway Deployment: %w", err)
+	}
+	if err == nil && deployment.Status.AvailableReplicas > 0 {
+		meta.SetStatusCondition(&gateway.Status.Conditions, metav1.Condition{
+			Type:    "Available",
+			Status:  metav1.ConditionTrue,
+			Reason:  "DeploymentAvailable",
+			Message: "MLflow AI Gateway is available",
+		})
+	} else {
+		meta.SetStatusCondition(&gateway.Status.Conditions, metav1.Condition{
+			Type:    "Available",
+			Status:  metav1.ConditionFalse,
+			Reason:  "DeploymentUnavailable",
+			Message: "Waiting for MLflow AI Gateway pods to become available",
+		})
+	}
+	if err := r.updateStatus(ctx, gateway); err != nil {
+		log.Error(err, "Failed to update MLflowGateway status")
+		return ctrl.Result{}, err
+	}
+
+	log.Info("Successfully reconciled MLflowGateway")
+	return ctrl.Result{}, nil
+}
+
+// shared returns the tracking server reconciler used for config resolution and applies.
+func (r *MLflowGatewayReconciler) shared() *MLflowReconciler {
+	if r.mlflow == nil {
+		r.mlflow = &MLflowReconciler{Client: r.Client, Scheme: r.Scheme, Namespace: r.Namespace, HTTPRouteAvailable: r.HTTPRouteAvailable}
+	}
+	return r.mlflow
+}
+
+// reconcileGatewayHTTPRoute applies or removes the gateway HTTPRoute according to spec.routing.
+func (r *MLflowGatewayReconciler) reconcileGatewayHTTPRoute(
+	ctx context.Context,
+	gateway *mlflowv1.MLflowGateway,
+	namespace string,
+	cfg *config.OperatorConfig,
+) error {
+	if !r.HTTPRouteAvailable {
+		return nil
+	}
+
+	if !gatewayRoutingEnabled(gateway) {
+		existing := &gatewayv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Name: GatewayResourceName, Namespace: namespace},
+		}
+		if err := r.Delete(ctx, existing); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete gateway HttpRoute while routing is disabled: %w", err)
+		}
+		return nil
+	}
+
+	httpRoute := buildGatewayHTTPRoute(gateway, namespace, cfg)
+	if err := controllerutil.SetControllerReference(gateway, httpRoute, r.Scheme); err != nil {
+		return fmt.Errorf("failed to set controller reference on gateway HttpRoute: %w", err)
+	}
+	return r.shared().applyObject(ctx, httpRoute)
+}
+
+// failStatus records a failed Available condition and returns cause for the caller to surface.
+func (r *MLflowGatewayReconciler) failStatus(ctx context.Context, gateway *mlflowv1.MLflowGateway, reason, message string, cause error) error {
+	meta.SetStatusCondition(&gateway.Status.Conditions, metav1.Condition{
+		Type:    "Available",
+		Status:  metav1.ConditionFalse,
+		Reason:  reason,
+		Message: message,
+	})
+	if err := r.updateStatus(ctx, gateway); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to update MLflowGateway status")
+	}
+	return cause
+}
+
+// updateStatus updates the MLflowGateway status with retry on conflict
+func (r *MLflowGatewayReconciler) updateStatus(ctx context.Context, gateway *mlflowv1.MLflowGateway) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &mlflowv1.MLflowGateway{}
+		if err := r.Get(ctx, types.NamespacedName{Name: gateway.Name}, latest); err != nil {
+			return err
+		}
+		latest.Status = gateway.Status
+		return r.Status().Update(ctx, latest)
+	})
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *MLflowGatewayReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.shared()
+	chartPath := r.ChartPath
+	if chartPath == "" {
+		chartPath = gatewayChartPath
+	}
+	if err := render.ValidateChart(chartPath); err != nil {
+		return err
+	}
+	builder := ctrl.NewControllerManagedBy(mgr).
+		For(&mlflowv1.MLflowGateway{}, controllerbuilder.WithPredicates(mlflowChangedPredicate())).
+		Owns(&appsv1.Deployment{}).
+		Owns(&corev1.ConfigMap{}, controllerbuilder.WithPredicates(managedObjectPredicate())).
+		Owns(&corev1.Service{}, controllerbuilder.WithPredicates(managedObjectPredicate())).
+		Owns(&corev1.ServiceAccount{}, controllerbuilder.OnlyMetadata, controllerbuilder.WithPredicates(managedObjectPredicate()))
+	if r.HTTPRouteAvailable {
+		builder = builder.Owns(&gatewayv1.HTTPRoute{})
+	}
+	return builder.Named("mlflowgateway").Complete(r)
+}
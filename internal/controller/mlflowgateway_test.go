@@ -0,0 +1,161 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
+)
+
+func newTestMLflowGateway() *mlflowv1.MLflowGateway {
+	return &mlflowv1.MLflowGateway{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow", UID: "gateway-uid"},
+		Spec: mlflowv1.MLflowGatewaySpec{
+			Endpoints: []mlflowv1.GatewayEndpoint{{
+				Name:         "chat",
+				EndpointType: mlflowv1.GatewayEndpointTypeChat,
+				Model: mlflowv1.GatewayModel{
+					Provider: "openai",
+					Name:     "gpt-4o-mini",
+					Config:   map[string]string{"openai_api_base": "https://llm.example.com/v1"},
+					SecretConfig: map[string]corev1.SecretKeySelector{
+						"openai_api_key": {LocalObjectReference: corev1.LocalObjectReference{Name: "openai-credentials"}, Key: "api-key"},
+					},
+				},
+			}},
+		},
+	}
+}
+
+func TestRenderGatewayChart(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	gateway := newTestMLflowGateway()
+	gateway.Spec.Replicas = ptr(int32(2))
+	cfg := &config.OperatorConfig{MLflowImage: "quay.io/opendatahub/mlflow:main"}
+	objects, err := render.NewHelmRenderer("../../charts/mlflow-gateway").RenderGatewayChart(gateway, "opendatahub", cfg)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(objects).To(gomega.HaveLen(4))
+	for _, obj := range objects {
+		g.Expect(obj.GetNamespace()).To(gomega.Equal("opendatahub"))
+		g.Expect(obj.GetLabels()).To(gomega.HaveKeyWithValue("app", ResourceName), "%s must stay visible to the label-filtered cache", obj.GetKind())
+	}
+
+	deployment := &appsv1.Deployment{}
+	g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(findObject(objects, "Deployment", GatewayResourceName).Object, deployment)).To(gomega.Succeed())
+	g.Expect(*deployment.Spec.Replicas).To(gomega.Equal(int32(2)))
+	// Pods must not match the tracking server's app=mlflow Service selector.
+	g.Expect(deployment.Spec.Selector.MatchLabels).To(gomega.Equal(map[string]string{"app": GatewayResourceName}))
+	container := deployment.Spec.Template.Spec.Containers[0]
+	g.Expect(container.Image).To(gomega.Equal("quay.io/opendatahub/mlflow:main"))
+	g.Expect(container.Command).To(gomega.Equal([]string{
+		"mlflow", "gateway", "start", "--config-path", "/etc/mlflow-gateway/config.yaml", "--host", "0.0.0.0", "--port", "5000",
+	}))
+	g.Expect(container.Env).To(gomega.HaveLen(1))
+	g.Expect(container.Env[0].ValueFrom.SecretKeyRef.Name).To(gomega.Equal("openai-credentials"))
+	g.Expect(deployment.Spec.Template.Annotations).To(gomega.HaveKey("checksum/config"))
+
+	configMap := findObject(objects, "ConfigMap", GatewayResourceName)
+	g.Expect(configMap).NotTo(gomega.BeNil())
+	configYAML, _, _ := unstructured.NestedString(configMap.Object, "data", "config.yaml")
+	g.Expect(configYAML).To(gomega.ContainSubstring("$MLFLOW_GATEWAY_SECRET_0_OPENAI_API_KEY"))
+	g.Expect(findObject(objects, "Service", GatewayResourceName)).NotTo(gomega.BeNil())
+	g.Expect(findObject(objects, "ServiceAccount", GatewayResourceName)).NotTo(gomega.BeNil())
+}
+
+func TestBuildGatewayHTTPRoute(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	gateway := newTestMLflowGateway()
+	gateway.Spec.Routing = &mlflowv1.RoutingConfig{
+		Gateways: []mlflowv1.GatewayReference{{Name: "genai-gateway", Namespace: ptr("genai")}},
+	}
+	route := buildGatewayHTTPRoute(gateway, "opendatahub", &config.OperatorConfig{GatewayName: "data-science-gateway"})
+	g.Expect(route.Spec.ParentRefs).To(gomega.HaveLen(1))
+	g.Expect(string(route.Spec.ParentRefs[0].Name)).To(gomega.Equal("genai-gateway"))
+	g.Expect(route.Spec.Rules).To(gomega.HaveLen(1))
+	rule := route.Spec.Rules[0]
+	g.Expect(*rule.Matches[0].Path.Value).To(gomega.Equal(GatewayPathPrefix))
+	g.Expect(*rule.Filters[0].URLRewrite.Path.ReplacePrefixMatch).To(gomega.Equal("/"))
+	g.Expect(string(rule.BackendRefs[0].Name)).To(gomega.Equal(GatewayResourceName))
+	g.Expect(int32(*rule.BackendRefs[0].Port)).To(gomega.Equal(int32(gatewayServicePort)))
+}
+
+func TestMLflowGatewayReconcile(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(gomega.Succeed())
+	g.Expect(mlflowv1.AddToScheme(scheme)).To(gomega.Succeed())
+	g.Expect(gatewayv1.Install(scheme)).To(gomega.Succeed())
+
+	gateway := newTestMLflowGateway()
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(gateway).
+		WithStatusSubresource(&mlflowv1.MLflowGateway{}).
+		Build()
+	r := &MLflowGatewayReconciler{
+		Client:             k8sClient,
+		Scheme:             scheme,
+		Namespace:          "opendatahub",
+		ChartPath:          "../../charts/mlflow-gateway",
+		HTTPRouteAvailable: true,
+	}
+	ctx := context.Background()
+	key := types.NamespacedName{Name: GatewayResourceName, Namespace: "opendatahub"}
+	request := ctrl.Request{NamespacedName: types.NamespacedName{Name: "mlflow"}}
+
+	_, err := r.Reconcile(ctx, request)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	deployment := &appsv1.Deployment{}
+	g.Expect(k8sClient.Get(ctx, key, deployment)).To(gomega.Succeed())
+	g.Expect(metav1.IsControlledBy(deployment, gateway)).To(gomega.BeTrue())
+	err = k8sClient.Get(ctx, key, &gatewayv1.HTTPRoute{})
+	g.Expect(apierrors.IsNotFound(err)).To(gomega.BeTrue(), "routing is opt-in for the unauthenticated gateway")
+
+	updated := &mlflowv1.MLflowGateway{}
+	g.Expect(k8sClient.Get(ctx, request.NamespacedName, updated)).To(gomega.Succeed())
+	g.Expect(updated.Status.Address.URL).To(gomega.Equal("http://mlflow-gateway.opendatahub.svc:5000"))
+	available := meta.FindStatusCondition(updated.Status.Conditions, "Available")
+	g.Expect(available).NotTo(gomega.BeNil())
+	g.Expect(available.Reason).To(gomega.Equal("DeploymentUnavailable"))
+
+	updated.Spec.Routing = &mlflowv1.RoutingConfig{}
+	g.Expect(k8sClient.Update(ctx, updated)).To(gomega.Succeed())
+	_, err = r.Reconcile(ctx, request)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(k8sClient.Get(ctx, key, &gatewayv1.HTTPRoute{})).To(gomega.Succeed())
+}
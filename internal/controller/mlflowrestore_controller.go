@@ -0,0 +1,281 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+// restoreScaleDownInterval is how often a restore checks that the server pods are gone.
+const restoreScaleDownInterval = 5 * time.Second
+
+// MLflowRestoreReconciler rehydrates the MLflow instance from a succeeded MLflowBackup. It
+// holds the instance at zero replicas through the RestoreAnnotation while the restore Job
+// runs, then releases it with a forced migration so the schema matches the running image.
+type MLflowRestoreReconciler struct {
+	client.Client
+	Scheme    *runtime.Scheme
+	Namespace string
+
+	// mlflow shares operator config resolution with the tracking server reconciler.
+	mlflow *MLflowReconciler
+}
+
+// +kubebuilder:rbac:groups=mlflow.opendatahub.io,resources=mlflowrestores,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=mlflow.opendatahub.io,resources=mlflowrestores/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=mlflow.opendatahub.io,resources=mlflowrestores/finalizers,verbs=update
+
+func (r *MLflowRestoreReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	restore := &mlflowv1.MLflowRestore{}
+	if err := r.Get(ctx, req.NamespacedName, restore); err != nil {
+		if errors.IsNotFound(err) {
+			log.Info("MLflowRestore resource not found. Ignoring since object must be deleted")
+			return ctrl.Result{}, nil
+		}
+		log.Error(err, "Failed to get MLflowRestore")
+		return ctrl.Result{}, err
+	}
+	if backupPhaseTerminal(restore.Status.Phase) {
+		return ctrl.Result{}, nil
+	}
+
+	backup := &mlflowv1.MLflowBackup{}
+	if err := r.Get(ctx, types.NamespacedName{Name: restore.Spec.BackupName}, backup); err != nil {
+		if !errors.IsNotFound(err) {
+			return ctrl.Result{}, fmt.Errorf("failed to get MLflowBackup %s: %w", restore.Spec.BackupName, err)
+		}
+		return r.wait(ctx, restore, "BackupNotFound", fmt.Sprintf("MLflowBackup %s does not exist", restore.Spec.BackupName))
+	}
+	switch {
+	case backup.Status.Phase == mlflowv1.BackupPhaseFailed:
+		return ctrl.Result{}, r.failStatus(ctx, restore, "BackupFailed", fmt.Sprintf("MLflowBackup %s failed", backup.Name))
+	case backup.Status.Phase != mlflowv1.BackupPhaseSucceeded:
+		return r.wait(ctx, restore, "BackupNotReady", fmt.Sprintf("Waiting for MLflowBackup %s to succeed", backup.Name))
+	case restore.Spec.IncludeArtifacts && !backup.Spec.IncludeArtifacts:
+		return ctrl.Result{}, r.failStatus(ctx, restore, "BackupHasNoArtifacts",
+			fmt.Sprintf("MLflowBackup %s was taken without includeArtifacts", backup.Name))
+	}
+
+	mlflow := &mlflowv1.MLflow{}
+	if err := r.Get(ctx, types.NamespacedName{Name: ResourceName}, mlflow); err != nil {
+		if !errors.IsNotFound(err) {
+			return ctrl.Result{}, fmt.Errorf("failed to get MLflow: %w", err)
+		}
+		return r.wait(ctx, restore, "MLflowNotFound", "Waiting for the MLflow instance to be created")
+	}
+	if holder := mlflow.Annotations[RestoreAnnotation]; holder != restore.Name {
+		if holder != "" && r.shared().activeRestore(ctx, holder) {
+			return r.wait(ctx, restore, "RestoreInProgress", fmt.Sprintf("Waiting for MLflowRestore %s to finish", holder))
+		}
+		if err := r.holdMLflow(ctx, mlflow, restore.Name); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to hold MLflow for restore: %w", err)
+		}
+		log.Info("Scaling MLflow to zero for restore", "backup", backup.Name)
+	}
+
+	cfg, err := r.shared().resolveOperatorConfig(ctx)
+	if err != nil {
+		log.Error(err, "Failed to resolve operator configuration")
+		return ctrl.Result{}, err
+	}
+	namespace := cfg.ApplicationsNamespace
+
+	if restore.Status.StartTime == nil {
+		now := metav1.Now()
+		restore.Status.StartTime = &now
+	}
+	jobName := backupJobName(backupModeRestore, restore.Name)
+	job, err := getDataJob(ctx, r.Client, jobName, namespace)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to get restore Job: %w", err)
+	}
+
+	switch {
+	case job == nil:
+		deployment := &appsv1.Deployment{}
+		if err := r.Get(ctx, types.NamespacedName{Name: ResourceName, Namespace: namespace}, deployment); err != nil {
+			if !errors.IsNotFound(err) {
+				return ctrl.Result{}, fmt.Errorf("failed to get MLflow Deployment: %w", err)
+			}
+			setBackupPhase(&restore.Status.Phase, &restore.Status.Conditions, mlflowv1.BackupPhaseRunning,
+				"MLflowUnavailable", fmt.Sprintf("Waiting for the MLflow Deployment in namespace %s", namespace))
+			return ctrl.Result{RequeueAfter: backupWaitInterval}, r.updateStatus(ctx, restore)
+		}
+		if deployment.Status.Replicas > 0 {
+			setBackupPhase(&restore.Status.Phase, &restore.Status.Conditions, mlflowv1.BackupPhaseRunning,
+				"ScalingDown", "Waiting for MLflow server pods to stop")
+			return ctrl.Result{RequeueAfter: restoreScaleDownInterval}, r.updateStatus(ctx, restore)
+		}
+
+		job, err = buildDataJob(deployment, dataJob{
+			mode:             backupModeRestore,
+			name:             jobName,
+			location:         backup.Status.Location,
+			includeArtifacts: restore.Spec.IncludeArtifacts,
+			envFrom:          backup.Spec.Destination.EnvFrom,
+			postgreSQLImage:  cfg.MirrorImage(cfg.PostgreSQLImage),
+		})
+		if err != nil {
+			return ctrl.Result{}, r.failStatus(ctx, restore, "InvalidDeployment", err.Error())
+		}
+		if err := controllerutil.SetControllerReference(restore, job, r.Scheme); err != nil {
+			return ctrl.Result{}, fmt.Errorf("set controller reference on restore Job: %w", err)
+		}
+		if err := r.Create(ctx, job); err != nil && !errors.IsAlreadyExists(err) {
+			return ctrl.Result{}, fmt.Errorf("failed to create restore Job: %w", err)
+		}
+		log.Info("Created restore Job", "job", jobName, "location", backup.Status.Location)
+		setBackupPhase(&restore.Status.Phase, &restore.Status.Conditions, mlflowv1.BackupPhaseRunning,
+			"RestoreRunning", fmt.Sprintf("Restore Job %s is running", jobName))
+	case isJobSuccessful(job):
+		if err := r.releaseMLflow(ctx, restore.Name, true); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to release MLflow after restore: %w", err)
+		}
+		completed := metav1.Now()
+		restore.Status.CompletionTime = &completed
+		setBackupPhase(&restore.Status.Phase, &restore.Status.Conditions, mlflowv1.BackupPhaseSucceeded,
+			"RestoreSucceeded", fmt.Sprintf("Restored MLflowBackup %s", backup.Name))
+		log.Info("Restore succeeded", "backup", backup.Name)
+		return ctrl.Result{}, r.updateStatus(ctx, restore)
+	case isJobFailed(job):
+		return ctrl.Result{}, r.failStatus(ctx, restore, "RestoreFailed", dataJobFailureMessage(ctx, r.Client, job))
+	default:
+		setBackupPhase(&restore.Status.Phase, &restore.Status.Conditions, mlflowv1.BackupPhaseRunning,
+			"RestoreRunning", fmt.Sprintf("Restore Job %s is running", jobName))
+	}
+
+	if err := r.updateStatus(ctx, restore); err != nil {
+		log.Error(err, "Failed to update MLflowRestore status")
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: backupPollInterval}, nil
+}
+
+// shared returns the tracking server reconciler used for config resolution.
+func (r *MLflowRestoreReconciler) shared() *MLflowReconciler {
+	if r.mlflow == nil {
+		r.mlflow = &MLflowReconciler{Client: r.Client, Scheme: r.Scheme, Namespace: r.Namespace}
+	}
+	return r.mlflow
+}
+
+// holdMLflow points the RestoreAnnotation at name. The optimistic lock keeps two restores
+// from taking the instance at once.
+func (r *MLflowRestoreReconciler) holdMLflow(ctx context.Context, mlflow *mlflowv1.MLflow, name string) error {
+	base := mlflow.DeepCopy()
+	if mlflow.Annotations == nil {
+		mlflow.Annotations = map[string]string{}
+	}
+	mlflow.Annotations[RestoreAnnotation] = name
+	return r.Patch(ctx, mlflow, client.MergeFromWithOptions(base, client.MergeFromWithOptimisticLock{}))
+}
+
+// releaseMLflow clears the RestoreAnnotation if name still holds it. After a successful
+// restore it also requests a forced migration, since the backup may predate the image.
+func (r *MLflowRestoreReconciler) releaseMLflow(ctx context.Context, name string, migrate bool) error {
+	mlflow := &mlflowv1.MLflow{}
+	if err := r.Get(ctx, types.NamespacedName{Name: ResourceName}, mlflow); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if mlflow.Annotations[RestoreAnnotation] != name {
+		return nil
+	}
+	annotations := map[string]any{RestoreAnnotation: nil}
+	if migrate {
+		annotations[forceMigrateAnnotation] = "true"
+	}
+	patchBytes, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{"annotations": annotations},
+	})
+	if err != nil {
+		return fmt.Errorf("marshal restore annotation patch: %w", err)
+	}
+	return r.Patch(ctx, mlflow, client.RawPatch(types.MergePatchType, patchBytes))
+}
+
+// wait keeps the restore Pending with reason until its preconditions are met.
+func (r *MLflowRestoreReconciler) wait(ctx context.Context, restore *mlflowv1.MLflowRestore, reason, message string) (ctrl.Result, error) {
+	setBackupPhase(&restore.Status.Phase, &restore.Status.Conditions, mlflowv1.BackupPhasePending, reason, message)
+	return ctrl.Result{RequeueAfter: backupWaitInterval}, r.updateStatus(ctx, restore)
+}
+
+// failStatus moves the restore to the terminal Failed phase and lets the instance scale
+// back up.
+func (r *MLflowRestoreReconciler) failStatus(ctx context.Context, restore *mlflowv1.MLflowRestore, reason, message string) error {
+	if err := r.releaseMLflow(ctx, restore.Name, false); err != nil {
+		return fmt.Errorf("failed to release MLflow after restore failure: %w", err)
+	}
+	completed := metav1.Now()
+	restore.Status.CompletionTime = &completed
+	setBackupPhase(&restore.Status.Phase, &restore.Status.Conditions, mlflowv1.BackupPhaseFailed, reason, message)
+	logf.FromContext(ctx).Info("Restore failed", "reason", reason, "message", message)
+	return r.updateStatus(ctx, restore)
+}
+
+// updateStatus updates the MLflowRestore status with retry on conflict
+func (r *MLflowRestoreReconciler) updateStatus(ctx context.Context, restore *mlflowv1.MLflowRestore) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &mlflowv1.MLflowRestore{}
+		if err := r.Get(ctx, types.NamespacedName{Name: restore.Name}, latest); err != nil {
+			return err
+		}
+		latest.Status = restore.Status
+		return r.Status().Update(ctx, latest)
+	})
+}
+
+// activeRestore reports whether the named MLflowRestore exists and has not finished. A
+// deleted or finished restore no longer holds the instance down.
+func (r *MLflowReconciler) activeRestore(ctx context.Context, name string) bool {
+	restore := &mlflowv1.MLflowRestore{}
+	if err := r.Get(ctx, types.NamespacedName{Name: name}, restore); err != nil {
+		if errors.IsNotFound(err) {
+			return false
+		}
+		// Stay scaled down rather than start the server against a half-restored store.
+		logf.FromContext(ctx).Error(err, "Failed to get MLflowRestore", "restore", name)
+		return true
+	}
+	return !backupPhaseTerminal(restore.Status.Phase)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *MLflowRestoreReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.shared()
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&mlflowv1.MLflowRestore{}).
+		Named("mlflowrestore").
+		Complete(r)
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+const (
+	// notificationTimeout bounds each webhook request so a slow receiver cannot stall reconciles.
+	notificationTimeout = 5 * time.Second
+
+	notificationFormatSlack = "Slack"
+
+	notificationEventAvailable       = "Available"
+	notificationEventUnavailable     = "Unavailable"
+	notificationEventMigrationFailed = "MigrationFailed"
+	notificationEventBackupFailed    = "BackupFailed"
+)
+
+// notificationEvent is one state transition reported to the notification webhook.
+type notificationEvent struct {
+	Event   string `json:"event"`
+	Reason  string `json:"reason"`
+	Message string `json:"message"`
+}
+
+// webhookNotifier posts notification events to the webhook configured in spec.notifications.
+type webhookNotifier struct {
+	// client performs webhook requests; nil uses a client with notificationTimeout.
+	client *http.Client
+
+	clientOnce    sync.Once
+	defaultClient *http.Client
+}
+
+// statusTransitions returns the events for the transitions from previous to current. Only
+// flips of an existing Available condition are reported, so the first reconcile of a new
+// instance stays quiet, while a migration is reported each time it newly enters the failed
+// state.
+func statusTransitions(previous, current *mlflowv1.MLflowStatus) []notificationEvent {
+	var events []notificationEvent
+
+	before := meta.FindStatusCondition(previous.Conditions, "Available")
+	after := meta.FindStatusCondition(current.Conditions, "Available")
+	if before != nil && after != nil && before.Status != after.Status {
+		event := notificationEventUnavailable
+		if meta.IsStatusConditionTrue(current.Conditions, "Available") {
+			event = notificationEventAvailable
+		}
+		events = append(events, notificationEvent{Event: event, Reason: after.Reason, Message: after.Message})
+	}
+
+	before = meta.FindStatusCondition(previous.Conditions, migrationConditionType)
+	after = meta.FindStatusCondition(current.Conditions, migrationConditionType)
+	if after != nil && after.Reason == migrationReasonFailed && (before == nil || before.Reason != migrationReasonFailed) {
+		events = append(events, notificationEvent{Event: notificationEventMigrationFailed, Reason: after.Reason, Message: after.Message})
+	}
+	return events
+}
+
+// notificationPayload renders the request body for one event.
+func notificationPayload(mlflow *mlflowv1.MLflow, event notificationEvent, now time.Time) ([]byte, error) {
+	if mlflow.Spec.Notifications.Format != nil && *mlflow.Spec.Notifications.Format == notificationFormatSlack {
+		text := fmt.Sprintf("MLflow %q is %s", mlflow.Name, strings.ToLower(event.Event))
+		switch event.Event {
+		case notificationEventMigrationFailed:
+			text = fmt.Sprintf("MLflow %q database migration failed", mlflow.Name)
+		case notificationEventBackupFailed:
+			text = fmt.Sprintf("MLflow %q backup failed", mlflow.Name)
+		}
+		if event.Message != "" {
+			text += ": " + event.Message
+		}
+		return json.Marshal(map[string]string{"text": text})
+	}
+	return json.Marshal(struct {
+		MLflow string    `json:"mlflow"`
+		Time   time.Time `json:"time"`
+		notificationEvent
+	}{MLflow: mlflow.Name, Time: now.UTC(), notificationEvent: event})
+}
+
+// notifyStatusTransitions posts the transitions between previous and the status just written.
+func (r *MLflowReconciler) notifyStatusTransitions(ctx context.Context, mlflow *mlflowv1.MLflow, previous *mlflowv1.MLflowStatus) {
+	r.notify(ctx, mlflow, statusTransitions(previous, &mlflow.Status))
+}
+
+// notify posts events to the webhook of mlflow's spec.notifications, if it has one. Delivery
+// is best effort: failures are logged and never fail the reconcile.
+func (r *MLflowReconciler) notify(ctx context.Context, mlflow *mlflowv1.MLflow, events []notificationEvent) {
+	if mlflow.Spec.Notifications == nil || len(events) == 0 {
+		return
+	}
+
+	log := logf.FromContext(ctx)
+	webhookURL, err := r.notificationWebhookURL(ctx, mlflow)
+	if err != nil {
+		log.Error(err, "Skipping notifications")
+		return
+	}
+	for _, event := range events {
+		if err := r.notifier.post(ctx, mlflow, webhookURL, event); err != nil {
+			log.Error(err, "Failed to deliver notification", "event", event.Event)
+			continue
+		}
+		log.Info("Delivered notification", "event", event.Event)
+	}
+}
+
+// notificationWebhookURL reads the webhook URL from its Secret in the applications namespace.
+// The Secret is user-owned and unlabeled, so it is read as an unstructured object, which goes
+// to the API server instead of the label-filtered Secret cache.
+func (r *MLflowReconciler) notificationWebhookURL(ctx context.Context, mlflow *mlflowv1.MLflow) (string, error) {
+	cfg, err := r.resolveOperatorConfig(ctx)
+	if err != nil {
+		return "", err
+	}
+	selector := mlflow.Spec.Notifications.WebhookURLFrom
+	secret := &unstructured.Unstructured{}
+	secret.SetAPIVersion("v1")
+	secret.SetKind("Secret")
+	key := types.NamespacedName{Name: selector.Name, Namespace: cfg.ApplicationsNamespace}
+	if err := r.Get(ctx, key, secret); err != nil {
+		return "", fmt.Errorf("failed to get notification webhook Secret %s: %w", key, err)
+	}
+	encoded, _, _ := unstructured.NestedString(secret.Object, "data", selector.Key)
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	webhookURL := strings.TrimSpace(string(decoded))
+	if err != nil || webhookURL == "" {
+		return "", fmt.Errorf("notification webhook Secret %s has no URL under key %q", key, selector.Key)
+	}
+	return webhookURL, nil
+}
+
+func (n *webhookNotifier) post(ctx context.Context, mlflow *mlflowv1.MLflow, webhookURL string, event notificationEvent) error {
+	body, err := notificationPayload(mlflow, event, time.Now())
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return errors.New("invalid notification webhook URL")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.httpClient().Do(req)
+	if err != nil {
+		// Webhook URLs usually embed a token, so drop the URL from the error.
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return fmt.Errorf("notification webhook request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("notification webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+func (n *webhookNotifier) httpClient() *http.Client {
+	if n.client != nil {
+		return n.client
+	}
+	n.clientOnce.Do(func() {
+		n.defaultClient = &http.Client{Timeout: notificationTimeout}
+	})
+	return n.defaultClient
+}
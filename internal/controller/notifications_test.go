@@ -0,0 +1,122 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func testStatusWithConditions(conditions ...metav1.Condition) *mlflowv1.MLflowStatus {
+	return &mlflowv1.MLflowStatus{Conditions: conditions}
+}
+
+func TestStatusTransitions(t *testing.T) {
+	g := gomega.NewWithT(t)
+	available := metav1.Condition{Type: "Available", Status: metav1.ConditionTrue, Reason: "DeploymentAvailable"}
+	unavailable := metav1.Condition{Type: "Available", Status: metav1.ConditionFalse, Reason: "DeploymentUnavailable", Message: "no ready pods"}
+	migrationFailed := metav1.Condition{Type: migrationConditionType, Status: metav1.ConditionFalse, Reason: migrationReasonFailed, Message: "job failed"}
+
+	g.Expect(statusTransitions(testStatusWithConditions(), testStatusWithConditions(unavailable))).To(gomega.BeEmpty(), "the first Available condition is not a transition")
+	g.Expect(statusTransitions(testStatusWithConditions(available), testStatusWithConditions(available))).To(gomega.BeEmpty())
+
+	g.Expect(statusTransitions(testStatusWithConditions(available), testStatusWithConditions(unavailable))).To(gomega.Equal([]notificationEvent{
+		{Event: notificationEventUnavailable, Reason: "DeploymentUnavailable", Message: "no ready pods"},
+	}))
+	g.Expect(statusTransitions(testStatusWithConditions(unavailable), testStatusWithConditions(available))).To(gomega.Equal([]notificationEvent{
+		{Event: notificationEventAvailable, Reason: "DeploymentAvailable"},
+	}))
+
+	events := statusTransitions(testStatusWithConditions(available), testStatusWithConditions(available, migrationFailed))
+	g.Expect(events).To(gomega.Equal([]notificationEvent{
+		{Event: notificationEventMigrationFailed, Reason: migrationReasonFailed, Message: "job failed"},
+	}))
+	g.Expect(statusTransitions(testStatusWithConditions(migrationFailed), testStatusWithConditions(migrationFailed))).To(gomega.BeEmpty(), "a failed migration is reported once")
+}
+
+func TestNotificationPayload(t *testing.T) {
+	g := gomega.NewWithT(t)
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec:       mlflowv1.MLflowSpec{Notifications: &mlflowv1.NotificationsConfig{}},
+	}
+	event := notificationEvent{Event: notificationEventUnavailable, Reason: "DeploymentUnavailable", Message: "no ready pods"}
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	body, err := notificationPayload(mlflow, event, now)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(body).To(gomega.MatchJSON(`{"mlflow":"mlflow","time":"2025-06-01T12:00:00Z","event":"Unavailable","reason":"DeploymentUnavailable","message":"no ready pods"}`))
+
+	mlflow.Spec.Notifications.Format = ptr(notificationFormatSlack)
+	body, err = notificationPayload(mlflow, event, now)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(body).To(gomega.MatchJSON(`{"text":"MLflow \"mlflow\" is unavailable: no ready pods"}`))
+}
+
+func TestNotifyStatusTransitions(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	received := make(chan map[string]string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		payload := map[string]string{}
+		_ = json.Unmarshal(body, &payload)
+		received <- payload
+	}))
+	defer server.Close()
+
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(gomega.Succeed())
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow-webhook", Namespace: "test-ns"},
+		Data:       map[string][]byte{"url": []byte(server.URL + "\n")},
+	}).Build()
+	r := &MLflowReconciler{Client: k8sClient, Scheme: scheme, Namespace: "test-ns"}
+
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{Notifications: &mlflowv1.NotificationsConfig{
+			WebhookURLFrom: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "mlflow-webhook"}, Key: "url"},
+		}},
+		Status: *testStatusWithConditions(metav1.Condition{Type: "Available", Status: metav1.ConditionFalse, Reason: "DeploymentUnavailable"}),
+	}
+	previous := testStatusWithConditions(metav1.Condition{Type: "Available", Status: metav1.ConditionTrue, Reason: "DeploymentAvailable"})
+
+	r.notifyStatusTransitions(context.Background(), mlflow, previous)
+	g.Expect(received).To(gomega.Receive(gomega.And(
+		gomega.HaveKeyWithValue("event", notificationEventUnavailable),
+		gomega.HaveKeyWithValue("mlflow", "mlflow"),
+	)))
+
+	// No transition, no request.
+	r.notifyStatusTransitions(context.Background(), mlflow, &mlflow.Status)
+	g.Consistently(received, 100*time.Millisecond).ShouldNot(gomega.Receive())
+}
@@ -6,9 +6,11 @@ import (
 	"strings"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 
@@ -17,7 +19,12 @@ import (
 	"github.com/opendatahub-io/mlflow-operator/internal/config"
 )
 
-const mlflowOperatorReadyConditionType = "MLflowOperatorReady"
+const (
+	mlflowOperatorReadyConditionType = "MLflowOperatorReady"
+
+	// clusterIngressName is the name of the singleton OpenShift Ingress config.
+	clusterIngressName = "cluster"
+)
 
 // resolveOperatorConfig keeps the legacy env/flag path as the base configuration
 // and only overlays module-CR state when the new controller handoff is enabled.
@@ -25,7 +32,68 @@ const mlflowOperatorReadyConditionType = "MLflowOperatorReady"
 // cache and RBAC scope continue to match the reconciler's live target namespace.
 // Readiness checks for the singleton MLflowOperator CR happen separately.
 func (r *MLflowReconciler) resolveOperatorConfig(ctx context.Context) (*config.OperatorConfig, error) {
-	return r.resolveOperatorConfigFromBase(ctx, config.GetConfig())
+	base, err := r.applyRuntimeConfigMap(ctx, config.GetConfig())
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := r.resolveOperatorConfigFromBase(ctx, base)
+	if err != nil {
+		return nil, err
+	}
+	if cfg, err = r.withSelectedGateway(ctx, cfg); err != nil {
+		return nil, err
+	}
+	return r.discoverMLflowURL(ctx, cfg)
+}
+
+// discoverMLflowURL fills in MLflowURL from the OpenShift cluster ingress domain when it was
+// not configured: the Gateway is published as https://<gateway>.<apps-domain>. The Ingress
+// is read uncached, and clusters without the config.openshift.io API, or namespaced installs,
+// keep the default.
+func (r *MLflowReconciler) discoverMLflowURL(ctx context.Context, cfg *config.OperatorConfig) (*config.OperatorConfig, error) {
+	if cfg.MLflowURLConfigured || cfg.GatewayName == "" || r.Namespaced {
+		return cfg, nil
+	}
+	ingress := &unstructured.Unstructured{}
+	ingress.SetAPIVersion("config.openshift.io/v1")
+	ingress.SetKind("Ingress")
+	if err := r.Get(ctx, types.NamespacedName{Name: clusterIngressName}, ingress); err != nil {
+		if apierrors.IsNotFound(err) || apimeta.IsNoMatchError(err) {
+			return cfg, nil
+		}
+		return nil, fmt.Errorf("failed to get cluster ingress %q: %w", clusterIngressName, err)
+	}
+	domain, _, _ := unstructured.NestedString(ingress.Object, "spec", "domain")
+	if domain = strings.Trim(strings.TrimSpace(domain), "."); domain == "" {
+		return cfg, nil
+	}
+	discovered := *cfg
+	discovered.MLflowURL = "https://" + cfg.GatewayName + "." + domain
+	discovered.MLflowURLConfigured = true
+	discovered.MLflowURLDiscovered = true
+	return &discovered, nil
+}
+
+// applyRuntimeConfigMap overlays the optional runtime ConfigMap on the env-derived config.
+// The ConfigMap is watched, so edits re-reconcile every MLflow instance without a restart.
+// Module-CR settings are overlaid afterwards and keep precedence when the handoff is enabled.
+func (r *MLflowReconciler) applyRuntimeConfigMap(
+	ctx context.Context,
+	baseConfig *config.OperatorConfig,
+) (*config.OperatorConfig, error) {
+	if r.Namespace == "" {
+		return baseConfig, nil
+	}
+
+	cm := &corev1.ConfigMap{}
+	err := r.Get(ctx, types.NamespacedName{Namespace: r.Namespace, Name: config.RuntimeConfigMapName}, cm)
+	if apierrors.IsNotFound(err) {
+		return baseConfig, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get operator ConfigMap %s/%s: %w", r.Namespace, config.RuntimeConfigMapName, err)
+	}
+	return baseConfig.WithRuntimeOverrides(cm.Data), nil
 }
 
 func (r *MLflowReconciler) resolveOperatorConfigFromBase(
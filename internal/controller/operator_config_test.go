@@ -4,8 +4,10 @@ import (
 	"context"
 	"testing"
 
+	corev1 "k8s.io/api/core/v1"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -299,3 +301,83 @@ func TestEnsureMLflowOperatorReadyPreservesAvailabilityWhenModuleDeletionBlocked
 		t.Fatalf("expected Progressing condition to remain unchanged, got %#v", progressing)
 	}
 }
+
+func TestApplyRuntimeConfigMapOverridesEnvConfig(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add core scheme: %v", err)
+	}
+
+	base := &config.OperatorConfig{
+		MLflowImage: "quay.io/opendatahub/mlflow:env",
+		GatewayName: "env-gateway",
+	}
+
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+	reconciler := &MLflowReconciler{Client: client, Scheme: scheme, Namespace: "opendatahub"}
+
+	resolved, err := reconciler.applyRuntimeConfigMap(context.Background(), base)
+	if err != nil {
+		t.Fatalf("apply runtime ConfigMap: %v", err)
+	}
+	if resolved != base {
+		t.Fatalf("expected env config to be used unchanged when the ConfigMap is missing")
+	}
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: config.RuntimeConfigMapName, Namespace: "opendatahub"},
+		Data:       map[string]string{"GATEWAY_NAME": "runtime-gateway"},
+	}
+	if err := client.Create(context.Background(), cm); err != nil {
+		t.Fatalf("create ConfigMap: %v", err)
+	}
+
+	resolved, err = reconciler.applyRuntimeConfigMap(context.Background(), base)
+	if err != nil {
+		t.Fatalf("apply runtime ConfigMap: %v", err)
+	}
+	if resolved.GatewayName != "runtime-gateway" {
+		t.Fatalf("expected runtime gateway override, got %q", resolved.GatewayName)
+	}
+	if resolved.MLflowImage != "quay.io/opendatahub/mlflow:env" {
+		t.Fatalf("expected env image to be kept, got %q", resolved.MLflowImage)
+	}
+}
+
+func TestDiscoverMLflowURL(t *testing.T) {
+	ingress := &unstructured.Unstructured{}
+	ingress.SetAPIVersion("config.openshift.io/v1")
+	ingress.SetKind("Ingress")
+	ingress.SetName(clusterIngressName)
+	if err := unstructured.SetNestedField(ingress.Object, "apps.cluster.example.com", "spec", "domain"); err != nil {
+		t.Fatalf("set ingress domain: %v", err)
+	}
+
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypeWithName(ingress.GroupVersionKind(), &unstructured.Unstructured{})
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(ingress).Build()
+	reconciler := &MLflowReconciler{Client: client, Scheme: scheme}
+
+	cfg := &config.OperatorConfig{GatewayName: "data-science-gateway", MLflowURL: config.DefaultMLflowURL}
+	resolved, err := reconciler.discoverMLflowURL(context.Background(), cfg)
+	if err != nil {
+		t.Fatalf("discoverMLflowURL() error = %v", err)
+	}
+	if resolved.MLflowURL != "https://data-science-gateway.apps.cluster.example.com" || !resolved.MLflowURLConfigured || !resolved.MLflowURLDiscovered {
+		t.Fatalf("discoverMLflowURL() = %+v, want the URL derived from the ingress domain", resolved)
+	}
+	if cfg.MLflowURLConfigured {
+		t.Fatalf("discoverMLflowURL() modified its input")
+	}
+
+	configured := &config.OperatorConfig{GatewayName: "data-science-gateway", MLflowURL: "https://mlflow.corp.example.com", MLflowURLConfigured: true}
+	if resolved, err := reconciler.discoverMLflowURL(context.Background(), configured); err != nil || resolved != configured {
+		t.Fatalf("discoverMLflowURL() = %+v, %v; want a configured MLFLOW_URL kept", resolved, err)
+	}
+
+	// Without the OpenShift config API the default is kept.
+	plain := &MLflowReconciler{Client: fake.NewClientBuilder().WithScheme(runtime.NewScheme()).Build()}
+	if resolved, err := plain.discoverMLflowURL(context.Background(), cfg); err != nil || resolved != cfg {
+		t.Fatalf("discoverMLflowURL() = %+v, %v; want the default kept", resolved, err)
+	}
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// ErrOptionalAPIsChanged is returned by OptionalAPIWatcher once an optional API was installed
+// or removed. The manager stops with it, so the process has to exit and start over.
+var ErrOptionalAPIsChanged = errors.New("optional APIs changed since startup")
+
+// OptionalAPI is an API whose availability at startup decided which caches, watches, and
+// resources the manager set up.
+type OptionalAPI struct {
+	// Name identifies the API in logs, e.g. "HTTPRoute".
+	Name string
+	// Available is the availability the manager was set up with.
+	Available bool
+	// Check re-runs discovery for the API.
+	Check func() (bool, error)
+}
+
+// OptionalAPIWatcher re-runs discovery for optional APIs every Interval and stops the manager
+// with ErrOptionalAPIsChanged when one of them was installed or removed. Caches and watches
+// cannot be added to a running manager, so starting over is how the operator picks up, say,
+// the Gateway API installed after it. Every replica runs it, since each has its own caches.
+type OptionalAPIWatcher struct {
+	Interval time.Duration
+	APIs     []OptionalAPI
+}
+
+// Start polls until ctx is cancelled or an API changed.
+func (w *OptionalAPIWatcher) Start(ctx context.Context) error {
+	log := logf.FromContext(ctx).WithName("optional-apis")
+	ticker := time.NewTicker(w.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		if changed := w.changed(ctx); len(changed) > 0 {
+			log.Info("Optional APIs changed since startup, restarting to set up their caches and watches",
+				"apis", changed)
+			return fmt.Errorf("%w: %s", ErrOptionalAPIsChanged, strings.Join(changed, ", "))
+		}
+	}
+}
+
+// NeedLeaderElection makes the manager run the watcher on standby replicas too.
+func (w *OptionalAPIWatcher) NeedLeaderElection() bool {
+	return false
+}
+
+// changed returns the APIs whose availability differs from the one at startup. An API whose
+// discovery fails keeps its startup availability until the next poll.
+func (w *OptionalAPIWatcher) changed(ctx context.Context) []string {
+	log := logf.FromContext(ctx)
+	var changed []string
+	for _, api := range w.APIs {
+		available, err := api.Check()
+		if err != nil {
+			log.V(1).Info("Optional API discovery failed", "api", api.Name, "error", err.Error())
+			continue
+		}
+		if available != api.Available {
+			state := "installed"
+			if !available {
+				state = "removed"
+			}
+			changed = append(changed, api.Name+" "+state)
+		}
+	}
+	return changed
+}
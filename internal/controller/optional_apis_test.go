@@ -0,0 +1,60 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+)
+
+func TestOptionalAPIWatcher(t *testing.T) {
+	g := gomega.NewWithT(t)
+	var polls atomic.Int32
+	httpRouteInstalled := func() (bool, error) {
+		// The Gateway API appears on the third poll.
+		return polls.Add(1) >= 3, nil
+	}
+	watcher := &OptionalAPIWatcher{
+		Interval: time.Millisecond,
+		APIs: []OptionalAPI{
+			{Name: "ConsoleLink", Available: true, Check: func() (bool, error) { return false, errors.New("discovery failed") }},
+			{Name: "HTTPRoute", Available: false, Check: httpRouteInstalled},
+		},
+	}
+
+	err := watcher.Start(context.Background())
+	g.Expect(err).To(gomega.MatchError(ErrOptionalAPIsChanged))
+	g.Expect(err.Error()).To(gomega.HaveSuffix(": HTTPRoute installed"))
+	g.Expect(polls.Load()).To(gomega.Equal(int32(3)))
+	g.Expect(watcher.NeedLeaderElection()).To(gomega.BeFalse())
+}
+
+func TestOptionalAPIWatcherStopsWithContext(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	watcher := &OptionalAPIWatcher{
+		Interval: time.Millisecond,
+		APIs:     []OptionalAPI{{Name: "HTTPRoute", Available: true, Check: func() (bool, error) { return true, nil }}},
+	}
+	g.Expect(watcher.Start(ctx)).To(gomega.Succeed())
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/yaml"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+)
+
+// platformDefaultsKey is the runtime ConfigMap key holding admin-managed MLflow spec defaults.
+const platformDefaultsKey = "defaults"
+
+// platformDefaults are admin-managed values applied to MLflow CRs when the corresponding
+// spec field is omitted. Field names mirror MLflowSpec so the YAML reads like a CR fragment.
+type platformDefaults struct {
+	// Image defaults spec.image; each of its fields is filled independently, and image is
+	// skipped when spec.version is set.
+	Image *mlflowv1.ImageConfig `json:"image,omitempty"`
+	// Resources defaults spec.resources when it is unset.
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+	// StorageClassName defaults the class of spec.storage and its database and artifacts
+	// volumes when they are set without one. It never enables a PVC on its own.
+	StorageClassName *string `json:"storageClassName,omitempty"`
+	// Routing defaults spec.routing; enabled and gateways are filled independently.
+	Routing *mlflowv1.RoutingConfig `json:"routing,omitempty"`
+}
+
+// loadPlatformDefaults reads the defaults key from the runtime operator ConfigMap.
+// A missing ConfigMap or key means no defaults.
+func (r *MLflowReconciler) loadPlatformDefaults(ctx context.Context) (*platformDefaults, error) {
+	if r.Namespace == "" {
+		return nil, nil
+	}
+
+	cm := &corev1.ConfigMap{}
+	err := r.Get(ctx, types.NamespacedName{Namespace: r.Namespace, Name: config.RuntimeConfigMapName}, cm)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get operator ConfigMap %s/%s: %w", r.Namespace, config.RuntimeConfigMapName, err)
+	}
+	return parsePlatformDefaults(cm.Data[platformDefaultsKey])
+}
+
+func parsePlatformDefaults(data string) (*platformDefaults, error) {
+	if data == "" {
+		return nil, nil
+	}
+	defaults := &platformDefaults{}
+	if err := yaml.UnmarshalStrict([]byte(data), defaults); err != nil {
+		return nil, fmt.Errorf("invalid %s in ConfigMap %s: %w", platformDefaultsKey, config.RuntimeConfigMapName, err)
+	}
+	return defaults, nil
+}
+
+// applyPlatformDefaults fills omitted spec fields from defaults in place. Values set on the
+// CR always win. The result is only used for this reconcile and is never written back.
+func applyPlatformDefaults(spec *mlflowv1.MLflowSpec, defaults *platformDefaults) {
+	if defaults == nil {
+		return
+	}
+
+	if defaults.Image != nil {
+		image := defaults.Image.DeepCopy()
+		if spec.Image == nil {
+			spec.Image = &mlflowv1.ImageConfig{}
+		}
+		// spec.version selects the image itself, so the default image only fills unversioned CRs.
+		if spec.Image.Image == nil && spec.Version == nil && defaults.Image.Image != nil {
+			spec.Image.Image = image.Image
+		}
+		if spec.Image.ImagePullPolicy == nil && defaults.Image.ImagePullPolicy != nil {
+			spec.Image.ImagePullPolicy = image.ImagePullPolicy
+		}
+		if spec.Image.ResolveDigest == nil && defaults.Image.ResolveDigest != nil {
+			spec.Image.ResolveDigest = image.ResolveDigest
+		}
+		if spec.Image.ArchitectureAffinity == nil && defaults.Image.ArchitectureAffinity != nil {
+			spec.Image.ArchitectureAffinity = image.ArchitectureAffinity
+		}
+	}
+
+	if spec.Resources == nil && defaults.Resources != nil {
+		spec.Resources = defaults.Resources.DeepCopy()
+	}
+
+	if spec.Storage != nil && defaults.StorageClassName != nil {
+		claims := []*corev1.PersistentVolumeClaimSpec{&spec.Storage.PersistentVolumeClaimSpec}
+		if spec.Storage.Database != nil {
+			claims = append(claims, &spec.Storage.Database.PersistentVolumeClaimSpec)
+		}
+		if spec.Storage.Artifacts != nil {
+			claims = append(claims, &spec.Storage.Artifacts.PersistentVolumeClaimSpec)
+		}
+		for _, claim := range claims {
+			if claim.StorageClassName == nil {
+				storageClassName := *defaults.StorageClassName
+				claim.StorageClassName = &storageClassName
+			}
+		}
+	}
+
+	if defaults.Routing != nil {
+		routing := defaults.Routing.DeepCopy()
+		if spec.Routing == nil {
+			spec.Routing = &mlflowv1.RoutingConfig{}
+		}
+		if spec.Routing.Enabled == nil && defaults.Routing.Enabled != nil {
+			spec.Routing.Enabled = routing.Enabled
+		}
+		if len(spec.Routing.Gateways) == 0 && len(defaults.Routing.Gateways) > 0 {
+			spec.Routing.Gateways = routing.Gateways
+		}
+	}
+}
+
+// applyFeatureGates turns off, for this reconcile, the spec features that FEATURE_GATES
+// disables cluster-wide. Gates backed by an API are applied at startup instead, by treating the
+// API as unavailable.
+func applyFeatureGates(spec *mlflowv1.MLflowSpec, cfg *config.OperatorConfig) {
+	// Workspaces span namespaces, which namespaced install mode cannot reach.
+	if !cfg.FeatureEnabled(config.FeatureWorkspaces) || cfg.Namespaced() {
+		if spec.Workspaces == nil {
+			spec.Workspaces = &mlflowv1.WorkspacesConfig{}
+		}
+		disabled := false
+		spec.Workspaces.Enabled = &disabled
+	}
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
+)
+
+const testPlatformDefaults = `
+image:
+  image: registry.example.com/mlflow:platform
+  imagePullPolicy: IfNotPresent
+resources:
+  requests:
+    cpu: 250m
+    memory: 512Mi
+storageClassName: fast-ssd
+routing:
+  gateways:
+    - name: internal-gateway
+`
+
+func TestApplyPlatformDefaultsFillsOmittedFields(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	defaults, err := parsePlatformDefaults(testPlatformDefaults)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	spec := mlflowv1.MLflowSpec{
+		Storage: &mlflowv1.StorageConfig{
+			Artifacts: &mlflowv1.StorageVolume{PersistentVolumeClaimSpec: corev1.PersistentVolumeClaimSpec{StorageClassName: ptr("standard")}},
+			Database:  &mlflowv1.StorageVolume{},
+		},
+	}
+	applyPlatformDefaults(&spec, defaults)
+
+	g.Expect(spec.Image).NotTo(gomega.BeNil())
+	g.Expect(*spec.Image.Image).To(gomega.Equal("registry.example.com/mlflow:platform"))
+	g.Expect(*spec.Image.ImagePullPolicy).To(gomega.Equal(corev1.PullIfNotPresent))
+	g.Expect(spec.Resources.Requests.Cpu().Cmp(resource.MustParse("250m"))).To(gomega.Equal(0))
+	g.Expect(*spec.Storage.StorageClassName).To(gomega.Equal("fast-ssd"))
+	g.Expect(*spec.Storage.Database.StorageClassName).To(gomega.Equal("fast-ssd"))
+	g.Expect(*spec.Storage.Artifacts.StorageClassName).To(gomega.Equal("standard"))
+	g.Expect(spec.Routing.Gateways).To(gomega.HaveLen(1))
+	g.Expect(spec.Routing.Gateways[0].Name).To(gomega.Equal("internal-gateway"))
+
+	// The parsed defaults must not alias the spec they were applied to.
+	spec.Routing.Gateways[0].Name = "mutated"
+	g.Expect(defaults.Routing.Gateways[0].Name).To(gomega.Equal("internal-gateway"))
+}
+
+func TestApplyPlatformDefaultsKeepsCRValues(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	defaults, err := parsePlatformDefaults(testPlatformDefaults)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	always := corev1.PullAlways
+	spec := mlflowv1.MLflowSpec{
+		Image: &mlflowv1.ImageConfig{ImagePullPolicy: &always},
+		Resources: &corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+		},
+		Routing: &mlflowv1.RoutingConfig{Enabled: ptr(false)},
+	}
+	applyPlatformDefaults(&spec, defaults)
+
+	// Partially-set image gets only the missing field.
+	g.Expect(*spec.Image.Image).To(gomega.Equal("registry.example.com/mlflow:platform"))
+	g.Expect(*spec.Image.ImagePullPolicy).To(gomega.Equal(corev1.PullAlways))
+	g.Expect(spec.Resources.Requests.Cpu().Cmp(resource.MustParse("2"))).To(gomega.Equal(0))
+	g.Expect(*spec.Routing.Enabled).To(gomega.BeFalse())
+	// Storage class never enables a PVC on its own.
+	g.Expect(spec.Storage).To(gomega.BeNil())
+}
+
+func TestParsePlatformDefaultsRejectsUnknownFields(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	_, err := parsePlatformDefaults("replicas: 3\n")
+	g.Expect(err).To(gomega.HaveOccurred())
+
+	defaults, err := parsePlatformDefaults("")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(defaults).To(gomega.BeNil())
+}
+
+func TestLoadPlatformDefaultsFromRuntimeConfigMap(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(corev1.AddToScheme(scheme)).To(gomega.Succeed())
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: config.RuntimeConfigMapName, Namespace: "opendatahub"},
+		Data:       map[string]string{platformDefaultsKey: testPlatformDefaults},
+	}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(cm).Build()
+	reconciler := &MLflowReconciler{Client: client, Scheme: scheme, Namespace: "opendatahub"}
+
+	defaults, err := reconciler.loadPlatformDefaults(context.Background())
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(defaults).NotTo(gomega.BeNil())
+	g.Expect(*defaults.StorageClassName).To(gomega.Equal("fast-ssd"))
+
+	missing := &MLflowReconciler{Client: client, Scheme: scheme, Namespace: "other-ns"}
+	defaults, err = missing.loadPlatformDefaults(context.Background())
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(defaults).To(gomega.BeNil())
+}
+
+func TestApplyFeatureGates(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	spec := &mlflowv1.MLflowSpec{}
+	applyFeatureGates(spec, &config.OperatorConfig{})
+	g.Expect(spec.Workspaces).To(gomega.BeNil())
+
+	spec.Workspaces = &mlflowv1.WorkspacesConfig{Enabled: ptr(true), Routes: true}
+	applyFeatureGates(spec, &config.OperatorConfig{FeatureGates: map[string]bool{config.FeatureWorkspaces: false}})
+	mlflow := &mlflowv1.MLflow{Spec: *spec}
+	g.Expect(render.WorkspacesEnabled(mlflow)).To(gomega.BeFalse())
+	g.Expect(workspaceRoutesEnabled(mlflow)).To(gomega.BeFalse())
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	crcache "sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// maxTerminationMessageLength bounds how much of a container's last termination message is
+// copied into a condition message.
+const maxTerminationMessageLength = 512
+
+// stuckContainerReasons are container waiting reasons that do not resolve without a change to
+// the image, configuration, or workload. Transient reasons such as ContainerCreating are not
+// reported.
+var stuckContainerReasons = []string{
+	"CrashLoopBackOff",
+	"ImagePullBackOff",
+	"ErrImagePull",
+	"InvalidImageName",
+	"CreateContainerConfigError",
+	"CreateContainerError",
+	"RunContainerError",
+}
+
+// podFailure is the container error that keeps a Deployment's pods from becoming ready.
+type podFailure struct {
+	reason  string
+	message string
+}
+
+// deploymentPodFailure returns the first stuck container among the Deployment's pods, or nil
+// when none is stuck. Pods are listed unstructured because the Pod cache only holds migration
+// Job pods.
+func (r *MLflowReconciler) deploymentPodFailure(ctx context.Context, deployment *appsv1.Deployment) (*podFailure, error) {
+	if deployment.Spec.Selector == nil || len(deployment.Spec.Selector.MatchLabels) == 0 {
+		return nil, nil
+	}
+	list := &unstructured.UnstructuredList{}
+	list.SetAPIVersion("v1")
+	list.SetKind("PodList")
+	if err := r.List(ctx, list, client.InNamespace(deployment.Namespace), client.MatchingLabels(deployment.Spec.Selector.MatchLabels)); err != nil {
+		return nil, fmt.Errorf("failed to list pods of Deployment %s: %w", deployment.Name, err)
+	}
+
+	slices.SortFunc(list.Items, func(a, b unstructured.Unstructured) int {
+		return strings.Compare(a.GetName(), b.GetName())
+	})
+	for _, item := range list.Items {
+		pod := &corev1.Pod{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, pod); err != nil {
+			return nil, fmt.Errorf("failed to convert pod %s: %w", item.GetName(), err)
+		}
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+		if failure := stuckContainerFailure(pod); failure != nil {
+			return failure, nil
+		}
+	}
+	return nil, nil
+}
+
+// stuckContainerFailure reports the first init or app container of pod that is waiting for a
+// stuck reason, including its last termination when it has crashed before.
+func stuckContainerFailure(pod *corev1.Pod) *podFailure {
+	statuses := append(slices.Clone(pod.Status.InitContainerStatuses), pod.Status.ContainerStatuses...)
+	for _, status := range statuses {
+		waiting := status.State.Waiting
+		if waiting == nil || !slices.Contains(stuckContainerReasons, waiting.Reason) {
+			continue
+		}
+		message := fmt.Sprintf("container %q in pod %s is in %s", status.Name, pod.Name, waiting.Reason)
+		if waiting.Message != "" {
+			message += ": " + waiting.Message
+		}
+		if terminated := status.LastTerminationState.Terminated; terminated != nil {
+			message += fmt.Sprintf("; last termination: %s (exit code %d)", terminated.Reason, terminated.ExitCode)
+			if text := strings.TrimSpace(terminated.Message); text != "" {
+				message += ": " + truncateDiffText(text, maxTerminationMessageLength)
+			}
+		}
+		return &podFailure{reason: waiting.Reason, message: message}
+	}
+	return nil
+}
+
+// NewServerPodWatchCache returns a cache of the MLflow server pods in namespace. The main
+// cache only holds migration Job pods, so the server pods get a cache of their own.
+func NewServerPodWatchCache(cfg *rest.Config, scheme *runtime.Scheme, namespace string) (crcache.Cache, error) {
+	return crcache.New(cfg, crcache.Options{
+		Scheme:            scheme,
+		DefaultNamespaces: map[string]crcache.Config{namespace: {}},
+		ByObject: map[client.Object]crcache.ByObject{
+			&corev1.Pod{}: {Label: labels.SelectorFromSet(labels.Set{"app": ResourceName})},
+		},
+		DefaultTransform: crcache.TransformStripManagedFields(),
+	})
+}
+
+// podFailureChangedPredicate admits pod updates that change which container is stuck and why.
+// Readiness changes already reach the reconciler through the owned Deployment's status, but a
+// container that never became ready can go into CrashLoopBackOff without touching it.
+func podFailureChangedPredicate() predicate.TypedPredicate[*corev1.Pod] {
+	return predicate.TypedFuncs[*corev1.Pod]{
+		CreateFunc:  func(event.TypedCreateEvent[*corev1.Pod]) bool { return false },
+		DeleteFunc:  func(event.TypedDeleteEvent[*corev1.Pod]) bool { return false },
+		GenericFunc: func(event.TypedGenericEvent[*corev1.Pod]) bool { return false },
+		UpdateFunc: func(e event.TypedUpdateEvent[*corev1.Pod]) bool {
+			before, after := stuckContainerFailure(e.ObjectOld), stuckContainerFailure(e.ObjectNew)
+			if before == nil || after == nil {
+				return before != after
+			}
+			return before.reason != after.reason
+		},
+	}
+}
+
+// serverPodToMLflowRequests maps an MLflow server pod to the singleton MLflow instance.
+func (r *MLflowReconciler) serverPodToMLflowRequests(ctx context.Context, pod *corev1.Pod) []reconcile.Request {
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: ResourceName}}}
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+func newPodFailureTestPod(name string, labels map[string]string, statuses ...corev1.ContainerStatus) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test-ns", Labels: labels},
+		Status:     corev1.PodStatus{ContainerStatuses: statuses},
+	}
+}
+
+func TestStuckContainerFailure(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	crashing := newPodFailureTestPod("mlflow-abc", nil, corev1.ContainerStatus{
+		Name: "mlflow",
+		State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{
+			Reason:  "CrashLoopBackOff",
+			Message: "back-off 5m0s restarting failed container",
+		}},
+		LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+			Reason:   "Error",
+			ExitCode: 1,
+			Message:  "sqlalchemy.exc.OperationalError: could not connect to server\n",
+		}},
+	})
+	failure := stuckContainerFailure(crashing)
+	g.Expect(failure).NotTo(gomega.BeNil())
+	g.Expect(failure.reason).To(gomega.Equal("CrashLoopBackOff"))
+	g.Expect(failure.message).To(gomega.Equal(`container "mlflow" in pod mlflow-abc is in CrashLoopBackOff: ` +
+		`back-off 5m0s restarting failed container; last termination: Error (exit code 1): ` +
+		`sqlalchemy.exc.OperationalError: could not connect to server`))
+
+	crashing.Status.ContainerStatuses[0].LastTerminationState.Terminated.Message = strings.Repeat("x", 2*maxTerminationMessageLength)
+	g.Expect(len(stuckContainerFailure(crashing).message)).To(gomega.BeNumerically("<", 2*maxTerminationMessageLength))
+
+	pulling := newPodFailureTestPod("mlflow-def", nil)
+	pulling.Status.InitContainerStatuses = []corev1.ContainerStatus{{
+		Name:  "ca-bundle",
+		State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff"}},
+	}}
+	failure = stuckContainerFailure(pulling)
+	g.Expect(failure).NotTo(gomega.BeNil())
+	g.Expect(failure.reason).To(gomega.Equal("ImagePullBackOff"))
+	g.Expect(failure.message).To(gomega.Equal(`container "ca-bundle" in pod mlflow-def is in ImagePullBackOff`))
+
+	starting := newPodFailureTestPod("mlflow-ghi", nil, corev1.ContainerStatus{
+		Name:  "mlflow",
+		State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ContainerCreating"}},
+	})
+	g.Expect(stuckContainerFailure(starting)).To(gomega.BeNil(), "transient waiting reasons are not failures")
+}
+
+func TestDeploymentPodFailure(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(gomega.Succeed())
+	selector := map[string]string{"app": "mlflow"}
+	stuck := corev1.ContainerStatus{
+		Name:  "mlflow",
+		State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ErrImagePull"}},
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		newPodFailureTestPod("mlflow-aaa", selector, corev1.ContainerStatus{
+			Name:  "mlflow",
+			Ready: true,
+			State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+		}),
+		newPodFailureTestPod("mlflow-bbb", selector, stuck),
+		newPodFailureTestPod("mlflow-artifacts-ccc", map[string]string{"app": "mlflow-artifacts"}, stuck),
+	).Build()
+	r := &MLflowReconciler{Client: k8sClient, Scheme: scheme}
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow", Namespace: "test-ns"},
+		Spec:       appsv1.DeploymentSpec{Selector: &metav1.LabelSelector{MatchLabels: selector}},
+	}
+
+	failure, err := r.deploymentPodFailure(context.Background(), deployment)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(failure).NotTo(gomega.BeNil())
+	g.Expect(failure.reason).To(gomega.Equal("ErrImagePull"))
+	g.Expect(failure.message).To(gomega.ContainSubstring("pod mlflow-bbb"))
+
+	deployment.Spec.Selector.MatchLabels = map[string]string{"app": "mlflow-registry"}
+	failure, err = r.deploymentPodFailure(context.Background(), deployment)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(failure).To(gomega.BeNil())
+}
+
+func TestPodFailureChangedPredicate(t *testing.T) {
+	g := gomega.NewWithT(t)
+	waiting := func(reason string) *corev1.Pod {
+		return newPodFailureTestPod("mlflow-abc", nil, corev1.ContainerStatus{
+			Name:  "mlflow",
+			State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: reason}},
+		})
+	}
+	changed := func(before, after *corev1.Pod) bool {
+		return podFailureChangedPredicate().Update(event.TypedUpdateEvent[*corev1.Pod]{ObjectOld: before, ObjectNew: after})
+	}
+
+	g.Expect(changed(waiting("ContainerCreating"), waiting("CrashLoopBackOff"))).To(gomega.BeTrue())
+	g.Expect(changed(waiting("ErrImagePull"), waiting("ImagePullBackOff"))).To(gomega.BeTrue())
+	g.Expect(changed(waiting("CrashLoopBackOff"), waiting("ContainerCreating"))).To(gomega.BeTrue())
+	g.Expect(changed(waiting("CrashLoopBackOff"), waiting("CrashLoopBackOff"))).To(gomega.BeFalse())
+	g.Expect(changed(waiting("ContainerCreating"), newPodFailureTestPod("mlflow-abc", nil))).To(gomega.BeFalse())
+	g.Expect(podFailureChangedPredicate().Create(event.TypedCreateEvent[*corev1.Pod]{Object: waiting("CrashLoopBackOff")})).To(gomega.BeFalse())
+}
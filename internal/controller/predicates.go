@@ -0,0 +1,49 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// mlflowChangedPredicate admits MLflow updates that change the spec, labels, or annotations.
+// Status-only writes (including the operator's own) do not bump metadata.generation and
+// are filtered out; metadata changes still pass so the force-migrate annotation and
+// propagated labels work.
+func mlflowChangedPredicate() predicate.Predicate {
+	return predicate.Or(predicate.GenerationChangedPredicate{}, predicate.AnnotationChangedPredicate{}, predicate.LabelChangedPredicate{})
+}
+
+// managedObjectPredicate admits events only for objects carrying the operator's app label
+// (mlflow, mlflow-gc and their per-instance suffixes), so unrelated churn on objects of the
+// same kind in the applications namespace never reaches the owner lookup.
+func managedObjectPredicate() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return strings.HasPrefix(obj.GetLabels()["app"], ResourceName)
+	})
+}
+
+// secretMetadata is the watch type for Secrets. The watches only need names, labels, and owner
+// references, so Secrets are cached as metadata and their payloads never enter the operator's
+// memory; reads go to the API server.
+func secretMetadata() *metav1.PartialObjectMetadata {
+	return &metav1.PartialObjectMetadata{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"}}
+}
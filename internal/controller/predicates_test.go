@@ -0,0 +1,111 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func TestMLflowChangedPredicate(t *testing.T) {
+	base := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow", Generation: 1},
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(*mlflowv1.MLflow)
+		want   bool
+	}{
+		{
+			name: "status-only update",
+			mutate: func(m *mlflowv1.MLflow) {
+				m.Status.Address = &mlflowv1.MLflowAddressStatus{URL: "https://mlflow.example.com"}
+			},
+			want: false,
+		},
+		{
+			name: "spec update bumps generation",
+			mutate: func(m *mlflowv1.MLflow) {
+				m.Generation = 2
+			},
+			want: true,
+		},
+		{
+			name: "annotation update",
+			mutate: func(m *mlflowv1.MLflow) {
+				m.Annotations = map[string]string{forceMigrateAnnotation: "true"}
+			},
+			want: true,
+		},
+		{
+			name: "label update",
+			mutate: func(m *mlflowv1.MLflow) {
+				m.Labels = map[string]string{"cost-center": "ml-platform"}
+			},
+			want: true,
+		},
+	}
+
+	p := mlflowChangedPredicate()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated := base.DeepCopy()
+			tt.mutate(updated)
+			if got := p.Update(event.UpdateEvent{ObjectOld: base, ObjectNew: updated}); got != tt.want {
+				t.Errorf("Update() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if !p.Create(event.CreateEvent{Object: base}) {
+		t.Error("Create() should always pass")
+	}
+	if !p.Delete(event.DeleteEvent{Object: base}) {
+		t.Error("Delete() should always pass")
+	}
+}
+
+func TestManagedObjectPredicate(t *testing.T) {
+	tests := []struct {
+		name   string
+		labels map[string]string
+		want   bool
+	}{
+		{name: "server object", labels: map[string]string{"app": "mlflow"}, want: true},
+		{name: "gc object", labels: map[string]string{"app": "mlflow-gc"}, want: true},
+		{name: "unrelated object", labels: map[string]string{"app": "postgres"}, want: false},
+		{name: "unlabeled object", labels: nil, want: false},
+	}
+
+	p := managedObjectPredicate()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "s", Namespace: "test-ns", Labels: tt.labels},
+			}
+			if got := p.Update(event.UpdateEvent{ObjectOld: secret, ObjectNew: secret}); got != tt.want {
+				t.Errorf("Update() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
+)
+
+// clusterProxyName is the name of the singleton OpenShift Proxy CR.
+const clusterProxyName = "cluster"
+
+// clusterProxy returns the cluster-wide proxy settings. On OpenShift they come from the status
+// of the cluster Proxy CR, whose noProxy already lists the service and cluster networks, so a
+// proxy change reaches the MLflow pods without an operator restart. Elsewhere the operator's
+// own HTTP_PROXY, HTTPS_PROXY and NO_PROXY are used, with the service network read from the
+// ServiceCIDR API. Both objects are read uncached, like the target namespace. In namespaced
+// install mode neither can be read, so only the operator's own settings are used.
+func (r *MLflowReconciler) clusterProxy(ctx context.Context, cfg *config.OperatorConfig) (render.ProxyConfig, error) {
+	if r.ConsoleLinkAvailable && !r.Namespaced {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion("config.openshift.io/v1")
+		obj.SetKind("Proxy")
+		err := r.Get(ctx, types.NamespacedName{Name: clusterProxyName}, obj)
+		if err == nil {
+			httpProxy, _, _ := unstructured.NestedString(obj.Object, "status", "httpProxy")
+			httpsProxy, _, _ := unstructured.NestedString(obj.Object, "status", "httpsProxy")
+			noProxy, _, _ := unstructured.NestedString(obj.Object, "status", "noProxy")
+			return render.ProxyConfig{HTTPProxy: httpProxy, HTTPSProxy: httpsProxy, NoProxy: noProxy}, nil
+		}
+		if !errors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+			return render.ProxyConfig{}, fmt.Errorf("failed to get cluster proxy %q: %w", clusterProxyName, err)
+		}
+	}
+
+	proxy := render.ProxyConfig{HTTPProxy: cfg.HTTPProxy, HTTPSProxy: cfg.HTTPSProxy, NoProxy: cfg.NoProxy}
+	if !proxy.Enabled() || r.Namespaced {
+		return proxy, nil
+	}
+	serviceNetwork, err := r.serviceNetwork(ctx)
+	if err != nil {
+		return render.ProxyConfig{}, err
+	}
+	proxy.NoProxy = strings.Join(render.MergeNoProxy(render.SplitNoProxy(proxy.NoProxy), serviceNetwork), ",")
+	return proxy, nil
+}
+
+// serviceNetwork returns the Service CIDRs of the cluster, or nil on clusters without the
+// ServiceCIDR API.
+func (r *MLflowReconciler) serviceNetwork(ctx context.Context) ([]string, error) {
+	list := &unstructured.UnstructuredList{}
+	list.SetAPIVersion("networking.k8s.io/v1")
+	list.SetKind("ServiceCIDRList")
+	if err := r.List(ctx, list); err != nil {
+		if meta.IsNoMatchError(err) || errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to list ServiceCIDRs: %w", err)
+	}
+	var cidrs []string
+	for _, item := range list.Items {
+		values, _, _ := unstructured.NestedStringSlice(item.Object, "spec", "cidrs")
+		cidrs = append(cidrs, values...)
+	}
+	return cidrs, nil
+}
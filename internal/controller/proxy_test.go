@@ -0,0 +1,77 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
+)
+
+func TestClusterProxy(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+	cfg := &config.OperatorConfig{HTTPSProxy: "http://env-proxy.example.com:3128", NoProxy: ".example.com"}
+	serviceCIDR := &networkingv1.ServiceCIDR{
+		ObjectMeta: metav1.ObjectMeta{Name: "kubernetes"},
+		Spec:       networkingv1.ServiceCIDRSpec{CIDRs: []string{"10.96.0.0/12"}},
+	}
+
+	// The Proxy CR wins on OpenShift.
+	r := newTestReconciler(t, &configv1.Proxy{
+		ObjectMeta: metav1.ObjectMeta{Name: clusterProxyName},
+		Status: configv1.ProxyStatus{
+			HTTPProxy:  "http://proxy.example.com:3128",
+			HTTPSProxy: "http://proxy.example.com:3128",
+			NoProxy:    ".cluster.local,.svc,172.30.0.0/16",
+		},
+	})
+	r.ConsoleLinkAvailable = true
+	proxy, err := r.clusterProxy(ctx, cfg)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(proxy).To(gomega.Equal(render.ProxyConfig{
+		HTTPProxy:  "http://proxy.example.com:3128",
+		HTTPSProxy: "http://proxy.example.com:3128",
+		NoProxy:    ".cluster.local,.svc,172.30.0.0/16",
+	}))
+
+	// Without a Proxy CR the operator env is used, with the service network added.
+	r = newTestReconciler(t, serviceCIDR)
+	proxy, err = r.clusterProxy(ctx, cfg)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(proxy).To(gomega.Equal(render.ProxyConfig{
+		HTTPSProxy: "http://env-proxy.example.com:3128",
+		NoProxy:    ".example.com,10.96.0.0/12",
+	}))
+
+	proxy, err = r.clusterProxy(ctx, &config.OperatorConfig{})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(proxy.Enabled()).To(gomega.BeFalse())
+
+	// Namespaced installs use the operator env as is.
+	r.Namespaced = true
+	proxy, err = r.clusterProxy(ctx, cfg)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(proxy).To(gomega.Equal(render.ProxyConfig{HTTPSProxy: "http://env-proxy.example.com:3128", NoProxy: ".example.com"}))
+}
@@ -0,0 +1,393 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+const (
+	// quotaExceededConditionType reports that the rendered workload does not fit the
+	// ResourceQuotas or LimitRanges of the target namespace.
+	quotaExceededConditionType = "QuotaExceeded"
+	quotaExceededReason        = "QuotaExceeded"
+)
+
+// checkNamespaceQuota returns why the rendered Deployments and new PVCs would be rejected by
+// the ResourceQuotas or LimitRanges of namespace, or nil when they fit. Quota usage already
+// includes the live objects, so only the growth over them is charged. Quotas with scopes are
+// skipped because whether they match depends on pod fields the check does not model.
+// ResourceQuotas and LimitRanges are read uncached: they are few and rarely change.
+func (r *MLflowReconciler) checkNamespaceQuota(ctx context.Context, namespace string, objects []*unstructured.Unstructured) ([]string, error) {
+	quotas := &corev1.ResourceQuotaList{}
+	if err := r.listUncached(ctx, namespace, "ResourceQuotaList", quotas); err != nil {
+		return nil, err
+	}
+	limitRanges := &corev1.LimitRangeList{}
+	if err := r.listUncached(ctx, namespace, "LimitRangeList", limitRanges); err != nil {
+		return nil, err
+	}
+	if len(quotas.Items) == 0 && len(limitRanges.Items) == 0 {
+		return nil, nil
+	}
+
+	var problems []string
+	desired := corev1.ResourceList{}
+	for _, obj := range objects {
+		switch obj.GetKind() {
+		case "Deployment":
+			deployment := &appsv1.Deployment{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, deployment); err != nil {
+				return nil, fmt.Errorf("failed to convert Deployment %s: %w", obj.GetName(), err)
+			}
+			podSpec := deployment.Spec.Template.Spec.DeepCopy()
+			problems = append(problems, applyContainerLimitRanges(podSpec, limitRanges.Items)...)
+			addResourceList(desired, deploymentQuotaUsage(deployment.Spec.Replicas, podSpec))
+
+			live := &appsv1.Deployment{}
+			err := r.Get(ctx, client.ObjectKeyFromObject(obj), live)
+			if err != nil && !errors.IsNotFound(err) {
+				return nil, fmt.Errorf("failed to get Deployment %s: %w", obj.GetName(), err)
+			}
+			if err == nil {
+				livePodSpec := live.Spec.Template.Spec.DeepCopy()
+				applyContainerLimitRanges(livePodSpec, limitRanges.Items)
+				subtractResourceList(desired, deploymentQuotaUsage(live.Spec.Replicas, livePodSpec))
+			}
+		case "PersistentVolumeClaim":
+			err := r.Get(ctx, client.ObjectKeyFromObject(obj), &corev1.PersistentVolumeClaim{})
+			if err == nil {
+				continue
+			}
+			if !errors.IsNotFound(err) {
+				return nil, fmt.Errorf("failed to get PersistentVolumeClaim %s: %w", obj.GetName(), err)
+			}
+			pvc := &corev1.PersistentVolumeClaim{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, pvc); err != nil {
+				return nil, fmt.Errorf("failed to convert PersistentVolumeClaim %s: %w", obj.GetName(), err)
+			}
+			problems = append(problems, pvcLimitRangeProblems(pvc, limitRanges.Items)...)
+			addResourceList(desired, pvcQuotaUsage(pvc))
+		}
+	}
+
+	for _, quota := range quotas.Items {
+		if len(quota.Spec.Scopes) > 0 || quota.Spec.ScopeSelector != nil {
+			continue
+		}
+		problems = append(problems, quotaShortfalls(&quota, desired)...)
+	}
+	return problems, nil
+}
+
+func (r *MLflowReconciler) listUncached(ctx context.Context, namespace, kind string, into runtime.Object) error {
+	list := &unstructured.UnstructuredList{}
+	list.SetAPIVersion("v1")
+	list.SetKind(kind)
+	if err := r.List(ctx, list, client.InNamespace(namespace)); err != nil {
+		return fmt.Errorf("failed to list %s in namespace %q: %w", strings.TrimSuffix(kind, "List"), namespace, err)
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(list.UnstructuredContent(), into); err != nil {
+		return fmt.Errorf("failed to convert %s: %w", kind, err)
+	}
+	return nil
+}
+
+// setQuotaExceededCondition records the quota problems, or removes the condition when there
+// are none.
+func setQuotaExceededCondition(mlflow *mlflowv1.MLflow, problems []string) {
+	if len(problems) == 0 {
+		meta.RemoveStatusCondition(&mlflow.Status.Conditions, quotaExceededConditionType)
+		return
+	}
+	message := "The target namespace cannot admit the MLflow workload: " + strings.Join(problems, "; ")
+	meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+		Type:               quotaExceededConditionType,
+		Status:             metav1.ConditionTrue,
+		Reason:             quotaExceededReason,
+		Message:            message,
+		ObservedGeneration: mlflow.Generation,
+	})
+	meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+		Type:               "Progressing",
+		Status:             metav1.ConditionFalse,
+		Reason:             quotaExceededReason,
+		Message:            message,
+		ObservedGeneration: mlflow.Generation,
+	})
+}
+
+// deploymentQuotaUsage returns what replicas pods of podSpec count against a ResourceQuota.
+// Rollout surge pods are not included.
+func deploymentQuotaUsage(replicas *int32, podSpec *corev1.PodSpec) corev1.ResourceList {
+	count := int64(1)
+	if replicas != nil {
+		count = int64(*replicas)
+	}
+	usage := corev1.ResourceList{}
+	if count == 0 {
+		return usage
+	}
+	requests, limits := podResources(podSpec)
+	for name, quantity := range requests {
+		total := quantity.DeepCopy()
+		total.Mul(count)
+		usage[corev1.ResourceName("requests."+string(name))] = total
+		if isStandardComputeResource(name) {
+			usage[name] = total
+		}
+	}
+	for name, quantity := range limits {
+		total := quantity.DeepCopy()
+		total.Mul(count)
+		usage[corev1.ResourceName("limits."+string(name))] = total
+	}
+	usage[corev1.ResourcePods] = *resource.NewQuantity(count, resource.DecimalSI)
+	return usage
+}
+
+func isStandardComputeResource(name corev1.ResourceName) bool {
+	return name == corev1.ResourceCPU || name == corev1.ResourceMemory || name == corev1.ResourceEphemeralStorage
+}
+
+// podResources returns the effective requests and limits of a pod the way the scheduler and
+// quota admission compute them: app containers and sidecars add up, and each regular init
+// container only needs to fit on its own next to the sidecars started before it.
+func podResources(podSpec *corev1.PodSpec) (corev1.ResourceList, corev1.ResourceList) {
+	requests, limits := corev1.ResourceList{}, corev1.ResourceList{}
+	for _, container := range podSpec.Containers {
+		addResourceList(requests, container.Resources.Requests)
+		addResourceList(limits, container.Resources.Limits)
+	}
+	sidecarRequests, sidecarLimits := corev1.ResourceList{}, corev1.ResourceList{}
+	initRequests, initLimits := corev1.ResourceList{}, corev1.ResourceList{}
+	for _, container := range podSpec.InitContainers {
+		if container.RestartPolicy != nil && *container.RestartPolicy == corev1.ContainerRestartPolicyAlways {
+			addResourceList(sidecarRequests, container.Resources.Requests)
+			addResourceList(sidecarLimits, container.Resources.Limits)
+			continue
+		}
+		maxResourceList(initRequests, sumResourceLists(sidecarRequests, container.Resources.Requests))
+		maxResourceList(initLimits, sumResourceLists(sidecarLimits, container.Resources.Limits))
+	}
+	addResourceList(requests, sidecarRequests)
+	addResourceList(limits, sidecarLimits)
+	maxResourceList(requests, initRequests)
+	maxResourceList(limits, initLimits)
+	for name, quantity := range podSpec.Overhead {
+		addResourceList(requests, corev1.ResourceList{name: quantity})
+		addResourceList(limits, corev1.ResourceList{name: quantity})
+	}
+	return requests, limits
+}
+
+// pvcQuotaUsage returns what a new PVC counts against a ResourceQuota.
+func pvcQuotaUsage(pvc *corev1.PersistentVolumeClaim) corev1.ResourceList {
+	usage := corev1.ResourceList{
+		corev1.ResourcePersistentVolumeClaims: *resource.NewQuantity(1, resource.DecimalSI),
+	}
+	storage, ok := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	if ok {
+		usage[corev1.ResourceRequestsStorage] = storage
+	}
+	if class := pvc.Spec.StorageClassName; class != nil && *class != "" {
+		prefix := *class + ".storageclass.storage.k8s.io/"
+		usage[corev1.ResourceName(prefix+string(corev1.ResourcePersistentVolumeClaims))] = *resource.NewQuantity(1, resource.DecimalSI)
+		if ok {
+			usage[corev1.ResourceName(prefix+string(corev1.ResourceRequestsStorage))] = storage
+		}
+	}
+	return usage
+}
+
+// quotaShortfalls reports each resource of quota that the growth in desired would overrun.
+func quotaShortfalls(quota *corev1.ResourceQuota, desired corev1.ResourceList) []string {
+	names := make([]string, 0, len(desired))
+	for name := range desired {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+
+	var shortfalls []string
+	for _, name := range names {
+		requested := desired[corev1.ResourceName(name)]
+		hard, limited := quota.Status.Hard[corev1.ResourceName(name)]
+		if !limited {
+			hard, limited = quota.Spec.Hard[corev1.ResourceName(name)]
+		}
+		if !limited || requested.Sign() <= 0 {
+			continue
+		}
+		used := quota.Status.Used[corev1.ResourceName(name)]
+		free := hard.DeepCopy()
+		free.Sub(used)
+		if free.Sign() < 0 {
+			free = resource.Quantity{Format: hard.Format}
+		}
+		if requested.Cmp(free) <= 0 {
+			continue
+		}
+		short := requested.DeepCopy()
+		short.Sub(free)
+		shortfalls = append(shortfalls, fmt.Sprintf("ResourceQuota %s: %s short by %s (needs %s more, %s of %s free)",
+			quota.Name, name, short.String(), requested.String(), free.String(), hard.String()))
+	}
+	return shortfalls
+}
+
+// applyContainerLimitRanges applies the container defaults of limitRanges to podSpec the way
+// LimitRanger admission does, and returns the containers that fall outside a min or max.
+func applyContainerLimitRanges(podSpec *corev1.PodSpec, limitRanges []corev1.LimitRange) []string {
+	var problems []string
+	for _, limitRange := range limitRanges {
+		for _, item := range limitRange.Spec.Limits {
+			if item.Type != corev1.LimitTypeContainer {
+				continue
+			}
+			for _, containers := range [][]corev1.Container{podSpec.InitContainers, podSpec.Containers} {
+				for i := range containers {
+					container := &containers[i]
+					defaultResources(&container.Resources, item)
+					problems = append(problems, limitRangeViolations(
+						fmt.Sprintf("LimitRange %s: container %q", limitRange.Name, container.Name),
+						container.Resources.Requests, container.Resources.Limits, item)...)
+				}
+			}
+		}
+	}
+	return problems
+}
+
+func defaultResources(resources *corev1.ResourceRequirements, item corev1.LimitRangeItem) {
+	for name, quantity := range item.Default {
+		if _, ok := resources.Limits[name]; !ok {
+			if resources.Limits == nil {
+				resources.Limits = corev1.ResourceList{}
+			}
+			resources.Limits[name] = quantity.DeepCopy()
+		}
+	}
+	for name, quantity := range item.DefaultRequest {
+		if _, ok := resources.Requests[name]; !ok {
+			if resources.Requests == nil {
+				resources.Requests = corev1.ResourceList{}
+			}
+			resources.Requests[name] = quantity.DeepCopy()
+		}
+	}
+	// The API server defaults a missing request to the limit.
+	for name, quantity := range resources.Limits {
+		if _, ok := resources.Requests[name]; !ok {
+			if resources.Requests == nil {
+				resources.Requests = corev1.ResourceList{}
+			}
+			resources.Requests[name] = quantity.DeepCopy()
+		}
+	}
+}
+
+// pvcLimitRangeProblems returns the PVC storage requests that fall outside a LimitRange.
+func pvcLimitRangeProblems(pvc *corev1.PersistentVolumeClaim, limitRanges []corev1.LimitRange) []string {
+	var problems []string
+	for _, limitRange := range limitRanges {
+		for _, item := range limitRange.Spec.Limits {
+			if item.Type != corev1.LimitTypePersistentVolumeClaim {
+				continue
+			}
+			problems = append(problems, limitRangeViolations(
+				fmt.Sprintf("LimitRange %s: PersistentVolumeClaim %s", limitRange.Name, pvc.Name),
+				pvc.Spec.Resources.Requests, nil, item)...)
+		}
+	}
+	return problems
+}
+
+func limitRangeViolations(subject string, requests, limits corev1.ResourceList, item corev1.LimitRangeItem) []string {
+	var violations []string
+	for _, name := range sortedResourceNames(item.Min) {
+		minimum := item.Min[name]
+		if request, ok := requests[name]; ok && request.Cmp(minimum) < 0 {
+			violations = append(violations, fmt.Sprintf("%s requests %s %s, below the minimum %s", subject, name, request.String(), minimum.String()))
+		}
+	}
+	for _, name := range sortedResourceNames(item.Max) {
+		maximum := item.Max[name]
+		value, ok := limits[name]
+		if !ok {
+			value, ok = requests[name]
+		}
+		if ok && value.Cmp(maximum) > 0 {
+			violations = append(violations, fmt.Sprintf("%s asks for %s %s, above the maximum %s", subject, name, value.String(), maximum.String()))
+		}
+	}
+	return violations
+}
+
+func sortedResourceNames(list corev1.ResourceList) []corev1.ResourceName {
+	names := make([]corev1.ResourceName, 0, len(list))
+	for name := range list {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+	return names
+}
+
+func addResourceList(into, add corev1.ResourceList) {
+	for name, quantity := range add {
+		total := into[name]
+		total.Add(quantity)
+		into[name] = total
+	}
+}
+
+func subtractResourceList(from, sub corev1.ResourceList) {
+	for name, quantity := range sub {
+		total := from[name]
+		total.Sub(quantity)
+		from[name] = total
+	}
+}
+
+func maxResourceList(into, other corev1.ResourceList) {
+	for name, quantity := range other {
+		if current, ok := into[name]; !ok || quantity.Cmp(current) > 0 {
+			into[name] = quantity.DeepCopy()
+		}
+	}
+}
+
+func sumResourceLists(a, b corev1.ResourceList) corev1.ResourceList {
+	sum := corev1.ResourceList{}
+	addResourceList(sum, a)
+	addResourceList(sum, b)
+	return sum
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+const quotaTestNamespace = "opendatahub"
+
+func quotaTestDeployment(replicas int32, memory string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow", Namespace: quotaTestNamespace},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+				InitContainers: []corev1.Container{{
+					Name:      "combine-ca-bundles",
+					Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("16Mi")}},
+				}},
+				Containers: []corev1.Container{{
+					Name:      "mlflow",
+					Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse(memory)}},
+				}},
+			}},
+		},
+	}
+}
+
+func toUnstructuredObjects(g *gomega.WithT, objs ...client.Object) []*unstructured.Unstructured {
+	result := make([]*unstructured.Unstructured, 0, len(objs))
+	for _, obj := range objs {
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		result = append(result, &unstructured.Unstructured{Object: content})
+	}
+	return result
+}
+
+func TestCheckNamespaceQuota(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+	quota := &corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{Name: "compute", Namespace: quotaTestNamespace},
+		Spec: corev1.ResourceQuotaSpec{Hard: corev1.ResourceList{
+			corev1.ResourceRequestsMemory:         resource.MustParse("4Gi"),
+			corev1.ResourcePersistentVolumeClaims: resource.MustParse("1"),
+		}},
+		Status: corev1.ResourceQuotaStatus{
+			Hard: corev1.ResourceList{
+				corev1.ResourceRequestsMemory:         resource.MustParse("4Gi"),
+				corev1.ResourcePersistentVolumeClaims: resource.MustParse("1"),
+			},
+			Used: corev1.ResourceList{
+				corev1.ResourceRequestsMemory:         resource.MustParse("3Gi"),
+				corev1.ResourcePersistentVolumeClaims: resource.MustParse("1"),
+			},
+		},
+	}
+	scoped := quota.DeepCopy()
+	scoped.Name = "best-effort"
+	scoped.Spec.Scopes = []corev1.ResourceQuotaScope{corev1.ResourceQuotaScopeBestEffort}
+	scoped.Status.Used = nil
+	scoped.Status.Hard = corev1.ResourceList{corev1.ResourcePods: resource.MustParse("0")}
+
+	// No quotas or limit ranges: nothing to check.
+	r := newTestReconciler(t)
+	problems, err := r.checkNamespaceQuota(ctx, quotaTestNamespace, toUnstructuredObjects(g, quotaTestDeployment(2, "2Gi")))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(problems).To(gomega.BeEmpty())
+
+	// A new instance asking for 4Gi does not fit the 1Gi left.
+	r = newTestReconciler(t, quota, scoped)
+	problems, err = r.checkNamespaceQuota(ctx, quotaTestNamespace, toUnstructuredObjects(g, quotaTestDeployment(2, "2Gi")))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(problems).To(gomega.ConsistOf("ResourceQuota compute: requests.memory short by 3Gi (needs 4Gi more, 1Gi of 4Gi free)"))
+
+	// The live Deployment is already counted, so only the growth has to fit.
+	r = newTestReconciler(t, quota, quotaTestDeployment(1, "2Gi"))
+	problems, err = r.checkNamespaceQuota(ctx, quotaTestNamespace, toUnstructuredObjects(g, quotaTestDeployment(1, "3Gi")))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(problems).To(gomega.BeEmpty())
+
+	// A new PVC needs a free claim slot; an existing one does not.
+	pvc := &corev1.PersistentVolumeClaim{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "PersistentVolumeClaim"},
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow-pvc", Namespace: quotaTestNamespace},
+		Spec: corev1.PersistentVolumeClaimSpec{Resources: corev1.VolumeResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Gi")},
+		}},
+	}
+	r = newTestReconciler(t, quota)
+	problems, err = r.checkNamespaceQuota(ctx, quotaTestNamespace, toUnstructuredObjects(g, pvc))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(problems).To(gomega.ConsistOf("ResourceQuota compute: persistentvolumeclaims short by 1 (needs 1 more, 0 of 1 free)"))
+
+	r = newTestReconciler(t, quota, pvc.DeepCopy())
+	problems, err = r.checkNamespaceQuota(ctx, quotaTestNamespace, toUnstructuredObjects(g, pvc))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(problems).To(gomega.BeEmpty())
+}
+
+func TestCheckNamespaceQuotaLimitRanges(t *testing.T) {
+	g := gomega.NewWithT(t)
+	limitRange := &corev1.LimitRange{
+		ObjectMeta: metav1.ObjectMeta{Name: "limits", Namespace: quotaTestNamespace},
+		Spec: corev1.LimitRangeSpec{Limits: []corev1.LimitRangeItem{
+			{
+				Type:           corev1.LimitTypeContainer,
+				DefaultRequest: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")},
+				Max:            corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+			},
+			{
+				Type: corev1.LimitTypePersistentVolumeClaim,
+				Min:  corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("20Gi")},
+			},
+		}},
+	}
+	quota := &corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{Name: "compute", Namespace: quotaTestNamespace},
+		Status: corev1.ResourceQuotaStatus{
+			Hard: corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("1")},
+			Used: corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("600m")},
+		},
+	}
+	pvc := &corev1.PersistentVolumeClaim{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "PersistentVolumeClaim"},
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow-pvc", Namespace: quotaTestNamespace},
+		Spec: corev1.PersistentVolumeClaimSpec{Resources: corev1.VolumeResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Gi")},
+		}},
+	}
+
+	r := newTestReconciler(t, limitRange, quota)
+	problems, err := r.checkNamespaceQuota(context.Background(), quotaTestNamespace, toUnstructuredObjects(g, quotaTestDeployment(1, "2Gi"), pvc))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	// The defaulted CPU request counts against the quota; the init container runs on its own.
+	g.Expect(problems).To(gomega.ConsistOf(
+		`LimitRange limits: container "mlflow" asks for memory 2Gi, above the maximum 1Gi`,
+		"LimitRange limits: PersistentVolumeClaim mlflow-pvc requests storage 10Gi, below the minimum 20Gi",
+		"ResourceQuota compute: requests.cpu short by 100m (needs 500m more, 400m of 1 free)",
+	))
+}
+
+func TestSetQuotaExceededCondition(t *testing.T) {
+	g := gomega.NewWithT(t)
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: ResourceName}}
+
+	setQuotaExceededCondition(mlflow, []string{"ResourceQuota compute: pods short by 1 (needs 1 more, 0 of 2 free)"})
+	g.Expect(meta.IsStatusConditionTrue(mlflow.Status.Conditions, quotaExceededConditionType)).To(gomega.BeTrue())
+	progressing := meta.FindStatusCondition(mlflow.Status.Conditions, "Progressing")
+	g.Expect(progressing.Reason).To(gomega.Equal(quotaExceededReason))
+	g.Expect(progressing.Message).To(gomega.ContainSubstring("pods short by 1"))
+
+	setQuotaExceededCondition(mlflow, nil)
+	g.Expect(meta.FindStatusCondition(mlflow.Status.Conditions, quotaExceededConditionType)).To(gomega.BeNil())
+}
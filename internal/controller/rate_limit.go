@@ -0,0 +1,160 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
+)
+
+const (
+	RateLimitPolicyCRDName = "RateLimitPolicy"
+
+	// rateLimitNotEnforcedConditionType reports that spec.server.rateLimit is set but no
+	// RateLimitPolicy protects the instance.
+	rateLimitNotEnforcedConditionType = "RateLimitNotEnforced"
+)
+
+// RateLimitPolicyGVK is the Kuadrant policy attached to the HTTPRoute. It is handled as
+// unstructured, like the Istio kinds, to avoid depending on the Kuadrant Go module.
+var RateLimitPolicyGVK = schema.GroupVersionKind{Group: "kuadrant.io", Version: "v1", Kind: RateLimitPolicyCRDName}
+
+// IsRateLimitPolicyAvailable checks if the Kuadrant RateLimitPolicy CRD is available in the cluster using discovery API
+func IsRateLimitPolicyAvailable(discoveryClient discovery.DiscoveryInterface) (bool, error) {
+	return isKindAvailable(discoveryClient, RateLimitPolicyGVK.GroupVersion(), RateLimitPolicyCRDName)
+}
+
+func serverRateLimit(mlflow *mlflowv1.MLflow) *mlflowv1.ServerRateLimit {
+	if mlflow.Spec.Server == nil {
+		return nil
+	}
+	return mlflow.Spec.Server.RateLimit
+}
+
+// rateLimitWindow converts a token-bucket style rate and burst into the fixed windows that
+// Kuadrant counts in: burst requests per window, with the window long enough that the
+// average stays at or below requestsPerSecond.
+func rateLimitWindow(rateLimit *mlflowv1.ServerRateLimit) (limit int64, windowSeconds int64) {
+	rate := int64(rateLimit.RequestsPerSecond)
+	burst := rate
+	if rateLimit.Burst != nil && int64(*rateLimit.Burst) > rate {
+		burst = int64(*rateLimit.Burst)
+	}
+	return burst, (burst + rate - 1) / rate
+}
+
+// buildRateLimitPolicy constructs the RateLimitPolicy that limits each client address on the
+// HTTPRoute of mlflow. It targets the whole route, so the derived artifact and registry
+// server rules share the client's budget.
+func buildRateLimitPolicy(mlflow *mlflowv1.MLflow, namespace string, rateLimit *mlflowv1.ServerRateLimit) *unstructured.Unstructured {
+	name := ResourceName + render.ResourceSuffix(mlflow.Name)
+	limit, windowSeconds := rateLimitWindow(rateLimit)
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(RateLimitPolicyGVK)
+	obj.SetName(name)
+	obj.SetNamespace(namespace)
+	obj.SetLabels(map[string]string{"app": ResourceName})
+	obj.Object["spec"] = map[string]interface{}{
+		"targetRef": map[string]interface{}{
+			"group": "gateway.networking.k8s.io",
+			"kind":  "HTTPRoute",
+			"name":  name,
+		},
+		"limits": map[string]interface{}{
+			"per-client": map[string]interface{}{
+				"rates": []interface{}{
+					map[string]interface{}{"limit": limit, "window": fmt.Sprintf("%ds", windowSeconds)},
+				},
+				"counters": []interface{}{
+					map[string]interface{}{"expression": "source.address"},
+				},
+			},
+		},
+	}
+	return obj
+}
+
+// reconcileRateLimit applies the RateLimitPolicy for spec.server.rateLimit, or deletes it when
+// rate limiting or the HTTPRoute is turned off, and sets the RateLimitNotEnforced condition
+// when the limit is requested but cannot be applied.
+func (r *MLflowReconciler) reconcileRateLimit(ctx context.Context, mlflow *mlflowv1.MLflow, namespace string) error {
+	rateLimit := serverRateLimit(mlflow)
+	reason, message := "", ""
+	switch {
+	case !r.HTTPRouteAvailable:
+		reason, message = "HTTPRouteUnavailable", "rate limits are enforced on the HTTPRoute, and Gateway API is not available"
+	case !routingEnabled(mlflow):
+		reason, message = "RoutingDisabled", "rate limits are enforced on the HTTPRoute, and spec.routing is disabled"
+	case !r.RateLimitPolicyAvailable:
+		reason, message = "RateLimitPolicyUnavailable", "the Kuadrant RateLimitPolicy API is not available; install Kuadrant to enforce spec.server.rateLimit"
+	}
+	if rateLimit != nil && reason != "" {
+		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+			Type:               rateLimitNotEnforcedConditionType,
+			Status:             metav1.ConditionTrue,
+			Reason:             reason,
+			Message:            message,
+			ObservedGeneration: mlflow.Generation,
+		})
+	} else {
+		meta.RemoveStatusCondition(&mlflow.Status.Conditions, rateLimitNotEnforcedConditionType)
+	}
+	if !r.RateLimitPolicyAvailable {
+		return nil
+	}
+
+	name := ResourceName + render.ResourceSuffix(mlflow.Name)
+	if rateLimit == nil || reason != "" {
+		existing := &unstructured.Unstructured{}
+		existing.SetGroupVersionKind(RateLimitPolicyGVK)
+		err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, existing)
+		if errors.IsNotFound(err) {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("failed to get RateLimitPolicy %s: %w", name, err)
+		}
+		if err := r.Delete(ctx, existing); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete RateLimitPolicy %s: %w", name, err)
+		}
+		logf.FromContext(ctx).Info("Deleted RateLimitPolicy", "name", name)
+		return nil
+	}
+
+	policy := buildRateLimitPolicy(mlflow, namespace, rateLimit)
+	propagateMetadata(mlflow, policy)
+	if err := controllerutil.SetControllerReference(mlflow, policy, r.Scheme); err != nil {
+		return fmt.Errorf("failed to set controller reference on RateLimitPolicy: %w", err)
+	}
+	if err := r.applyObject(ctx, policy); err != nil {
+		return fmt.Errorf("failed to apply RateLimitPolicy %s: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func TestRateLimitWindow(t *testing.T) {
+	tests := []struct {
+		name          string
+		rateLimit     mlflowv1.ServerRateLimit
+		limit, window int64
+	}{
+		{name: "no burst", rateLimit: mlflowv1.ServerRateLimit{RequestsPerSecond: 10}, limit: 10, window: 1},
+		{name: "even burst", rateLimit: mlflowv1.ServerRateLimit{RequestsPerSecond: 10, Burst: ptr(int32(50))}, limit: 50, window: 5},
+		{name: "window rounds up", rateLimit: mlflowv1.ServerRateLimit{RequestsPerSecond: 10, Burst: ptr(int32(15))}, limit: 15, window: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			limit, window := rateLimitWindow(&tt.rateLimit)
+			g.Expect(limit).To(gomega.Equal(tt.limit))
+			g.Expect(window).To(gomega.Equal(tt.window))
+		})
+	}
+}
+
+func TestReconcileRateLimit(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(mlflowv1.AddToScheme(scheme)).To(gomega.Succeed())
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	r := &MLflowReconciler{Client: k8sClient, Scheme: scheme, HTTPRouteAvailable: true, RateLimitPolicyAvailable: true}
+	ctx := context.Background()
+	get := func() (*unstructured.Unstructured, error) {
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(RateLimitPolicyGVK)
+		return obj, k8sClient.Get(ctx, types.NamespacedName{Name: "mlflow", Namespace: "test-ns"}, obj)
+	}
+
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: ResourceName, UID: "uid"},
+		Spec: mlflowv1.MLflowSpec{Server: &mlflowv1.ServerConfig{
+			RateLimit: &mlflowv1.ServerRateLimit{RequestsPerSecond: 20, Burst: ptr(int32(100))},
+		}},
+	}
+	g.Expect(r.reconcileRateLimit(ctx, mlflow, "test-ns")).To(gomega.Succeed())
+	policy, err := get()
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	target, _, _ := unstructured.NestedString(policy.Object, "spec", "targetRef", "name")
+	g.Expect(target).To(gomega.Equal("mlflow"))
+	rates, _, _ := unstructured.NestedSlice(policy.Object, "spec", "limits", "per-client", "rates")
+	g.Expect(rates).To(gomega.HaveLen(1))
+	g.Expect(rates[0]).To(gomega.HaveKeyWithValue("window", "5s"))
+	g.Expect(policy.GetOwnerReferences()).To(gomega.HaveLen(1))
+	g.Expect(meta.FindStatusCondition(mlflow.Status.Conditions, rateLimitNotEnforcedConditionType)).To(gomega.BeNil())
+
+	// Without a route there is nothing to attach to, so the policy goes and the gap is reported.
+	mlflow.Spec.Routing = &mlflowv1.RoutingConfig{Enabled: ptr(false)}
+	g.Expect(r.reconcileRateLimit(ctx, mlflow, "test-ns")).To(gomega.Succeed())
+	_, err = get()
+	g.Expect(apierrors.IsNotFound(err)).To(gomega.BeTrue())
+	condition := meta.FindStatusCondition(mlflow.Status.Conditions, rateLimitNotEnforcedConditionType)
+	g.Expect(condition).NotTo(gomega.BeNil())
+	g.Expect(condition.Reason).To(gomega.Equal("RoutingDisabled"))
+
+	mlflow.Spec.Routing = nil
+	mlflow.Spec.Server.RateLimit = nil
+	g.Expect(r.reconcileRateLimit(ctx, mlflow, "test-ns")).To(gomega.Succeed())
+	g.Expect(meta.FindStatusCondition(mlflow.Status.Conditions, rateLimitNotEnforcedConditionType)).To(gomega.BeNil())
+	_, err = get()
+	g.Expect(apierrors.IsNotFound(err)).To(gomega.BeTrue())
+}
+
+func TestReconcileRateLimit_KuadrantUnavailable(t *testing.T) {
+	g := gomega.NewWithT(t)
+	r := &MLflowReconciler{HTTPRouteAvailable: true}
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: ResourceName},
+		Spec: mlflowv1.MLflowSpec{Server: &mlflowv1.ServerConfig{
+			RateLimit: &mlflowv1.ServerRateLimit{RequestsPerSecond: 5},
+		}},
+	}
+
+	g.Expect(r.reconcileRateLimit(context.Background(), mlflow, "test-ns")).To(gomega.Succeed())
+	condition := meta.FindStatusCondition(mlflow.Status.Conditions, rateLimitNotEnforcedConditionType)
+	g.Expect(condition).NotTo(gomega.BeNil())
+	g.Expect(condition.Reason).To(gomega.Equal("RateLimitPolicyUnavailable"))
+}
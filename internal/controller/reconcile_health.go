@@ -0,0 +1,138 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+// ReconcileTracker records when each MLflow instance last finished a reconcile, so the
+// manager's readiness probe can tell a wedged reconciler from a live process. Add it to the
+// manager: it only starts checking once this replica is elected leader, because standby
+// replicas do not reconcile.
+type ReconcileTracker struct {
+	// Threshold is how long an instance may go without a finished reconcile.
+	Threshold time.Duration
+
+	mu       sync.Mutex
+	finished map[string]time.Time
+	// leading is when this replica started reconciling; zero while standing by.
+	leading time.Time
+	now     func() time.Time
+}
+
+// NewReconcileTracker returns a tracker that reports instances not reconciled within threshold.
+func NewReconcileTracker(threshold time.Duration) *ReconcileTracker {
+	return &ReconcileTracker{Threshold: threshold, finished: map[string]time.Time{}, now: time.Now}
+}
+
+// Start marks this replica as the leader until ctx is cancelled.
+func (t *ReconcileTracker) Start(ctx context.Context) error {
+	t.mu.Lock()
+	t.leading = t.now()
+	t.mu.Unlock()
+	<-ctx.Done()
+	t.mu.Lock()
+	t.leading = time.Time{}
+	t.mu.Unlock()
+	return nil
+}
+
+// NeedLeaderElection makes the manager start the tracker only on the leader.
+func (t *ReconcileTracker) NeedLeaderElection() bool {
+	return true
+}
+
+// observe records a finished reconcile of name and makes sure the instance is reconciled
+// again well within the threshold, so a steady instance does not look stale.
+func (t *ReconcileTracker) observe(name string, result ctrl.Result) ctrl.Result {
+	if t == nil {
+		return result
+	}
+	t.mu.Lock()
+	t.finished[name] = t.now()
+	t.mu.Unlock()
+	if resync := t.Threshold / 2; result.RequeueAfter == 0 || result.RequeueAfter > resync {
+		result.RequeueAfter = resync
+	}
+	return result
+}
+
+func (t *ReconcileTracker) forget(name string) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	delete(t.finished, name)
+	t.mu.Unlock()
+}
+
+// Checker returns a readiness check that fails when an MLflow instance has not finished a
+// reconcile within the threshold. Instances are reconciled at least every half threshold, so
+// a stale one means its reconcile is stuck or the work queue stopped draining.
+func (t *ReconcileTracker) Checker(c client.Reader) healthz.Checker {
+	return func(req *http.Request) error {
+		t.mu.Lock()
+		leading := t.leading
+		t.mu.Unlock()
+		if leading.IsZero() {
+			return nil
+		}
+
+		list := &mlflowv1.MLflowList{}
+		if err := c.List(req.Context(), list); err != nil {
+			return fmt.Errorf("failed to list MLflow instances: %w", err)
+		}
+		now := t.now()
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		var stale []string
+		for _, mlflow := range list.Items {
+			if mlflow.DeletionTimestamp != nil {
+				continue
+			}
+			// Instances created or inherited since this replica took over get a full threshold.
+			last := leading
+			if created := mlflow.CreationTimestamp.Time; created.After(last) {
+				last = created
+			}
+			if finished, ok := t.finished[mlflow.Name]; ok && finished.After(last) {
+				last = finished
+			}
+			if now.Sub(last) > t.Threshold {
+				stale = append(stale, mlflow.Name)
+			}
+		}
+		if len(stale) > 0 {
+			sort.Strings(stale)
+			return fmt.Errorf("MLflow instances not reconciled within %s: %s", t.Threshold, strings.Join(stale, ", "))
+		}
+		return nil
+	}
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func TestReconcileTrackerObserveResyncs(t *testing.T) {
+	g := gomega.NewWithT(t)
+	tracker := NewReconcileTracker(10 * time.Minute)
+
+	g.Expect(tracker.observe("mlflow", ctrl.Result{})).To(gomega.Equal(ctrl.Result{RequeueAfter: 5 * time.Minute}))
+	g.Expect(tracker.observe("mlflow", ctrl.Result{RequeueAfter: time.Hour}).RequeueAfter).To(gomega.Equal(5 * time.Minute))
+	g.Expect(tracker.observe("mlflow", ctrl.Result{RequeueAfter: time.Minute}).RequeueAfter).To(gomega.Equal(time.Minute))
+
+	var disabled *ReconcileTracker
+	g.Expect(disabled.observe("mlflow", ctrl.Result{})).To(gomega.Equal(ctrl.Result{}))
+}
+
+func TestReconcileTrackerChecker(t *testing.T) {
+	g := gomega.NewWithT(t)
+	scheme := runtime.NewScheme()
+	g.Expect(mlflowv1.AddToScheme(scheme)).To(gomega.Succeed())
+
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := start
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: ResourceName, CreationTimestamp: metav1.NewTime(start.Add(-time.Hour))},
+	}).Build()
+	tracker := NewReconcileTracker(10 * time.Minute)
+	tracker.now = func() time.Time { return now }
+	check := tracker.Checker(c)
+	req := httptest.NewRequest(http.MethodGet, "/readyz/instances", nil)
+
+	// Standby replicas do not reconcile, so they always pass.
+	now = start.Add(time.Hour)
+	g.Expect(check(req)).To(gomega.Succeed())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	now = start
+	started := make(chan struct{})
+	go func() {
+		close(started)
+		_ = tracker.Start(ctx)
+	}()
+	<-started
+	g.Eventually(func() bool {
+		tracker.mu.Lock()
+		defer tracker.mu.Unlock()
+		return !tracker.leading.IsZero()
+	}).Should(gomega.BeTrue())
+
+	// A new leader gives every instance a full threshold.
+	now = start.Add(5 * time.Minute)
+	g.Expect(check(req)).To(gomega.Succeed())
+	tracker.observe(ResourceName, ctrl.Result{})
+
+	now = start.Add(14 * time.Minute)
+	g.Expect(check(req)).To(gomega.Succeed())
+
+	now = start.Add(16 * time.Minute)
+	g.Expect(check(req)).To(gomega.MatchError(gomega.ContainSubstring("not reconciled within 10m0s: mlflow")))
+}
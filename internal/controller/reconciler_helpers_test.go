@@ -0,0 +1,53 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+// newTestReconciler returns a reconciler whose fake client holds objs.
+func newTestReconciler(t *testing.T, objs ...client.Object) *MLflowReconciler {
+	t.Helper()
+	return newTestReconcilerFrom(t, fake.NewClientBuilder().WithObjects(objs...))
+}
+
+// newTestReconcilerFrom returns a reconciler whose fake client is built from builder, for
+// tests that need status subresources or interceptors. The scheme holds the client-go,
+// MLflow, and OpenShift config types.
+func newTestReconcilerFrom(t *testing.T, builder *fake.ClientBuilder) *MLflowReconciler {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("add client-go scheme: %v", err)
+	}
+	if err := mlflowv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add mlflow scheme: %v", err)
+	}
+	if err := configv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add config scheme: %v", err)
+	}
+	return &MLflowReconciler{Client: builder.WithScheme(scheme).Build(), Scheme: scheme}
+}
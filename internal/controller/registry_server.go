@@ -0,0 +1,26 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+// registryAPIPaths are the model registry routes, relative to the static prefix, that move to
+// the registry server when it runs separately. The UI uses the ajax-api variants.
+var registryAPIPaths = []string{
+	"/api/2.0/mlflow/registered-models",
+	"/api/2.0/mlflow/model-versions",
+	"/ajax-api/2.0/mlflow/registered-models",
+	"/ajax-api/2.0/mlflow/model-versions",
+}
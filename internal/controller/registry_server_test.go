@@ -0,0 +1,57 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+)
+
+func newRegistryServerMLflow() *mlflowv1.MLflow {
+	return &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI:     ptr(testBackendStoreURI),
+			RegistryStoreURI:    ptr("postgresql://registry-db:5432/registry"),
+			DefaultArtifactRoot: ptr("s3://bucket/artifacts"),
+			Registry: &mlflowv1.RegistryConfig{
+				SeparateDeployment: true,
+				Replicas:           ptr(int32(2)),
+			},
+		},
+	}
+}
+
+func TestBuildHTTPRoute_RegistryServer(t *testing.T) {
+	g := gomega.NewWithT(t)
+	cfg := &config.OperatorConfig{GatewayName: "data-science-gateway"}
+
+	route := buildHTTPRoute(newRegistryServerMLflow(), "test-ns", cfg)
+	g.Expect(route.Spec.Rules).To(gomega.HaveLen(3))
+	registryRule := route.Spec.Rules[2]
+	var paths []string
+	for _, match := range registryRule.Matches {
+		paths = append(paths, *match.Path.Value)
+	}
+	g.Expect(paths).To(gomega.ContainElements("/mlflow/api/2.0/mlflow/registered-models", "/mlflow/ajax-api/2.0/mlflow/model-versions"))
+	g.Expect(string(registryRule.BackendRefs[0].Name)).To(gomega.Equal("mlflow-registry"))
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
+)
+
+// renderCache memoizes rendered chart output per MLflow instance so periodic resyncs
+// with an unchanged spec, chart and operator configuration skip the Helm render.
+type renderCache struct {
+	mu      sync.Mutex
+	entries map[string]renderCacheEntry
+}
+
+type renderCacheEntry struct {
+	fingerprint  string
+	chartVersion string
+	objects      []*unstructured.Unstructured
+}
+
+// get returns deep copies of the cached objects when the fingerprint matches.
+func (c *renderCache) get(name, fingerprint string) ([]*unstructured.Unstructured, string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[name]
+	if !ok || entry.fingerprint != fingerprint {
+		return nil, "", false
+	}
+	return copyObjects(entry.objects), entry.chartVersion, true
+}
+
+func (c *renderCache) put(name, fingerprint, chartVersion string, objects []*unstructured.Unstructured) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.entries == nil {
+		c.entries = make(map[string]renderCacheEntry)
+	}
+	c.entries[name] = renderCacheEntry{
+		fingerprint:  fingerprint,
+		chartVersion: chartVersion,
+		objects:      copyObjects(objects),
+	}
+}
+
+func (c *renderCache) forget(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, name)
+}
+
+// copyObjects deep-copies rendered objects; callers mutate them (owner references, migration
+// hashes) before applying, so cached entries must never be handed out directly.
+func copyObjects(objects []*unstructured.Unstructured) []*unstructured.Unstructured {
+	copied := make([]*unstructured.Unstructured, 0, len(objects))
+	for _, obj := range objects {
+		copied = append(copied, obj.DeepCopy())
+	}
+	return copied
+}
+
+// renderFingerprint hashes every input that influences RenderChart output.
+func renderFingerprint(
+	mlflow *mlflowv1.MLflow,
+	namespace string,
+	opts render.RenderOptions,
+	cfg *config.OperatorConfig,
+	chartDigest string,
+) (string, error) {
+	if cfg == nil {
+		cfg = config.GetConfig()
+	}
+	data, err := json.Marshal(struct {
+		Name        string                 `json:"name"`
+		Namespace   string                 `json:"namespace"`
+		Spec        mlflowv1.MLflowSpec    `json:"spec"`
+		Options     render.RenderOptions   `json:"options"`
+		Config      *config.OperatorConfig `json:"config"`
+		ChartDigest string                 `json:"chartDigest"`
+	}{
+		Name:        mlflow.Name,
+		Namespace:   namespace,
+		Spec:        mlflow.Spec,
+		Options:     opts,
+		Config:      cfg,
+		ChartDigest: chartDigest,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal render inputs: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// chartDigest hashes the relative path and content of every file under the chart directory.
+func chartDigest(chartDir string) (string, error) {
+	hash := sha256.New()
+	err := filepath.WalkDir(chartDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(chartDir, path)
+		if err != nil {
+			return err
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(hash, "%s\x00%d\x00", filepath.ToSlash(rel), len(content))
+		hash.Write(content)
+		return nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to hash chart %s: %w", chartDir, err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// chartDigests caches chartDigest by chart path. The chart directory ships in the operator
+// image and does not change while the process runs, and a renderer is built per reconcile,
+// so the digest is computed once per path instead of reading every chart file on each resync.
+var chartDigests sync.Map
+
+// cachedChartDigest returns chartDigest(chartDir), computing it on first use. Errors are
+// not cached, so a transient read failure is retried on the next reconcile.
+func cachedChartDigest(chartDir string) (string, error) {
+	if digest, ok := chartDigests.Load(chartDir); ok {
+		return digest.(string), nil
+	}
+	digest, err := chartDigest(chartDir)
+	if err != nil {
+		return "", err
+	}
+	chartDigests.Store(chartDir, digest)
+	return digest, nil
+}
+
+// renderChart renders the chart for mlflow, reusing the previous output when the render
+// fingerprint is unchanged. It returns the objects and the chart version they came from.
+func (r *MLflowReconciler) renderChart(
+	renderer *render.HelmRenderer,
+	mlflow *mlflowv1.MLflow,
+	namespace string,
+	opts render.RenderOptions,
+	cfg *config.OperatorConfig,
+) ([]*unstructured.Unstructured, string, error) {
+	digest, err := cachedChartDigest(renderer.ChartPath())
+	if err != nil {
+		return nil, "", err
+	}
+	fingerprint, err := renderFingerprint(mlflow, namespace, opts, cfg, digest)
+	if err != nil {
+		return nil, "", err
+	}
+	if objects, chartVersion, ok := r.renderCache.get(mlflow.Name, fingerprint); ok {
+		renderCacheHits.Inc()
+		return objects, chartVersion, nil
+	}
+
+	start := time.Now()
+	objects, err := renderer.RenderChart(mlflow, namespace, opts, cfg)
+	r.RenderRecorder.record(mlflow.Name, renderer.ChartVersion(), renderer.Values(), objects, err)
+	if err != nil {
+		r.renderCache.forget(mlflow.Name)
+		return nil, "", err
+	}
+	renderDurationSeconds.Observe(time.Since(start).Seconds())
+	renderedManifests.Observe(float64(len(objects)))
+	r.renderCache.put(mlflow.Name, fingerprint, renderer.ChartVersion(), objects)
+	return objects, renderer.ChartVersion(), nil
+}
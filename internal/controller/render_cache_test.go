@@ -0,0 +1,205 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
+)
+
+func newRenderCacheTestMLflow() *mlflowv1.MLflow {
+	return &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec:       mlflowv1.MLflowSpec{BackendStoreURI: ptr(testBackendStoreURI)},
+	}
+}
+
+func TestRenderChartReusesCachedObjects(t *testing.T) {
+	r := &MLflowReconciler{}
+	renderer := render.NewHelmRenderer("../../charts/mlflow")
+	mlflow := newRenderCacheTestMLflow()
+	cfg := &config.OperatorConfig{ApplicationsNamespace: "test-ns"}
+
+	first, version, err := r.renderChart(renderer, mlflow, "test-ns", render.RenderOptions{}, cfg)
+	if err != nil {
+		t.Fatalf("renderChart() error = %v", err)
+	}
+	if version == "" {
+		t.Error("renderChart() returned an empty chart version")
+	}
+
+	// Mutations by the caller must not leak into the cached entry.
+	first[0].SetLabels(map[string]string{"mutated": "true"})
+
+	hitsBefore := counterValue(t, "mlflow_operator_render_cache_hits_total")
+	second, cachedVersion, err := r.renderChart(renderer, mlflow, "test-ns", render.RenderOptions{}, cfg)
+	if err != nil {
+		t.Fatalf("renderChart() error = %v", err)
+	}
+	if got := counterValue(t, "mlflow_operator_render_cache_hits_total"); got != hitsBefore+1 {
+		t.Errorf("cache hits = %v, want %v", got, hitsBefore+1)
+	}
+	if cachedVersion != version {
+		t.Errorf("cached chart version = %q, want %q", cachedVersion, version)
+	}
+	if len(second) != len(first) {
+		t.Fatalf("cached render returned %d objects, want %d", len(second), len(first))
+	}
+	if _, ok := second[0].GetLabels()["mutated"]; ok {
+		t.Error("cached objects were modified through a previously returned slice")
+	}
+}
+
+func TestRenderChartInvalidatesCacheOnInputChange(t *testing.T) {
+	r := &MLflowReconciler{}
+	renderer := render.NewHelmRenderer("../../charts/mlflow")
+	mlflow := newRenderCacheTestMLflow()
+	cfg := &config.OperatorConfig{ApplicationsNamespace: "test-ns"}
+
+	if _, _, err := r.renderChart(renderer, mlflow, "test-ns", render.RenderOptions{}, cfg); err != nil {
+		t.Fatalf("renderChart() error = %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(*mlflowv1.MLflow, *render.RenderOptions, *config.OperatorConfig)
+	}{
+		{
+			name: "spec change",
+			mutate: func(m *mlflowv1.MLflow, _ *render.RenderOptions, _ *config.OperatorConfig) {
+				m.Spec.Replicas = ptr(int32(3))
+			},
+		},
+		{
+			name: "render option change",
+			mutate: func(_ *mlflowv1.MLflow, opts *render.RenderOptions, _ *config.OperatorConfig) {
+				opts.Suspended = true
+			},
+		},
+		{
+			name: "operator config change",
+			mutate: func(_ *mlflowv1.MLflow, _ *render.RenderOptions, c *config.OperatorConfig) {
+				c.MLflowImage = "quay.io/example/mlflow:next"
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := mlflow.DeepCopy()
+			opts := render.RenderOptions{}
+			c := *cfg
+			tt.mutate(m, &opts, &c)
+
+			hitsBefore := counterValue(t, "mlflow_operator_render_cache_hits_total")
+			if _, _, err := r.renderChart(renderer, m, "test-ns", opts, &c); err != nil {
+				t.Fatalf("renderChart() error = %v", err)
+			}
+			if got := counterValue(t, "mlflow_operator_render_cache_hits_total"); got != hitsBefore {
+				t.Errorf("expected a fresh render, got a cache hit")
+			}
+		})
+	}
+}
+
+func TestChartDigestTracksFileContent(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "templates"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	templatePath := filepath.Join(dir, "templates", "service.yaml")
+	if err := os.WriteFile(templatePath, []byte("kind: Service\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	before, err := chartDigest(dir)
+	if err != nil {
+		t.Fatalf("chartDigest() error = %v", err)
+	}
+	again, err := chartDigest(dir)
+	if err != nil {
+		t.Fatalf("chartDigest() error = %v", err)
+	}
+	if before != again {
+		t.Errorf("chartDigest() is not stable: %q != %q", before, again)
+	}
+
+	if err := os.WriteFile(templatePath, []byte("kind: Deployment\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	after, err := chartDigest(dir)
+	if err != nil {
+		t.Fatalf("chartDigest() error = %v", err)
+	}
+	if after == before {
+		t.Error("chartDigest() did not change after a template edit")
+	}
+}
+
+func TestCachedChartDigestReadsChartOnce(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := cachedChartDigest(filepath.Join(dir, "missing")); err == nil {
+		t.Fatal("cachedChartDigest() of a missing chart did not fail")
+	}
+	templatePath := filepath.Join(dir, "service.yaml")
+	if err := os.WriteFile(templatePath, []byte("kind: Service\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	first, err := cachedChartDigest(dir)
+	if err != nil {
+		t.Fatalf("cachedChartDigest() error = %v", err)
+	}
+	// The chart is not read again, so an edit does not change the cached digest.
+	if err := os.WriteFile(templatePath, []byte("kind: Deployment\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	second, err := cachedChartDigest(dir)
+	if err != nil {
+		t.Fatalf("cachedChartDigest() error = %v", err)
+	}
+	if second != first {
+		t.Errorf("cachedChartDigest() = %q, want the cached %q", second, first)
+	}
+}
+
+func counterValue(t *testing.T, name string) float64 {
+	t.Helper()
+	families, err := metrics.Registry.Gather()
+	if err != nil {
+		t.Fatalf("gather metrics: %v", err)
+	}
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+		var total float64
+		for _, m := range family.GetMetric() {
+			total += m.GetCounter().GetValue()
+		}
+		return total
+	}
+	return 0
+}
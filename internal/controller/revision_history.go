@@ -0,0 +1,188 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
+)
+
+const (
+	// RevisionLabel carries the revision number of a revision Secret.
+	RevisionLabel = "mlflow.opendatahub.io/revision"
+	// RevisionSecretType marks revision Secrets, like helm.sh/release.v1 does for Helm releases.
+	RevisionSecretType = "mlflow.opendatahub.io/revision.v1"
+
+	revisionManifestsKey        = "manifests"
+	revisionDigestAnnotation    = "mlflow.opendatahub.io/manifests-digest"
+	revisionChartAnnotation     = "mlflow.opendatahub.io/chart-version"
+	defaultRevisionHistoryLimit = 10
+)
+
+func revisionHistoryLimit(mlflow *mlflowv1.MLflow) int {
+	if mlflow.Spec.RevisionHistoryLimit == nil {
+		return defaultRevisionHistoryLimit
+	}
+	return int(*mlflow.Spec.RevisionHistoryLimit)
+}
+
+func revisionSecretName(mlflow *mlflowv1.MLflow, revision int64) string {
+	return fmt.Sprintf("%s%s-revision-%d", ResourceName, render.ResourceSuffix(mlflow.Name), revision)
+}
+
+// encodeRevisionManifests serializes objects as gzip-compressed JSON and returns it with the
+// digest of the uncompressed form.
+func encodeRevisionManifests(objects []*unstructured.Unstructured) ([]byte, string, error) {
+	manifests := make([]map[string]interface{}, 0, len(objects))
+	for _, obj := range objects {
+		manifests = append(manifests, obj.Object)
+	}
+	data, err := json.Marshal(manifests)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to marshal revision manifests: %w", err)
+	}
+	sum := sha256.Sum256(data)
+
+	var compressed bytes.Buffer
+	writer := gzip.NewWriter(&compressed)
+	if _, err := writer.Write(data); err != nil {
+		return nil, "", fmt.Errorf("failed to compress revision manifests: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, "", fmt.Errorf("failed to compress revision manifests: %w", err)
+	}
+	return compressed.Bytes(), hex.EncodeToString(sum[:]), nil
+}
+
+// listRevisionSecrets returns the revision Secrets of mlflow in namespace, oldest first. The
+// list is unstructured, so it reads the API server rather than a cache that may not have
+// seen the last revision yet.
+func (r *MLflowReconciler) listRevisionSecrets(ctx context.Context, mlflow *mlflowv1.MLflow, namespace string) ([]unstructured.Unstructured, error) {
+	list := &unstructured.UnstructuredList{}
+	list.SetAPIVersion("v1")
+	list.SetKind("SecretList")
+	if err := r.List(ctx, list, client.InNamespace(namespace), client.HasLabels{RevisionLabel}, client.MatchingLabels{"app": ResourceName}); err != nil {
+		return nil, fmt.Errorf("failed to list revision Secrets: %w", err)
+	}
+
+	var revisions []unstructured.Unstructured
+	for _, item := range list.Items {
+		if _, ok := revisionNumber(&item); ok && metav1.IsControlledBy(&item, mlflow) {
+			revisions = append(revisions, item)
+		}
+	}
+	slices.SortFunc(revisions, func(a, b unstructured.Unstructured) int {
+		na, _ := revisionNumber(&a)
+		nb, _ := revisionNumber(&b)
+		return int(na - nb)
+	})
+	return revisions, nil
+}
+
+func revisionNumber(secret *unstructured.Unstructured) (int64, bool) {
+	revision, err := strconv.ParseInt(secret.GetLabels()[RevisionLabel], 10, 64)
+	return revision, err == nil && revision > 0
+}
+
+// recordRevision stores objects as a new revision Secret unless the newest revision already
+// holds the same manifests, then deletes revisions beyond spec.revisionHistoryLimit. It
+// returns the revision holding objects, or 0 when the history is disabled.
+func (r *MLflowReconciler) recordRevision(
+	ctx context.Context,
+	mlflow *mlflowv1.MLflow,
+	namespace, chartVersion string,
+	objects []*unstructured.Unstructured,
+) (int64, error) {
+	revisions, err := r.listRevisionSecrets(ctx, mlflow, namespace)
+	if err != nil {
+		return 0, err
+	}
+	limit := revisionHistoryLimit(mlflow)
+
+	var current int64
+	if limit > 0 {
+		compressed, digest, err := encodeRevisionManifests(objects)
+		if err != nil {
+			return 0, err
+		}
+		if n := len(revisions); n > 0 && revisions[n-1].GetAnnotations()[revisionDigestAnnotation] == digest {
+			current, _ = revisionNumber(&revisions[n-1])
+		} else {
+			if n > 0 {
+				current, _ = revisionNumber(&revisions[n-1])
+			}
+			current++
+			secret, err := r.buildRevisionSecret(mlflow, namespace, chartVersion, current, digest, compressed)
+			if err != nil {
+				return 0, err
+			}
+			if err := r.applyObject(ctx, secret); err != nil {
+				return 0, fmt.Errorf("failed to record revision %d: %w", current, err)
+			}
+			revisions = append(revisions, *secret)
+		}
+	}
+
+	for i := 0; i < len(revisions)-limit; i++ {
+		if err := r.Delete(ctx, &revisions[i]); err != nil && !errors.IsNotFound(err) {
+			return current, fmt.Errorf("failed to delete revision Secret %s: %w", revisions[i].GetName(), err)
+		}
+	}
+	return current, nil
+}
+
+func (r *MLflowReconciler) buildRevisionSecret(
+	mlflow *mlflowv1.MLflow,
+	namespace, chartVersion string,
+	revision int64,
+	digest string,
+	compressed []byte,
+) (*unstructured.Unstructured, error) {
+	secret := &unstructured.Unstructured{Object: map[string]interface{}{
+		"type": RevisionSecretType,
+		"data": map[string]interface{}{
+			revisionManifestsKey: base64.StdEncoding.EncodeToString(compressed),
+		},
+	}}
+	secret.SetAPIVersion("v1")
+	secret.SetKind("Secret")
+	secret.SetName(revisionSecretName(mlflow, revision))
+	secret.SetNamespace(namespace)
+	secret.SetLabels(map[string]string{"app": ResourceName, RevisionLabel: strconv.FormatInt(revision, 10)})
+	secret.SetAnnotations(map[string]string{revisionDigestAnnotation: digest, revisionChartAnnotation: chartVersion})
+	if err := controllerutil.SetControllerReference(mlflow, secret, r.Scheme); err != nil {
+		return nil, fmt.Errorf("failed to set controller reference on revision Secret: %w", err)
+	}
+	return secret, nil
+}
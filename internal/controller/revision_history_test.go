@@ -0,0 +1,108 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func decodeTestRevision(g *gomega.WithT, secret *corev1.Secret) []map[string]interface{} {
+	reader, err := gzip.NewReader(bytes.NewReader(secret.Data[revisionManifestsKey]))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	data, err := io.ReadAll(reader)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	var manifests []map[string]interface{}
+	g.Expect(json.Unmarshal(data, &manifests)).To(gomega.Succeed())
+	return manifests
+}
+
+func TestRecordRevision(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(gomega.Succeed())
+	g.Expect(mlflowv1.AddToScheme(scheme)).To(gomega.Succeed())
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow", UID: "uid"},
+		Spec:       mlflowv1.MLflowSpec{RevisionHistoryLimit: ptr(int32(2))},
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(mlflow).Build()
+	r := &MLflowReconciler{Client: k8sClient, Scheme: scheme}
+	ctx := context.Background()
+
+	manifests := func(value string) []*unstructured.Unstructured {
+		return []*unstructured.Unstructured{newRollbackTestConfigMap(value)}
+	}
+	listRevisions := func() []string {
+		secrets := &corev1.SecretList{}
+		g.Expect(k8sClient.List(ctx, secrets, client.InNamespace("test-ns"))).To(gomega.Succeed())
+		var names []string
+		for _, secret := range secrets.Items {
+			names = append(names, secret.Name)
+		}
+		return names
+	}
+
+	revision, err := r.recordRevision(ctx, mlflow, "test-ns", "1.0.0", manifests("v1"))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(revision).To(gomega.Equal(int64(1)))
+
+	// Identical manifests reuse the newest revision.
+	revision, err = r.recordRevision(ctx, mlflow, "test-ns", "1.0.0", manifests("v1"))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(revision).To(gomega.Equal(int64(1)))
+	g.Expect(listRevisions()).To(gomega.ConsistOf("mlflow-revision-1"))
+
+	for i, value := range []string{"v2", "v3"} {
+		revision, err = r.recordRevision(ctx, mlflow, "test-ns", "1.0.0", manifests(value))
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(revision).To(gomega.Equal(int64(i + 2)))
+	}
+	g.Expect(listRevisions()).To(gomega.ConsistOf("mlflow-revision-2", "mlflow-revision-3"))
+
+	secret := &corev1.Secret{}
+	g.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: "mlflow-revision-3", Namespace: "test-ns"}, secret)).To(gomega.Succeed())
+	g.Expect(string(secret.Type)).To(gomega.Equal(RevisionSecretType))
+	g.Expect(secret.Labels).To(gomega.HaveKeyWithValue(RevisionLabel, "3"))
+	g.Expect(secret.Annotations).To(gomega.HaveKeyWithValue(revisionChartAnnotation, "1.0.0"))
+	g.Expect(metav1.IsControlledBy(secret, mlflow)).To(gomega.BeTrue())
+	recorded := decodeTestRevision(g, secret)
+	g.Expect(recorded).To(gomega.HaveLen(1))
+	g.Expect(recorded[0]["data"]).To(gomega.Equal(map[string]interface{}{"value": "v3"}))
+
+	mlflow.Spec.RevisionHistoryLimit = ptr(int32(0))
+	revision, err = r.recordRevision(ctx, mlflow, "test-ns", "1.0.0", manifests("v3"))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(revision).To(gomega.BeZero())
+	g.Expect(listRevisions()).To(gomega.BeEmpty())
+}
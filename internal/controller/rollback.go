@@ -0,0 +1,122 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+// appliedRevisions remembers the last object set each MLflow instance applied completely, so a
+// reconcile that fails halfway can restore it. The record is in memory: after an operator
+// restart there is nothing to roll back to until the next complete apply.
+type appliedRevisions struct {
+	mu      sync.Mutex
+	entries map[string][]*unstructured.Unstructured
+}
+
+func (a *appliedRevisions) get(name string) ([]*unstructured.Unstructured, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	objects, ok := a.entries[name]
+	if !ok {
+		return nil, false
+	}
+	return copyObjects(objects), true
+}
+
+func (a *appliedRevisions) put(name string, objects []*unstructured.Unstructured) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.entries == nil {
+		a.entries = make(map[string][]*unstructured.Unstructured)
+	}
+	a.entries[name] = copyObjects(objects)
+}
+
+func (a *appliedRevisions) forget(name string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	delete(a.entries, name)
+}
+
+// rollbackError reports a failed apply together with the outcome of rolling it back.
+type rollbackError struct {
+	cause      error
+	rolledBack int
+	failed     []error
+}
+
+func (e *rollbackError) Error() string {
+	msg := fmt.Sprintf("%v; rolled back %d object(s) to the previous revision", e.cause, e.rolledBack)
+	if len(e.failed) > 0 {
+		msg += fmt.Sprintf(" (rollback incomplete: %v)", errors.Join(e.failed...))
+	}
+	return msg
+}
+
+func (e *rollbackError) Unwrap() error {
+	return e.cause
+}
+
+// rollbackPartialApply restores the objects a failed apply already changed, similar to
+// helm --atomic: objects from the previous revision are re-applied and objects the previous
+// revision did not have are deleted. PVCs and shared RBAC objects are never deleted. Without a
+// previous revision, cause is returned unchanged and the objects stay as applied.
+func (r *MLflowReconciler) rollbackPartialApply(ctx context.Context, mlflow *mlflowv1.MLflow, applied []*unstructured.Unstructured, cause error) error {
+	previous, ok := r.appliedRevisions.get(mlflow.Name)
+	if !ok || len(applied) == 0 {
+		return cause
+	}
+	log := logf.FromContext(ctx)
+
+	byKey := make(map[appliedObjectKey]*unstructured.Unstructured, len(previous))
+	for _, obj := range previous {
+		byKey[appliedKey(obj)] = obj
+	}
+
+	result := &rollbackError{cause: cause}
+	for _, obj := range applied {
+		if prev, found := byKey[appliedKey(obj)]; found {
+			if err := r.applyObject(ctx, prev); err != nil {
+				result.failed = append(result.failed, fmt.Errorf("restore %s/%s: %w", obj.GetKind(), obj.GetName(), err))
+				continue
+			}
+		} else {
+			if obj.GetKind() == "PersistentVolumeClaim" || isSharedRBACObject(obj) {
+				continue
+			}
+			if err := r.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+				result.failed = append(result.failed, fmt.Errorf("delete %s/%s: %w", obj.GetKind(), obj.GetName(), err))
+				continue
+			}
+		}
+		result.rolledBack++
+	}
+	log.Info("Rolled back partially applied objects", "rolledBack", result.rolledBack, "failed", len(result.failed))
+	return result
+}
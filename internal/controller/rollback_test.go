@@ -0,0 +1,116 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func newRollbackTestConfigMap(value string) *unstructured.Unstructured {
+	obj := newApplyOrderTestObject("v1", "ConfigMap", "mlflow")
+	obj.Object["data"] = map[string]interface{}{"value": value}
+	return obj
+}
+
+func TestApplyRenderedObjectsRollsBackPartialApply(t *testing.T) {
+	applyErr := errors.New("deployment rejected")
+	failDeployment := false
+	r := newApplyOrderTestReconciler(t, interceptor.Funcs{
+		Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+			if failDeployment && !isDryRunPatch(opts) && obj.GetObjectKind().GroupVersionKind().Kind == "Deployment" {
+				return applyErr
+			}
+			return c.Patch(ctx, obj, patch, opts...)
+		},
+	})
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "mlflow", UID: "uid"}}
+	ctx := context.Background()
+
+	previous := []*unstructured.Unstructured{
+		newRollbackTestConfigMap("v1"),
+		newApplyOrderTestObject("apps/v1", "Deployment", "mlflow"),
+	}
+	if err := r.applyRenderedObjects(ctx, mlflow, previous); err != nil {
+		t.Fatalf("applyRenderedObjects() previous revision error = %v", err)
+	}
+
+	failDeployment = true
+	deployment := newApplyOrderTestObject("apps/v1", "Deployment", "mlflow")
+	deployment.SetLabels(map[string]string{"revision": "next"})
+	next := []*unstructured.Unstructured{
+		newRollbackTestConfigMap("v2"),
+		newApplyOrderTestObject("v1", "Service", "mlflow-extra"),
+		deployment,
+	}
+	err := r.applyRenderedObjects(ctx, mlflow, next)
+	if !errors.Is(err, applyErr) {
+		t.Fatalf("applyRenderedObjects() error = %v, want %v", err, applyErr)
+	}
+	want := "apply Deployment/mlflow: deployment rejected; rolled back 2 object(s) to the previous revision"
+	if err.Error() != want {
+		t.Errorf("error = %q, want %q", err, want)
+	}
+
+	configMap := &corev1.ConfigMap{}
+	if err := r.Get(ctx, client.ObjectKey{Name: "mlflow", Namespace: "test-ns"}, configMap); err != nil {
+		t.Fatalf("get ConfigMap: %v", err)
+	}
+	if configMap.Data["value"] != "v1" {
+		t.Errorf("ConfigMap value = %q, want the previous revision's v1", configMap.Data["value"])
+	}
+	err = r.Get(ctx, client.ObjectKey{Name: "mlflow-extra", Namespace: "test-ns"}, &corev1.Service{})
+	if !apierrors.IsNotFound(err) {
+		t.Errorf("Service added by the failed revision still exists: %v", err)
+	}
+}
+
+func TestApplyRenderedObjectsWithoutPreviousRevision(t *testing.T) {
+	applyErr := errors.New("deployment rejected")
+	r := newApplyOrderTestReconciler(t, interceptor.Funcs{
+		Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+			if !isDryRunPatch(opts) && obj.GetObjectKind().GroupVersionKind().Kind == "Deployment" {
+				return applyErr
+			}
+			return c.Patch(ctx, obj, patch, opts...)
+		},
+	})
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "mlflow", UID: "uid"}}
+	ctx := context.Background()
+
+	err := r.applyRenderedObjects(ctx, mlflow, []*unstructured.Unstructured{
+		newRollbackTestConfigMap("v1"),
+		newApplyOrderTestObject("apps/v1", "Deployment", "mlflow"),
+	})
+	if err == nil || err.Error() != "apply Deployment/mlflow: deployment rejected" {
+		t.Fatalf("applyRenderedObjects() error = %v, want the apply error unchanged", err)
+	}
+	// Nothing to roll back to, so the first wave stays applied for the retry.
+	if err := r.Get(ctx, client.ObjectKey{Name: "mlflow", Namespace: "test-ns"}, &corev1.ConfigMap{}); err != nil {
+		t.Errorf("get ConfigMap: %v", err)
+	}
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
+)
+
+const (
+	// routeReadyConditionType reports whether the HTTPRoute is attached to a programmed Gateway.
+	routeReadyConditionType = "RouteReady"
+
+	routeReadyReasonAccepted             = "RouteAccepted"
+	routeReadyReasonPending              = "RoutePending"
+	routeReadyReasonNotAccepted          = "RouteNotAccepted"
+	routeReadyReasonRefsNotResolved      = "RouteRefsNotResolved"
+	routeReadyReasonGatewayNotFound      = "GatewayNotFound"
+	routeReadyReasonGatewayNotProgrammed = "GatewayNotProgrammed"
+)
+
+// setRouteReadyCondition reflects the HTTPRoute status, and the Programmed condition of each
+// parent Gateway, in the RouteReady condition. The condition is removed when the instance has
+// no HTTPRoute. A route that is not ready does not affect Available: the server is still
+// reachable in-cluster.
+func (r *MLflowReconciler) setRouteReadyCondition(ctx context.Context, mlflow *mlflowv1.MLflow, namespace string) error {
+	if !r.HTTPRouteAvailable || !routingEnabled(mlflow) {
+		meta.RemoveStatusCondition(&mlflow.Status.Conditions, routeReadyConditionType)
+		return nil
+	}
+
+	route := &gatewayv1.HTTPRoute{}
+	key := types.NamespacedName{Name: ResourceName + render.ResourceSuffix(mlflow.Name), Namespace: namespace}
+	if err := r.Get(ctx, key, route); err != nil {
+		if !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to get HttpRoute: %w", err)
+		}
+		// The cache has not seen the route that was just applied yet.
+		route = &gatewayv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace}}
+	}
+
+	gateways := map[types.NamespacedName]*gatewayv1.Gateway{}
+	for _, parentRef := range route.Spec.ParentRefs {
+		gatewayKey := parentGatewayKey(parentRef, route.Namespace)
+		gateway := &gatewayv1.Gateway{}
+		err := r.Get(ctx, gatewayKey, gateway)
+		switch {
+		case errors.IsNotFound(err):
+			gateway = nil
+		case err != nil:
+			return fmt.Errorf("failed to get Gateway %s: %w", gatewayKey, err)
+		}
+		gateways[gatewayKey] = gateway
+	}
+
+	condition := routeReadyCondition(route, gateways)
+	condition.ObservedGeneration = mlflow.Generation
+	if previous := meta.FindStatusCondition(mlflow.Status.Conditions, routeReadyConditionType); previous == nil || previous.Reason != condition.Reason {
+		logf.FromContext(ctx).Info("HttpRoute readiness changed", "reason", condition.Reason, "message", condition.Message)
+	}
+	meta.SetStatusCondition(&mlflow.Status.Conditions, condition)
+	return nil
+}
+
+// routeReadyCondition evaluates each parentRef of route: its Gateway must exist and be
+// programmed, and the route status for that parent must report Accepted and ResolvedRefs.
+// gateways holds the parent Gateways by key, with nil for a missing Gateway. The first
+// failing parent decides the condition.
+func routeReadyCondition(route *gatewayv1.HTTPRoute, gateways map[types.NamespacedName]*gatewayv1.Gateway) metav1.Condition {
+	notReady := func(reason, message string) metav1.Condition {
+		return metav1.Condition{Type: routeReadyConditionType, Status: metav1.ConditionFalse, Reason: reason, Message: message}
+	}
+
+	if len(route.Spec.ParentRefs) == 0 {
+		return notReady(routeReadyReasonPending, "HttpRoute has not been created yet")
+	}
+	for _, parentRef := range route.Spec.ParentRefs {
+		gatewayKey := parentGatewayKey(parentRef, route.Namespace)
+		gateway := gateways[gatewayKey]
+		if gateway == nil {
+			return notReady(routeReadyReasonGatewayNotFound, fmt.Sprintf("Gateway %s does not exist", gatewayKey))
+		}
+		if programmed := meta.FindStatusCondition(gateway.Status.Conditions, string(gatewayv1.GatewayConditionProgrammed)); programmed == nil || programmed.Status != metav1.ConditionTrue {
+			return notReady(routeReadyReasonGatewayNotProgrammed, fmt.Sprintf("Gateway %s is not programmed%s", gatewayKey, conditionDetail(programmed)))
+		}
+
+		parentStatus := findRouteParentStatus(route, parentRef)
+		if parentStatus == nil {
+			return notReady(routeReadyReasonPending, fmt.Sprintf("HttpRoute has not been processed by Gateway %s yet", gatewayKey))
+		}
+		if accepted := meta.FindStatusCondition(parentStatus.Conditions, string(gatewayv1.RouteConditionAccepted)); accepted == nil || accepted.Status != metav1.ConditionTrue {
+			return notReady(routeReadyReasonNotAccepted, fmt.Sprintf("HttpRoute is not accepted by Gateway %s%s", gatewayKey, conditionDetail(accepted)))
+		}
+		if resolved := meta.FindStatusCondition(parentStatus.Conditions, string(gatewayv1.RouteConditionResolvedRefs)); resolved == nil || resolved.Status != metav1.ConditionTrue {
+			return notReady(routeReadyReasonRefsNotResolved, fmt.Sprintf("HttpRoute backends are not resolved for Gateway %s%s", gatewayKey, conditionDetail(resolved)))
+		}
+	}
+	return metav1.Condition{
+		Type:    routeReadyConditionType,
+		Status:  metav1.ConditionTrue,
+		Reason:  routeReadyReasonAccepted,
+		Message: fmt.Sprintf("HttpRoute is accepted by %d programmed Gateway(s)", len(route.Spec.ParentRefs)),
+	}
+}
+
+// parentGatewayKey returns the Gateway a parentRef points at; the namespace defaults to the route's.
+func parentGatewayKey(parentRef gatewayv1.ParentReference, routeNamespace string) types.NamespacedName {
+	namespace := routeNamespace
+	if parentRef.Namespace != nil && *parentRef.Namespace != "" {
+		namespace = string(*parentRef.Namespace)
+	}
+	return types.NamespacedName{Name: string(parentRef.Name), Namespace: namespace}
+}
+
+// findRouteParentStatus returns the route status entry for parentRef. Gateway controllers
+// echo the parentRef back, so entries are matched on Gateway and section name.
+func findRouteParentStatus(route *gatewayv1.HTTPRoute, parentRef gatewayv1.ParentReference) *gatewayv1.RouteParentStatus {
+	want := parentGatewayKey(parentRef, route.Namespace)
+	for i := range route.Status.Parents {
+		status := &route.Status.Parents[i]
+		if parentGatewayKey(status.ParentRef, route.Namespace) != want {
+			continue
+		}
+		if sectionNameOf(status.ParentRef) == sectionNameOf(parentRef) {
+			return status
+		}
+	}
+	return nil
+}
+
+func sectionNameOf(parentRef gatewayv1.ParentReference) string {
+	if parentRef.SectionName == nil {
+		return ""
+	}
+	return string(*parentRef.SectionName)
+}
+
+// conditionDetail formats the reason and message of an unmet condition for a status message.
+func conditionDetail(condition *metav1.Condition) string {
+	if condition == nil {
+		return ""
+	}
+	if condition.Message == "" {
+		return fmt.Sprintf(" (%s)", condition.Reason)
+	}
+	return fmt.Sprintf(" (%s: %s)", condition.Reason, condition.Message)
+}
+
+// gatewayToMLflowRequests maps Gateway changes to the MLflow instances whose HTTPRoute
+// attaches to that Gateway. A Gateway matching GATEWAY_SELECTOR and GATEWAY_CLASS_NAME maps
+// to the singleton MLflow, since it may now be the one to select.
+func (r *MLflowReconciler) gatewayToMLflowRequests(ctx context.Context, obj client.Object) []reconcile.Request {
+	log := logf.FromContext(ctx)
+
+	if gateway, ok := obj.(*gatewayv1.Gateway); ok {
+		cfg, err := r.applyRuntimeConfigMap(ctx, config.GetConfig())
+		if err != nil {
+			log.Error(err, "Failed to read operator config for Gateway watch")
+		} else if gatewaySelectionMatches(cfg, gateway) {
+			return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: ResourceName}}}
+		}
+	}
+
+	routes := &gatewayv1.HTTPRouteList{}
+	if err := r.List(ctx, routes, client.MatchingLabels{"app": ResourceName}); err != nil {
+		log.Error(err, "Failed to list HttpRoutes for Gateway watch")
+		return nil
+	}
+
+	gatewayKey := types.NamespacedName{Name: obj.GetName(), Namespace: obj.GetNamespace()}
+	var requests []reconcile.Request
+	for _, route := range routes.Items {
+		owner := metav1.GetControllerOf(&route)
+		if owner == nil || owner.Kind != "MLflow" {
+			continue
+		}
+		for _, parentRef := range route.Spec.ParentRefs {
+			if parentGatewayKey(parentRef, route.Namespace) == gatewayKey {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: owner.Name}})
+				break
+			}
+		}
+	}
+	return requests
+}
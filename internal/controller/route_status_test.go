@@ -0,0 +1,146 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+)
+
+func newTestRouteGateway(programmed metav1.ConditionStatus) *gatewayv1.Gateway {
+	return &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Name: "data-science-gateway", Namespace: defaultGatewayNamespace},
+		Status: gatewayv1.GatewayStatus{Conditions: []metav1.Condition{{
+			Type:   string(gatewayv1.GatewayConditionProgrammed),
+			Status: programmed,
+			Reason: "Programmed",
+		}}},
+	}
+}
+
+func newTestAcceptedRoute(mlflow *mlflowv1.MLflow, conditions ...metav1.Condition) *gatewayv1.HTTPRoute {
+	route := buildHTTPRoute(mlflow, "test-ns", &config.OperatorConfig{GatewayName: "data-science-gateway"})
+	route.Status.Parents = []gatewayv1.RouteParentStatus{{
+		ParentRef:      route.Spec.ParentRefs[0],
+		ControllerName: "openshift.io/gateway-controller/v1",
+		Conditions:     conditions,
+	}}
+	return route
+}
+
+func TestRouteReadyCondition(t *testing.T) {
+	g := gomega.NewWithT(t)
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "mlflow"}}
+	gatewayKey := types.NamespacedName{Name: "data-science-gateway", Namespace: defaultGatewayNamespace}
+	accepted := metav1.Condition{Type: string(gatewayv1.RouteConditionAccepted), Status: metav1.ConditionTrue, Reason: "Accepted"}
+	resolved := metav1.Condition{Type: string(gatewayv1.RouteConditionResolvedRefs), Status: metav1.ConditionTrue, Reason: "ResolvedRefs"}
+	programmed := map[types.NamespacedName]*gatewayv1.Gateway{gatewayKey: newTestRouteGateway(metav1.ConditionTrue)}
+
+	condition := routeReadyCondition(newTestAcceptedRoute(mlflow, accepted, resolved), programmed)
+	g.Expect(condition.Status).To(gomega.Equal(metav1.ConditionTrue))
+	g.Expect(condition.Reason).To(gomega.Equal(routeReadyReasonAccepted))
+
+	condition = routeReadyCondition(newTestAcceptedRoute(mlflow, accepted, resolved), map[types.NamespacedName]*gatewayv1.Gateway{gatewayKey: nil})
+	g.Expect(condition.Reason).To(gomega.Equal(routeReadyReasonGatewayNotFound))
+	g.Expect(condition.Message).To(gomega.ContainSubstring("openshift-ingress/data-science-gateway"))
+
+	condition = routeReadyCondition(newTestAcceptedRoute(mlflow, accepted, resolved),
+		map[types.NamespacedName]*gatewayv1.Gateway{gatewayKey: newTestRouteGateway(metav1.ConditionFalse)})
+	g.Expect(condition.Reason).To(gomega.Equal(routeReadyReasonGatewayNotProgrammed))
+
+	route := newTestAcceptedRoute(mlflow)
+	route.Status.Parents = nil
+	g.Expect(routeReadyCondition(route, programmed).Reason).To(gomega.Equal(routeReadyReasonPending))
+
+	notAccepted := metav1.Condition{
+		Type:    string(gatewayv1.RouteConditionAccepted),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(gatewayv1.RouteReasonNoMatchingListenerHostname),
+		Message: "no listener matches the route hostnames",
+	}
+	condition = routeReadyCondition(newTestAcceptedRoute(mlflow, notAccepted, resolved), programmed)
+	g.Expect(condition.Status).To(gomega.Equal(metav1.ConditionFalse))
+	g.Expect(condition.Reason).To(gomega.Equal(routeReadyReasonNotAccepted))
+	g.Expect(condition.Message).To(gomega.ContainSubstring("NoMatchingListenerHostname: no listener matches the route hostnames"))
+
+	unresolved := metav1.Condition{Type: string(gatewayv1.RouteConditionResolvedRefs), Status: metav1.ConditionFalse, Reason: string(gatewayv1.RouteReasonBackendNotFound)}
+	g.Expect(routeReadyCondition(newTestAcceptedRoute(mlflow, accepted, unresolved), programmed).Reason).To(gomega.Equal(routeReadyReasonRefsNotResolved))
+}
+
+func TestSetRouteReadyCondition(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(gomega.Succeed())
+	g.Expect(gatewayv1.Install(scheme)).To(gomega.Succeed())
+
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "mlflow"}}
+	route := newTestAcceptedRoute(mlflow,
+		metav1.Condition{Type: string(gatewayv1.RouteConditionAccepted), Status: metav1.ConditionTrue, Reason: "Accepted"},
+		metav1.Condition{Type: string(gatewayv1.RouteConditionResolvedRefs), Status: metav1.ConditionTrue, Reason: "ResolvedRefs"},
+	)
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(route).Build()
+	r := &MLflowReconciler{Client: k8sClient, Scheme: scheme, HTTPRouteAvailable: true}
+	ctx := context.Background()
+
+	// The default Gateway is missing, which would otherwise leave the route silently unserved.
+	g.Expect(r.setRouteReadyCondition(ctx, mlflow, "test-ns")).To(gomega.Succeed())
+	condition := meta.FindStatusCondition(mlflow.Status.Conditions, routeReadyConditionType)
+	g.Expect(condition).NotTo(gomega.BeNil())
+	g.Expect(condition.Reason).To(gomega.Equal(routeReadyReasonGatewayNotFound))
+
+	g.Expect(k8sClient.Create(ctx, newTestRouteGateway(metav1.ConditionTrue))).To(gomega.Succeed())
+	g.Expect(r.setRouteReadyCondition(ctx, mlflow, "test-ns")).To(gomega.Succeed())
+	g.Expect(meta.IsStatusConditionTrue(mlflow.Status.Conditions, routeReadyConditionType)).To(gomega.BeTrue())
+
+	mlflow.Spec.Routing = &mlflowv1.RoutingConfig{Enabled: ptr(false)}
+	g.Expect(r.setRouteReadyCondition(ctx, mlflow, "test-ns")).To(gomega.Succeed())
+	g.Expect(meta.FindStatusCondition(mlflow.Status.Conditions, routeReadyConditionType)).To(gomega.BeNil())
+}
+
+func TestGatewayToMLflowRequests(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(gomega.Succeed())
+	g.Expect(gatewayv1.Install(scheme)).To(gomega.Succeed())
+
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "mlflow"}}
+	route := newTestAcceptedRoute(mlflow)
+	route.OwnerReferences = []metav1.OwnerReference{{APIVersion: "mlflow.opendatahub.io/v1", Kind: "MLflow", Name: "mlflow", UID: "uid", Controller: ptr(true)}}
+	r := &MLflowReconciler{Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(route).Build(), Scheme: scheme}
+
+	requests := r.gatewayToMLflowRequests(context.Background(), newTestRouteGateway(metav1.ConditionTrue))
+	g.Expect(requests).To(gomega.HaveLen(1))
+	g.Expect(requests[0].Name).To(gomega.Equal("mlflow"))
+
+	other := newTestRouteGateway(metav1.ConditionTrue)
+	other.Name = "other-gateway"
+	g.Expect(r.gatewayToMLflowRequests(context.Background(), other)).To(gomega.BeEmpty())
+}
@@ -21,12 +21,16 @@ import (
 	_ "embed"
 	"encoding/base64"
 	"fmt"
+	"strings"
 
 	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
 	"github.com/opendatahub-io/mlflow-operator/internal/config"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
 	consolev1 "github.com/openshift/api/console/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/discovery"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -39,7 +43,17 @@ var consoleLinkIconSVG []byte
 
 const (
 	ServiceMonitorCRDName = "ServiceMonitor"
+	PodMonitorCRDName     = "PodMonitor"
+	PrometheusRuleCRDName = "PrometheusRule"
 	MLflowOperatorCRDName = "MLflowOperator"
+	VirtualServiceCRDName = "VirtualService"
+)
+
+// Istio networking kinds are handled as unstructured objects so the operator
+// does not need to vendor the Istio API module for this optional backend.
+var (
+	VirtualServiceGVK  = schema.GroupVersionKind{Group: "networking.istio.io", Version: "v1beta1", Kind: VirtualServiceCRDName}
+	DestinationRuleGVK = schema.GroupVersionKind{Group: "networking.istio.io", Version: "v1beta1", Kind: "DestinationRule"}
 )
 
 // IsConsoleLinkAvailable checks if ConsoleLink CRD is available in the cluster using discovery API
@@ -102,27 +116,69 @@ func IsHTTPRouteAvailable(discoveryClient discovery.DiscoveryInterface) (bool, e
 
 // IsServiceMonitorAvailable checks if ServiceMonitor CRD is available in the cluster using discovery API
 func IsServiceMonitorAvailable(discoveryClient discovery.DiscoveryInterface) (bool, error) {
+	return isKindAvailable(discoveryClient, monitoringGroupVersion, ServiceMonitorCRDName)
+}
+
+// IsPodMonitorAvailable checks if PodMonitor CRD is available in the cluster using discovery API
+func IsPodMonitorAvailable(discoveryClient discovery.DiscoveryInterface) (bool, error) {
+	return isKindAvailable(discoveryClient, monitoringGroupVersion, PodMonitorCRDName)
+}
+
+// IsPrometheusRuleAvailable checks if PrometheusRule CRD is available in the cluster using discovery API
+func IsPrometheusRuleAvailable(discoveryClient discovery.DiscoveryInterface) (bool, error) {
+	return isKindAvailable(discoveryClient, monitoringGroupVersion, PrometheusRuleCRDName)
+}
+
+var monitoringGroupVersion = schema.GroupVersion{Group: "monitoring.coreos.com", Version: "v1"}
+
+// isKindAvailable checks if kind is served by the cluster under gv.
+func isKindAvailable(discoveryClient discovery.DiscoveryInterface, gv schema.GroupVersion, kind string) (bool, error) {
 	ctx := context.Background()
 	log := logf.FromContext(ctx)
 
-	gv := schema.GroupVersion{Group: "monitoring.coreos.com", Version: "v1"}
 	resourceList, err := discoveryClient.ServerResourcesForGroupVersion(gv.String())
 	if err != nil {
 		if errors.IsNotFound(err) || discovery.IsGroupDiscoveryFailedError(err) {
-			log.V(1).Info(fmt.Sprintf("%s CRD not available in cluster", ServiceMonitorCRDName))
+			log.V(1).Info(fmt.Sprintf("%s CRD not available in cluster", kind))
 			return false, nil
 		}
-		return false, fmt.Errorf("failed to check for %s availability: %w", ServiceMonitorCRDName, err)
+		return false, fmt.Errorf("failed to check for %s availability: %w", kind, err)
 	}
 
 	for _, resource := range resourceList.APIResources {
-		if resource.Kind == ServiceMonitorCRDName {
-			log.V(1).Info(fmt.Sprintf("%s CRD is available in cluster", ServiceMonitorCRDName))
+		if resource.Kind == kind {
+			log.V(1).Info(fmt.Sprintf("%s CRD is available in cluster", kind))
 			return true, nil
 		}
 	}
 
-	log.V(1).Info(fmt.Sprintf("%s CRD not found in resource list", ServiceMonitorCRDName))
+	log.V(1).Info(fmt.Sprintf("%s CRD not found in resource list", kind))
+	return false, nil
+}
+
+// IsVirtualServiceAvailable checks if the Istio VirtualService CRD is available in the cluster using discovery API
+func IsVirtualServiceAvailable(discoveryClient discovery.DiscoveryInterface) (bool, error) {
+	ctx := context.Background()
+	log := logf.FromContext(ctx)
+
+	gv := VirtualServiceGVK.GroupVersion()
+	resourceList, err := discoveryClient.ServerResourcesForGroupVersion(gv.String())
+	if err != nil {
+		if errors.IsNotFound(err) || discovery.IsGroupDiscoveryFailedError(err) {
+			log.V(1).Info(fmt.Sprintf("%s CRD not available in cluster", VirtualServiceCRDName))
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to check for %s availability: %w", VirtualServiceCRDName, err)
+	}
+
+	for _, resource := range resourceList.APIResources {
+		if resource.Kind == VirtualServiceCRDName {
+			log.V(1).Info(fmt.Sprintf("%s CRD is available in cluster", VirtualServiceCRDName))
+			return true, nil
+		}
+	}
+
+	log.V(1).Info(fmt.Sprintf("%s CRD not found in resource list", VirtualServiceCRDName))
 	return false, nil
 }
 
@@ -164,18 +220,127 @@ func (r *MLflowReconciler) reconcileConsoleLink(
 		log.V(1).Info("Skipping ConsoleLink creation - not available in cluster")
 		return nil
 	}
+	if r.Namespaced {
+		log.V(1).Info("Skipping ConsoleLink creation - cluster-scoped objects are not managed in namespaced install mode")
+		return nil
+	}
+
+	consoleLink := buildConsoleLink(mlflow, cfg)
+
+	if !routingEnabled(mlflow) || consoleLinkDisabled(mlflow) {
+		if err := r.Delete(ctx, consoleLink); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete disabled ConsoleLink: %w", err)
+		}
+		log.V(1).Info("ConsoleLink disabled for MLflow instance", "name", consoleLink.Name)
+		return nil
+	}
+
+	// Withdraw the link while the instance is not serving, so the console never offers a URL
+	// that 404s during rollout, suspension, or a Gateway outage.
+	if !consoleLinkServing(mlflow) {
+		if err := r.Delete(ctx, consoleLink); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete ConsoleLink while MLflow is not serving: %w", err)
+		}
+		log.V(1).Info("MLflow instance not serving, withholding ConsoleLink", "name", consoleLink.Name)
+		return nil
+	}
+
+	propagateMetadata(mlflow, consoleLink)
+
+	// Set owner reference
+	if err := controllerutil.SetControllerReference(mlflow, consoleLink, r.Scheme); err != nil {
+		return fmt.Errorf("failed to set controller reference on ConsoleLink: %w", err)
+	}
+
+	// Create or update the ConsoleLink
+	if err := r.applyObject(ctx, consoleLink); err != nil {
+		log.Error(err, "Failed to apply ConsoleLink", "name", consoleLink.Name)
+		return err
+	}
+
+	log.V(1).Info("Successfully reconciled ConsoleLink", "name", consoleLink.Name)
+	return nil
+}
+
+// routingEnabled reports whether the operator should manage routing resources
+// for the MLflow instance. Routing is enabled unless spec.routing.enabled is false.
+func routingEnabled(mlflow *mlflowv1.MLflow) bool {
+	return mlflow.Spec.Routing == nil ||
+		mlflow.Spec.Routing.Enabled == nil ||
+		*mlflow.Spec.Routing.Enabled
+}
+
+// routePathPrefix is the path prefix the routes publish the instance under: /mlflow[-suffix],
+// or "" when it is served at the root of its hostnames.
+func routePathPrefix(mlflow *mlflowv1.MLflow) string {
+	if render.ServesAtRoot(mlflow) {
+		return ""
+	}
+	return "/" + ResourceName + render.ResourceSuffix(mlflow.Name)
+}
+
+// routeHostname returns the spec.routing.customDomain host, else the first hostname in
+// spec.routing.hostnames without a wildcard, or "" when there is none.
+func routeHostname(mlflow *mlflowv1.MLflow) string {
+	if mlflow.Spec.Routing == nil {
+		return ""
+	}
+	if domain := mlflow.Spec.Routing.CustomDomain; domain != nil {
+		return domain.Host
+	}
+	for _, hostname := range mlflow.Spec.Routing.Hostnames {
+		if !strings.HasPrefix(hostname, "*") {
+			return hostname
+		}
+	}
+	return ""
+}
+
+// consoleLinkServing reports whether the instance is serving through its public route: the
+// server is Available and, when the route reports readiness, RouteReady is not False.
+func consoleLinkServing(mlflow *mlflowv1.MLflow) bool {
+	return meta.IsStatusConditionTrue(mlflow.Status.Conditions, "Available") &&
+		!meta.IsStatusConditionFalse(mlflow.Status.Conditions, routeReadyConditionType)
+}
 
+// consoleLinkDisabled reports whether the MLflow CR suppresses its ConsoleLink.
+func consoleLinkDisabled(mlflow *mlflowv1.MLflow) bool {
+	return mlflow.Spec.ConsoleLink != nil &&
+		mlflow.Spec.ConsoleLink.Disable != nil &&
+		*mlflow.Spec.ConsoleLink.Disable
+}
+
+// buildConsoleLink renders the ConsoleLink for an MLflow instance, applying any
+// per-instance text and section overrides from spec.consoleLink.
+func buildConsoleLink(mlflow *mlflowv1.MLflow, cfg *config.OperatorConfig) *consolev1.ConsoleLink {
 	// Determine ConsoleLink name based on CR name
 	// If CR name is "mlflow", ConsoleLink name is "mlflow"
 	// Otherwise ConsoleLink name is "mlflow-${cr_name}"
-	consoleLinkName := ResourceName + getResourceSuffix(mlflow.Name)
+	consoleLinkName := ResourceName + render.ResourceSuffix(mlflow.Name)
 
 	// Encode SVG icon to base64
 	iconBase64 := base64.StdEncoding.EncodeToString(consoleLinkIconSVG)
 	iconDataURL := "data:image/svg+xml;base64," + iconBase64
 
-	// Create ConsoleLink object
-	consoleLink := &consolev1.ConsoleLink{
+	href := fmt.Sprintf("%s/%s", strings.TrimRight(cfg.MLflowURL, "/"), consoleLinkName)
+	if externalURL := render.ServerExternalURL(mlflow); externalURL != "" {
+		href = externalURL
+	} else if hostname := routeHostname(mlflow); hostname != "" {
+		href = "https://" + hostname + routePathPrefix(mlflow)
+	}
+
+	text := "MLflow"
+	section := cfg.SectionTitle
+	if override := mlflow.Spec.ConsoleLink; override != nil {
+		if override.Text != nil && *override.Text != "" {
+			text = *override.Text
+		}
+		if override.Section != nil && *override.Section != "" {
+			section = *override.Section
+		}
+	}
+
+	return &consolev1.ConsoleLink{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: "console.openshift.io/v1",
 			Kind:       "ConsoleLink",
@@ -188,30 +353,16 @@ func (r *MLflowReconciler) reconcileConsoleLink(
 		},
 		Spec: consolev1.ConsoleLinkSpec{
 			Link: consolev1.Link{
-				Text: "MLflow",
-				Href: fmt.Sprintf("%s/%s", cfg.MLflowURL, consoleLinkName),
+				Text: text,
+				Href: href,
 			},
 			Location: consolev1.ApplicationMenu,
 			ApplicationMenu: &consolev1.ApplicationMenuSpec{
-				Section:  cfg.SectionTitle,
+				Section:  section,
 				ImageURL: iconDataURL,
 			},
 		},
 	}
-
-	// Set owner reference
-	if err := controllerutil.SetControllerReference(mlflow, consoleLink, r.Scheme); err != nil {
-		return fmt.Errorf("failed to set controller reference on ConsoleLink: %w", err)
-	}
-
-	// Create or update the ConsoleLink
-	if err := r.applyObject(ctx, consoleLink); err != nil {
-		log.Error(err, "Failed to apply ConsoleLink", "name", consoleLinkName)
-		return err
-	}
-
-	log.V(1).Info("Successfully reconciled ConsoleLink", "name", consoleLinkName)
-	return nil
 }
 
 // reconcileHttpRoute creates or updates the HttpRoute for MLflow
@@ -231,20 +382,148 @@ func (r *MLflowReconciler) reconcileHttpRoute(
 	// Determine HttpRoute name and path prefix based on CR name using resource suffix
 	// If CR name is "mlflow", HttpRoute name is "mlflow" and path prefix is "/mlflow"
 	// Otherwise HttpRoute name is "mlflow-${cr_name}" and path prefix is "/mlflow-${cr_name}"
-	suffix := getResourceSuffix(mlflow.Name)
+	// With spec.routing.rootPath there is no path prefix
+	suffix := render.ResourceSuffix(mlflow.Name)
 	httpRouteName := ResourceName + suffix
-	pathPrefix := "/" + ResourceName + suffix
+
+	if !routingEnabled(mlflow) {
+		existing := &gatewayv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Name: httpRouteName, Namespace: namespace},
+		}
+		if err := r.Delete(ctx, existing); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete HttpRoute while routing is disabled: %w", err)
+		}
+		log.V(1).Info("Routing disabled for MLflow instance, skipping HttpRoute", "name", httpRouteName)
+		return nil
+	}
+
+	httpRoute := buildHTTPRoute(mlflow, namespace, cfg)
+	propagateMetadata(mlflow, httpRoute)
+
+	// Set owner reference
+	if err := controllerutil.SetControllerReference(mlflow, httpRoute, r.Scheme); err != nil {
+		return fmt.Errorf("failed to set controller reference on HttpRoute: %w", err)
+	}
+
+	// Create or update the HttpRoute
+	if err := r.applyObject(ctx, httpRoute); err != nil {
+		log.Error(err, "Failed to apply HttpRoute", "name", httpRouteName)
+		return err
+	}
+
+	log.V(1).Info("Successfully reconciled HttpRoute", "name", httpRouteName, "parentRefs", len(httpRoute.Spec.ParentRefs))
+	return nil
+}
+
+// buildHTTPRoute constructs the desired HttpRoute for an MLflow instance.
+func buildHTTPRoute(mlflow *mlflowv1.MLflow, namespace string, cfg *config.OperatorConfig) *gatewayv1.HTTPRoute {
+	suffix := render.ResourceSuffix(mlflow.Name)
+	httpRouteName := ResourceName + suffix
+	pathPrefix := routePathPrefix(mlflow)
 	v1PathPrefix := pathPrefix + "/v1"
 	replaceV1Prefix := "/v1"
+	catchAllPrefix := pathPrefix
+	if catchAllPrefix == "" {
+		catchAllPrefix = "/"
+	}
 	serviceName := ResourceName + suffix
 
 	// Create HttpRoute object
 	pathMatchType := gatewayv1.PathMatchPathPrefix
 	servicePort := gatewayv1.PortNumber(8443)
 	weight := int32(1)
+	backendRefs := []gatewayv1.HTTPBackendRef{
+		{
+			BackendRef: gatewayv1.BackendRef{
+				BackendObjectReference: gatewayv1.BackendObjectReference{
+					Name: gatewayv1.ObjectName(serviceName),
+					Port: &servicePort,
+				},
+				Weight: &weight,
+			},
+		},
+	}
+	// During a canary rollout or blue/green switch the weights become percentages split
+	// between both revisions.
+	if revisionName, revisionWeight := secondaryRevisionTraffic(mlflow); revisionWeight > 0 {
+		stableWeight := 100 - revisionWeight
+		backendRefs[0].Weight = &stableWeight
+		backendRefs = append(backendRefs, gatewayv1.HTTPBackendRef{
+			BackendRef: gatewayv1.BackendRef{
+				BackendObjectReference: gatewayv1.BackendObjectReference{
+					Name: gatewayv1.ObjectName(revisionName),
+					Port: &servicePort,
+				},
+				Weight: &revisionWeight,
+			},
+		})
+	}
 
-	gatewayNamespace := "openshift-ingress"
-	httpRoute := &gatewayv1.HTTPRoute{
+	rules := []gatewayv1.HTTPRouteRule{
+		{
+			Matches: []gatewayv1.HTTPRouteMatch{
+				{
+					Path: &gatewayv1.HTTPPathMatch{
+						Type:  &pathMatchType,
+						Value: &v1PathPrefix,
+					},
+				},
+			},
+			Filters: []gatewayv1.HTTPRouteFilter{
+				{
+					Type: gatewayv1.HTTPRouteFilterURLRewrite,
+					URLRewrite: &gatewayv1.HTTPURLRewriteFilter{
+						Path: &gatewayv1.HTTPPathModifier{
+							Type:               gatewayv1.PrefixMatchHTTPPathModifier,
+							ReplacePrefixMatch: &replaceV1Prefix,
+						},
+					},
+				},
+			},
+			BackendRefs: backendRefs,
+		},
+		{
+			Matches: []gatewayv1.HTTPRouteMatch{
+				{
+					Path: &gatewayv1.HTTPPathMatch{
+						Type:  &pathMatchType,
+						Value: &catchAllPrefix,
+					},
+				},
+			},
+			BackendRefs: backendRefs,
+		},
+	}
+	// The longer artifact and registry API prefixes win over the catch-all rule above.
+	if render.ArtifactsServerEnabled(mlflow) {
+		rules = append(rules, buildDerivedServerRule(pathPrefix, artifactsAPIPaths, render.ArtifactsServerResourceName(mlflow)))
+	}
+	if render.RegistryServerEnabled(mlflow) {
+		rules = append(rules, buildDerivedServerRule(pathPrefix, registryAPIPaths, render.RegistryServerResourceName(mlflow)))
+	}
+	if timeout := requestTimeout(mlflow); timeout != "" {
+		requestDuration := gatewayv1.Duration(timeout)
+		for i := range rules {
+			rules[i].Timeouts = &gatewayv1.HTTPRouteTimeouts{Request: &requestDuration}
+		}
+	}
+	var hostnames []gatewayv1.Hostname
+	if mlflow.Spec.Routing != nil {
+		for _, hostname := range mlflow.Spec.Routing.Hostnames {
+			hostnames = append(hostnames, gatewayv1.Hostname(hostname))
+		}
+	}
+	parentRefs := buildHTTPRouteParentRefs(mlflow.Spec.Routing, cfg)
+	if domain := customDomain(mlflow); domain != nil {
+		parentRefs = append(parentRefs, customDomainParentRef(mlflow, namespace))
+		// Without hostnames the route already matches the listener hostname; with them, the
+		// custom host has to be listed too or the route would not match on that listener.
+		if len(hostnames) > 0 {
+			hostnames = append(hostnames, gatewayv1.Hostname(domain.Host))
+		}
+	}
+
+	return &gatewayv1.HTTPRoute{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: "gateway.networking.k8s.io/v1",
 			Kind:       "HTTPRoute",
@@ -258,82 +537,232 @@ func (r *MLflowReconciler) reconcileHttpRoute(
 		},
 		Spec: gatewayv1.HTTPRouteSpec{
 			CommonRouteSpec: gatewayv1.CommonRouteSpec{
-				ParentRefs: []gatewayv1.ParentReference{
-					{
-						Name:      gatewayv1.ObjectName(cfg.GatewayName),
-						Namespace: (*gatewayv1.Namespace)(&gatewayNamespace),
-					},
-				},
+				ParentRefs: parentRefs,
 			},
-			Rules: []gatewayv1.HTTPRouteRule{
-				{
-					Matches: []gatewayv1.HTTPRouteMatch{
-						{
-							Path: &gatewayv1.HTTPPathMatch{
-								Type:  &pathMatchType,
-								Value: &v1PathPrefix,
-							},
-						},
-					},
-					Filters: []gatewayv1.HTTPRouteFilter{
-						{
-							Type: gatewayv1.HTTPRouteFilterURLRewrite,
-							URLRewrite: &gatewayv1.HTTPURLRewriteFilter{
-								Path: &gatewayv1.HTTPPathModifier{
-									Type:               gatewayv1.PrefixMatchHTTPPathModifier,
-									ReplacePrefixMatch: &replaceV1Prefix,
-								},
-							},
-						},
-					},
-					BackendRefs: []gatewayv1.HTTPBackendRef{
-						{
-							BackendRef: gatewayv1.BackendRef{
-								BackendObjectReference: gatewayv1.BackendObjectReference{
-									Name: gatewayv1.ObjectName(serviceName),
-									Port: &servicePort,
-								},
-								Weight: &weight,
-							},
-						},
-					},
-				},
-				{
-					Matches: []gatewayv1.HTTPRouteMatch{
-						{
-							Path: &gatewayv1.HTTPPathMatch{
-								Type:  &pathMatchType,
-								Value: &pathPrefix,
-							},
-						},
-					},
-					BackendRefs: []gatewayv1.HTTPBackendRef{
-						{
-							BackendRef: gatewayv1.BackendRef{
-								BackendObjectReference: gatewayv1.BackendObjectReference{
-									Name: gatewayv1.ObjectName(serviceName),
-									Port: &servicePort,
-								},
-								Weight: &weight,
-							},
-						},
+			Hostnames: hostnames,
+			Rules:     rules,
+		},
+	}
+}
+
+// requestTimeout returns spec.server.limits.requestTimeoutSeconds as a route timeout such as
+// "300s", or "" when the gateway default applies.
+func requestTimeout(mlflow *mlflowv1.MLflow) string {
+	limits := render.ServerLimits(mlflow)
+	if limits == nil || limits.RequestTimeoutSeconds == nil {
+		return ""
+	}
+	return fmt.Sprintf("%ds", *limits.RequestTimeoutSeconds)
+}
+
+// buildDerivedServerRule routes the given API paths under pathPrefix to a derived server Service.
+func buildDerivedServerRule(pathPrefix string, paths []string, serviceName string) gatewayv1.HTTPRouteRule {
+	pathMatchType := gatewayv1.PathMatchPathPrefix
+	servicePort := gatewayv1.PortNumber(8443)
+	weight := int32(1)
+	matches := make([]gatewayv1.HTTPRouteMatch, 0, len(paths))
+	for _, path := range paths {
+		value := pathPrefix + path
+		matches = append(matches, gatewayv1.HTTPRouteMatch{
+			Path: &gatewayv1.HTTPPathMatch{Type: &pathMatchType, Value: &value},
+		})
+	}
+	return gatewayv1.HTTPRouteRule{
+		Matches: matches,
+		BackendRefs: []gatewayv1.HTTPBackendRef{
+			{
+				BackendRef: gatewayv1.BackendRef{
+					BackendObjectReference: gatewayv1.BackendObjectReference{
+						Name: gatewayv1.ObjectName(serviceName),
+						Port: &servicePort,
 					},
+					Weight: &weight,
 				},
 			},
 		},
 	}
+}
 
-	// Set owner reference
-	if err := controllerutil.SetControllerReference(mlflow, httpRoute, r.Scheme); err != nil {
-		return fmt.Errorf("failed to set controller reference on HttpRoute: %w", err)
+// buildHTTPRouteParentRefs returns the Gateway parentRefs for an operator-managed HttpRoute.
+// routing.gateways takes precedence; otherwise the route attaches to the
+// operator-wide Gateway, in the default gateway namespace unless it was selected by label or class.
+func buildHTTPRouteParentRefs(routing *mlflowv1.RoutingConfig, cfg *config.OperatorConfig) []gatewayv1.ParentReference {
+	if routing == nil || len(routing.Gateways) == 0 {
+		gatewayNamespace := gatewayv1.Namespace(defaultGatewayNamespace)
+		if cfg.GatewayNamespace != "" {
+			gatewayNamespace = gatewayv1.Namespace(cfg.GatewayNamespace)
+		}
+		return []gatewayv1.ParentReference{
+			{
+				Name:      gatewayv1.ObjectName(cfg.GatewayName),
+				Namespace: &gatewayNamespace,
+			},
+		}
 	}
 
-	// Create or update the HttpRoute
-	if err := r.applyObject(ctx, httpRoute); err != nil {
-		log.Error(err, "Failed to apply HttpRoute", "name", httpRouteName)
-		return err
+	parentRefs := make([]gatewayv1.ParentReference, 0, len(routing.Gateways))
+	for _, gw := range routing.Gateways {
+		gatewayNamespace := gatewayv1.Namespace(defaultGatewayNamespace)
+		if gw.Namespace != nil && *gw.Namespace != "" {
+			gatewayNamespace = gatewayv1.Namespace(*gw.Namespace)
+		}
+		parentRef := gatewayv1.ParentReference{
+			Name:      gatewayv1.ObjectName(gw.Name),
+			Namespace: &gatewayNamespace,
+		}
+		if gw.SectionName != nil && *gw.SectionName != "" {
+			sectionName := gatewayv1.SectionName(*gw.SectionName)
+			parentRef.SectionName = &sectionName
+		}
+		parentRefs = append(parentRefs, parentRef)
 	}
+	return parentRefs
+}
 
-	log.V(1).Info("Successfully reconciled HttpRoute", "name", httpRouteName, "pathPrefix", pathPrefix)
+// reconcileVirtualService creates or updates the Istio VirtualService and DestinationRule for MLflow.
+// This backend is only used on clusters that serve Istio networking APIs but not Gateway API;
+// when HTTPRoute is available it takes precedence.
+func (r *MLflowReconciler) reconcileVirtualService(
+	ctx context.Context,
+	mlflow *mlflowv1.MLflow,
+	namespace string,
+	cfg *config.OperatorConfig,
+) error {
+	log := logf.FromContext(ctx)
+
+	if !r.usesVirtualServiceRouting() {
+		log.V(1).Info("Skipping VirtualService creation - not the active routing backend")
+		return nil
+	}
+
+	virtualService := buildVirtualService(mlflow, namespace, cfg)
+	destinationRule := buildDestinationRule(mlflow, namespace)
+
+	if !routingEnabled(mlflow) {
+		for _, obj := range []*unstructured.Unstructured{virtualService, destinationRule} {
+			if err := r.Delete(ctx, obj); err != nil && !errors.IsNotFound(err) {
+				return fmt.Errorf("failed to delete %s while routing is disabled: %w", obj.GetKind(), err)
+			}
+		}
+		log.V(1).Info("Routing disabled for MLflow instance, skipping VirtualService", "name", virtualService.GetName())
+		return nil
+	}
+
+	for _, obj := range []*unstructured.Unstructured{destinationRule, virtualService} {
+		propagateMetadata(mlflow, obj)
+		if err := controllerutil.SetControllerReference(mlflow, obj, r.Scheme); err != nil {
+			return fmt.Errorf("failed to set controller reference on %s: %w", obj.GetKind(), err)
+		}
+		if err := r.applyObject(ctx, obj); err != nil {
+			log.Error(err, "Failed to apply Istio routing object", "kind", obj.GetKind(), "name", obj.GetName())
+			return err
+		}
+	}
+
+	log.V(1).Info("Successfully reconciled VirtualService", "name", virtualService.GetName())
 	return nil
 }
+
+// usesVirtualServiceRouting reports whether the Istio backend is the active routing backend.
+func (r *MLflowReconciler) usesVirtualServiceRouting() bool {
+	return r.VirtualServiceAvailable && !r.HTTPRouteAvailable
+}
+
+// publicRouteAvailable reports whether an operator-managed external route is published for the instance.
+func (r *MLflowReconciler) publicRouteAvailable(mlflow *mlflowv1.MLflow) bool {
+	return (r.HTTPRouteAvailable || r.VirtualServiceAvailable) && routingEnabled(mlflow)
+}
+
+// buildVirtualService constructs the desired Istio VirtualService for an MLflow instance.
+// It mirrors the HttpRoute rules: /mlflow[-suffix]/v1 is rewritten to /v1 and everything
+// else under the path prefix is forwarded unchanged to the MLflow Service.
+func buildVirtualService(mlflow *mlflowv1.MLflow, namespace string, cfg *config.OperatorConfig) *unstructured.Unstructured {
+	suffix := render.ResourceSuffix(mlflow.Name)
+	pathPrefix := routePathPrefix(mlflow)
+	catchAllPrefix := pathPrefix
+	if catchAllPrefix == "" {
+		catchAllPrefix = "/"
+	}
+	hosts := []interface{}{"*"}
+	if mlflow.Spec.Routing != nil && len(mlflow.Spec.Routing.Hostnames) > 0 {
+		hosts = []interface{}{}
+		for _, hostname := range mlflow.Spec.Routing.Hostnames {
+			hosts = append(hosts, hostname)
+		}
+		// The Istio Gateway serving the custom host and its certificate is not the operator's.
+		if domain := customDomain(mlflow); domain != nil {
+			hosts = append(hosts, domain.Host)
+		}
+	}
+	destination := map[string]interface{}{
+		"destination": map[string]interface{}{
+			"host": mlflowServiceHost(mlflow, namespace),
+			"port": map[string]interface{}{"number": int64(mlflowServicePort)},
+		},
+	}
+
+	// Istio Gateways are referenced as "namespace/name"; sectionName has no
+	// VirtualService equivalent and is ignored for this backend.
+	gateways := []interface{}{}
+	for _, ref := range buildHTTPRouteParentRefs(mlflow.Spec.Routing, cfg) {
+		gateways = append(gateways, fmt.Sprintf("%s/%s", *ref.Namespace, ref.Name))
+	}
+
+	httpRoutes := []interface{}{
+		map[string]interface{}{
+			"name":    "api-v1",
+			"match":   []interface{}{map[string]interface{}{"uri": map[string]interface{}{"prefix": pathPrefix + "/v1"}}},
+			"rewrite": map[string]interface{}{"uri": "/v1"},
+			"route":   []interface{}{destination},
+		},
+		map[string]interface{}{
+			"name":  "mlflow",
+			"match": []interface{}{map[string]interface{}{"uri": map[string]interface{}{"prefix": catchAllPrefix}}},
+			"route": []interface{}{destination},
+		},
+	}
+	if timeout := requestTimeout(mlflow); timeout != "" {
+		for _, route := range httpRoutes {
+			route.(map[string]interface{})["timeout"] = timeout
+		}
+	}
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(VirtualServiceGVK)
+	obj.SetName(ResourceName + suffix)
+	obj.SetNamespace(namespace)
+	obj.SetLabels(map[string]string{"app": ResourceName})
+	obj.Object["spec"] = map[string]interface{}{
+		"hosts":    hosts,
+		"gateways": gateways,
+		"http":     httpRoutes,
+	}
+	return obj
+}
+
+// buildDestinationRule constructs the DestinationRule that originates TLS from the
+// gateway to the MLflow Service, which only serves HTTPS.
+func buildDestinationRule(mlflow *mlflowv1.MLflow, namespace string) *unstructured.Unstructured {
+	host := mlflowServiceHost(mlflow, namespace)
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(DestinationRuleGVK)
+	obj.SetName(ResourceName + render.ResourceSuffix(mlflow.Name))
+	obj.SetNamespace(namespace)
+	obj.SetLabels(map[string]string{"app": ResourceName})
+	obj.Object["spec"] = map[string]interface{}{
+		"host": host,
+		"trafficPolicy": map[string]interface{}{
+			"tls": map[string]interface{}{
+				"mode": "SIMPLE",
+				"sni":  host,
+			},
+		},
+	}
+	return obj
+}
+
+// mlflowServiceHost returns the cluster-local FQDN of the MLflow Service.
+func mlflowServiceHost(mlflow *mlflowv1.MLflow, namespace string) string {
+	return fmt.Sprintf("%s%s.%s.svc.cluster.local", ResourceName, render.ResourceSuffix(mlflow.Name), namespace)
+}
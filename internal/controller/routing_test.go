@@ -0,0 +1,663 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	consolev1 "github.com/openshift/api/console/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
+)
+
+func TestBuildConsoleLink(t *testing.T) {
+	cfg := &config.OperatorConfig{
+		MLflowURL:    "https://gateway.example.com",
+		SectionTitle: "OpenShift AI",
+	}
+
+	tests := []struct {
+		name        string
+		mlflow      *mlflowv1.MLflow
+		wantName    string
+		wantText    string
+		wantSection string
+		wantHref    string
+	}{
+		{
+			name:        "defaults",
+			mlflow:      &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "mlflow"}},
+			wantName:    "mlflow",
+			wantText:    "MLflow",
+			wantSection: "OpenShift AI",
+			wantHref:    "https://gateway.example.com/mlflow",
+		},
+		{
+			name: "text and section overrides",
+			mlflow: &mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "dev"},
+				Spec: mlflowv1.MLflowSpec{
+					ConsoleLink: &mlflowv1.ConsoleLinkConfig{
+						Text:    ptr("MLflow (dev)"),
+						Section: ptr("Development"),
+					},
+				},
+			},
+			wantName:    "mlflow-dev",
+			wantText:    "MLflow (dev)",
+			wantSection: "Development",
+			wantHref:    "https://gateway.example.com/mlflow-dev",
+		},
+		{
+			name: "empty overrides fall back to defaults",
+			mlflow: &mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+				Spec: mlflowv1.MLflowSpec{
+					ConsoleLink: &mlflowv1.ConsoleLinkConfig{
+						Text:    ptr(""),
+						Section: ptr(""),
+					},
+				},
+			},
+			wantName:    "mlflow",
+			wantText:    "MLflow",
+			wantSection: "OpenShift AI",
+			wantHref:    "https://gateway.example.com/mlflow",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			link := buildConsoleLink(tt.mlflow, cfg)
+			if link.Name != tt.wantName {
+				t.Errorf("name = %q, want %q", link.Name, tt.wantName)
+			}
+			if link.Spec.Text != tt.wantText {
+				t.Errorf("text = %q, want %q", link.Spec.Text, tt.wantText)
+			}
+			if link.Spec.Href != tt.wantHref {
+				t.Errorf("href = %q, want %q", link.Spec.Href, tt.wantHref)
+			}
+			if link.Spec.ApplicationMenu == nil || link.Spec.ApplicationMenu.Section != tt.wantSection {
+				t.Errorf("applicationMenu.section = %v, want %q", link.Spec.ApplicationMenu, tt.wantSection)
+			}
+		})
+	}
+}
+
+func TestReconcileConsoleLinkDeletesDisabledLink(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := consolev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add console scheme: %v", err)
+	}
+	if err := mlflowv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add MLflow scheme: %v", err)
+	}
+
+	existing := &consolev1.ConsoleLink{ObjectMeta: metav1.ObjectMeta{Name: "mlflow"}}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(existing).Build()
+	reconciler := &MLflowReconciler{Client: client, Scheme: scheme, ConsoleLinkAvailable: true}
+
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			ConsoleLink: &mlflowv1.ConsoleLinkConfig{Disable: ptr(true)},
+		},
+	}
+
+	if err := reconciler.reconcileConsoleLink(context.Background(), mlflow, &config.OperatorConfig{}); err != nil {
+		t.Fatalf("reconcileConsoleLink() error = %v", err)
+	}
+
+	err := client.Get(context.Background(), types.NamespacedName{Name: "mlflow"}, &consolev1.ConsoleLink{})
+	if !apierrors.IsNotFound(err) {
+		t.Fatalf("expected disabled ConsoleLink to be deleted, got err=%v", err)
+	}
+
+	// A second pass with nothing left to delete must still succeed.
+	if err := reconciler.reconcileConsoleLink(context.Background(), mlflow, &config.OperatorConfig{}); err != nil {
+		t.Fatalf("reconcileConsoleLink() second pass error = %v", err)
+	}
+}
+
+func TestReconcileConsoleLinkFollowsServingState(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := consolev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add console scheme: %v", err)
+	}
+	if err := mlflowv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add MLflow scheme: %v", err)
+	}
+
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+	reconciler := &MLflowReconciler{Client: client, Scheme: scheme, ConsoleLinkAvailable: true}
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "mlflow", UID: "mlflow-uid"}}
+	cfg := &config.OperatorConfig{MLflowURL: "https://example.com"}
+	key := types.NamespacedName{Name: "mlflow"}
+
+	setCondition := func(conditionType string, status metav1.ConditionStatus) {
+		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{Type: conditionType, Status: status, Reason: "Test"})
+	}
+	reconcile := func() {
+		t.Helper()
+		if err := reconciler.reconcileConsoleLink(context.Background(), mlflow, cfg); err != nil {
+			t.Fatalf("reconcileConsoleLink() error = %v", err)
+		}
+	}
+
+	// A new instance gets no link until it is available.
+	reconcile()
+	if err := client.Get(context.Background(), key, &consolev1.ConsoleLink{}); !apierrors.IsNotFound(err) {
+		t.Fatalf("expected no ConsoleLink before the instance is available, got err=%v", err)
+	}
+
+	setCondition("Available", metav1.ConditionTrue)
+	reconcile()
+	if err := client.Get(context.Background(), key, &consolev1.ConsoleLink{}); err != nil {
+		t.Fatalf("expected ConsoleLink once the instance is available, got err=%v", err)
+	}
+
+	setCondition(routeReadyConditionType, metav1.ConditionFalse)
+	reconcile()
+	if err := client.Get(context.Background(), key, &consolev1.ConsoleLink{}); !apierrors.IsNotFound(err) {
+		t.Fatalf("expected ConsoleLink to be withdrawn while the route is not ready, got err=%v", err)
+	}
+
+	setCondition(routeReadyConditionType, metav1.ConditionTrue)
+	setCondition("Available", metav1.ConditionFalse)
+	reconcile()
+	if err := client.Get(context.Background(), key, &consolev1.ConsoleLink{}); !apierrors.IsNotFound(err) {
+		t.Fatalf("expected no ConsoleLink while the instance is unavailable, got err=%v", err)
+	}
+}
+
+func TestReconcileRoutingDisabledRemovesResources(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := consolev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add console scheme: %v", err)
+	}
+	if err := gatewayv1.Install(scheme); err != nil {
+		t.Fatalf("add gateway scheme: %v", err)
+	}
+	if err := mlflowv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add MLflow scheme: %v", err)
+	}
+
+	const namespace = "opendatahub"
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&consolev1.ConsoleLink{ObjectMeta: metav1.ObjectMeta{Name: "mlflow"}},
+		&gatewayv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Name: "mlflow", Namespace: namespace}},
+	).Build()
+	reconciler := &MLflowReconciler{
+		Client:               client,
+		Scheme:               scheme,
+		ConsoleLinkAvailable: true,
+		HTTPRouteAvailable:   true,
+	}
+
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			Routing: &mlflowv1.RoutingConfig{Enabled: ptr(false)},
+		},
+	}
+	cfg := &config.OperatorConfig{}
+
+	for pass := range 2 {
+		if err := reconciler.reconcileHttpRoute(context.Background(), mlflow, namespace, cfg); err != nil {
+			t.Fatalf("reconcileHttpRoute() pass %d error = %v", pass, err)
+		}
+		if err := reconciler.reconcileConsoleLink(context.Background(), mlflow, cfg); err != nil {
+			t.Fatalf("reconcileConsoleLink() pass %d error = %v", pass, err)
+		}
+	}
+
+	err := client.Get(context.Background(), types.NamespacedName{Name: "mlflow", Namespace: namespace}, &gatewayv1.HTTPRoute{})
+	if !apierrors.IsNotFound(err) {
+		t.Errorf("expected HTTPRoute to be deleted, got err=%v", err)
+	}
+	err = client.Get(context.Background(), types.NamespacedName{Name: "mlflow"}, &consolev1.ConsoleLink{})
+	if !apierrors.IsNotFound(err) {
+		t.Errorf("expected ConsoleLink to be deleted, got err=%v", err)
+	}
+}
+
+func TestBuildHTTPRouteParentRefs(t *testing.T) {
+	cfg := &config.OperatorConfig{GatewayName: "data-science-gateway"}
+
+	type ref struct {
+		name, namespace, section string
+	}
+	tests := []struct {
+		name    string
+		routing *mlflowv1.RoutingConfig
+		want    []ref
+	}{
+		{
+			name: "defaults to operator gateway",
+			want: []ref{{name: "data-science-gateway", namespace: "openshift-ingress"}},
+		},
+		{
+			name:    "empty gateway list falls back to operator gateway",
+			routing: &mlflowv1.RoutingConfig{},
+			want:    []ref{{name: "data-science-gateway", namespace: "openshift-ingress"}},
+		},
+		{
+			name: "multiple gateways with section names",
+			routing: &mlflowv1.RoutingConfig{
+				Gateways: []mlflowv1.GatewayReference{
+					{Name: "internal", Namespace: ptr("gateways"), SectionName: ptr("https-internal")},
+					{Name: "external"},
+				},
+			},
+			want: []ref{
+				{name: "internal", namespace: "gateways", section: "https-internal"},
+				{name: "external", namespace: "openshift-ingress"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mlflow := &mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+				Spec:       mlflowv1.MLflowSpec{Routing: tt.routing},
+			}
+			route := buildHTTPRoute(mlflow, "opendatahub", cfg)
+			got := route.Spec.ParentRefs
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d parentRefs, want %d", len(got), len(tt.want))
+			}
+			for i, want := range tt.want {
+				if string(got[i].Name) != want.name {
+					t.Errorf("parentRefs[%d].name = %q, want %q", i, got[i].Name, want.name)
+				}
+				if got[i].Namespace == nil || string(*got[i].Namespace) != want.namespace {
+					t.Errorf("parentRefs[%d].namespace = %v, want %q", i, got[i].Namespace, want.namespace)
+				}
+				var section string
+				if got[i].SectionName != nil {
+					section = string(*got[i].SectionName)
+				}
+				if section != want.section {
+					t.Errorf("parentRefs[%d].sectionName = %q, want %q", i, section, want.section)
+				}
+			}
+		})
+	}
+}
+
+func TestBuildVirtualService(t *testing.T) {
+	cfg := &config.OperatorConfig{GatewayName: "data-science-gateway"}
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "dev"},
+		Spec: mlflowv1.MLflowSpec{
+			Routing: &mlflowv1.RoutingConfig{
+				Gateways: []mlflowv1.GatewayReference{
+					{Name: "internal", Namespace: ptr("istio-system"), SectionName: ptr("https")},
+					{Name: "external"},
+				},
+			},
+		},
+	}
+
+	vs := buildVirtualService(mlflow, "opendatahub", cfg)
+	if vs.GroupVersionKind() != VirtualServiceGVK {
+		t.Fatalf("gvk = %v, want %v", vs.GroupVersionKind(), VirtualServiceGVK)
+	}
+	if vs.GetName() != "mlflow-dev" || vs.GetNamespace() != "opendatahub" {
+		t.Errorf("object key = %s/%s, want opendatahub/mlflow-dev", vs.GetNamespace(), vs.GetName())
+	}
+
+	gateways, _, err := unstructured.NestedStringSlice(vs.Object, "spec", "gateways")
+	if err != nil {
+		t.Fatalf("read gateways: %v", err)
+	}
+	wantGateways := []string{"istio-system/internal", "openshift-ingress/external"}
+	if !reflect.DeepEqual(gateways, wantGateways) {
+		t.Errorf("gateways = %v, want %v", gateways, wantGateways)
+	}
+
+	routes, _, err := unstructured.NestedSlice(vs.Object, "spec", "http")
+	if err != nil || len(routes) != 2 {
+		t.Fatalf("http routes = %v (err=%v), want 2 entries", routes, err)
+	}
+	v1Route := routes[0].(map[string]interface{})
+	prefix, _, _ := unstructured.NestedString(v1Route["match"].([]interface{})[0].(map[string]interface{}), "uri", "prefix")
+	if prefix != "/mlflow-dev/v1" {
+		t.Errorf("api route prefix = %q, want %q", prefix, "/mlflow-dev/v1")
+	}
+	rewrite, _, _ := unstructured.NestedString(v1Route, "rewrite", "uri")
+	if rewrite != "/v1" {
+		t.Errorf("api route rewrite = %q, want %q", rewrite, "/v1")
+	}
+	host, _, _ := unstructured.NestedString(v1Route["route"].([]interface{})[0].(map[string]interface{}), "destination", "host")
+	if host != "mlflow-dev.opendatahub.svc.cluster.local" {
+		t.Errorf("destination host = %q", host)
+	}
+
+	dr := buildDestinationRule(mlflow, "opendatahub")
+	mode, _, _ := unstructured.NestedString(dr.Object, "spec", "trafficPolicy", "tls", "mode")
+	if mode != "SIMPLE" {
+		t.Errorf("destination rule tls mode = %q, want SIMPLE", mode)
+	}
+}
+
+func TestReconcileVirtualServiceBackendSelection(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := mlflowv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add MLflow scheme: %v", err)
+	}
+
+	const namespace = "opendatahub"
+	existing := buildVirtualService(&mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "mlflow"}}, namespace, &config.OperatorConfig{})
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			Routing: &mlflowv1.RoutingConfig{Enabled: ptr(false)},
+		},
+	}
+
+	t.Run("gateway api takes precedence", func(t *testing.T) {
+		client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(existing.DeepCopy()).Build()
+		reconciler := &MLflowReconciler{Client: client, Scheme: scheme, HTTPRouteAvailable: true, VirtualServiceAvailable: true}
+		if err := reconciler.reconcileVirtualService(context.Background(), mlflow, namespace, &config.OperatorConfig{}); err != nil {
+			t.Fatalf("reconcileVirtualService() error = %v", err)
+		}
+		got := &unstructured.Unstructured{}
+		got.SetGroupVersionKind(VirtualServiceGVK)
+		if err := client.Get(context.Background(), types.NamespacedName{Name: "mlflow", Namespace: namespace}, got); err != nil {
+			t.Fatalf("expected VirtualService to be left untouched, got err=%v", err)
+		}
+	})
+
+	t.Run("routing disabled removes istio objects", func(t *testing.T) {
+		client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(existing.DeepCopy()).Build()
+		reconciler := &MLflowReconciler{Client: client, Scheme: scheme, VirtualServiceAvailable: true}
+		for pass := range 2 {
+			if err := reconciler.reconcileVirtualService(context.Background(), mlflow, namespace, &config.OperatorConfig{}); err != nil {
+				t.Fatalf("reconcileVirtualService() pass %d error = %v", pass, err)
+			}
+		}
+		got := &unstructured.Unstructured{}
+		got.SetGroupVersionKind(VirtualServiceGVK)
+		err := client.Get(context.Background(), types.NamespacedName{Name: "mlflow", Namespace: namespace}, got)
+		if !apierrors.IsNotFound(err) {
+			t.Fatalf("expected VirtualService to be deleted, got err=%v", err)
+		}
+	})
+}
+
+func TestRouteRequestTimeout(t *testing.T) {
+	cfg := &config.OperatorConfig{GatewayName: "data-science-gateway"}
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			Server: &mlflowv1.ServerConfig{
+				Limits: &mlflowv1.ServerLimits{RequestTimeoutSeconds: ptr(int32(900))},
+			},
+		},
+	}
+
+	route := buildHTTPRoute(mlflow, "opendatahub", cfg)
+	for i, rule := range route.Spec.Rules {
+		if rule.Timeouts == nil || rule.Timeouts.Request == nil || *rule.Timeouts.Request != "900s" {
+			t.Errorf("rule %d timeouts = %+v, want request 900s", i, rule.Timeouts)
+		}
+	}
+
+	routes, _, _ := unstructured.NestedSlice(buildVirtualService(mlflow, "opendatahub", cfg).Object, "spec", "http")
+	for i, route := range routes {
+		if timeout := route.(map[string]interface{})["timeout"]; timeout != "900s" {
+			t.Errorf("virtual service route %d timeout = %v, want 900s", i, timeout)
+		}
+	}
+
+	mlflow.Spec.Server = nil
+	if timeouts := buildHTTPRoute(mlflow, "opendatahub", cfg).Spec.Rules[0].Timeouts; timeouts != nil {
+		t.Errorf("timeouts = %+v, want gateway default", timeouts)
+	}
+}
+
+func TestGatewayURL(t *testing.T) {
+	hostname := func(h string) *gatewayv1.Hostname {
+		value := gatewayv1.Hostname(h)
+		return &value
+	}
+	listener := func(name string, protocol gatewayv1.ProtocolType, port gatewayv1.PortNumber, host *gatewayv1.Hostname) gatewayv1.Listener {
+		return gatewayv1.Listener{Name: gatewayv1.SectionName(name), Protocol: protocol, Port: port, Hostname: host}
+	}
+	addresses := []gatewayv1.GatewayStatusAddress{{Value: "203.0.113.10"}}
+
+	tests := []struct {
+		name        string
+		listeners   []gatewayv1.Listener
+		addresses   []gatewayv1.GatewayStatusAddress
+		sectionName string
+		want        string
+	}{
+		{
+			name:      "https listener hostname",
+			listeners: []gatewayv1.Listener{listener("https", gatewayv1.HTTPSProtocolType, 443, hostname("data-science-gateway.apps.example.com"))},
+			want:      "https://data-science-gateway.apps.example.com",
+		},
+		{
+			name: "https preferred over http",
+			listeners: []gatewayv1.Listener{
+				listener("http", gatewayv1.HTTPProtocolType, 80, hostname("http.example.com")),
+				listener("https", gatewayv1.HTTPSProtocolType, 443, hostname("secure.example.com")),
+			},
+			want: "https://secure.example.com",
+		},
+		{
+			name: "section name selects the listener",
+			listeners: []gatewayv1.Listener{
+				listener("https", gatewayv1.HTTPSProtocolType, 443, hostname("secure.example.com")),
+				listener("internal", gatewayv1.HTTPProtocolType, 8080, hostname("internal.example.com")),
+			},
+			sectionName: "internal",
+			want:        "http://internal.example.com:8080",
+		},
+		{
+			name:      "wildcard hostname falls back to the status address",
+			listeners: []gatewayv1.Listener{listener("https", gatewayv1.HTTPSProtocolType, 8443, hostname("*.apps.example.com"))},
+			addresses: addresses,
+			want:      "https://203.0.113.10:8443",
+		},
+		{
+			name:      "IPv6 status address",
+			listeners: []gatewayv1.Listener{listener("https", gatewayv1.HTTPSProtocolType, 443, nil)},
+			addresses: []gatewayv1.GatewayStatusAddress{{Value: "2001:db8::1"}},
+			want:      "https://[2001:db8::1]",
+		},
+		{
+			name:      "no host yet",
+			listeners: []gatewayv1.Listener{listener("https", gatewayv1.HTTPSProtocolType, 443, nil)},
+		},
+		{
+			name:      "no HTTP listener",
+			listeners: []gatewayv1.Listener{listener("tls", gatewayv1.TLSProtocolType, 443, hostname("tls.example.com"))},
+			addresses: addresses,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gateway := &gatewayv1.Gateway{
+				Spec:   gatewayv1.GatewaySpec{Listeners: tt.listeners},
+				Status: gatewayv1.GatewayStatus{Addresses: tt.addresses},
+			}
+			if got := gatewayURL(gateway, tt.sectionName); got != tt.want {
+				t.Errorf("gatewayURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithGatewayURL(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := gatewayv1.Install(scheme); err != nil {
+		t.Fatalf("add Gateway API scheme: %v", err)
+	}
+
+	host := gatewayv1.Hostname("data-science-gateway.apps.example.com")
+	gateway := &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Name: "data-science-gateway", Namespace: defaultGatewayNamespace},
+		Spec: gatewayv1.GatewaySpec{Listeners: []gatewayv1.Listener{
+			{Name: "https", Protocol: gatewayv1.HTTPSProtocolType, Port: 443, Hostname: &host},
+		}},
+	}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(gateway).Build()
+	reconciler := &MLflowReconciler{Client: client, Scheme: scheme, HTTPRouteAvailable: true}
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "mlflow"}}
+
+	resolve := func(cfg *config.OperatorConfig) *config.OperatorConfig {
+		t.Helper()
+		resolved, err := reconciler.withGatewayURL(context.Background(), mlflow, cfg)
+		if err != nil {
+			t.Fatalf("withGatewayURL() error = %v", err)
+		}
+		return resolved
+	}
+
+	cfg := &config.OperatorConfig{GatewayName: "data-science-gateway", MLflowURL: config.DefaultMLflowURL}
+	resolved := resolve(cfg)
+	if resolved.MLflowURL != "https://data-science-gateway.apps.example.com" || !resolved.MLflowURLConfigured {
+		t.Errorf("MLflowURL = %q (configured %v), want the Gateway listener host", resolved.MLflowURL, resolved.MLflowURLConfigured)
+	}
+	if cfg.MLflowURL != config.DefaultMLflowURL {
+		t.Errorf("withGatewayURL() modified its input")
+	}
+	if got := buildConsoleLink(mlflow, resolved).Spec.Href; got != "https://data-science-gateway.apps.example.com/mlflow" {
+		t.Errorf("ConsoleLink href = %q, want the Gateway listener host", got)
+	}
+
+	// The Gateway hostname is more precise than the ingress domain guess.
+	discovered := &config.OperatorConfig{
+		GatewayName:         "data-science-gateway",
+		MLflowURL:           "https://data-science-gateway.apps.other.example.com",
+		MLflowURLConfigured: true,
+		MLflowURLDiscovered: true,
+	}
+	if got := resolve(discovered).MLflowURL; got != "https://data-science-gateway.apps.example.com" {
+		t.Errorf("MLflowURL = %q, want the Gateway listener host over the discovered one", got)
+	}
+
+	// An explicitly configured MLFLOW_URL wins.
+	configured := &config.OperatorConfig{GatewayName: "data-science-gateway", MLflowURL: "https://mlflow.corp.example.com", MLflowURLConfigured: true}
+	if got := resolve(configured).MLflowURL; got != "https://mlflow.corp.example.com" {
+		t.Errorf("MLflowURL = %q, want MLFLOW_URL", got)
+	}
+
+	// A missing Gateway leaves the configuration alone.
+	missing := &config.OperatorConfig{GatewayName: "other-gateway", MLflowURL: config.DefaultMLflowURL}
+	if got := resolve(missing); got != missing {
+		t.Errorf("withGatewayURL() = %+v, want the input unchanged", got)
+	}
+}
+
+func TestRootPathRouting(t *testing.T) {
+	cfg := &config.OperatorConfig{
+		GatewayName:         "data-science-gateway",
+		MLflowURL:           "https://gateway.example.com",
+		MLflowURLConfigured: true,
+	}
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: ResourceName},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI: ptr(testBackendStoreURI),
+			ArtifactsServer: &mlflowv1.ArtifactsServerConfig{Enabled: true},
+			Routing: &mlflowv1.RoutingConfig{
+				Hostnames: []string{"*.apps.example.com", "mlflow.example.com"},
+				RootPath:  true,
+			},
+		},
+	}
+
+	route := buildHTTPRoute(mlflow, "opendatahub", cfg)
+	if want := []gatewayv1.Hostname{"*.apps.example.com", "mlflow.example.com"}; !reflect.DeepEqual(route.Spec.Hostnames, want) {
+		t.Errorf("hostnames = %v, want %v", route.Spec.Hostnames, want)
+	}
+	var paths []string
+	for _, rule := range route.Spec.Rules {
+		for _, match := range rule.Matches {
+			paths = append(paths, *match.Path.Value)
+		}
+	}
+	if want := []string{"/v1", "/", "/api/2.0/mlflow-artifacts", "/ajax-api/2.0/mlflow-artifacts"}; !reflect.DeepEqual(paths, want) {
+		t.Errorf("route paths = %v, want %v", paths, want)
+	}
+
+	vs := buildVirtualService(mlflow, "opendatahub", cfg)
+	hosts, _, _ := unstructured.NestedStringSlice(vs.Object, "spec", "hosts")
+	if want := []string{"*.apps.example.com", "mlflow.example.com"}; !reflect.DeepEqual(hosts, want) {
+		t.Errorf("virtual service hosts = %v, want %v", hosts, want)
+	}
+	httpRoutes, _, _ := unstructured.NestedSlice(vs.Object, "spec", "http")
+	catchAll, _, _ := unstructured.NestedString(
+		httpRoutes[1].(map[string]interface{})["match"].([]interface{})[0].(map[string]interface{}), "uri", "prefix")
+	if catchAll != "/" {
+		t.Errorf("virtual service catch-all prefix = %q, want /", catchAll)
+	}
+
+	setObservedURLs(mlflow, "opendatahub", true, cfg)
+	if mlflow.Status.URL != "https://mlflow.example.com" {
+		t.Errorf("status.url = %q, want https://mlflow.example.com", mlflow.Status.URL)
+	}
+	if mlflow.Status.Address.URL != "https://mlflow.opendatahub.svc:8443" {
+		t.Errorf("status.address.url = %q, want https://mlflow.opendatahub.svc:8443", mlflow.Status.Address.URL)
+	}
+	if href := buildConsoleLink(mlflow, cfg).Spec.Href; href != "https://mlflow.example.com" {
+		t.Errorf("console link href = %q, want https://mlflow.example.com", href)
+	}
+
+	workspaceRoute := buildWorkspaceHTTPRoute(mlflow, "opendatahub", "team-a", cfg)
+	catchAllRule := workspaceRoute.Spec.Rules[1]
+	if path := *catchAllRule.Matches[0].Path.Value; path != "/team-a" {
+		t.Errorf("workspace catch-all path = %q, want /team-a", path)
+	}
+	if replacement := *catchAllRule.Filters[1].URLRewrite.Path.ReplacePrefixMatch; replacement != "/" {
+		t.Errorf("workspace catch-all rewrite = %q, want /", replacement)
+	}
+
+	values, err := (&render.HelmRenderer{}).BuildValues(mlflow, "opendatahub", render.RenderOptions{}, nil)
+	if err != nil {
+		t.Fatalf("BuildValues: %v", err)
+	}
+	if prefix := values["mlflow"].(map[string]interface{})["staticPrefix"]; prefix != "" {
+		t.Errorf("staticPrefix = %v, want empty", prefix)
+	}
+
+	// A dedicated hostname alone keeps the prefix.
+	mlflow.Spec.Routing.RootPath = false
+	setObservedURLs(mlflow, "opendatahub", true, cfg)
+	if mlflow.Status.URL != "https://mlflow.example.com/mlflow" {
+		t.Errorf("status.url = %q, want https://mlflow.example.com/mlflow", mlflow.Status.URL)
+	}
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
+)
+
+const (
+	// s3TLSVerificationDisabledConditionType warns that spec.artifacts.s3.insecureSkipTLSVerify
+	// is enabled in a namespace labeled as production.
+	s3TLSVerificationDisabledConditionType = "S3TLSVerificationDisabled"
+
+	environmentNamespaceLabel = "environment"
+)
+
+// productionEnvironments are the environment label values that mark a production namespace.
+var productionEnvironments = []string{"production", "prod"}
+
+// setS3TLSVerificationCondition sets the S3TLSVerificationDisabled warning when S3 TLS
+// verification is skipped in a production namespace, and removes it otherwise. The toggle
+// is meant for lab object stores; it is not rejected in production so that a namespace
+// relabel cannot take a running instance down.
+func setS3TLSVerificationCondition(mlflow *mlflowv1.MLflow, namespace *corev1.Namespace) {
+	if !render.S3InsecureSkipTLSVerify(mlflow) || namespace == nil ||
+		!slices.Contains(productionEnvironments, namespace.Labels[environmentNamespaceLabel]) {
+		meta.RemoveStatusCondition(&mlflow.Status.Conditions, s3TLSVerificationDisabledConditionType)
+		return
+	}
+	meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+		Type:   s3TLSVerificationDisabledConditionType,
+		Status: metav1.ConditionTrue,
+		Reason: "InsecureSkipTLSVerifyInProduction",
+		Message: fmt.Sprintf("spec.artifacts.s3.insecureSkipTLSVerify disables certificate verification for the S3 artifact store "+
+			"in namespace %q, labeled %s=%s; trust the endpoint with caBundleConfigMap instead",
+			namespace.Name, environmentNamespaceLabel, namespace.Labels[environmentNamespaceLabel]),
+		ObservedGeneration: mlflow.Generation,
+	})
+}
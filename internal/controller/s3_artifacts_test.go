@@ -0,0 +1,66 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func s3TLSTestMLflow(insecure *bool) *mlflowv1.MLflow {
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec:       mlflowv1.MLflowSpec{BackendStoreURI: ptr(testBackendStoreURI)},
+	}
+	if insecure != nil {
+		mlflow.Spec.Artifacts = &mlflowv1.ArtifactsConfig{S3: &mlflowv1.S3ArtifactsConfig{InsecureSkipTLSVerify: insecure}}
+	}
+	return mlflow
+}
+
+func TestSetS3TLSVerificationCondition(t *testing.T) {
+	g := gomega.NewWithT(t)
+	namespace := func(labels map[string]string) *corev1.Namespace {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "opendatahub", Labels: labels}}
+	}
+	production := namespace(map[string]string{environmentNamespaceLabel: "production"})
+
+	mlflow := s3TLSTestMLflow(ptr(true))
+	setS3TLSVerificationCondition(mlflow, namespace(map[string]string{environmentNamespaceLabel: "lab"}))
+	g.Expect(meta.FindStatusCondition(mlflow.Status.Conditions, s3TLSVerificationDisabledConditionType)).To(gomega.BeNil())
+
+	setS3TLSVerificationCondition(mlflow, production)
+	condition := meta.FindStatusCondition(mlflow.Status.Conditions, s3TLSVerificationDisabledConditionType)
+	g.Expect(condition).NotTo(gomega.BeNil())
+	g.Expect(condition.Status).To(gomega.Equal(metav1.ConditionTrue))
+	g.Expect(condition.Message).To(gomega.ContainSubstring(`namespace "opendatahub", labeled environment=production`))
+
+	prod := s3TLSTestMLflow(ptr(true))
+	setS3TLSVerificationCondition(prod, namespace(map[string]string{environmentNamespaceLabel: "prod"}))
+	g.Expect(meta.IsStatusConditionTrue(prod.Status.Conditions, s3TLSVerificationDisabledConditionType)).To(gomega.BeTrue())
+
+	// Turning verification back on clears the warning.
+	mlflow.Spec.Artifacts.S3.InsecureSkipTLSVerify = ptr(false)
+	setS3TLSVerificationCondition(mlflow, production)
+	g.Expect(meta.FindStatusCondition(mlflow.Status.Conditions, s3TLSVerificationDisabledConditionType)).To(gomega.BeNil())
+}
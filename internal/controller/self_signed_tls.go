@@ -0,0 +1,205 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"slices"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
+)
+
+const (
+	// selfSignedTLSAnnotation marks a TLS Secret the operator generated. Only marked Secrets
+	// are rotated; a Secret provided by the user or another issuer is left alone.
+	selfSignedTLSAnnotation = "mlflow.opendatahub.io/self-signed"
+
+	selfSignedCertValidity    = 365 * 24 * time.Hour
+	selfSignedCertRenewBefore = 30 * 24 * time.Hour
+)
+
+// IsServiceCAAvailable checks if the OpenShift service CA operator API is available in the
+// cluster using discovery API
+func IsServiceCAAvailable(discoveryClient discovery.DiscoveryInterface) (bool, error) {
+	return isKindAvailable(discoveryClient, schema.GroupVersion{Group: "operator.openshift.io", Version: "v1"}, "ServiceCA")
+}
+
+// IsCertManagerAvailable checks if the cert-manager Certificate CRD is available in the
+// cluster using discovery API
+func IsCertManagerAvailable(discoveryClient discovery.DiscoveryInterface) (bool, error) {
+	return isKindAvailable(discoveryClient, schema.GroupVersion{Group: "cert-manager.io", Version: "v1"}, "Certificate")
+}
+
+// selfSignedTLSDNSNames are the names the self-signed certificate is valid for. The wildcards
+// cover the derived server and canary Services, which share the certificate.
+func selfSignedTLSDNSNames(mlflow *mlflowv1.MLflow, namespace string) []string {
+	service := ResourceName + render.ResourceSuffix(mlflow.Name)
+	return []string{
+		fmt.Sprintf("%s.%s.svc", service, namespace),
+		fmt.Sprintf("%s.%s.svc.cluster.local", service, namespace),
+		fmt.Sprintf("*.%s.svc", namespace),
+		fmt.Sprintf("*.%s.svc.cluster.local", namespace),
+	}
+}
+
+// ensureSelfSignedTLSSecret creates the TLS Secret with a self-signed certificate when the
+// cluster has no issuer for it, and replaces the certificate selfSignedCertRenewBefore ahead
+// of its expiry. It returns the hash of the certificate in use, for the pod template, and
+// when the certificate is due for renewal. It does nothing unless SelfSignedTLS is set or
+// when the Secret exists without the self-signed annotation. The Secret is read as
+// unstructured so the API server is asked directly rather than the label-filtered cache.
+func (r *MLflowReconciler) ensureSelfSignedTLSSecret(
+	ctx context.Context,
+	mlflow *mlflowv1.MLflow,
+	namespace string,
+	now time.Time,
+) (string, time.Duration, error) {
+	if !r.SelfSignedTLS {
+		return "", 0, nil
+	}
+	dnsNames := selfSignedTLSDNSNames(mlflow, namespace)
+
+	existing := &unstructured.Unstructured{}
+	existing.SetAPIVersion("v1")
+	existing.SetKind("Secret")
+	err := r.Get(ctx, types.NamespacedName{Name: TLSSecretName, Namespace: namespace}, existing)
+	if err != nil && !errors.IsNotFound(err) {
+		return "", 0, fmt.Errorf("failed to get TLS Secret %s: %w", TLSSecretName, err)
+	}
+	if err == nil {
+		if existing.GetAnnotations()[selfSignedTLSAnnotation] != "true" {
+			return "", 0, nil
+		}
+		encoded, _, _ := unstructured.NestedString(existing.Object, "data", "tls.crt")
+		if cert := parseCertificate(encoded); cert != nil && slices.Equal(cert.DNSNames, dnsNames) {
+			if renewAt := cert.NotAfter.Add(-selfSignedCertRenewBefore); now.Before(renewAt) {
+				return certificateHash(cert.Raw), renewAt.Sub(now), nil
+			}
+		}
+	}
+
+	certPEM, keyPEM, der, err := generateSelfSignedCertificate(dnsNames, now)
+	if err != nil {
+		return "", 0, err
+	}
+	secret := &unstructured.Unstructured{Object: map[string]interface{}{
+		"type": "kubernetes.io/tls",
+		"data": map[string]interface{}{
+			"tls.crt": base64.StdEncoding.EncodeToString(certPEM),
+			"tls.key": base64.StdEncoding.EncodeToString(keyPEM),
+			// The certificate is its own CA; clients trust it through ca.crt.
+			"ca.crt": base64.StdEncoding.EncodeToString(certPEM),
+		},
+	}}
+	secret.SetAPIVersion("v1")
+	secret.SetKind("Secret")
+	secret.SetName(TLSSecretName)
+	secret.SetNamespace(namespace)
+	secret.SetLabels(map[string]string{"app": ResourceName})
+	secret.SetAnnotations(map[string]string{selfSignedTLSAnnotation: "true"})
+	propagateMetadata(mlflow, secret)
+	if err := controllerutil.SetControllerReference(mlflow, secret, r.Scheme); err != nil {
+		return "", 0, fmt.Errorf("failed to set controller reference on TLS Secret: %w", err)
+	}
+	if err := r.applyObject(ctx, secret); err != nil {
+		return "", 0, fmt.Errorf("failed to apply TLS Secret %s: %w", TLSSecretName, err)
+	}
+	logf.FromContext(ctx).Info("Issued self-signed TLS certificate", "secret", TLSSecretName, "dnsNames", dnsNames)
+	return certificateHash(der), selfSignedCertValidity - selfSignedCertRenewBefore, nil
+}
+
+// generateSelfSignedCertificate returns a PEM certificate and key valid for dnsNames from now,
+// and the certificate in DER form.
+func generateSelfSignedCertificate(dnsNames []string, now time.Time) ([]byte, []byte, []byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to generate TLS key: %w", err)
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to generate certificate serial number: %w", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: dnsNames[0]},
+		DNSNames:     dnsNames,
+		// Allow for clock skew between the operator and its clients.
+		NotBefore:   now.Add(-time.Hour),
+		NotAfter:    now.Add(selfSignedCertValidity),
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		// The key is mounted into every MLflow pod, so the certificate must not be able to sign
+		// others. Clients still trust it as ca.crt, since a self-signed leaf in the trust pool is
+		// its own anchor.
+		BasicConstraintsValid: true,
+		IsCA:                  false,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to create self-signed certificate: %w", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to encode TLS key: %w", err)
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return certPEM, keyPEM, der, nil
+}
+
+// parseCertificate decodes the first certificate of a base64 PEM bundle, or returns nil.
+func parseCertificate(encoded string) *x509.Certificate {
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil
+	}
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil
+	}
+	return cert
+}
+
+func certificateHash(der []byte) string {
+	sum := sha256.Sum256(der)
+	return hex.EncodeToString(sum[:8])
+}
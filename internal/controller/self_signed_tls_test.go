@@ -0,0 +1,143 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func TestEnsureSelfSignedTLSSecret(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: ResourceName, UID: "uid"}}
+	key := types.NamespacedName{Name: TLSSecretName, Namespace: quotaTestNamespace}
+	now := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	// Disabled when the cluster has an issuer.
+	r := newTestReconciler(t)
+	g.Expect(mlflowv1.AddToScheme(r.Scheme)).To(gomega.Succeed())
+	hash, renewAfter, err := r.ensureSelfSignedTLSSecret(ctx, mlflow, quotaTestNamespace, now)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(hash).To(gomega.BeEmpty())
+	g.Expect(renewAfter).To(gomega.BeZero())
+
+	r.SelfSignedTLS = true
+	hash, renewAfter, err = r.ensureSelfSignedTLSSecret(ctx, mlflow, quotaTestNamespace, now)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(hash).NotTo(gomega.BeEmpty())
+	g.Expect(renewAfter).To(gomega.Equal(selfSignedCertValidity - selfSignedCertRenewBefore))
+
+	secret := &corev1.Secret{}
+	g.Expect(r.Get(ctx, key, secret)).To(gomega.Succeed())
+	g.Expect(secret.Type).To(gomega.Equal(corev1.SecretTypeTLS))
+	g.Expect(secret.Annotations).To(gomega.HaveKeyWithValue(selfSignedTLSAnnotation, "true"))
+	g.Expect(secret.OwnerReferences).To(gomega.HaveLen(1))
+	g.Expect(secret.Data).To(gomega.HaveKey("tls.key"))
+	g.Expect(secret.Data["ca.crt"]).To(gomega.Equal(secret.Data["tls.crt"]))
+	cert := parseCertificate(base64.StdEncoding.EncodeToString(secret.Data["tls.crt"]))
+	g.Expect(cert).NotTo(gomega.BeNil())
+	g.Expect(cert.DNSNames).To(gomega.ContainElement("mlflow." + quotaTestNamespace + ".svc"))
+
+	// A valid certificate is kept until it is due for renewal.
+	later := now.Add(24 * time.Hour)
+	again, renewAfter, err := r.ensureSelfSignedTLSSecret(ctx, mlflow, quotaTestNamespace, later)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(again).To(gomega.Equal(hash))
+	g.Expect(renewAfter).To(gomega.Equal(cert.NotAfter.Add(-selfSignedCertRenewBefore).Sub(later)))
+
+	// Inside the renewal window the certificate is replaced.
+	rotated, _, err := r.ensureSelfSignedTLSSecret(ctx, mlflow, quotaTestNamespace, cert.NotAfter.Add(-time.Hour))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(rotated).NotTo(gomega.Equal(hash))
+}
+
+func TestEnsureSelfSignedTLSSecretKeepsProvidedSecret(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+	provided := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: TLSSecretName, Namespace: quotaTestNamespace},
+		Type:       corev1.SecretTypeTLS,
+		Data:       map[string][]byte{"tls.crt": []byte("user cert"), "tls.key": []byte("user key")},
+	}
+	r := newTestReconciler(t, provided)
+	r.SelfSignedTLS = true
+
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: ResourceName}}
+	hash, renewAfter, err := r.ensureSelfSignedTLSSecret(ctx, mlflow, quotaTestNamespace, time.Now())
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(hash).To(gomega.BeEmpty())
+	g.Expect(renewAfter).To(gomega.BeZero())
+
+	secret := &corev1.Secret{}
+	g.Expect(r.Get(ctx, types.NamespacedName{Name: TLSSecretName, Namespace: quotaTestNamespace}, secret)).
+		To(gomega.Succeed())
+	g.Expect(secret.Data["tls.crt"]).To(gomega.Equal([]byte("user cert")))
+}
+
+func TestGenerateSelfSignedCertificateCannotSign(t *testing.T) {
+	g := gomega.NewWithT(t)
+	now := time.Now()
+	dnsNames := []string{"mlflow.test-ns.svc", "*.test-ns.svc"}
+	certPEM, keyPEM, der, err := generateSelfSignedCertificate(dnsNames, now)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	leaf, err := x509.ParseCertificate(der)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(leaf.BasicConstraintsValid).To(gomega.BeTrue())
+	g.Expect(leaf.IsCA).To(gomega.BeFalse())
+	g.Expect(leaf.KeyUsage & x509.KeyUsageCertSign).To(gomega.BeZero())
+
+	// Clients trusting ca.crt accept the leaf itself.
+	roots := x509.NewCertPool()
+	g.Expect(roots.AppendCertsFromPEM(certPEM)).To(gomega.BeTrue())
+	_, err = leaf.Verify(x509.VerifyOptions{DNSName: "mlflow.test-ns.svc", Roots: roots})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	// A certificate minted with the mounted key is rejected.
+	block, _ := pem.Decode(keyPEM)
+	key, err := x509.ParseECPrivateKey(block.Bytes)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	forged := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "attacker.example.com"},
+		DNSNames:     []string{"attacker.example.com"},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	forgedDER, err := x509.CreateCertificate(rand.Reader, forged, leaf, &key.PublicKey, key)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	forgedCert, err := x509.ParseCertificate(forgedDER)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	_, err = forgedCert.Verify(x509.VerifyOptions{DNSName: "attacker.example.com", Roots: roots})
+	g.Expect(err).To(gomega.HaveOccurred())
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
+)
+
+func loggingConfigMapName(mlflow *mlflowv1.MLflow) string {
+	return "mlflow-logging" + render.ResourceSuffix(mlflow.Name)
+}
+
+// deleteLoggingConfigMap removes the JSON log configuration after spec.server.logging.format
+// switches back to text.
+func (r *MLflowReconciler) deleteLoggingConfigMap(ctx context.Context, mlflow *mlflowv1.MLflow, namespace string) error {
+	configMap := &corev1.ConfigMap{}
+	err := r.Get(ctx, types.NamespacedName{Name: loggingConfigMapName(mlflow), Namespace: namespace}, configMap)
+	if errors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to get logging ConfigMap: %w", err)
+	}
+	if configMap.Labels["app"] != ResourceName+render.ResourceSuffix(mlflow.Name) {
+		return nil
+	}
+	if err := r.Delete(ctx, configMap); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete logging ConfigMap: %w", err)
+	}
+	logf.FromContext(ctx).Info("Deleted JSON logging configuration", "name", configMap.Name)
+	return nil
+}
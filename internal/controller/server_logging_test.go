@@ -0,0 +1,56 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func TestDeleteLoggingConfigMap(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(gomega.Succeed())
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "mlflow"}}
+	key := types.NamespacedName{Name: "mlflow-logging", Namespace: "test-ns"}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace, Labels: map[string]string{"app": "mlflow"}},
+	}).Build()
+	r := &MLflowReconciler{Client: k8sClient, Scheme: scheme}
+	ctx := context.Background()
+
+	g.Expect(r.deleteLoggingConfigMap(ctx, mlflow, "test-ns")).To(gomega.Succeed())
+	g.Expect(apierrors.IsNotFound(k8sClient.Get(ctx, key, &corev1.ConfigMap{}))).To(gomega.BeTrue())
+	g.Expect(r.deleteLoggingConfigMap(ctx, mlflow, "test-ns")).To(gomega.Succeed())
+
+	// A same-named ConfigMap the operator did not render is left alone.
+	g.Expect(k8sClient.Create(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace}})).To(gomega.Succeed())
+	g.Expect(r.deleteLoggingConfigMap(ctx, mlflow, "test-ns")).To(gomega.Succeed())
+	g.Expect(k8sClient.Get(ctx, key, &corev1.ConfigMap{})).To(gomega.Succeed())
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
+)
+
+const (
+	PeerAuthenticationCRDName = "PeerAuthentication"
+
+	meshMTLSStrict = "STRICT"
+)
+
+// PeerAuthenticationGVK is handled as unstructured, like the Istio networking kinds.
+var PeerAuthenticationGVK = schema.GroupVersionKind{Group: "security.istio.io", Version: "v1beta1", Kind: PeerAuthenticationCRDName}
+
+// IsPeerAuthenticationAvailable checks if the Istio PeerAuthentication CRD is available in the cluster using discovery API
+func IsPeerAuthenticationAvailable(discoveryClient discovery.DiscoveryInterface) (bool, error) {
+	return isKindAvailable(discoveryClient, PeerAuthenticationGVK.GroupVersion(), PeerAuthenticationCRDName)
+}
+
+func serviceMeshMTLSMode(mlflow *mlflowv1.MLflow) string {
+	if mlflow.Spec.ServiceMesh != nil && mlflow.Spec.ServiceMesh.MTLSMode != nil {
+		return *mlflow.Spec.ServiceMesh.MTLSMode
+	}
+	return meshMTLSStrict
+}
+
+// serviceMeshStrict reports whether clients outside the mesh are rejected.
+func serviceMeshStrict(mlflow *mlflowv1.MLflow) bool {
+	return render.ServiceMeshEnabled(mlflow) && serviceMeshMTLSMode(mlflow) == meshMTLSStrict
+}
+
+// meshServerNames returns the Deployment names, which double as Service names and pod app
+// labels, of every server that may carry a sidecar. Derived servers copy the main pod
+// template, annotations included.
+func meshServerNames(mlflow *mlflowv1.MLflow) []string {
+	return []string{
+		ResourceName + render.ResourceSuffix(mlflow.Name),
+		render.ArtifactsServerResourceName(mlflow),
+		render.RegistryServerResourceName(mlflow),
+	}
+}
+
+func meshServerEnabled(mlflow *mlflowv1.MLflow, name string) bool {
+	switch name {
+	case render.ArtifactsServerResourceName(mlflow):
+		return render.ArtifactsServerEnabled(mlflow)
+	case render.RegistryServerResourceName(mlflow):
+		return render.RegistryServerEnabled(mlflow)
+	default:
+		return true
+	}
+}
+
+// buildPeerAuthentication sets the mTLS mode for the pods of one server.
+func buildPeerAuthentication(mlflow *mlflowv1.MLflow, name, namespace string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(PeerAuthenticationGVK)
+	obj.SetName(name)
+	obj.SetNamespace(namespace)
+	obj.SetLabels(map[string]string{"app": ResourceName})
+	obj.Object["spec"] = map[string]interface{}{
+		"selector": map[string]interface{}{
+			"matchLabels": map[string]interface{}{"app": name},
+		},
+		"mtls": map[string]interface{}{"mode": serviceMeshMTLSMode(mlflow)},
+	}
+	return obj
+}
+
+// buildMeshDestinationRule makes mesh clients use Istio mutual TLS towards one server Service,
+// even where auto mTLS is turned off mesh-wide. MLflow's own TLS is carried inside it.
+func buildMeshDestinationRule(name, namespace string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(DestinationRuleGVK)
+	obj.SetName(name)
+	obj.SetNamespace(namespace)
+	obj.SetLabels(map[string]string{"app": ResourceName})
+	obj.Object["spec"] = map[string]interface{}{
+		"host": fmt.Sprintf("%s.%s.svc.cluster.local", name, namespace),
+		"trafficPolicy": map[string]interface{}{
+			"tls": map[string]interface{}{"mode": "ISTIO_MUTUAL"},
+		},
+	}
+	return obj
+}
+
+// reconcileServiceMesh applies or removes the PeerAuthentication and DestinationRule of each
+// server according to spec.serviceMesh. While the VirtualService backend is active, the main
+// Service keeps its routing DestinationRule, which originates TLS from the ingress gateway.
+func (r *MLflowReconciler) reconcileServiceMesh(ctx context.Context, mlflow *mlflowv1.MLflow, namespace string) error {
+	if !r.ServiceMeshAvailable {
+		return nil
+	}
+
+	mainServer := ResourceName + render.ResourceSuffix(mlflow.Name)
+	for _, name := range meshServerNames(mlflow) {
+		objects := []*unstructured.Unstructured{buildPeerAuthentication(mlflow, name, namespace)}
+		if name != mainServer || !r.usesVirtualServiceRouting() {
+			objects = append(objects, buildMeshDestinationRule(name, namespace))
+		}
+
+		for _, obj := range objects {
+			if render.ServiceMeshEnabled(mlflow) && meshServerEnabled(mlflow, name) {
+				propagateMetadata(mlflow, obj)
+				if err := controllerutil.SetControllerReference(mlflow, obj, r.Scheme); err != nil {
+					return fmt.Errorf("failed to set controller reference on %s: %w", obj.GetKind(), err)
+				}
+				if err := r.applyObject(ctx, obj); err != nil {
+					return fmt.Errorf("failed to apply %s %s: %w", obj.GetKind(), name, err)
+				}
+				continue
+			}
+			if err := r.deleteMeshObject(ctx, obj); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// deleteMeshObject removes a mesh object left over from a disabled mesh or server.
+func (r *MLflowReconciler) deleteMeshObject(ctx context.Context, obj *unstructured.Unstructured) error {
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(obj.GroupVersionKind())
+	err := r.Get(ctx, types.NamespacedName{Name: obj.GetName(), Namespace: obj.GetNamespace()}, existing)
+	if errors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to get %s %s: %w", obj.GetKind(), obj.GetName(), err)
+	}
+	if err := r.Delete(ctx, existing); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete %s %s: %w", obj.GetKind(), obj.GetName(), err)
+	}
+	logf.FromContext(ctx).Info("Deleted service mesh object", "kind", obj.GetKind(), "name", obj.GetName())
+	return nil
+}
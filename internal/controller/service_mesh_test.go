@@ -0,0 +1,112 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func newServiceMeshMLflow() *mlflowv1.MLflow {
+	return &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow", UID: "mlflow-uid"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI: ptr(testBackendStoreURI),
+			ServiceMesh:     &mlflowv1.ServiceMeshConfig{Enabled: true},
+		},
+	}
+}
+
+func TestReconcileServiceMesh(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(mlflowv1.AddToScheme(scheme)).To(gomega.Succeed())
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	r := &MLflowReconciler{Client: k8sClient, Scheme: scheme, ServiceMeshAvailable: true, HTTPRouteAvailable: true}
+	ctx := context.Background()
+	get := func(gvk schema.GroupVersionKind, name string) (*unstructured.Unstructured, error) {
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(gvk)
+		return obj, k8sClient.Get(ctx, types.NamespacedName{Name: name, Namespace: "test-ns"}, obj)
+	}
+
+	mlflow := newServiceMeshMLflow()
+	mlflow.Spec.ServiceMesh.MTLSMode = ptr("PERMISSIVE")
+	g.Expect(r.reconcileServiceMesh(ctx, mlflow, "test-ns")).To(gomega.Succeed())
+	peerAuthentication, err := get(PeerAuthenticationGVK, "mlflow")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	mode, _, _ := unstructured.NestedString(peerAuthentication.Object, "spec", "mtls", "mode")
+	g.Expect(mode).To(gomega.Equal("PERMISSIVE"))
+	selector, _, _ := unstructured.NestedStringMap(peerAuthentication.Object, "spec", "selector", "matchLabels")
+	g.Expect(selector).To(gomega.Equal(map[string]string{"app": "mlflow"}))
+	destinationRule, err := get(DestinationRuleGVK, "mlflow")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	tlsMode, _, _ := unstructured.NestedString(destinationRule.Object, "spec", "trafficPolicy", "tls", "mode")
+	g.Expect(tlsMode).To(gomega.Equal("ISTIO_MUTUAL"))
+	_, err = get(PeerAuthenticationGVK, "mlflow-artifacts")
+	g.Expect(apierrors.IsNotFound(err)).To(gomega.BeTrue(), "disabled derived servers get no mesh objects")
+
+	mlflow.Spec.ServiceMesh.Enabled = false
+	g.Expect(r.reconcileServiceMesh(ctx, mlflow, "test-ns")).To(gomega.Succeed())
+	_, err = get(PeerAuthenticationGVK, "mlflow")
+	g.Expect(apierrors.IsNotFound(err)).To(gomega.BeTrue())
+	_, err = get(DestinationRuleGVK, "mlflow")
+	g.Expect(apierrors.IsNotFound(err)).To(gomega.BeTrue())
+}
+
+func TestReconcileServiceMesh_VirtualServiceKeepsRoutingDestinationRule(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(mlflowv1.AddToScheme(scheme)).To(gomega.Succeed())
+	routingRule := buildDestinationRule(&mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "mlflow"}}, "test-ns")
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(routingRule).Build()
+	r := &MLflowReconciler{Client: k8sClient, Scheme: scheme, ServiceMeshAvailable: true, VirtualServiceAvailable: true}
+
+	mlflow := newServiceMeshMLflow()
+	mlflow.Spec.ServiceMesh.Enabled = false
+	g.Expect(r.reconcileServiceMesh(context.Background(), mlflow, "test-ns")).To(gomega.Succeed())
+	got := &unstructured.Unstructured{}
+	got.SetGroupVersionKind(DestinationRuleGVK)
+	g.Expect(k8sClient.Get(context.Background(), types.NamespacedName{Name: "mlflow", Namespace: "test-ns"}, got)).To(gomega.Succeed())
+	tlsMode, _, _ := unstructured.NestedString(got.Object, "spec", "trafficPolicy", "tls", "mode")
+	g.Expect(tlsMode).To(gomega.Equal("SIMPLE"))
+}
+
+func TestDeepHealthCheckDefaultsOffUnderStrictMesh(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	mlflow := newServiceMeshMLflow()
+	g.Expect(deepHealthCheckEnabled(mlflow, true)).To(gomega.BeFalse())
+	mlflow.Spec.ServiceMesh.MTLSMode = ptr("PERMISSIVE")
+	g.Expect(deepHealthCheckEnabled(mlflow, true)).To(gomega.BeTrue())
+	mlflow.Spec.ServiceMesh.MTLSMode = nil
+	mlflow.Spec.DeepHealthCheck = ptr(true)
+	g.Expect(deepHealthCheckEnabled(mlflow, true)).To(gomega.BeTrue())
+}
@@ -0,0 +1,350 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
+)
+
+const (
+	sqliteMigrationRunningReason = "SQLiteMigrationRunning"
+	sqliteMigrationFailedReason  = "SQLiteMigrationFailed"
+	sqliteMigrationContainerName = "sqlite-migrate"
+	sqliteMigrationPollInterval  = 15 * time.Second
+
+	sqliteMigrationPythonScriptEnv = "SQLITE_MIGRATION_PYTHON_SCRIPT"
+	sqliteMigrationTargetURIEnv    = "SQLITE_MIGRATION_TARGET_URI"
+	sqliteMigrationCommand         = `exec python3.12 -c "$SQLITE_MIGRATION_PYTHON_SCRIPT"`
+)
+
+//go:embed assets/mlflow_sqlite_migrate.py
+var sqliteMigrationPythonScript string
+
+func copySQLiteEnabled(mlflow *mlflowv1.MLflow) bool {
+	return mlflow.Spec.Migration == nil || mlflow.Spec.Migration.CopySQLite == nil || *mlflow.Spec.Migration.CopySQLite
+}
+
+func sqliteMigrationJobName(mlflow *mlflowv1.MLflow, revision string) string {
+	return ResourceName + render.ResourceSuffix(mlflow.Name) + "-sqlite-" + revision[:10]
+}
+
+// sqliteMigrationHolding reports whether a SQLite copy keeps the Deployment on its SQLite
+// backend store. The operator-managed migration is skipped meanwhile, since the Job
+// upgrades the new backend itself.
+func sqliteMigrationHolding(mlflow *mlflowv1.MLflow) bool {
+	status := mlflow.Status.SQLiteMigration
+	return status != nil && status.Phase != mlflowv1.SQLiteMigrationPhaseSucceeded
+}
+
+// sqliteFileBackendStore reports whether deployment serves from a SQLite file on a PVC. Only
+// inline URIs are considered; the chart never reads a SQLite URI from a Secret.
+func sqliteFileBackendStore(deployment *appsv1.Deployment) bool {
+	container := findContainer(deployment.Spec.Template.Spec.Containers, "mlflow")
+	if container == nil {
+		return false
+	}
+	env := backendStoreURIEnv(container.Env)
+	if len(env) == 0 || env[0].ValueFrom != nil || !strings.HasPrefix(env[0].Value, "sqlite:") {
+		return false
+	}
+	return slices.ContainsFunc(deployment.Spec.Template.Spec.Volumes, func(volume corev1.Volume) bool {
+		return volume.PersistentVolumeClaim != nil
+	})
+}
+
+// postgreSQLBackendStoreCandidate reports whether deployment may serve from PostgreSQL. A
+// URI read from a Secret is checked by the Job, which fails for other stores.
+func postgreSQLBackendStoreCandidate(deployment *appsv1.Deployment) bool {
+	container := findContainer(deployment.Spec.Template.Spec.Containers, "mlflow")
+	if container == nil {
+		return false
+	}
+	env := backendStoreURIEnv(container.Env)
+	return len(env) == 1 && (env[0].ValueFrom != nil || strings.HasPrefix(env[0].Value, "postgresql"))
+}
+
+// buildSQLiteMigrationJob builds the Job that copies the SQLite store of live into the
+// backend store of desired. It runs with the pod settings and volumes of live, so it sees
+// the SQLite file, and with the image of desired, so both stores end up at the schema the
+// new pods expect. The Job is kept after it finishes: a failed Job holds the instance on
+// SQLite until it is deleted.
+func buildSQLiteMigrationJob(mlflow *mlflowv1.MLflow, live, desired *appsv1.Deployment, name string) (*batchv1.Job, error) {
+	job, err := buildMigrationJobFromDeployment(mlflow, live, live.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	liveContainer := findContainer(live.Spec.Template.Spec.Containers, "mlflow")
+	desiredContainer := findContainer(desired.Spec.Template.Spec.Containers, "mlflow")
+	if desiredContainer == nil {
+		return nil, fmt.Errorf("deployment %s/%s has no mlflow container", desired.Namespace, desired.Name)
+	}
+	targetEnv := backendStoreURIEnv(desiredContainer.Env)
+	if len(targetEnv) == 0 {
+		return nil, fmt.Errorf("deployment %s/%s has no backend store", desired.Namespace, desired.Name)
+	}
+	target := targetEnv[0]
+	target.Name = sqliteMigrationTargetURIEnv
+
+	job.Name = name
+	labels := buildDataJobLabels(live.Spec.Template.Labels, "sqlite-migration")
+	job.Labels = labels
+	job.Spec.Template.Labels = labels
+	backoffLimit := int32(0)
+	job.Spec.BackoffLimit = &backoffLimit
+	job.Spec.TTLSecondsAfterFinished = nil
+
+	podSpec := &job.Spec.Template.Spec
+	container := &podSpec.Containers[0]
+	container.Name = sqliteMigrationContainerName
+	container.Image = desiredContainer.Image
+	container.Args = []string{sqliteMigrationCommand}
+	container.Env = append(slices.Clone(liveContainer.Env), target,
+		corev1.EnvVar{Name: sqliteMigrationPythonScriptEnv, Value: sqliteMigrationPythonScript})
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{Name: backupWorkVolumeName, MountPath: backupWorkDir})
+	podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+		Name:         backupWorkVolumeName,
+		VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+	})
+	podSpec.Affinity = dataJobAffinity(live, podSpec)
+	return job, nil
+}
+
+// handleSQLiteMigration copies a SQLite backend store into PostgreSQL when the backend store
+// of an instance moves from the SQLite file on its PVC to PostgreSQL. The Deployment stays
+// on SQLite while its pods are scaled to zero and a Job copies and verifies the data, and
+// only switches to the new backend store once the Job succeeds. A failed copy scales the
+// SQLite revision back up. It returns handled while the copy holds the Deployment; the
+// caller then skips the rest of the reconcile.
+func (r *MLflowReconciler) handleSQLiteMigration(
+	ctx context.Context,
+	mlflow *mlflowv1.MLflow,
+	namespace string,
+	objects []*unstructured.Unstructured,
+	now time.Time,
+) (ctrl.Result, bool, error) {
+	log := logf.FromContext(ctx)
+	stableName := ResourceName + render.ResourceSuffix(mlflow.Name)
+	if !copySQLiteEnabled(mlflow) || render.FindRenderedObject(objects, "Deployment", stableName) == nil {
+		return ctrl.Result{}, false, r.abandonSQLiteMigration(ctx, mlflow, namespace)
+	}
+	desired, err := renderedDeployment(objects, stableName, namespace)
+	if err != nil {
+		return ctrl.Result{}, true, err
+	}
+	desiredRevision, err := backendStoreRevision(desired)
+	if err != nil {
+		return ctrl.Result{}, true, err
+	}
+	live := &appsv1.Deployment{}
+	if err := r.Get(ctx, types.NamespacedName{Name: stableName, Namespace: namespace}, live); err != nil {
+		if !errors.IsNotFound(err) {
+			return ctrl.Result{}, true, fmt.Errorf("failed to get Deployment %s: %w", stableName, err)
+		}
+		// First install: nothing to copy from.
+		return ctrl.Result{}, false, r.abandonSQLiteMigration(ctx, mlflow, namespace)
+	}
+	liveRevision, err := backendStoreRevision(live)
+	if err != nil {
+		return ctrl.Result{}, true, err
+	}
+	if liveRevision == desiredRevision || !sqliteFileBackendStore(live) || !postgreSQLBackendStoreCandidate(desired) {
+		return ctrl.Result{}, false, r.abandonSQLiteMigration(ctx, mlflow, namespace)
+	}
+
+	status := mlflow.Status.SQLiteMigration
+	if status == nil || status.Revision != desiredRevision {
+		if err := r.abandonSQLiteMigration(ctx, mlflow, namespace); err != nil {
+			return ctrl.Result{}, true, err
+		}
+		status = &mlflowv1.SQLiteMigrationStatus{
+			Revision:    desiredRevision,
+			Phase:       mlflowv1.SQLiteMigrationPhaseScalingDown,
+			JobName:     sqliteMigrationJobName(mlflow, desiredRevision),
+			StartedTime: metav1.NewTime(now),
+			Message:     "Stopping the MLflow pods before copying the SQLite backend store",
+		}
+		// A finished Job from an earlier attempt at the same backend must not be reused.
+		stale := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: status.JobName, Namespace: namespace}}
+		if err := r.Delete(ctx, stale, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !errors.IsNotFound(err) {
+			return ctrl.Result{}, true, fmt.Errorf("failed to delete stale SQLite migration Job: %w", err)
+		}
+		mlflow.Status.SQLiteMigration = status
+	}
+	if status.Phase == mlflowv1.SQLiteMigrationPhaseSucceeded {
+		// The copy already ran for this backend; the switch only has to be applied.
+		return ctrl.Result{}, false, nil
+	}
+
+	// Everything but the Deployment follows the spec while the copy holds it on SQLite.
+	held := withoutRenderedObject(objects, "Deployment", stableName)
+	if err := r.applyRenderedObjects(ctx, mlflow, held); err != nil {
+		return ctrl.Result{}, true, err
+	}
+
+	job, err := getDataJob(ctx, r.Client, status.JobName, namespace)
+	if err != nil {
+		return ctrl.Result{}, true, fmt.Errorf("failed to get SQLite migration Job: %w", err)
+	}
+	if status.Phase == mlflowv1.SQLiteMigrationPhaseFailed && job == nil {
+		// Deleting the failed Job asks for another attempt.
+		status.Phase = mlflowv1.SQLiteMigrationPhaseScalingDown
+		status.StartedTime = metav1.NewTime(now)
+		status.CompletionTime = nil
+		status.Message = "Stopping the MLflow pods before retrying the SQLite copy"
+		clearSQLiteMigrationDegraded(mlflow)
+	}
+
+	requeue := sqliteMigrationPollInterval
+	switch status.Phase {
+	case mlflowv1.SQLiteMigrationPhaseScalingDown:
+		if err := r.scaleDeployment(ctx, live, 0); err != nil {
+			return ctrl.Result{}, true, err
+		}
+		if live.Status.Replicas > 0 {
+			status.Message = fmt.Sprintf("Waiting for %d MLflow pods to stop before copying the SQLite backend store", live.Status.Replicas)
+			break
+		}
+		status.Phase = mlflowv1.SQLiteMigrationPhaseCopying
+		fallthrough
+	case mlflowv1.SQLiteMigrationPhaseCopying:
+		switch {
+		case job == nil:
+			job, err := buildSQLiteMigrationJob(mlflow, live, desired, status.JobName)
+			if err != nil {
+				return ctrl.Result{}, true, err
+			}
+			if err := controllerutil.SetControllerReference(mlflow, job, r.Scheme); err != nil {
+				return ctrl.Result{}, true, fmt.Errorf("set controller reference on SQLite migration Job: %w", err)
+			}
+			if err := r.Create(ctx, job); err != nil && !errors.IsAlreadyExists(err) {
+				return ctrl.Result{}, true, fmt.Errorf("failed to create SQLite migration Job: %w", err)
+			}
+			status.Message = fmt.Sprintf("Copying the SQLite backend store into PostgreSQL with Job %s", status.JobName)
+		case isJobFailed(job):
+			completed := metav1.NewTime(now)
+			status.Phase = mlflowv1.SQLiteMigrationPhaseFailed
+			status.CompletionTime = &completed
+			status.Message = fmt.Sprintf("Copying the SQLite backend store failed: %s", dataJobFailureMessage(ctx, r.Client, job))
+			log.Info("SQLite migration failed", "job", job.Name, "reason", status.Message)
+		case isJobSuccessful(job):
+			completed := metav1.NewTime(now)
+			status.Phase = mlflowv1.SQLiteMigrationPhaseSucceeded
+			status.CompletionTime = &completed
+			status.Message = "Copied the SQLite backend store into PostgreSQL and verified the row counts"
+			log.Info("SQLite migration succeeded, switching to the new backend store", "job", job.Name)
+			return ctrl.Result{}, false, nil
+		}
+	}
+
+	if status.Phase == mlflowv1.SQLiteMigrationPhaseFailed {
+		replicas := int32(1)
+		if desired.Spec.Replicas != nil {
+			replicas = *desired.Spec.Replicas
+		}
+		if err := r.scaleDeployment(ctx, live, replicas); err != nil {
+			return ctrl.Result{}, true, err
+		}
+		message := status.Message + fmt.Sprintf("; serving from SQLite until Job %s is deleted to retry or the backend store changes", status.JobName)
+		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+			Type:    "Degraded",
+			Status:  metav1.ConditionTrue,
+			Reason:  sqliteMigrationFailedReason,
+			Message: message,
+		})
+		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+			Type:    "Progressing",
+			Status:  metav1.ConditionFalse,
+			Reason:  sqliteMigrationFailedReason,
+			Message: message,
+		})
+		// Deleting the Job is not watched, so look for a retry now and then.
+		requeue = migrationJobRequeueAfter
+	} else {
+		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+			Type:    "Available",
+			Status:  metav1.ConditionFalse,
+			Reason:  sqliteMigrationRunningReason,
+			Message: status.Message,
+		})
+		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+			Type:    "Progressing",
+			Status:  metav1.ConditionTrue,
+			Reason:  sqliteMigrationRunningReason,
+			Message: status.Message,
+		})
+	}
+	if err := r.updateStatus(ctx, mlflow); err != nil {
+		return ctrl.Result{}, true, err
+	}
+	return ctrl.Result{RequeueAfter: requeue}, true, nil
+}
+
+// abandonSQLiteMigration drops an unfinished copy once it no longer applies, for example
+// when the backend store is set back to SQLite. The record of a successful copy is kept.
+func (r *MLflowReconciler) abandonSQLiteMigration(ctx context.Context, mlflow *mlflowv1.MLflow, namespace string) error {
+	if !sqliteMigrationHolding(mlflow) {
+		return nil
+	}
+	job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: mlflow.Status.SQLiteMigration.JobName, Namespace: namespace}}
+	if err := r.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete SQLite migration Job: %w", err)
+	}
+	mlflow.Status.SQLiteMigration = nil
+	clearSQLiteMigrationDegraded(mlflow)
+	return nil
+}
+
+func clearSQLiteMigrationDegraded(mlflow *mlflowv1.MLflow) {
+	if degraded := meta.FindStatusCondition(mlflow.Status.Conditions, "Degraded"); degraded != nil && degraded.Reason == sqliteMigrationFailedReason {
+		meta.RemoveStatusCondition(&mlflow.Status.Conditions, "Degraded")
+	}
+}
+
+// scaleDeployment sets the replicas of the live deployment, which the rendered objects no
+// longer manage while a SQLite copy holds it.
+func (r *MLflowReconciler) scaleDeployment(ctx context.Context, deployment *appsv1.Deployment, replicas int32) error {
+	if deployment.Spec.Replicas != nil && *deployment.Spec.Replicas == replicas {
+		return nil
+	}
+	patch := client.MergeFrom(deployment.DeepCopy())
+	deployment.Spec.Replicas = &replicas
+	if err := r.Patch(ctx, deployment, patch); err != nil {
+		return fmt.Errorf("failed to scale Deployment %s to %d replicas: %w", deployment.Name, replicas, err)
+	}
+	return nil
+}
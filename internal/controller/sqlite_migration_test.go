@@ -0,0 +1,188 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
+)
+
+const sqliteBackendURI = "sqlite:////mlflow/mlflow.db"
+
+// newSQLiteMigrationTestReconciler serves the status subresource, so the tests can report
+// Deployment and copy Job progress with status updates.
+func newSQLiteMigrationTestReconciler(t *testing.T, objs ...client.Object) *MLflowReconciler {
+	t.Helper()
+	return newTestReconcilerFrom(t, fake.NewClientBuilder().WithObjects(objs...).
+		WithStatusSubresource(&mlflowv1.MLflow{}, &appsv1.Deployment{}, &batchv1.Job{}))
+}
+
+// sqliteLiveDeployment returns a rolled-out Deployment serving from a SQLite file on a PVC.
+func sqliteLiveDeployment(t *testing.T) *appsv1.Deployment {
+	t.Helper()
+	deployment := blueGreenLiveDeployment(t, ResourceName, sqliteBackendURI)
+	podSpec := &deployment.Spec.Template.Spec
+	podSpec.Volumes = []corev1.Volume{{
+		Name: "mlflow-storage",
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "mlflow-pvc"},
+		},
+	}}
+	podSpec.Containers[0].VolumeMounts = []corev1.VolumeMount{{Name: "mlflow-storage", MountPath: "/mlflow"}}
+	return deployment
+}
+
+func TestBuildSQLiteMigrationJob(t *testing.T) {
+	g := gomega.NewWithT(t)
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: ResourceName}}
+	live := sqliteLiveDeployment(t)
+	desired := blueGreenLiveDeployment(t, ResourceName, blueBackendURI)
+	desired.Spec.Template.Spec.Containers[0].Image = "quay.io/opendatahub/mlflow:new"
+
+	g.Expect(sqliteFileBackendStore(live)).To(gomega.BeTrue())
+	g.Expect(postgreSQLBackendStoreCandidate(desired)).To(gomega.BeTrue())
+	g.Expect(sqliteFileBackendStore(desired)).To(gomega.BeFalse())
+
+	job, err := buildSQLiteMigrationJob(mlflow, live, desired, "mlflow-sqlite-test")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(job.Name).To(gomega.Equal("mlflow-sqlite-test"))
+	g.Expect(job.Labels).To(gomega.HaveKeyWithValue("component", "mlflow-sqlite-migration"))
+	g.Expect(job.Labels).NotTo(gomega.HaveKey("app"))
+	g.Expect(*job.Spec.BackoffLimit).To(gomega.BeZero())
+	g.Expect(job.Spec.TTLSecondsAfterFinished).To(gomega.BeNil())
+
+	podSpec := job.Spec.Template.Spec
+	g.Expect(podSpec.Containers).To(gomega.HaveLen(1))
+	container := podSpec.Containers[0]
+	g.Expect(container.Name).To(gomega.Equal(sqliteMigrationContainerName))
+	g.Expect(container.Image).To(gomega.Equal("quay.io/opendatahub/mlflow:new"))
+	g.Expect(container.Args).To(gomega.Equal([]string{sqliteMigrationCommand}))
+	g.Expect(container.Env).To(gomega.ContainElements(
+		corev1.EnvVar{Name: "MLFLOW_BACKEND_STORE_URI", Value: sqliteBackendURI},
+		corev1.EnvVar{Name: sqliteMigrationTargetURIEnv, Value: blueBackendURI},
+	))
+	g.Expect(container.VolumeMounts).To(gomega.ContainElements(
+		gomega.HaveField("Name", "mlflow-storage"),
+		gomega.HaveField("Name", backupWorkVolumeName),
+	))
+	g.Expect(podSpec.Volumes).To(gomega.HaveLen(2))
+	// The server pods still run when the Job is built, so it lands on their node.
+	g.Expect(podSpec.Affinity.PodAffinity).NotTo(gomega.BeNil())
+}
+
+func TestHandleSQLiteMigration(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: ResourceName}}
+	r := newSQLiteMigrationTestReconciler(t, mlflow.DeepCopy(), sqliteLiveDeployment(t))
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	// Only the Deployment is rendered, which the fake client never applies while it is held.
+	objects := []*unstructured.Unstructured{render.FindRenderedObject(blueGreenTestObjects(t, blueBackendURI), "Deployment", ResourceName)}
+	reconcile := func() (time.Duration, bool) {
+		t.Helper()
+		result, handled, err := r.handleSQLiteMigration(ctx, mlflow, canaryTestNamespace, objects, now)
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		return result.RequeueAfter, handled
+	}
+	getLive := func() *appsv1.Deployment {
+		t.Helper()
+		live := &appsv1.Deployment{}
+		g.Expect(r.Get(ctx, types.NamespacedName{Name: ResourceName, Namespace: canaryTestNamespace}, live)).To(gomega.Succeed())
+		return live
+	}
+
+	// Moving to PostgreSQL first stops the SQLite pods.
+	requeue, handled := reconcile()
+	g.Expect(handled).To(gomega.BeTrue())
+	g.Expect(requeue).To(gomega.Equal(sqliteMigrationPollInterval))
+	status := mlflow.Status.SQLiteMigration
+	g.Expect(status.Phase).To(gomega.Equal(mlflowv1.SQLiteMigrationPhaseScalingDown))
+	live := getLive()
+	g.Expect(*live.Spec.Replicas).To(gomega.BeZero())
+	g.Expect(live.Spec.Template.Spec.Containers[0].Env).To(gomega.ContainElement(
+		corev1.EnvVar{Name: "MLFLOW_BACKEND_STORE_URI", Value: sqliteBackendURI}))
+	g.Expect(meta.FindStatusCondition(mlflow.Status.Conditions, "Available").Reason).To(gomega.Equal(sqliteMigrationRunningReason))
+
+	// With the pods gone the copy Job starts.
+	live.Status = appsv1.DeploymentStatus{}
+	g.Expect(r.Status().Update(ctx, live)).To(gomega.Succeed())
+	_, handled = reconcile()
+	g.Expect(handled).To(gomega.BeTrue())
+	g.Expect(mlflow.Status.SQLiteMigration.Phase).To(gomega.Equal(mlflowv1.SQLiteMigrationPhaseCopying))
+	job := &batchv1.Job{}
+	g.Expect(r.Get(ctx, types.NamespacedName{Name: status.JobName, Namespace: canaryTestNamespace}, job)).To(gomega.Succeed())
+	g.Expect(job.OwnerReferences).To(gomega.HaveLen(1))
+	g.Expect(job.Spec.Template.Spec.Affinity).To(gomega.BeNil())
+
+	// A failed copy serves from SQLite again until the Job is deleted.
+	job.Status.Failed = 1
+	job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Message: "row counts differ"}}
+	g.Expect(r.Status().Update(ctx, job)).To(gomega.Succeed())
+	requeue, handled = reconcile()
+	g.Expect(handled).To(gomega.BeTrue())
+	g.Expect(requeue).To(gomega.Equal(migrationJobRequeueAfter))
+	g.Expect(mlflow.Status.SQLiteMigration.Phase).To(gomega.Equal(mlflowv1.SQLiteMigrationPhaseFailed))
+	g.Expect(*getLive().Spec.Replicas).To(gomega.Equal(int32(2)))
+	degraded := meta.FindStatusCondition(mlflow.Status.Conditions, "Degraded")
+	g.Expect(degraded).NotTo(gomega.BeNil())
+	g.Expect(degraded.Reason).To(gomega.Equal(sqliteMigrationFailedReason))
+
+	g.Expect(r.Delete(ctx, job)).To(gomega.Succeed())
+	_, handled = reconcile()
+	g.Expect(handled).To(gomega.BeTrue())
+	g.Expect(mlflow.Status.SQLiteMigration.Phase).To(gomega.Equal(mlflowv1.SQLiteMigrationPhaseCopying))
+	g.Expect(meta.FindStatusCondition(mlflow.Status.Conditions, "Degraded")).To(gomega.BeNil())
+
+	// A successful copy lets the new backend store through.
+	g.Expect(r.Get(ctx, types.NamespacedName{Name: status.JobName, Namespace: canaryTestNamespace}, job)).To(gomega.Succeed())
+	job.Status.Succeeded = 1
+	g.Expect(r.Status().Update(ctx, job)).To(gomega.Succeed())
+	_, handled = reconcile()
+	g.Expect(handled).To(gomega.BeFalse())
+	g.Expect(mlflow.Status.SQLiteMigration.Phase).To(gomega.Equal(mlflowv1.SQLiteMigrationPhaseSucceeded))
+	g.Expect(sqliteMigrationHolding(mlflow)).To(gomega.BeFalse())
+}
+
+func TestHandleSQLiteMigration_Disabled(t *testing.T) {
+	g := gomega.NewWithT(t)
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: ResourceName},
+		Spec:       mlflowv1.MLflowSpec{Migration: &mlflowv1.MLflowMigrationConfig{CopySQLite: new(bool)}},
+	}
+	r := newSQLiteMigrationTestReconciler(t, mlflow.DeepCopy(), sqliteLiveDeployment(t))
+
+	_, handled, err := r.handleSQLiteMigration(context.Background(), mlflow, canaryTestNamespace, blueGreenTestObjects(t, blueBackendURI), time.Now())
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(handled).To(gomega.BeFalse())
+	g.Expect(mlflow.Status.SQLiteMigration).To(gomega.BeNil())
+}
@@ -6,6 +6,7 @@ import (
 
 	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
 	"github.com/opendatahub-io/mlflow-operator/internal/config"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
 )
 
 const mlflowServicePort = 8443
@@ -16,25 +17,41 @@ func buildStatusURL(mlflowName, baseURL string, baseURLConfigured bool) string {
 		return ""
 	}
 
-	return fmt.Sprintf("%s/%s%s", baseURL, ResourceName, getResourceSuffix(mlflowName))
+	return fmt.Sprintf("%s/%s%s", baseURL, ResourceName, render.ResourceSuffix(mlflowName))
 }
 
-func buildStatusAddress(mlflowName, namespace string) *mlflowv1.MLflowAddressStatus {
+// buildPublicURL returns the external URL of mlflow: its dedicated hostname when
+// spec.routing.hostnames names one, otherwise the configured gateway URL. An instance served
+// at the root of wildcard hostnames only has no URL the operator can name.
+func buildPublicURL(mlflow *mlflowv1.MLflow, cfg *config.OperatorConfig) string {
+	if hostname := routeHostname(mlflow); hostname != "" {
+		return "https://" + hostname + routePathPrefix(mlflow)
+	}
+	if render.ServesAtRoot(mlflow) {
+		return ""
+	}
+	return buildStatusURL(mlflow.Name, cfg.MLflowURL, cfg.MLflowURLConfigured)
+}
+
+func buildStatusAddress(mlflow *mlflowv1.MLflow, namespace string) *mlflowv1.MLflowAddressStatus {
 	if namespace == "" {
 		return nil
 	}
 
-	serviceName := ResourceName + getResourceSuffix(mlflowName)
+	serviceName := ResourceName + render.ResourceSuffix(mlflow.Name)
 	return &mlflowv1.MLflowAddressStatus{
-		URL: fmt.Sprintf("https://%s.%s.svc:%d%s", serviceName, namespace, mlflowServicePort, StaticPrefix),
+		URL: fmt.Sprintf("https://%s.%s.svc:%d%s", serviceName, namespace, mlflowServicePort, render.ServerStaticPrefix(mlflow)),
 	}
 }
 
 func setObservedURLs(mlflow *mlflowv1.MLflow, namespace string, publicRouteAvailable bool, cfg *config.OperatorConfig) {
-	mlflow.Status.Address = buildStatusAddress(mlflow.Name, namespace)
+	mlflow.Status.Address = buildStatusAddress(mlflow, namespace)
 
-	if publicRouteAvailable && cfg != nil {
-		mlflow.Status.URL = buildStatusURL(mlflow.Name, cfg.MLflowURL, cfg.MLflowURLConfigured)
+	// An external reverse proxy does not depend on the operator-managed routes.
+	if externalURL := render.ServerExternalURL(mlflow); externalURL != "" {
+		mlflow.Status.URL = externalURL
+	} else if publicRouteAvailable && cfg != nil {
+		mlflow.Status.URL = buildPublicURL(mlflow, cfg)
 	} else {
 		mlflow.Status.URL = ""
 	}
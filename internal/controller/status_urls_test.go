@@ -1,12 +1,14 @@
 package controller
 
 import (
+	"strings"
 	"testing"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
 	"github.com/opendatahub-io/mlflow-operator/internal/config"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
 )
 
 func TestBuildStatusURL(t *testing.T) {
@@ -108,7 +110,7 @@ func TestBuildStatusAddress(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := buildStatusAddress(tt.mlflowName, tt.namespace)
+			got := buildStatusAddress(&mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: tt.mlflowName}}, tt.namespace)
 			if tt.wantNil {
 				if got != nil {
 					t.Fatalf("buildStatusAddress() = %#v, want nil", got)
@@ -161,4 +163,25 @@ func TestSetObservedURLs(t *testing.T) {
 			t.Fatalf("status.Address = %#v, want internal service URL", mlflow.Status.Address)
 		}
 	})
+
+	t.Run("external reverse proxy", func(t *testing.T) {
+		externalURL := "https://ml.example.com/mlflow/"
+		mlflow := &mlflowv1.MLflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+			Spec:       mlflowv1.MLflowSpec{Server: &mlflowv1.ServerConfig{ExternalURL: &externalURL}},
+		}
+		cfg := &config.OperatorConfig{MLflowURL: "https://gateway.example.com", MLflowURLConfigured: true}
+
+		setObservedURLs(mlflow, "opendatahub", false, cfg)
+
+		if mlflow.Status.URL != "https://ml.example.com/mlflow" {
+			t.Fatalf("status.URL = %q, want the external URL without a route", mlflow.Status.URL)
+		}
+		if href := buildConsoleLink(mlflow, cfg).Spec.Href; href != "https://ml.example.com/mlflow" {
+			t.Fatalf("ConsoleLink href = %q, want the external URL", href)
+		}
+		if origins := render.BuildCORSAllowedOrigins(mlflow, "opendatahub", cfg); !strings.HasSuffix(origins, ",https://gateway.example.com,https://ml.example.com") {
+			t.Fatalf("CORS origins = %q, want the external origin", origins)
+		}
+	})
 }
@@ -0,0 +1,72 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+const (
+	suspendedConditionType = "Suspended"
+	suspendedReason        = "Suspended"
+	resumedReason          = "Resumed"
+)
+
+// specSuspended reports whether spec.suspend hibernates the MLflow instance.
+func specSuspended(mlflow *mlflowv1.MLflow) bool {
+	return mlflow.Spec.Suspend != nil && *mlflow.Spec.Suspend
+}
+
+// setSuspendedConditions records that the instance is hibernated. Available and
+// Progressing are both False so consumers do not wait on a rollout that will not happen.
+func setSuspendedConditions(mlflow *mlflowv1.MLflow, message string) {
+	meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+		Type:    suspendedConditionType,
+		Status:  metav1.ConditionTrue,
+		Reason:  suspendedReason,
+		Message: message,
+	})
+	meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+		Type:    "Available",
+		Status:  metav1.ConditionFalse,
+		Reason:  suspendedReason,
+		Message: message,
+	})
+	meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+		Type:    "Progressing",
+		Status:  metav1.ConditionFalse,
+		Reason:  suspendedReason,
+		Message: message,
+	})
+}
+
+// clearSuspendedCondition flips a previously recorded Suspended condition to False
+// once the instance is resumed. Instances that were never suspended get no condition.
+func clearSuspendedCondition(mlflow *mlflowv1.MLflow) {
+	if meta.FindStatusCondition(mlflow.Status.Conditions, suspendedConditionType) == nil {
+		return
+	}
+	meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+		Type:    suspendedConditionType,
+		Status:  metav1.ConditionFalse,
+		Reason:  resumedReason,
+		Message: "MLflow instance resumed",
+	})
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	gomega "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func TestSuspendedConditions(t *testing.T) {
+	g := gomega.NewWithT(t)
+	mlflow := &mlflowv1.MLflow{}
+
+	// Never-suspended instances do not grow a Suspended condition.
+	clearSuspendedCondition(mlflow)
+	g.Expect(meta.FindStatusCondition(mlflow.Status.Conditions, suspendedConditionType)).To(gomega.BeNil())
+
+	setSuspendedConditions(mlflow, "suspended")
+	g.Expect(meta.IsStatusConditionTrue(mlflow.Status.Conditions, suspendedConditionType)).To(gomega.BeTrue())
+	available := meta.FindStatusCondition(mlflow.Status.Conditions, "Available")
+	g.Expect(available).NotTo(gomega.BeNil())
+	g.Expect(available.Status).To(gomega.Equal(metav1.ConditionFalse))
+	g.Expect(available.Reason).To(gomega.Equal(suspendedReason))
+
+	clearSuspendedCondition(mlflow)
+	resumed := meta.FindStatusCondition(mlflow.Status.Conditions, suspendedConditionType)
+	g.Expect(resumed).NotTo(gomega.BeNil())
+	g.Expect(resumed.Status).To(gomega.Equal(metav1.ConditionFalse))
+	g.Expect(resumed.Reason).To(gomega.Equal(resumedReason))
+}
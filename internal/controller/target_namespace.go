@@ -0,0 +1,127 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+const (
+	// targetNamespaceNotReadyReason is the Available condition reason for a target namespace
+	// that cannot host the MLflow pods.
+	targetNamespaceNotReadyReason = "TargetNamespaceNotReady"
+
+	podSecurityEnforceLabel = "pod-security.kubernetes.io/enforce"
+)
+
+// podSecurityLevels are the Pod Security Admission levels, from least to most restrictive.
+var podSecurityLevels = []string{"privileged", "baseline", "restricted"}
+
+// checkTargetNamespace returns the target namespace and why it cannot host the MLflow pods,
+// or "" when it can. The namespace is nil when it does not exist. It is read uncached: it is
+// one object, and caching every Namespace for it would cost more than the request. In
+// namespaced install mode Namespaces cannot be read, so the check is skipped.
+func (r *MLflowReconciler) checkTargetNamespace(
+	ctx context.Context, mlflow *mlflowv1.MLflow, targetNamespace string,
+) (*corev1.Namespace, string, error) {
+	if r.Namespaced {
+		return nil, "", nil
+	}
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("v1")
+	obj.SetKind("Namespace")
+	if err := r.Get(ctx, types.NamespacedName{Name: targetNamespace}, obj); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, fmt.Sprintf("Target namespace %q does not exist; create it or point APPLICATIONS_NAMESPACE at an existing namespace", targetNamespace), nil
+		}
+		return nil, "", fmt.Errorf("failed to get target namespace %q: %w", targetNamespace, err)
+	}
+	namespace := &corev1.Namespace{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, namespace); err != nil {
+		return nil, "", fmt.Errorf("failed to convert target namespace %q: %w", targetNamespace, err)
+	}
+	return namespace, targetNamespaceProblem(mlflow, namespace), nil
+}
+
+// targetNamespaceProblem checks that namespace is active and that its Pod Security
+// Admission enforce level admits the MLflow pods. The chart defaults meet the restricted
+// level, so only spec.podSecurityContext and spec.securityContext overrides can break it.
+func targetNamespaceProblem(mlflow *mlflowv1.MLflow, namespace *corev1.Namespace) string {
+	if namespace.Status.Phase == corev1.NamespaceTerminating || namespace.DeletionTimestamp != nil {
+		return fmt.Sprintf("Target namespace %q is being deleted", namespace.Name)
+	}
+
+	level, labeled := namespace.Labels[podSecurityEnforceLabel]
+	if !labeled {
+		return ""
+	}
+	if !slices.Contains(podSecurityLevels, level) {
+		return fmt.Sprintf("Target namespace %q has invalid label %s=%q; use one of %v", namespace.Name, podSecurityEnforceLabel, level, podSecurityLevels)
+	}
+	if violation := podSecurityViolation(mlflow, level); violation != "" {
+		return fmt.Sprintf("Target namespace %q enforces the %s pod security level, which rejects the MLflow pods: %s; relabel the namespace or relax the override",
+			namespace.Name, level, violation)
+	}
+	return ""
+}
+
+// podSecurityViolation returns the first spec security override that the given Pod Security
+// level rejects. It covers the controls the overrides can reach, not the full standard.
+func podSecurityViolation(mlflow *mlflowv1.MLflow, level string) string {
+	if level == "privileged" {
+		return ""
+	}
+	container := mlflow.Spec.SecurityContext
+	if container != nil && container.Privileged != nil && *container.Privileged {
+		return "securityContext.privileged is true"
+	}
+	if level == "baseline" {
+		return ""
+	}
+
+	pod := mlflow.Spec.PodSecurityContext
+	switch {
+	case container != nil && container.AllowPrivilegeEscalation != nil && *container.AllowPrivilegeEscalation:
+		return "securityContext.allowPrivilegeEscalation is true"
+	case container != nil && container.RunAsNonRoot != nil && !*container.RunAsNonRoot:
+		return "securityContext.runAsNonRoot is false"
+	case pod != nil && pod.RunAsNonRoot != nil && !*pod.RunAsNonRoot:
+		return "podSecurityContext.runAsNonRoot is false"
+	case container != nil && container.RunAsUser != nil && *container.RunAsUser == 0:
+		return "securityContext.runAsUser is 0"
+	case pod != nil && pod.RunAsUser != nil && *pod.RunAsUser == 0:
+		return "podSecurityContext.runAsUser is 0"
+	}
+	if container != nil && container.Capabilities != nil {
+		for _, capability := range container.Capabilities.Add {
+			if capability != "NET_BIND_SERVICE" {
+				return fmt.Sprintf("securityContext.capabilities.add includes %s", capability)
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func TestTargetNamespaceProblem(t *testing.T) {
+	g := gomega.NewWithT(t)
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "mlflow"}}
+	namespace := func(labels map[string]string) *corev1.Namespace {
+		return &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: "opendatahub", Labels: labels},
+			Status:     corev1.NamespaceStatus{Phase: corev1.NamespaceActive},
+		}
+	}
+
+	g.Expect(targetNamespaceProblem(mlflow, namespace(nil))).To(gomega.BeEmpty())
+	g.Expect(targetNamespaceProblem(mlflow, namespace(map[string]string{podSecurityEnforceLabel: "restricted"}))).To(gomega.BeEmpty(),
+		"the chart defaults meet the restricted level")
+	g.Expect(targetNamespaceProblem(mlflow, namespace(map[string]string{podSecurityEnforceLabel: "strict"}))).To(
+		gomega.ContainSubstring(`invalid label pod-security.kubernetes.io/enforce="strict"`))
+
+	terminating := namespace(nil)
+	terminating.Status.Phase = corev1.NamespaceTerminating
+	g.Expect(targetNamespaceProblem(mlflow, terminating)).To(gomega.ContainSubstring("is being deleted"))
+
+	mlflow.Spec.PodSecurityContext = &corev1.PodSecurityContext{RunAsUser: ptr(int64(0))}
+	g.Expect(targetNamespaceProblem(mlflow, namespace(map[string]string{podSecurityEnforceLabel: "restricted"}))).To(
+		gomega.ContainSubstring("enforces the restricted pod security level, which rejects the MLflow pods: podSecurityContext.runAsUser is 0"))
+	g.Expect(targetNamespaceProblem(mlflow, namespace(map[string]string{podSecurityEnforceLabel: "baseline"}))).To(gomega.BeEmpty())
+
+	mlflow.Spec.SecurityContext = &corev1.SecurityContext{Privileged: ptr(true)}
+	g.Expect(targetNamespaceProblem(mlflow, namespace(map[string]string{podSecurityEnforceLabel: "baseline"}))).To(
+		gomega.ContainSubstring("securityContext.privileged is true"))
+	g.Expect(targetNamespaceProblem(mlflow, namespace(map[string]string{podSecurityEnforceLabel: "privileged"}))).To(gomega.BeEmpty())
+}
+
+func TestCheckTargetNamespace(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(gomega.Succeed())
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "opendatahub"},
+	}).Build()
+	r := &MLflowReconciler{Client: k8sClient, Scheme: scheme}
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "mlflow"}}
+
+	namespace, problem, err := r.checkTargetNamespace(context.Background(), mlflow, "opendatahub")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(problem).To(gomega.BeEmpty())
+	g.Expect(namespace.Name).To(gomega.Equal("opendatahub"))
+
+	namespace, problem, err = r.checkTargetNamespace(context.Background(), mlflow, "missing")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(namespace).To(gomega.BeNil())
+	g.Expect(problem).To(gomega.ContainSubstring(`Target namespace "missing" does not exist`))
+
+	// Namespaced installs cannot read Namespaces, so the check is skipped.
+	r.Namespaced = true
+	namespace, problem, err = r.checkTargetNamespace(context.Background(), mlflow, "missing")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(namespace).To(gomega.BeNil())
+	g.Expect(problem).To(gomega.BeEmpty())
+}
@@ -0,0 +1,223 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
+)
+
+// telemetrySchemePattern limits reported store types to plain URI schemes, so a malformed
+// URI can never leak part of a path or credential into a report.
+var telemetrySchemePattern = regexp.MustCompile(`^[a-z][a-z0-9-]{0,31}$`)
+
+// telemetryReport is the anonymous usage report. It only carries counts keyed by a fixed
+// vocabulary of store types and feature names; no names, namespaces, or URIs.
+type telemetryReport struct {
+	MLflowVersion  string         `json:"mlflowVersion"`
+	Instances      int            `json:"instances"`
+	BackendStores  map[string]int `json:"backendStores"`
+	ArtifactStores map[string]int `json:"artifactStores"`
+	Storage        map[string]int `json:"storage"`
+	Features       map[string]int `json:"features"`
+}
+
+// TelemetryReporter periodically posts an anonymous usage report to Endpoint. Add it to the
+// manager only when telemetry is enabled: it runs on the leader, so a cluster reports once
+// per interval however many replicas it runs.
+type TelemetryReporter struct {
+	// Client lists the MLflow instances to report on.
+	Client client.Reader
+	// Endpoint receives each report as a JSON POST.
+	Endpoint string
+	// Interval is the time between reports.
+	Interval time.Duration
+
+	// client performs report requests; nil uses a client with notificationTimeout.
+	client *http.Client
+}
+
+// NewTelemetryReporter returns a reporter that posts to endpoint every interval.
+func NewTelemetryReporter(c client.Reader, endpoint string, interval time.Duration) *TelemetryReporter {
+	return &TelemetryReporter{Client: c, Endpoint: endpoint, Interval: interval}
+}
+
+// Start reports once right away and then every Interval until ctx is cancelled. Delivery is
+// best effort: failures are logged and retried at the next interval.
+func (t *TelemetryReporter) Start(ctx context.Context) error {
+	log := logf.FromContext(ctx).WithName("telemetry")
+	ticker := time.NewTicker(t.Interval)
+	defer ticker.Stop()
+	for {
+		if err := t.report(ctx); err != nil {
+			log.Error(err, "Failed to send usage telemetry")
+		} else {
+			log.V(1).Info("Sent usage telemetry", "endpoint", t.Endpoint)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// NeedLeaderElection makes the manager start the reporter only on the leader.
+func (t *TelemetryReporter) NeedLeaderElection() bool {
+	return true
+}
+
+func (t *TelemetryReporter) report(ctx context.Context) error {
+	list := &mlflowv1.MLflowList{}
+	if err := t.Client.List(ctx, list); err != nil {
+		return fmt.Errorf("failed to list MLflow instances: %w", err)
+	}
+	body, err := json.Marshal(buildTelemetryReport(list.Items))
+	if err != nil {
+		return fmt.Errorf("failed to render usage telemetry: %w", err)
+	}
+
+	httpClient := t.client
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: notificationTimeout}
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build telemetry request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post usage telemetry: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("telemetry endpoint returned %s", resp.Status)
+	}
+	return nil
+}
+
+// buildTelemetryReport counts the instances in items by store type, storage mode, and the
+// optional features they enable. Instances being deleted are left out.
+func buildTelemetryReport(items []mlflowv1.MLflow) *telemetryReport {
+	report := &telemetryReport{
+		MLflowVersion:  config.SupportedMLflowVersion,
+		BackendStores:  map[string]int{},
+		ArtifactStores: map[string]int{},
+		Storage:        map[string]int{},
+		Features:       map[string]int{},
+	}
+	for i := range items {
+		mlflow := &items[i]
+		if mlflow.DeletionTimestamp != nil {
+			continue
+		}
+		report.Instances++
+		report.BackendStores[telemetryBackendStore(mlflow)]++
+		report.ArtifactStores[telemetryArtifactStore(mlflow)]++
+		report.Storage[telemetryStorage(mlflow)]++
+
+		features := map[string]bool{
+			"artifactsServer":  render.ArtifactsServerEnabled(mlflow),
+			"registryServer":   render.RegistryServerEnabled(mlflow),
+			"workspaces":       render.WorkspacesEnabled(mlflow),
+			"workspaceRoutes":  workspaceRoutesEnabled(mlflow),
+			"serviceMesh":      render.ServiceMeshEnabled(mlflow),
+			"canary":           canaryStrategyEnabled(mlflow),
+			"blueGreen":        blueGreenStrategyEnabled(mlflow),
+			"highAvailability": mlflow.Spec.HighAvailability != nil,
+			"hibernation":      mlflow.Spec.Hibernation != nil,
+			"notifications":    mlflow.Spec.Notifications != nil,
+		}
+		for name, enabled := range features {
+			if enabled {
+				report.Features[name]++
+			}
+		}
+	}
+	return report
+}
+
+// telemetryBackendStore reports the database type of the backend store: the URI scheme
+// without its driver suffix, or "secret" when the URI is only known to the Secret.
+func telemetryBackendStore(mlflow *mlflowv1.MLflow) string {
+	if mlflow.Spec.BackendStoreURIFrom != nil {
+		return "secret"
+	}
+	if mlflow.Spec.BackendStoreURI == nil || *mlflow.Spec.BackendStoreURI == "" {
+		return "unset"
+	}
+	return telemetryScheme(*mlflow.Spec.BackendStoreURI)
+}
+
+// telemetryArtifactStore reports the scheme of the location the server writes artifacts to
+// when it proxies them, and of the default artifact root otherwise.
+func telemetryArtifactStore(mlflow *mlflowv1.MLflow) string {
+	if mlflow.Spec.ServeArtifacts != nil && *mlflow.Spec.ServeArtifacts {
+		if mlflow.Spec.ArtifactsDestination == nil || *mlflow.Spec.ArtifactsDestination == "" {
+			return "file"
+		}
+		return telemetryScheme(*mlflow.Spec.ArtifactsDestination)
+	}
+	if mlflow.Spec.DefaultArtifactRoot == nil || *mlflow.Spec.DefaultArtifactRoot == "" {
+		return "unset"
+	}
+	return telemetryScheme(*mlflow.Spec.DefaultArtifactRoot)
+}
+
+func telemetryStorage(mlflow *mlflowv1.MLflow) string {
+	storage := mlflow.Spec.Storage
+	switch {
+	case storage == nil:
+		return "none"
+	case storage.Ephemeral:
+		return "ephemeral"
+	case storage.ExistingClaim != "":
+		return "existingClaim"
+	default:
+		return "pvc"
+	}
+}
+
+// telemetryScheme returns the scheme of uri, e.g. "postgresql" for
+// "postgresql+psycopg2://...". Paths without a scheme are local files.
+func telemetryScheme(uri string) string {
+	scheme, _, found := strings.Cut(uri, ":")
+	if !found {
+		return "file"
+	}
+	scheme, _, _ = strings.Cut(strings.ToLower(scheme), "+")
+	if !telemetrySchemePattern.MatchString(scheme) {
+		return "other"
+	}
+	return scheme
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+const (
+	// tlsSecretPendingConditionType reports that the serving certificate Secret mounted by the
+	// MLflow pods does not exist yet, typically while the OpenShift service CA issues it.
+	tlsSecretPendingConditionType = "TLSSecretPending"
+	tlsSecretPendingReason        = "TLSSecretPending"
+
+	// tlsSecretPollInterval is how often a held instance checks for the Secret. The Secret
+	// cache only holds labeled Secrets, so the issued one does not trigger a reconcile.
+	tlsSecretPollInterval = 10 * time.Second
+)
+
+// holdDeploymentsForTLSSecret drops the rendered Deployments that mount the TLS Secret from
+// objects while the Secret does not exist and the Deployment has not been created yet, so a
+// fresh install does not start pods that cannot mount their certificate. The Services are
+// still applied, which is what makes service-ca issue the Secret. Deployments that already
+// exist are kept: their running pods are unaffected and new ones start once the Secret
+// appears. It returns the remaining objects and the names of the held Deployments, and sets
+// or clears the TLSSecretPending condition.
+func (r *MLflowReconciler) holdDeploymentsForTLSSecret(
+	ctx context.Context,
+	mlflow *mlflowv1.MLflow,
+	namespace string,
+	objects []*unstructured.Unstructured,
+) ([]*unstructured.Unstructured, []string, error) {
+	// Read unstructured so the API server is asked directly; service-ca does not label the
+	// Secret, so the Secret cache never holds it.
+	secret := &unstructured.Unstructured{}
+	secret.SetAPIVersion("v1")
+	secret.SetKind("Secret")
+	err := r.Get(ctx, types.NamespacedName{Name: TLSSecretName, Namespace: namespace}, secret)
+	if err == nil {
+		meta.RemoveStatusCondition(&mlflow.Status.Conditions, tlsSecretPendingConditionType)
+		return objects, nil, nil
+	}
+	if !errors.IsNotFound(err) {
+		return nil, nil, fmt.Errorf("failed to get TLS Secret %s: %w", TLSSecretName, err)
+	}
+
+	var kept []*unstructured.Unstructured
+	var held []string
+	for _, obj := range objects {
+		if obj.GetKind() != "Deployment" || !mountsTLSSecret(obj) {
+			kept = append(kept, obj)
+			continue
+		}
+		existing := &appsv1.Deployment{}
+		err := r.Get(ctx, types.NamespacedName{Name: obj.GetName(), Namespace: namespace}, existing)
+		if err == nil {
+			kept = append(kept, obj)
+			continue
+		}
+		if !errors.IsNotFound(err) {
+			return nil, nil, fmt.Errorf("failed to get Deployment %s: %w", obj.GetName(), err)
+		}
+		held = append(held, obj.GetName())
+	}
+
+	message := fmt.Sprintf("TLS Secret %s does not exist in namespace %s yet", TLSSecretName, namespace)
+	if r.ConsoleLinkAvailable {
+		message += "; the OpenShift service CA issues it for the MLflow Service"
+	}
+	switch len(held) {
+	case 0:
+	case 1:
+		message += fmt.Sprintf(". Deployment %s is created once it exists", held[0])
+	default:
+		message += fmt.Sprintf(". Deployments %s are created once it exists", strings.Join(held, ", "))
+	}
+	meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+		Type:               tlsSecretPendingConditionType,
+		Status:             metav1.ConditionTrue,
+		Reason:             tlsSecretPendingReason,
+		Message:            message,
+		ObservedGeneration: mlflow.Generation,
+	})
+	return kept, held, nil
+}
+
+// mountsTLSSecret reports whether the pod template of deployment mounts the TLS Secret.
+func mountsTLSSecret(deployment *unstructured.Unstructured) bool {
+	volumes, _, _ := unstructured.NestedSlice(deployment.Object, "spec", "template", "spec", "volumes")
+	for _, v := range volumes {
+		volume, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if name, _, _ := unstructured.NestedString(volume, "secret", "secretName"); name == TLSSecretName {
+			return true
+		}
+	}
+	return false
+}
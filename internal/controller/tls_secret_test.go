@@ -0,0 +1,81 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func tlsTestDeployment(name string) *appsv1.Deployment {
+	deployment := quotaTestDeployment(1, "1Gi")
+	deployment.Name = name
+	deployment.Spec.Template.Spec.Volumes = []corev1.Volume{{
+		Name:         "mlflow-tls",
+		VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: TLSSecretName}},
+	}}
+	return deployment
+}
+
+func TestHoldDeploymentsForTLSSecret(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: ResourceName}}
+	service := &corev1.Service{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Service"},
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow", Namespace: quotaTestNamespace},
+	}
+	rendered := func() []*unstructured.Unstructured {
+		return toUnstructuredObjects(g, service, tlsTestDeployment("mlflow"), tlsTestDeployment("mlflow-artifacts"))
+	}
+
+	// Fresh install: the Service is applied so service-ca can issue the Secret, the
+	// Deployments wait for it.
+	r := newTestReconciler(t)
+	kept, held, err := r.holdDeploymentsForTLSSecret(ctx, mlflow, quotaTestNamespace, rendered())
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(kept).To(gomega.HaveLen(1))
+	g.Expect(kept[0].GetKind()).To(gomega.Equal("Service"))
+	g.Expect(held).To(gomega.Equal([]string{"mlflow", "mlflow-artifacts"}))
+	condition := meta.FindStatusCondition(mlflow.Status.Conditions, tlsSecretPendingConditionType)
+	g.Expect(condition).NotTo(gomega.BeNil())
+	g.Expect(condition.Message).To(gomega.ContainSubstring("Deployments mlflow, mlflow-artifacts are created once it exists"))
+
+	// Existing Deployments keep being applied.
+	r = newTestReconciler(t, tlsTestDeployment("mlflow"))
+	kept, held, err = r.holdDeploymentsForTLSSecret(ctx, mlflow, quotaTestNamespace, rendered())
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(kept).To(gomega.HaveLen(2))
+	g.Expect(held).To(gomega.Equal([]string{"mlflow-artifacts"}))
+
+	// Once the Secret exists nothing is held and the condition goes away.
+	r = newTestReconciler(t, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: TLSSecretName, Namespace: quotaTestNamespace}})
+	kept, held, err = r.holdDeploymentsForTLSSecret(ctx, mlflow, quotaTestNamespace, rendered())
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(kept).To(gomega.HaveLen(3))
+	g.Expect(held).To(gomega.BeEmpty())
+	g.Expect(meta.FindStatusCondition(mlflow.Status.Conditions, tlsSecretPendingConditionType)).To(gomega.BeNil())
+}
@@ -0,0 +1,288 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
+)
+
+const (
+	defaultUsageStatisticsInterval = time.Hour
+	minUsageStatisticsInterval     = 5 * time.Minute
+	// usageCollectionTimeout bounds one collection, which runs inside a reconcile.
+	usageCollectionTimeout = 2 * time.Minute
+	usageRecentRunsWindow  = 24 * time.Hour
+
+	// Search responses carry tags, params, and metrics, and mlflowAPI reads at most 1 MiB of
+	// each, so pages stay small.
+	usageExperimentsPageSize = 200
+	usageRunsPageSize        = 50
+	usageModelsPageSize      = 100
+	// usageRunsExperimentBatch is the number of experiments one runs search covers.
+	usageRunsExperimentBatch = 100
+)
+
+// usageCollector remembers when collection was last attempted for each instance, so a failing
+// collection is retried on schedule rather than on every reconcile.
+type usageCollector struct {
+	mu           sync.Mutex
+	lastAttempts map[string]time.Time
+}
+
+func (c *usageCollector) lastAttempt(name string) (time.Time, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	at, ok := c.lastAttempts[name]
+	return at, ok
+}
+
+func (c *usageCollector) record(name string, at time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.lastAttempts == nil {
+		c.lastAttempts = map[string]time.Time{}
+	}
+	c.lastAttempts[name] = at
+}
+
+// forget drops the attempt record and the usage metrics of an instance.
+func (c *usageCollector) forget(name string) {
+	c.mu.Lock()
+	delete(c.lastAttempts, name)
+	c.mu.Unlock()
+	usageExperiments.DeleteLabelValues(name)
+	usageRunsLast24h.DeleteLabelValues(name)
+	usageRegisteredModels.DeleteLabelValues(name)
+}
+
+func usageStatisticsEnabled(mlflow *mlflowv1.MLflow) bool {
+	return mlflow.Spec.UsageStatistics != nil && mlflow.Spec.UsageStatistics.Enabled
+}
+
+// usageStatisticsInterval returns spec.usageStatistics.interval, raised to the minimum.
+func usageStatisticsInterval(mlflow *mlflowv1.MLflow) time.Duration {
+	interval := defaultUsageStatisticsInterval
+	if stats := mlflow.Spec.UsageStatistics; stats != nil && stats.Interval != nil && stats.Interval.Duration > 0 {
+		interval = stats.Interval.Duration
+	}
+	return max(interval, minUsageStatisticsInterval)
+}
+
+// reconcileUsageStatistics collects status.usage from a ready server when the interval has
+// passed since the last attempt, and returns when the next collection is due, or zero when
+// none is scheduled. A failed collection keeps the previous counts.
+func (r *MLflowReconciler) reconcileUsageStatistics(ctx context.Context, mlflow *mlflowv1.MLflow, now time.Time) time.Duration {
+	if !usageStatisticsEnabled(mlflow) {
+		mlflow.Status.Usage = nil
+		r.usageCollector.forget(mlflow.Name)
+		return 0
+	}
+	if mlflow.Status.Address == nil {
+		return 0
+	}
+
+	interval := usageStatisticsInterval(mlflow)
+	last, attempted := r.usageCollector.lastAttempt(mlflow.Name)
+	if usage := mlflow.Status.Usage; usage != nil && usage.LastUpdateTime.After(last) {
+		last, attempted = usage.LastUpdateTime.Time, true
+	}
+	if attempted {
+		if remaining := last.Add(interval).Sub(now); remaining > 0 {
+			return remaining
+		}
+	}
+
+	r.usageCollector.record(mlflow.Name, now)
+	usage, err := r.collectUsage(ctx, mlflow, now)
+	if err != nil {
+		logf.FromContext(ctx).Info("MLflow usage statistics collection failed", "error", err.Error())
+		return interval
+	}
+	mlflow.Status.Usage = usage
+	usageExperiments.WithLabelValues(mlflow.Name).Set(float64(usage.Experiments))
+	usageRunsLast24h.WithLabelValues(mlflow.Name).Set(float64(usage.RunsLast24h))
+	usageRegisteredModels.WithLabelValues(mlflow.Name).Set(float64(usage.RegisteredModels))
+	return interval
+}
+
+// collectUsage counts the experiments, recent runs, and registered models of every workspace.
+func (r *MLflowReconciler) collectUsage(ctx context.Context, mlflow *mlflowv1.MLflow, now time.Time) (*mlflowv1.UsageStatus, error) {
+	ctx, cancel := context.WithTimeout(ctx, usageCollectionTimeout)
+	defer cancel()
+
+	usage := &mlflowv1.UsageStatus{LastUpdateTime: metav1.NewTime(now)}
+	workspaces := []string{""}
+	if render.WorkspacesEnabled(mlflow) {
+		var err error
+		if workspaces, err = r.usageWorkspaces(ctx, mlflow); err != nil {
+			return nil, err
+		}
+		usage.Workspaces = int32(len(workspaces))
+	}
+
+	api := mlflowAPI{
+		client:  r.healthProber.httpClient(),
+		token:   r.healthProber.token(),
+		baseURL: mlflow.Status.Address.URL,
+	}
+	since := now.Add(-usageRecentRunsWindow)
+	for _, workspace := range workspaces {
+		api.workspace = workspace
+		if err := countUsage(ctx, api, since, usage); err != nil {
+			if workspace == "" {
+				return nil, err
+			}
+			return nil, fmt.Errorf("workspace %q: %w", workspace, err)
+		}
+	}
+	return usage, nil
+}
+
+// usageWorkspaces returns the namespaces the Kubernetes workspace store serves as workspaces:
+// every Namespace, or those matching spec.workspaceLabelSelector.
+func (r *MLflowReconciler) usageWorkspaces(ctx context.Context, mlflow *mlflowv1.MLflow) ([]string, error) {
+	if r.Namespaced {
+		return nil, errors.New("workspaces cannot be listed in namespaced install mode, which has no access to Namespaces")
+	}
+	if render.WorkspaceStoreURI(mlflow) != render.DefaultWorkspaceStoreURI {
+		return nil, errors.New("workspaces can only be listed with the Kubernetes workspace store")
+	}
+	var opts []client.ListOption
+	if mlflow.Spec.WorkspaceLabelSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(mlflow.Spec.WorkspaceLabelSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid workspaceLabelSelector: %w", err)
+		}
+		opts = append(opts, client.MatchingLabelsSelector{Selector: selector})
+	}
+	// Read uncached, like the target namespace check: one list per interval costs less than
+	// caching every Namespace.
+	list := &unstructured.UnstructuredList{}
+	list.SetAPIVersion("v1")
+	list.SetKind("NamespaceList")
+	if err := r.List(ctx, list, opts...); err != nil {
+		return nil, fmt.Errorf("failed to list workspace namespaces: %w", err)
+	}
+	names := make([]string, 0, len(list.Items))
+	for _, namespace := range list.Items {
+		names = append(names, namespace.GetName())
+	}
+	slices.Sort(names)
+	return names, nil
+}
+
+// countUsage adds the counts of the workspace of api to usage.
+func countUsage(ctx context.Context, api mlflowAPI, since time.Time, usage *mlflowv1.UsageStatus) error {
+	var experimentIDs []string
+	err := paginate(func(pageToken string) (string, error) {
+		var page struct {
+			Experiments []struct {
+				ExperimentID string `json:"experiment_id"`
+			} `json:"experiments"`
+			NextPageToken string `json:"next_page_token"`
+		}
+		request := map[string]interface{}{"max_results": usageExperimentsPageSize}
+		if pageToken != "" {
+			request["page_token"] = pageToken
+		}
+		if err := api.call(ctx, http.MethodPost, "/api/2.0/mlflow/experiments/search", nil, request, &page); err != nil {
+			return "", err
+		}
+		for _, experiment := range page.Experiments {
+			experimentIDs = append(experimentIDs, experiment.ExperimentID)
+		}
+		return page.NextPageToken, nil
+	})
+	if err != nil {
+		return err
+	}
+	usage.Experiments += int64(len(experimentIDs))
+
+	filter := "attributes.start_time >= " + strconv.FormatInt(since.UnixMilli(), 10)
+	for batch := range slices.Chunk(experimentIDs, usageRunsExperimentBatch) {
+		err := paginate(func(pageToken string) (string, error) {
+			var page struct {
+				Runs          []struct{} `json:"runs"`
+				NextPageToken string     `json:"next_page_token"`
+			}
+			request := map[string]interface{}{
+				"experiment_ids": batch,
+				"filter":         filter,
+				"max_results":    usageRunsPageSize,
+			}
+			if pageToken != "" {
+				request["page_token"] = pageToken
+			}
+			if err := api.call(ctx, http.MethodPost, "/api/2.0/mlflow/runs/search", nil, request, &page); err != nil {
+				return "", err
+			}
+			usage.RunsLast24h += int64(len(page.Runs))
+			return page.NextPageToken, nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return paginate(func(pageToken string) (string, error) {
+		var page struct {
+			RegisteredModels []struct{} `json:"registered_models"`
+			NextPageToken    string     `json:"next_page_token"`
+		}
+		query := url.Values{"max_results": {strconv.Itoa(usageModelsPageSize)}}
+		if pageToken != "" {
+			query.Set("page_token", pageToken)
+		}
+		if err := api.call(ctx, http.MethodGet, "/api/2.0/mlflow/registered-models/search", query, nil, &page); err != nil {
+			return "", err
+		}
+		usage.RegisteredModels += int64(len(page.RegisteredModels))
+		return page.NextPageToken, nil
+	})
+}
+
+// paginate calls fetch with the page token it returned last, starting with none, until it
+// returns no token. A repeated token ends the loop too, so a server bug cannot spin it.
+func paginate(fetch func(pageToken string) (string, error)) error {
+	var token string
+	for {
+		next, err := fetch(token)
+		if err != nil {
+			return err
+		}
+		if next == "" || next == token {
+			return nil
+		}
+		token = next
+	}
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+// fakeUsageWorkspace holds the objects of one workspace served by fakeUsageAPI.
+type fakeUsageWorkspace struct {
+	experiments, recentRuns, models int
+}
+
+// fakeUsageAPI serves the search endpoints usage statistics use, paging with offsets.
+type fakeUsageAPI struct {
+	workspaces map[string]fakeUsageWorkspace
+	failing    atomic.Bool
+	requests   atomic.Int32
+}
+
+func (f *fakeUsageAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.requests.Add(1)
+	if f.failing.Load() {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		return
+	}
+	workspace := f.workspaces[r.Header.Get(workspaceHeader)]
+	var body map[string]interface{}
+	_ = json.NewDecoder(r.Body).Decode(&body)
+	token, _ := body["page_token"].(string)
+	pageSize, _ := body["max_results"].(float64)
+	if r.Method == http.MethodGet {
+		token = r.URL.Query().Get("page_token")
+		size, _ := strconv.Atoi(r.URL.Query().Get("max_results"))
+		pageSize = float64(size)
+	}
+	page := func(key string, total int) {
+		offset, _ := strconv.Atoi(token)
+		end := min(offset+int(pageSize), total)
+		items := make([]map[string]string, 0, end-offset)
+		for i := offset; i < end; i++ {
+			items = append(items, map[string]string{"experiment_id": strconv.Itoa(i)})
+		}
+		response := map[string]interface{}{key: items}
+		if end < total {
+			response["next_page_token"] = strconv.Itoa(end)
+		}
+		_ = json.NewEncoder(w).Encode(response)
+	}
+
+	switch r.URL.Path {
+	case "/mlflow/api/2.0/mlflow/experiments/search":
+		page("experiments", workspace.experiments)
+	case "/mlflow/api/2.0/mlflow/runs/search":
+		// Every recent run belongs to the first experiment.
+		ids, _ := body["experiment_ids"].([]interface{})
+		filter, _ := body["filter"].(string)
+		runs := 0
+		if len(ids) > 0 && ids[0] == "0" && filter != "" {
+			runs = workspace.recentRuns
+		}
+		page("runs", runs)
+	case "/mlflow/api/2.0/mlflow/registered-models/search":
+		page("registered_models", workspace.models)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// usageGaugeValue returns the value of the series of a registered usage gauge for the
+// singleton instance, and whether it exists.
+func usageGaugeValue(t *testing.T, name string) (float64, bool) {
+	t.Helper()
+	families, err := metrics.Registry.Gather()
+	if err != nil {
+		t.Fatalf("gather metrics: %v", err)
+	}
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+		for _, m := range family.GetMetric() {
+			for _, lp := range m.GetLabel() {
+				if lp.GetName() == "name" && lp.GetValue() == ResourceName {
+					return m.GetGauge().GetValue(), true
+				}
+			}
+		}
+	}
+	return 0, false
+}
+
+func TestReconcileUsageStatistics(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+	api := &fakeUsageAPI{workspaces: map[string]fakeUsageWorkspace{
+		"team-a": {experiments: 250, recentRuns: 120, models: 3},
+		"team-b": {experiments: 1, models: 150},
+		"system": {experiments: 7},
+	}}
+	r, server := newBootstrapTestReconciler(t, api)
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(gomega.Succeed())
+	r.Client = fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: map[string]string{"mlflow": "true"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-b", Labels: map[string]string{"mlflow": "true"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "system"}},
+	).Build()
+
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: ResourceName},
+		Spec: mlflowv1.MLflowSpec{
+			UsageStatistics:        &mlflowv1.UsageStatisticsConfig{Enabled: true},
+			WorkspaceLabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"mlflow": "true"}},
+		},
+		Status: mlflowv1.MLflowStatus{Address: &mlflowv1.MLflowAddressStatus{URL: server.URL + StaticPrefix}},
+	}
+	now := time.Now().Truncate(time.Second)
+
+	g.Expect(r.reconcileUsageStatistics(ctx, mlflow, now)).To(gomega.Equal(defaultUsageStatisticsInterval))
+	g.Expect(mlflow.Status.Usage).To(gomega.Equal(&mlflowv1.UsageStatus{
+		Experiments:      251,
+		RunsLast24h:      120,
+		RegisteredModels: 153,
+		Workspaces:       2,
+		LastUpdateTime:   metav1.NewTime(now),
+	}))
+	models, ok := usageGaugeValue(t, "mlflow_operator_usage_registered_models")
+	g.Expect(ok).To(gomega.BeTrue())
+	g.Expect(models).To(gomega.Equal(float64(153)))
+
+	// Nothing is collected before the interval has passed.
+	requests := api.requests.Load()
+	g.Expect(r.reconcileUsageStatistics(ctx, mlflow, now.Add(10*time.Minute))).To(gomega.Equal(50 * time.Minute))
+	g.Expect(api.requests.Load()).To(gomega.Equal(requests))
+
+	// A failed collection keeps the counts and is retried after the interval, not on every reconcile.
+	api.failing.Store(true)
+	later := now.Add(2 * time.Hour)
+	g.Expect(r.reconcileUsageStatistics(ctx, mlflow, later)).To(gomega.Equal(defaultUsageStatisticsInterval))
+	g.Expect(mlflow.Status.Usage.LastUpdateTime.Time).To(gomega.Equal(now))
+	requests = api.requests.Load()
+	g.Expect(r.reconcileUsageStatistics(ctx, mlflow, later.Add(time.Minute))).To(gomega.Equal(59 * time.Minute))
+	g.Expect(api.requests.Load()).To(gomega.Equal(requests))
+
+	// Disabling clears the status and the metrics.
+	mlflow.Spec.UsageStatistics.Enabled = false
+	g.Expect(r.reconcileUsageStatistics(ctx, mlflow, later)).To(gomega.BeZero())
+	g.Expect(mlflow.Status.Usage).To(gomega.BeNil())
+	_, ok = usageGaugeValue(t, "mlflow_operator_usage_registered_models")
+	g.Expect(ok).To(gomega.BeFalse())
+}
+
+func TestUsageStatisticsWithoutWorkspaces(t *testing.T) {
+	g := gomega.NewWithT(t)
+	api := &fakeUsageAPI{workspaces: map[string]fakeUsageWorkspace{"": {experiments: 2, recentRuns: 1, models: 1}}}
+	r, server := newBootstrapTestReconciler(t, api)
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: ResourceName},
+		Spec: mlflowv1.MLflowSpec{
+			UsageStatistics: &mlflowv1.UsageStatisticsConfig{Enabled: true, Interval: &metav1.Duration{Duration: time.Minute}},
+			Workspaces:      &mlflowv1.WorkspacesConfig{Enabled: ptr(false)},
+		},
+		Status: mlflowv1.MLflowStatus{Address: &mlflowv1.MLflowAddressStatus{URL: server.URL + StaticPrefix}},
+	}
+
+	// Intervals below the minimum are raised to it.
+	g.Expect(r.reconcileUsageStatistics(context.Background(), mlflow, time.Now())).To(gomega.Equal(minUsageStatisticsInterval))
+	g.Expect(mlflow.Status.Usage.Experiments).To(gomega.Equal(int64(2)))
+	g.Expect(mlflow.Status.Usage.RunsLast24h).To(gomega.Equal(int64(1)))
+	g.Expect(mlflow.Status.Usage.RegisteredModels).To(gomega.Equal(int64(1)))
+	g.Expect(mlflow.Status.Usage.Workspaces).To(gomega.BeZero())
+	r.usageCollector.forget(ResourceName)
+}
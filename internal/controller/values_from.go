@@ -0,0 +1,124 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"helm.sh/helm/v3/pkg/chartutil"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
+)
+
+const (
+	valuesFromDefaultKey  = "values.yaml"
+	valuesFromErrorReason = "ValuesFromError"
+)
+
+// resolveValuesFrom reads the spec.valuesFrom sources in namespace and merges them in order,
+// later sources winning. Sources are read as unstructured so Secrets outside the label-scoped
+// cache are read from the API server.
+func (r *MLflowReconciler) resolveValuesFrom(ctx context.Context, mlflow *mlflowv1.MLflow, namespace string) (map[string]interface{}, error) {
+	var merged map[string]interface{}
+	for i, ref := range mlflow.Spec.ValuesFrom {
+		key := ref.ValuesKey
+		if key == "" {
+			key = valuesFromDefaultKey
+		}
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion("v1")
+		obj.SetKind(ref.Kind)
+		if err := r.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: namespace}, obj); err != nil {
+			if errors.IsNotFound(err) {
+				if ref.Optional {
+					continue
+				}
+				return nil, fmt.Errorf("spec.valuesFrom[%d]: %s %q not found in namespace %q", i, ref.Kind, ref.Name, namespace)
+			}
+			return nil, fmt.Errorf("spec.valuesFrom[%d]: get %s %q: %w", i, ref.Kind, ref.Name, err)
+		}
+		data, found, err := valuesSourceData(obj, key)
+		if err != nil {
+			return nil, fmt.Errorf("spec.valuesFrom[%d]: %s %q key %q: %w", i, ref.Kind, ref.Name, key, err)
+		}
+		if !found {
+			if ref.Optional {
+				continue
+			}
+			return nil, fmt.Errorf("spec.valuesFrom[%d]: %s %q has no key %q", i, ref.Kind, ref.Name, key)
+		}
+		values, err := chartutil.ReadValues(data)
+		if err != nil {
+			return nil, fmt.Errorf("spec.valuesFrom[%d]: %s %q key %q is not a YAML map: %w", i, ref.Kind, ref.Name, key, err)
+		}
+		merged = render.CoalesceValues(values, merged)
+	}
+	return merged, nil
+}
+
+// valuesSourceData returns the raw value of key in a ConfigMap or Secret.
+func valuesSourceData(obj *unstructured.Unstructured, key string) ([]byte, bool, error) {
+	if obj.GetKind() == "ConfigMap" {
+		if value, found, _ := unstructured.NestedString(obj.Object, "data", key); found {
+			return []byte(value), true, nil
+		}
+		value, found, _ := unstructured.NestedString(obj.Object, "binaryData", key)
+		if !found {
+			return nil, false, nil
+		}
+		data, err := base64.StdEncoding.DecodeString(value)
+		return data, true, err
+	}
+	value, found, _ := unstructured.NestedString(obj.Object, "data", key)
+	if !found {
+		return nil, false, nil
+	}
+	data, err := base64.StdEncoding.DecodeString(value)
+	return data, true, err
+}
+
+// valuesSourceToMLflowRequests returns a map function enqueueing the MLflow instances whose
+// spec.valuesFrom names an object of kind.
+func (r *MLflowReconciler) valuesSourceToMLflowRequests(kind string) func(context.Context, client.Object) []reconcile.Request {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		mlflowList := &mlflowv1.MLflowList{}
+		if err := r.List(ctx, mlflowList); err != nil {
+			logf.FromContext(ctx).Error(err, "Failed to list MLflow instances for valuesFrom watch")
+			return nil
+		}
+
+		var requests []reconcile.Request
+		for _, mlflow := range mlflowList.Items {
+			for _, ref := range mlflow.Spec.ValuesFrom {
+				if ref.Kind == kind && ref.Name == obj.GetName() {
+					requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: mlflow.Name}})
+					break
+				}
+			}
+		}
+		return requests
+	}
+}
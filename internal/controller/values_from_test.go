@@ -0,0 +1,70 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func TestResolveValuesFrom(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "platform-values", Namespace: canaryTestNamespace},
+		Data: map[string]string{
+			"values.yaml": "podAnnotations:\n  team: ml\n  tier: gold\nservice:\n  annotations:\n    a: b\n",
+		},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "env-values", Namespace: canaryTestNamespace},
+		Data:       map[string][]byte{"prod.yaml": []byte("podAnnotations:\n  tier: platinum\n")},
+	}
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: ResourceName},
+		Spec: mlflowv1.MLflowSpec{ValuesFrom: []mlflowv1.ValuesReference{
+			{Kind: "ConfigMap", Name: "platform-values"},
+			{Kind: "Secret", Name: "env-values", ValuesKey: "prod.yaml"},
+			{Kind: "Secret", Name: "missing", Optional: true},
+			{Kind: "ConfigMap", Name: "platform-values", ValuesKey: "missing.yaml", Optional: true},
+		}},
+	}
+	r := newTestReconciler(t, mlflow.DeepCopy(), configMap, secret)
+
+	values, err := r.resolveValuesFrom(ctx, mlflow, canaryTestNamespace)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	annotations, _, _ := unstructured.NestedStringMap(values, "podAnnotations")
+	g.Expect(annotations).To(gomega.Equal(map[string]string{"team": "ml", "tier": "platinum"}))
+	serviceAnnotations, _, _ := unstructured.NestedStringMap(values, "service", "annotations")
+	g.Expect(serviceAnnotations).To(gomega.HaveKeyWithValue("a", "b"))
+
+	mlflow.Spec.ValuesFrom = []mlflowv1.ValuesReference{{Kind: "Secret", Name: "missing"}}
+	_, err = r.resolveValuesFrom(ctx, mlflow, canaryTestNamespace)
+	g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring(`spec.valuesFrom[0]: Secret "missing" not found`)))
+
+	mlflow.Spec.ValuesFrom = []mlflowv1.ValuesReference{{Kind: "ConfigMap", Name: "platform-values", ValuesKey: "other.yaml"}}
+	_, err = r.resolveValuesFrom(ctx, mlflow, canaryTestNamespace)
+	g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring(`has no key "other.yaml"`)))
+}
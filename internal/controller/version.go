@@ -0,0 +1,81 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+// unsupportedVersionReason is the Available condition reason for an unresolvable spec.version.
+const unsupportedVersionReason = "UnsupportedVersion"
+
+// incompatibleVersionReason is the Available condition reason for a spec.image.image tag that
+// names an MLflow major version the bundled chart does not render arguments for.
+const incompatibleVersionReason = "IncompatibleVersion"
+
+// chartMinMLflowMajor and chartMaxMLflowMajor bound the MLflow major versions whose server
+// flags, auth app, and environment the bundled chart renders. Raise them together with the
+// chart templates.
+const (
+	chartMinMLflowMajor = 3
+	chartMaxMLflowMajor = 3
+)
+
+// imageVersionTagPattern matches image tags that name an MLflow release, such as v3.1.0,
+// 3.1, or 3.1.0-full. Other tags (latest, main, odh-stable) are not checked.
+var imageVersionTagPattern = regexp.MustCompile(`^v?([0-9]{1,2})\.[0-9]+(\.[0-9]+)?([-+._].*)?$`)
+
+// imageTag returns the tag of image, or "" when it has none. A digest is ignored.
+func imageTag(image string) string {
+	image, _, _ = strings.Cut(image, "@")
+	colon := strings.LastIndex(image, ":")
+	if colon < 0 || colon < strings.LastIndex(image, "/") {
+		return ""
+	}
+	return image[colon+1:]
+}
+
+// checkImageVersionCompatibility rejects a spec.image.image whose tag pins an MLflow major
+// version outside the range the bundled chart supports, so the server is never started with
+// arguments it does not understand. Tags that do not look like a release are accepted.
+func checkImageVersionCompatibility(mlflow *mlflowv1.MLflow) error {
+	if mlflow.Spec.Image == nil || mlflow.Spec.Image.Image == nil {
+		return nil
+	}
+	match := imageVersionTagPattern.FindStringSubmatch(imageTag(*mlflow.Spec.Image.Image))
+	if match == nil {
+		return nil
+	}
+	major, err := strconv.Atoi(match[1])
+	if err != nil || (major >= chartMinMLflowMajor && major <= chartMaxMLflowMajor) {
+		return nil
+	}
+	return fmt.Errorf("spec.image.image %q runs MLflow %d, which the bundled chart does not support; supported versions: %s",
+		*mlflow.Spec.Image.Image, major, chartMLflowVersionRange())
+}
+
+func chartMLflowVersionRange() string {
+	if chartMinMLflowMajor == chartMaxMLflowMajor {
+		return fmt.Sprintf("%d.x", chartMinMLflowMajor)
+	}
+	return fmt.Sprintf("%d.x to %d.x", chartMinMLflowMajor, chartMaxMLflowMajor)
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func TestApplyPlatformDefaultsSkipsImageForVersionedCR(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	defaults, err := parsePlatformDefaults(testPlatformDefaults)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	spec := mlflowv1.MLflowSpec{Version: ptr("3.13")}
+	applyPlatformDefaults(&spec, defaults)
+
+	g.Expect(spec.Image.Image).To(gomega.BeNil())
+	g.Expect(spec.Image.ImagePullPolicy).NotTo(gomega.BeNil())
+}
+
+func TestCheckImageVersionCompatibility(t *testing.T) {
+	tests := []struct {
+		image   string
+		wantErr bool
+	}{
+		{image: "quay.io/opendatahub/mlflow:v3.1.0"},
+		{image: "ghcr.io/mlflow/mlflow:3.7.0-full"},
+		{image: "quay.io/opendatahub/mlflow:odh-stable"},
+		{image: "registry.local:5000/mlflow"},
+		{image: "quay.io/opendatahub/mlflow@sha256:0123abcd"},
+		{image: "ghcr.io/mlflow/mlflow:v2.19.0", wantErr: true},
+		{image: "ghcr.io/mlflow/mlflow:2.17@sha256:0123abcd", wantErr: true},
+		{image: "ghcr.io/mlflow/mlflow:v4.0.0rc1", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.image, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mlflow := &mlflowv1.MLflow{Spec: mlflowv1.MLflowSpec{Image: &mlflowv1.ImageConfig{Image: ptr(tt.image)}}}
+			err := checkImageVersionCompatibility(mlflow)
+			if tt.wantErr {
+				g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("supported versions: 3.x")))
+				return
+			}
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+	}
+	g := gomega.NewWithT(t)
+	g.Expect(checkImageVersionCompatibility(&mlflowv1.MLflow{})).To(gomega.Succeed())
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
+)
+
+// workspaceAccessGroup is an access role with the groups spec.accessRoles.groups binds to it.
+type workspaceAccessGroup struct {
+	role   string
+	groups []string
+}
+
+// workspaceAccessGroups returns the viewer, editor, and admin roles with their groups.
+func workspaceAccessGroups(mlflow *mlflowv1.MLflow) []workspaceAccessGroup {
+	var groups mlflowv1.AccessRoleGroups
+	if mlflow.Spec.AccessRoles != nil && mlflow.Spec.AccessRoles.Groups != nil {
+		groups = *mlflow.Spec.AccessRoles.Groups
+	}
+	return []workspaceAccessGroup{
+		{role: "viewer", groups: groups.Viewers},
+		{role: "editor", groups: groups.Editors},
+		{role: "admin", groups: groups.Admins},
+	}
+}
+
+// workspaceGroupRoleBindingName is the RoleBinding that binds groups to the role ClusterRole
+// of mlflow, e.g. mlflow-viewers for mlflow-viewer.
+func workspaceGroupRoleBindingName(mlflow *mlflowv1.MLflow, role string) string {
+	return ResourceName + render.ResourceSuffix(mlflow.Name) + "-" + role + "s"
+}
+
+// buildWorkspaceGroupRoleBinding binds groups to the role access ClusterRole of mlflow in the
+// workspace that owns mlflowConfig. Group members are resolved by the API server on each
+// access review, so membership changes in the identity provider apply without an update.
+func buildWorkspaceGroupRoleBinding(mlflow *mlflowv1.MLflow, mlflowConfig *unstructured.Unstructured, role string, groups []string) *unstructured.Unstructured {
+	subjects := make([]interface{}, 0, len(groups))
+	seen := map[string]bool{}
+	for _, group := range groups {
+		if seen[group] {
+			continue
+		}
+		seen[group] = true
+		subjects = append(subjects, map[string]interface{}{
+			"apiGroup": "rbac.authorization.k8s.io",
+			"kind":     "Group",
+			"name":     group,
+		})
+	}
+	roleBinding := &unstructured.Unstructured{Object: map[string]interface{}{
+		"roleRef": map[string]interface{}{
+			"apiGroup": "rbac.authorization.k8s.io",
+			"kind":     "ClusterRole",
+			"name":     ResourceName + render.ResourceSuffix(mlflow.Name) + "-" + role,
+		},
+		"subjects": subjects,
+	}}
+	roleBinding.SetAPIVersion("rbac.authorization.k8s.io/v1")
+	roleBinding.SetKind("RoleBinding")
+	roleBinding.SetName(workspaceGroupRoleBindingName(mlflow, role))
+	roleBinding.SetNamespace(mlflowConfig.GetNamespace())
+	roleBinding.SetLabels(map[string]string{"app": ResourceName})
+	roleBinding.SetOwnerReferences([]metav1.OwnerReference{mlflowConfigOwnerReference(mlflowConfig)})
+	return roleBinding
+}
+
+// reconcileWorkspaceGroupBindings applies the group RoleBindings of one workspace, and deletes
+// the ones whose role no longer lists groups. RoleBindings without an MLflowConfig owner were
+// not created by the operator and are left alone.
+func (r *MLflowReconciler) reconcileWorkspaceGroupBindings(ctx context.Context, mlflow *mlflowv1.MLflow, mlflowConfig *unstructured.Unstructured) error {
+	namespace := mlflowConfig.GetNamespace()
+	for _, access := range workspaceAccessGroups(mlflow) {
+		if len(access.groups) > 0 {
+			roleBinding := buildWorkspaceGroupRoleBinding(mlflow, mlflowConfig, access.role, access.groups)
+			if err := r.applyObject(ctx, roleBinding); err != nil {
+				return fmt.Errorf("failed to apply RoleBinding %s in namespace %s: %w", roleBinding.GetName(), namespace, err)
+			}
+			continue
+		}
+
+		existing := &unstructured.Unstructured{}
+		existing.SetAPIVersion("rbac.authorization.k8s.io/v1")
+		existing.SetKind("RoleBinding")
+		name := workspaceGroupRoleBindingName(mlflow, access.role)
+		err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, existing)
+		if errors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return fmt.Errorf("failed to get RoleBinding %s in namespace %s: %w", name, namespace, err)
+		}
+		if !hasMLflowConfigOwner(existing) {
+			continue
+		}
+		if err := r.Delete(ctx, existing); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete RoleBinding %s in namespace %s: %w", name, namespace, err)
+		}
+		logf.FromContext(ctx).Info("Deleted workspace group RoleBinding", "name", name, "namespace", namespace)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func TestReconcileWorkspaceGroupBindings(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(gomega.Succeed())
+	g.Expect(mlflowv1.AddToScheme(scheme)).To(gomega.Succeed())
+
+	mlflowConfig := newTestMLflowConfig("team-a", "mlflow")
+	// A binding the namespace owner created under the same name is never touched.
+	userManaged := &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow-admins", Namespace: "team-a"},
+		RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: "mlflow-admin"},
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(mlflowConfig, userManaged).Build()
+	r := &MLflowReconciler{Client: k8sClient, Scheme: scheme, MLflowConfigAvailable: true}
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{AccessRoles: &mlflowv1.AccessRolesConfig{Groups: &mlflowv1.AccessRoleGroups{
+			Viewers: []string{"data-science", "auditors", "data-science"},
+			Editors: []string{"ml-engineers"},
+		}}},
+	}
+	ctx := context.Background()
+	key := func(name string) types.NamespacedName { return types.NamespacedName{Name: name, Namespace: "team-a"} }
+
+	g.Expect(r.reconcileWorkspaceConnections(ctx, mlflow)).To(gomega.Succeed())
+	viewers := &rbacv1.RoleBinding{}
+	g.Expect(k8sClient.Get(ctx, key("mlflow-viewers"), viewers)).To(gomega.Succeed())
+	g.Expect(viewers.RoleRef).To(gomega.Equal(rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: "mlflow-viewer"}))
+	g.Expect(viewers.Subjects).To(gomega.Equal([]rbacv1.Subject{
+		{APIGroup: rbacv1.GroupName, Kind: rbacv1.GroupKind, Name: "data-science"},
+		{APIGroup: rbacv1.GroupName, Kind: rbacv1.GroupKind, Name: "auditors"},
+	}))
+	g.Expect(viewers.OwnerReferences).To(gomega.ConsistOf(mlflowConfigOwnerReference(mlflowConfig)))
+	g.Expect(k8sClient.Get(ctx, key("mlflow-editors"), &rbacv1.RoleBinding{})).To(gomega.Succeed())
+
+	// Emptying a role deletes its binding.
+	mlflow.Spec.AccessRoles.Groups.Viewers = nil
+	mlflow.Spec.AccessRoles.Groups.Editors = []string{"ml-engineers", "contractors"}
+	g.Expect(r.reconcileWorkspaceConnections(ctx, mlflow)).To(gomega.Succeed())
+	err := k8sClient.Get(ctx, key("mlflow-viewers"), &rbacv1.RoleBinding{})
+	g.Expect(apierrors.IsNotFound(err)).To(gomega.BeTrue())
+	editors := &rbacv1.RoleBinding{}
+	g.Expect(k8sClient.Get(ctx, key("mlflow-editors"), editors)).To(gomega.Succeed())
+	g.Expect(editors.Subjects).To(gomega.HaveLen(2))
+
+	mlflow.Spec.AccessRoles = nil
+	g.Expect(r.reconcileWorkspaceConnections(ctx, mlflow)).To(gomega.Succeed())
+	err = k8sClient.Get(ctx, key("mlflow-editors"), &rbacv1.RoleBinding{})
+	g.Expect(apierrors.IsNotFound(err)).To(gomega.BeTrue())
+	g.Expect(k8sClient.Get(ctx, key("mlflow-admins"), &rbacv1.RoleBinding{})).To(gomega.Succeed())
+}
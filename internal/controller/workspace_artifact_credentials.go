@@ -0,0 +1,141 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+const (
+	// ArtifactConnectionSecretName is the workspace Secret that MLflowConfig
+	// spec.artifactRootSecret must name.
+	ArtifactConnectionSecretName = "mlflow-artifact-connection"
+	// MirroredFromAnnotation marks a workspace artifact Secret as a copy of
+	// spec.workspaceArtifactCredentials; only marked Secrets are updated or deleted.
+	MirroredFromAnnotation = "mlflow.opendatahub.io/mirrored-from"
+)
+
+func workspaceArtifactCredentialsEnabled(mlflow *mlflowv1.MLflow) bool {
+	return mlflow.Spec.WorkspaceArtifactCredentials != nil && mlflow.Spec.WorkspaceArtifactCredentials.SecretName != ""
+}
+
+// artifactCredentialsSource reads the source Secret of spec.workspaceArtifactCredentials. It
+// is read as unstructured so the API server is asked directly, since the cache only holds
+// Secrets labeled app=mlflow.
+func (r *MLflowReconciler) artifactCredentialsSource(ctx context.Context, mlflow *mlflowv1.MLflow) (*unstructured.Unstructured, error) {
+	cfg, err := r.resolveOperatorConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	source := &unstructured.Unstructured{}
+	source.SetAPIVersion("v1")
+	source.SetKind("Secret")
+	key := types.NamespacedName{Name: mlflow.Spec.WorkspaceArtifactCredentials.SecretName, Namespace: cfg.ApplicationsNamespace}
+	if err := r.Get(ctx, key, source); err != nil {
+		return nil, fmt.Errorf("failed to get workspace artifact credentials Secret %s: %w", key, err)
+	}
+	return source, nil
+}
+
+// buildArtifactCredentialsMirror copies the type and data of source into the artifact
+// connection Secret of the workspace that owns mlflowConfig.
+func buildArtifactCredentialsMirror(source, mlflowConfig *unstructured.Unstructured) *unstructured.Unstructured {
+	content := map[string]interface{}{}
+	if data, ok := source.Object["data"].(map[string]interface{}); ok {
+		content["data"] = data
+	}
+	secretType, _, _ := unstructured.NestedString(source.Object, "type")
+	if secretType == "" {
+		secretType = string(corev1.SecretTypeOpaque)
+	}
+	content["type"] = secretType
+
+	secret := &unstructured.Unstructured{Object: content}
+	secret.SetAPIVersion("v1")
+	secret.SetKind("Secret")
+	secret.SetName(ArtifactConnectionSecretName)
+	secret.SetNamespace(mlflowConfig.GetNamespace())
+	secret.SetLabels(map[string]string{"app": ResourceName})
+	secret.SetAnnotations(map[string]string{MirroredFromAnnotation: source.GetNamespace() + "/" + source.GetName()})
+	secret.SetOwnerReferences([]metav1.OwnerReference{mlflowConfigOwnerReference(mlflowConfig)})
+	return secret
+}
+
+// reconcileArtifactCredentialsMirror applies the mirrored artifact Secret of one workspace, or
+// deletes it when source is nil. Secrets without MirroredFromAnnotation belong to the
+// namespace owner and are never touched.
+func (r *MLflowReconciler) reconcileArtifactCredentialsMirror(ctx context.Context, source, mlflowConfig *unstructured.Unstructured) error {
+	existing := &unstructured.Unstructured{}
+	existing.SetAPIVersion("v1")
+	existing.SetKind("Secret")
+	err := r.Get(ctx, types.NamespacedName{Name: ArtifactConnectionSecretName, Namespace: mlflowConfig.GetNamespace()}, existing)
+	if err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to get %s in namespace %s: %w", ArtifactConnectionSecretName, mlflowConfig.GetNamespace(), err)
+	}
+	exists := err == nil
+	if exists {
+		if _, mirrored := existing.GetAnnotations()[MirroredFromAnnotation]; !mirrored {
+			return nil
+		}
+	}
+
+	if source == nil {
+		if !exists {
+			return nil
+		}
+		if err := r.Delete(ctx, existing); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete mirrored %s in namespace %s: %w", ArtifactConnectionSecretName, mlflowConfig.GetNamespace(), err)
+		}
+		logf.FromContext(ctx).Info("Deleted mirrored artifact credentials", "namespace", mlflowConfig.GetNamespace())
+		return nil
+	}
+
+	if err := r.applyObject(ctx, buildArtifactCredentialsMirror(source, mlflowConfig)); err != nil {
+		return fmt.Errorf("failed to mirror artifact credentials into namespace %s: %w", mlflowConfig.GetNamespace(), err)
+	}
+	return nil
+}
+
+// artifactCredentialsSourceToMLflowRequests maps a Secret in the applications namespace to the
+// MLflow instances that mirror it, so rotated keys reach the workspaces right away.
+func (r *MLflowReconciler) artifactCredentialsSourceToMLflowRequests(ctx context.Context, obj client.Object) []reconcile.Request {
+	mlflowList := &mlflowv1.MLflowList{}
+	if err := r.List(ctx, mlflowList); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to list MLflow instances for artifact credentials watch")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, mlflow := range mlflowList.Items {
+		if workspaceArtifactCredentialsEnabled(&mlflow) && mlflow.Spec.WorkspaceArtifactCredentials.SecretName == obj.GetName() {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: mlflow.Name}})
+		}
+	}
+	return requests
+}
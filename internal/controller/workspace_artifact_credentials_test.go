@@ -0,0 +1,96 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func TestReconcileWorkspaceArtifactCredentials(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(gomega.Succeed())
+	g.Expect(mlflowv1.AddToScheme(scheme)).To(gomega.Succeed())
+
+	source := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "platform-s3", Namespace: "test-ns"},
+		Data:       map[string][]byte{"AWS_ACCESS_KEY_ID": []byte("key-1"), "AWS_S3_BUCKET": []byte("bucket")},
+	}
+	userSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: ArtifactConnectionSecretName, Namespace: "team-b"},
+		Data:       map[string][]byte{"AWS_ACCESS_KEY_ID": []byte("team-b-key")},
+	}
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			WorkspaceArtifactCredentials: &mlflowv1.WorkspaceArtifactCredentialsConfig{SecretName: "platform-s3"},
+		},
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		newTestMLflowConfig("team-a", "mlflow"), newTestMLflowConfig("team-b", "mlflow"), source, userSecret, mlflow,
+	).Build()
+	r := &MLflowReconciler{Client: k8sClient, Scheme: scheme, Namespace: "test-ns", MLflowConfigAvailable: true}
+	ctx := context.Background()
+	teamA := types.NamespacedName{Name: ArtifactConnectionSecretName, Namespace: "team-a"}
+
+	g.Expect(r.reconcileWorkspaceConnections(ctx, mlflow)).To(gomega.Succeed())
+	mirror := &corev1.Secret{}
+	g.Expect(k8sClient.Get(ctx, teamA, mirror)).To(gomega.Succeed())
+	g.Expect(mirror.Data).To(gomega.Equal(source.Data))
+	g.Expect(mirror.Type).To(gomega.Equal(corev1.SecretTypeOpaque))
+	g.Expect(mirror.Annotations).To(gomega.HaveKeyWithValue(MirroredFromAnnotation, "test-ns/platform-s3"))
+	g.Expect(hasMLflowConfigOwner(mirror)).To(gomega.BeTrue())
+
+	kept := &corev1.Secret{}
+	g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(userSecret), kept)).To(gomega.Succeed())
+	g.Expect(kept.Data).To(gomega.Equal(userSecret.Data), "a workspace's own Secret is never overwritten")
+
+	// Rotating the source key reaches the workspace on the reconcile its watch triggers.
+	g.Expect(r.artifactCredentialsSourceToMLflowRequests(ctx, source)).To(gomega.ConsistOf(
+		reconcile.Request{NamespacedName: types.NamespacedName{Name: "mlflow"}},
+	))
+	source.Data["AWS_ACCESS_KEY_ID"] = []byte("key-2")
+	g.Expect(k8sClient.Update(ctx, source)).To(gomega.Succeed())
+	g.Expect(r.reconcileWorkspaceConnections(ctx, mlflow)).To(gomega.Succeed())
+	g.Expect(k8sClient.Get(ctx, teamA, mirror)).To(gomega.Succeed())
+	g.Expect(mirror.Data).To(gomega.HaveKeyWithValue("AWS_ACCESS_KEY_ID", []byte("key-2")))
+
+	// An unreadable source keeps the existing copies.
+	g.Expect(k8sClient.Delete(ctx, source)).To(gomega.Succeed())
+	g.Expect(r.reconcileWorkspaceConnections(ctx, mlflow)).To(gomega.Succeed())
+	g.Expect(k8sClient.Get(ctx, teamA, &corev1.Secret{})).To(gomega.Succeed())
+
+	mlflow.Spec.WorkspaceArtifactCredentials = nil
+	g.Expect(r.reconcileWorkspaceConnections(ctx, mlflow)).To(gomega.Succeed())
+	g.Expect(apierrors.IsNotFound(k8sClient.Get(ctx, teamA, &corev1.Secret{}))).To(gomega.BeTrue())
+	g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(userSecret), &corev1.Secret{})).To(gomega.Succeed())
+}
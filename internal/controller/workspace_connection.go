@@ -0,0 +1,291 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/yaml"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
+)
+
+const (
+	MLflowConfigCRDName = "MLflowConfig"
+	// WorkspaceConnectionConfigMapName is the ConfigMap the operator publishes in every
+	// namespace with an MLflowConfig.
+	WorkspaceConnectionConfigMapName = "mlflow-connection"
+
+	// serviceCAInjectAnnotation asks the OpenShift service CA operator to write the CA that
+	// signs Service serving certificates into the ConfigMap's service-ca.crt key.
+	serviceCAInjectAnnotation = "service.beta.openshift.io/inject-cabundle"
+
+	// ClientEnvAnnotation on an MLflowConfig holds a YAML map of extra environment variables
+	// to publish in the workspace connection ConfigMap. It is an annotation because the
+	// MLflowConfig schema is owned by the upstream MLflow plugins repository.
+	ClientEnvAnnotation = "mlflow.opendatahub.io/client-env"
+)
+
+// MLflowConfigGVK identifies the namespaced MLflowConfig singleton. It is handled as an
+// unstructured object because its Go types live in the upstream MLflow plugins repository.
+var MLflowConfigGVK = schema.GroupVersionKind{Group: "mlflow.kubeflow.org", Version: "v1", Kind: MLflowConfigCRDName}
+
+// IsMLflowConfigAvailable checks if the MLflowConfig CRD is available in the cluster using discovery API.
+func IsMLflowConfigAvailable(discoveryClient discovery.DiscoveryInterface) (bool, error) {
+	ctx := context.Background()
+	log := logf.FromContext(ctx)
+
+	gv := MLflowConfigGVK.GroupVersion()
+	resourceList, err := discoveryClient.ServerResourcesForGroupVersion(gv.String())
+	if err != nil {
+		if errors.IsNotFound(err) || discovery.IsGroupDiscoveryFailedError(err) {
+			log.V(1).Info(fmt.Sprintf("%s CRD not available in cluster", MLflowConfigCRDName))
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to check for %s availability: %w", MLflowConfigCRDName, err)
+	}
+
+	for _, resource := range resourceList.APIResources {
+		if resource.Kind == MLflowConfigCRDName {
+			log.V(1).Info(fmt.Sprintf("%s CRD is available in cluster", MLflowConfigCRDName))
+			return true, nil
+		}
+	}
+
+	log.V(1).Info(fmt.Sprintf("%s CRD not found in resource list", MLflowConfigCRDName))
+	return false, nil
+}
+
+// buildWorkspaceConnectionConfigMap builds the connection ConfigMap for the workspace that
+// owns mlflowConfig. Keys are environment variable names so pods can consume it with envFrom.
+// clientEnv comes from the MLflowConfig; operator-managed keys take precedence over it.
+func buildWorkspaceConnectionConfigMap(mlflow *mlflowv1.MLflow, mlflowConfig *unstructured.Unstructured, clientEnv map[string]string, isOpenShift bool) *unstructured.Unstructured {
+	data := map[string]interface{}{}
+	for key, value := range clientEnv {
+		data[key] = value
+	}
+	data["MLFLOW_PATH_PREFIX"] = render.ServerStaticPrefix(mlflow)
+	if render.WorkspacesEnabled(mlflow) {
+		data["MLFLOW_WORKSPACE"] = mlflowConfig.GetNamespace()
+	}
+	if mlflow.Status.Address != nil {
+		data["MLFLOW_TRACKING_URI"] = mlflow.Status.Address.URL
+	}
+	if mlflow.Status.URL != "" {
+		data["MLFLOW_EXTERNAL_URL"] = mlflow.Status.URL
+	}
+	if url := workspaceURL(mlflow, mlflowConfig.GetNamespace()); url != "" {
+		data["MLFLOW_WORKSPACE_URL"] = url
+	}
+	for key, value := range systemMetricsEnv(mlflow) {
+		data[key] = value
+	}
+
+	configMap := &unstructured.Unstructured{Object: map[string]interface{}{"data": data}}
+	configMap.SetAPIVersion("v1")
+	configMap.SetKind("ConfigMap")
+	configMap.SetName(WorkspaceConnectionConfigMapName)
+	configMap.SetNamespace(mlflowConfig.GetNamespace())
+	configMap.SetLabels(map[string]string{"app": ResourceName})
+	if isOpenShift {
+		configMap.SetAnnotations(map[string]string{serviceCAInjectAnnotation: "true"})
+	}
+	configMap.SetOwnerReferences([]metav1.OwnerReference{mlflowConfigOwnerReference(mlflowConfig)})
+	return configMap
+}
+
+// mlflowConfigClientEnv parses the ClientEnvAnnotation of an MLflowConfig.
+func mlflowConfigClientEnv(mlflowConfig *unstructured.Unstructured) (map[string]string, error) {
+	raw, ok := mlflowConfig.GetAnnotations()[ClientEnvAnnotation]
+	if !ok || strings.TrimSpace(raw) == "" {
+		return nil, nil
+	}
+	env := map[string]string{}
+	if err := yaml.Unmarshal([]byte(raw), &env); err != nil {
+		return nil, fmt.Errorf("%s must be a map of environment variable names to string values: %w", ClientEnvAnnotation, err)
+	}
+	for key := range env {
+		if errs := validation.IsEnvVarName(key); len(errs) > 0 {
+			return nil, fmt.Errorf("%s has invalid environment variable name %q: %s", ClientEnvAnnotation, key, strings.Join(errs, "; "))
+		}
+	}
+	return env, nil
+}
+
+// systemMetricsEnv returns the MLflow client settings for spec.systemMetrics. They are
+// published to workspaces rather than set on the server, because the client samples the
+// metrics inside the training pod.
+func systemMetricsEnv(mlflow *mlflowv1.MLflow) map[string]string {
+	systemMetrics := mlflow.Spec.SystemMetrics
+	if systemMetrics == nil || !systemMetrics.Enabled {
+		return nil
+	}
+	env := map[string]string{"MLFLOW_ENABLE_SYSTEM_METRICS_LOGGING": "true"}
+	if systemMetrics.SamplingIntervalSeconds != nil {
+		env["MLFLOW_SYSTEM_METRICS_SAMPLING_INTERVAL"] = strconv.Itoa(int(*systemMetrics.SamplingIntervalSeconds))
+	}
+	if systemMetrics.SamplesBeforeLogging != nil {
+		env["MLFLOW_SYSTEM_METRICS_SAMPLES_BEFORE_LOGGING"] = strconv.Itoa(int(*systemMetrics.SamplesBeforeLogging))
+	}
+	return env
+}
+
+// mlflowConfigOwnerReference makes a workspace object owned by its MLflowConfig, so it is
+// garbage collected with it.
+func mlflowConfigOwnerReference(mlflowConfig *unstructured.Unstructured) metav1.OwnerReference {
+	return metav1.OwnerReference{
+		APIVersion: MLflowConfigGVK.GroupVersion().String(),
+		Kind:       MLflowConfigCRDName,
+		Name:       mlflowConfig.GetName(),
+		UID:        mlflowConfig.GetUID(),
+	}
+}
+
+// reconcileWorkspaceConnections publishes the tracking endpoint, and client and artifact
+// credentials and group RoleBindings when enabled, into every namespace with an MLflowConfig.
+// Workspace objects are applied as unstructured objects so reads go to the API server instead
+// of the cache, which only covers the operator's target namespace.
+func (r *MLflowReconciler) reconcileWorkspaceConnections(ctx context.Context, mlflow *mlflowv1.MLflow) error {
+	if !r.MLflowConfigAvailable {
+		return nil
+	}
+
+	mlflowConfigs, err := r.listMLflowConfigs(ctx)
+	if err != nil {
+		return err
+	}
+
+	// A source that cannot be read leaves existing mirrors in place rather than deleting
+	// workspace credentials over what may be a transient error.
+	var artifactCredentials *unstructured.Unstructured
+	mirrorArtifactCredentials := true
+	if workspaceArtifactCredentialsEnabled(mlflow) {
+		artifactCredentials, err = r.artifactCredentialsSource(ctx, mlflow)
+		if err != nil {
+			logf.FromContext(ctx).Error(err, "Skipping workspace artifact credentials")
+			mirrorArtifactCredentials = false
+		}
+	}
+
+	for i := range mlflowConfigs {
+		mlflowConfig := &mlflowConfigs[i]
+		if mlflowConfig.GetName() != ResourceName || mlflowConfig.GetDeletionTimestamp() != nil {
+			continue
+		}
+		// A malformed annotation only drops the extra variables, so the workspace still gets
+		// its tracking URI.
+		clientEnv, err := mlflowConfigClientEnv(mlflowConfig)
+		if err != nil {
+			logf.FromContext(ctx).Error(err, "Ignoring MLflowConfig client env", "namespace", mlflowConfig.GetNamespace())
+		}
+		configMap := buildWorkspaceConnectionConfigMap(mlflow, mlflowConfig, clientEnv, r.ConsoleLinkAvailable)
+		if err := r.applyObject(ctx, configMap); err != nil {
+			return fmt.Errorf("failed to publish %s in namespace %s: %w",
+				WorkspaceConnectionConfigMapName, mlflowConfig.GetNamespace(), err)
+		}
+		if err := r.reconcileClientCredentials(ctx, mlflow, mlflowConfig); err != nil {
+			return err
+		}
+		if err := r.reconcileWorkspaceGroupBindings(ctx, mlflow, mlflowConfig); err != nil {
+			return err
+		}
+		// A data connection chosen by the workspace takes precedence over the central Secret.
+		dataConnection, err := r.workspaceDataConnection(ctx, mlflowConfig)
+		switch {
+		case err != nil:
+			logf.FromContext(ctx).Error(err, "Ignoring MLflowConfig data connection", "namespace", mlflowConfig.GetNamespace())
+		case dataConnection != nil:
+			if err := r.reconcileArtifactCredentialsMirror(ctx, dataConnection, mlflowConfig); err != nil {
+				return err
+			}
+		case mirrorArtifactCredentials:
+			if err := r.reconcileArtifactCredentialsMirror(ctx, artifactCredentials, mlflowConfig); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// listMLflowConfigs lists MLflowConfigs cluster-wide, or only in WatchNamespaces when set, so
+// a namespace-restricted operator needs list access in those namespaces alone.
+func (r *MLflowReconciler) listMLflowConfigs(ctx context.Context) ([]unstructured.Unstructured, error) {
+	listGVK := MLflowConfigGVK.GroupVersion().WithKind(MLflowConfigCRDName + "List")
+	if len(r.WatchNamespaces) == 0 {
+		mlflowConfigs := &unstructured.UnstructuredList{}
+		mlflowConfigs.SetGroupVersionKind(listGVK)
+		if err := r.List(ctx, mlflowConfigs); err != nil {
+			return nil, fmt.Errorf("failed to list MLflowConfigs: %w", err)
+		}
+		return mlflowConfigs.Items, nil
+	}
+
+	var items []unstructured.Unstructured
+	for _, namespace := range r.WatchNamespaces {
+		mlflowConfigs := &unstructured.UnstructuredList{}
+		mlflowConfigs.SetGroupVersionKind(listGVK)
+		if err := r.List(ctx, mlflowConfigs, client.InNamespace(namespace)); err != nil {
+			return nil, fmt.Errorf("failed to list MLflowConfigs in namespace %s: %w", namespace, err)
+		}
+		items = append(items, mlflowConfigs.Items...)
+	}
+	return items, nil
+}
+
+// mlflowConfigToMLflowRequests maps MLflowConfig changes to all MLflow instances so new
+// workspaces receive their connection ConfigMap.
+func (r *MLflowReconciler) mlflowConfigToMLflowRequests(ctx context.Context, obj client.Object) []reconcile.Request {
+	log := logf.FromContext(ctx)
+	if obj.GetName() != ResourceName {
+		return nil
+	}
+	if len(r.WatchNamespaces) > 0 && !slices.Contains(r.WatchNamespaces, obj.GetNamespace()) {
+		return nil
+	}
+
+	mlflowList := &mlflowv1.MLflowList{}
+	if err := r.List(ctx, mlflowList); err != nil {
+		log.Error(err, "Failed to list MLflow instances for MLflowConfig watch")
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(mlflowList.Items))
+	for _, mlflow := range mlflowList.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      mlflow.Name,
+				Namespace: mlflow.Namespace,
+			},
+		})
+	}
+	return requests
+}
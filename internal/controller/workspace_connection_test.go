@@ -0,0 +1,208 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func newTestMLflowConfig(namespace, name string) *unstructured.Unstructured {
+	mlflowConfig := &unstructured.Unstructured{}
+	mlflowConfig.SetGroupVersionKind(MLflowConfigGVK)
+	mlflowConfig.SetNamespace(namespace)
+	mlflowConfig.SetName(name)
+	mlflowConfig.SetUID(types.UID(namespace + "-uid"))
+	return mlflowConfig
+}
+
+func TestBuildWorkspaceConnectionConfigMap(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "mlflow"}}
+	mlflow.Status.Address = buildStatusAddress(mlflow, "opendatahub")
+	mlflow.Status.URL = "https://data-science-gateway.apps.example.com/mlflow"
+
+	configMap := buildWorkspaceConnectionConfigMap(mlflow, newTestMLflowConfig("team-a", "mlflow"), nil, true)
+	g.Expect(configMap.GetNamespace()).To(gomega.Equal("team-a"))
+	g.Expect(configMap.GetName()).To(gomega.Equal(WorkspaceConnectionConfigMapName))
+	g.Expect(configMap.GetAnnotations()).To(gomega.HaveKeyWithValue(serviceCAInjectAnnotation, "true"))
+	g.Expect(configMap.GetOwnerReferences()).To(gomega.Equal([]metav1.OwnerReference{{
+		APIVersion: "mlflow.kubeflow.org/v1",
+		Kind:       "MLflowConfig",
+		Name:       "mlflow",
+		UID:        "team-a-uid",
+	}}))
+
+	data, _, _ := unstructured.NestedStringMap(configMap.Object, "data")
+	g.Expect(data).To(gomega.Equal(map[string]string{
+		"MLFLOW_TRACKING_URI": "https://mlflow.opendatahub.svc:8443/mlflow",
+		"MLFLOW_EXTERNAL_URL": "https://data-science-gateway.apps.example.com/mlflow",
+		"MLFLOW_PATH_PREFIX":  "/mlflow",
+		"MLFLOW_WORKSPACE":    "team-a",
+	}))
+
+	mlflow.Status.URL = ""
+	configMap = buildWorkspaceConnectionConfigMap(mlflow, newTestMLflowConfig("team-a", "mlflow"), nil, false)
+	g.Expect(configMap.GetAnnotations()).To(gomega.BeEmpty())
+	g.Expect(configMap.Object["data"]).NotTo(gomega.HaveKey("MLFLOW_EXTERNAL_URL"))
+
+	mlflow.Spec.Workspaces = &mlflowv1.WorkspacesConfig{Enabled: ptr(false)}
+	configMap = buildWorkspaceConnectionConfigMap(mlflow, newTestMLflowConfig("team-a", "mlflow"), nil, false)
+	g.Expect(configMap.Object["data"]).NotTo(gomega.HaveKey("MLFLOW_WORKSPACE"))
+}
+
+func TestWorkspaceConnectionSystemMetrics(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "mlflow"}}
+	data := func() map[string]string {
+		configMap := buildWorkspaceConnectionConfigMap(mlflow, newTestMLflowConfig("team-a", "mlflow"), nil, false)
+		data, _, _ := unstructured.NestedStringMap(configMap.Object, "data")
+		return data
+	}
+	g.Expect(data()).NotTo(gomega.HaveKey("MLFLOW_ENABLE_SYSTEM_METRICS_LOGGING"))
+
+	mlflow.Spec.SystemMetrics = &mlflowv1.SystemMetricsConfig{Enabled: true}
+	g.Expect(data()).To(gomega.HaveKeyWithValue("MLFLOW_ENABLE_SYSTEM_METRICS_LOGGING", "true"))
+	g.Expect(data()).NotTo(gomega.HaveKey("MLFLOW_SYSTEM_METRICS_SAMPLING_INTERVAL"))
+
+	mlflow.Spec.SystemMetrics.SamplingIntervalSeconds = ptr(int32(5))
+	mlflow.Spec.SystemMetrics.SamplesBeforeLogging = ptr(int32(6))
+	g.Expect(data()).To(gomega.And(
+		gomega.HaveKeyWithValue("MLFLOW_SYSTEM_METRICS_SAMPLING_INTERVAL", "5"),
+		gomega.HaveKeyWithValue("MLFLOW_SYSTEM_METRICS_SAMPLES_BEFORE_LOGGING", "6"),
+	))
+
+	mlflow.Spec.SystemMetrics = &mlflowv1.SystemMetricsConfig{Enabled: false}
+	g.Expect(data()).NotTo(gomega.HaveKey("MLFLOW_ENABLE_SYSTEM_METRICS_LOGGING"))
+}
+
+func TestWorkspaceConnectionClientEnv(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	mlflowConfig := newTestMLflowConfig("team-a", "mlflow")
+	mlflowConfig.SetAnnotations(map[string]string{ClientEnvAnnotation: `
+MLFLOW_S3_ENDPOINT_URL: https://s3.example.com
+MLFLOW_EXPERIMENT_NAME: team-a-default
+MLFLOW_PATH_PREFIX: /elsewhere
+`})
+	clientEnv, err := mlflowConfigClientEnv(mlflowConfig)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "mlflow"}}
+	configMap := buildWorkspaceConnectionConfigMap(mlflow, mlflowConfig, clientEnv, false)
+	data, _, _ := unstructured.NestedStringMap(configMap.Object, "data")
+	g.Expect(data).To(gomega.HaveKeyWithValue("MLFLOW_S3_ENDPOINT_URL", "https://s3.example.com"))
+	g.Expect(data).To(gomega.HaveKeyWithValue("MLFLOW_EXPERIMENT_NAME", "team-a-default"))
+	g.Expect(data).To(gomega.HaveKeyWithValue("MLFLOW_PATH_PREFIX", "/mlflow"), "operator-managed keys win")
+
+	mlflowConfig.SetAnnotations(map[string]string{ClientEnvAnnotation: "1BAD: value"})
+	_, err = mlflowConfigClientEnv(mlflowConfig)
+	g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring(`invalid environment variable name "1BAD"`)))
+
+	mlflowConfig.SetAnnotations(map[string]string{ClientEnvAnnotation: "- not-a-map"})
+	_, err = mlflowConfigClientEnv(mlflowConfig)
+	g.Expect(err).To(gomega.HaveOccurred())
+
+	mlflowConfig.SetAnnotations(nil)
+	g.Expect(mlflowConfigClientEnv(mlflowConfig)).To(gomega.BeEmpty())
+}
+
+func TestReconcileWorkspaceConnections(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(gomega.Succeed())
+	g.Expect(mlflowv1.AddToScheme(scheme)).To(gomega.Succeed())
+
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		newTestMLflowConfig("team-a", "mlflow"),
+		newTestMLflowConfig("team-b", "mlflow"),
+		newTestMLflowConfig("team-c", "not-the-singleton"),
+	).Build()
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "mlflow"}}
+	mlflow.Status.Address = buildStatusAddress(mlflow, "opendatahub")
+
+	r := &MLflowReconciler{Client: k8sClient, Scheme: scheme}
+	g.Expect(r.reconcileWorkspaceConnections(context.Background(), mlflow)).To(gomega.Succeed())
+	err := k8sClient.Get(context.Background(), types.NamespacedName{Name: WorkspaceConnectionConfigMapName, Namespace: "team-a"}, &corev1.ConfigMap{})
+	g.Expect(apierrors.IsNotFound(err)).To(gomega.BeTrue(), "nothing is published without the MLflowConfig CRD")
+
+	r.MLflowConfigAvailable = true
+	g.Expect(r.reconcileWorkspaceConnections(context.Background(), mlflow)).To(gomega.Succeed())
+	for _, namespace := range []string{"team-a", "team-b"} {
+		configMap := &corev1.ConfigMap{}
+		g.Expect(k8sClient.Get(context.Background(), types.NamespacedName{Name: WorkspaceConnectionConfigMapName, Namespace: namespace}, configMap)).To(gomega.Succeed())
+		g.Expect(configMap.Data).To(gomega.HaveKeyWithValue("MLFLOW_TRACKING_URI", "https://mlflow.opendatahub.svc:8443/mlflow"))
+		g.Expect(configMap.Data).To(gomega.HaveKeyWithValue("MLFLOW_WORKSPACE", namespace))
+	}
+	err = k8sClient.Get(context.Background(), types.NamespacedName{Name: WorkspaceConnectionConfigMapName, Namespace: "team-c"}, &corev1.ConfigMap{})
+	g.Expect(apierrors.IsNotFound(err)).To(gomega.BeTrue())
+}
+
+func TestReconcileWorkspaceConnections_WatchNamespaces(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(gomega.Succeed())
+	g.Expect(mlflowv1.AddToScheme(scheme)).To(gomega.Succeed())
+
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		newTestMLflowConfig("team-a", "mlflow"),
+		newTestMLflowConfig("team-b", "mlflow"),
+	).Build()
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "mlflow"}}
+	mlflow.Status.Address = buildStatusAddress(mlflow, "opendatahub")
+
+	r := &MLflowReconciler{Client: k8sClient, Scheme: scheme, MLflowConfigAvailable: true, WatchNamespaces: []string{"team-b"}}
+	g.Expect(r.reconcileWorkspaceConnections(context.Background(), mlflow)).To(gomega.Succeed())
+	g.Expect(k8sClient.Get(context.Background(), types.NamespacedName{Name: WorkspaceConnectionConfigMapName, Namespace: "team-b"}, &corev1.ConfigMap{})).To(gomega.Succeed())
+	err := k8sClient.Get(context.Background(), types.NamespacedName{Name: WorkspaceConnectionConfigMapName, Namespace: "team-a"}, &corev1.ConfigMap{})
+	g.Expect(apierrors.IsNotFound(err)).To(gomega.BeTrue(), "namespaces outside WATCH_NAMESPACES are not scanned")
+}
+
+func TestMLflowConfigToMLflowRequests(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(mlflowv1.AddToScheme(scheme)).To(gomega.Succeed())
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "mlflow"}},
+	).Build()
+	r := &MLflowReconciler{Client: k8sClient, Scheme: scheme}
+
+	requests := r.mlflowConfigToMLflowRequests(context.Background(), newTestMLflowConfig("team-a", "mlflow"))
+	g.Expect(requests).To(gomega.HaveLen(1))
+	g.Expect(requests[0].Name).To(gomega.Equal("mlflow"))
+	g.Expect(r.mlflowConfigToMLflowRequests(context.Background(), newTestMLflowConfig("team-a", "other"))).To(gomega.BeEmpty())
+
+	r.WatchNamespaces = []string{"team-b"}
+	g.Expect(r.mlflowConfigToMLflowRequests(context.Background(), newTestMLflowConfig("team-a", "mlflow"))).To(gomega.BeEmpty())
+}
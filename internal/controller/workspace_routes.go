@@ -0,0 +1,265 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
+)
+
+const (
+	// workspaceHeader selects the MLflow workspace of a request. Workspace routes set it, so
+	// a tenant URL cannot be used to reach another workspace.
+	workspaceHeader = "X-MLflow-Workspace"
+
+	// workspaceRouteLabel on a workspace ReferenceGrant names the workspace its HTTPRoute
+	// serves, so grants double as the index of routes to clean up.
+	workspaceRouteLabel = "mlflow.opendatahub.io/workspace-route"
+
+	referenceGrantAPIVersion = "gateway.networking.k8s.io/v1beta1"
+)
+
+// reservedWorkspacePaths are the first path segments the MLflow server serves under its
+// static prefix. A workspace route for a namespace of the same name would shadow them on the
+// shared Gateway, so such namespaces get no route.
+var reservedWorkspacePaths = []string{
+	"v1", "api", "ajax-api", "static-files", "graphql", "health", "version", "server-info",
+	"get-artifact", "model-versions",
+}
+
+func workspaceRoutesEnabled(mlflow *mlflowv1.MLflow) bool {
+	return render.WorkspacesEnabled(mlflow) && mlflow.Spec.Workspaces != nil && mlflow.Spec.Workspaces.Routes &&
+		routingEnabled(mlflow)
+}
+
+func workspaceRouteName(mlflow *mlflowv1.MLflow) string {
+	return ResourceName + render.ResourceSuffix(mlflow.Name) + "-workspace"
+}
+
+// workspaceReferenceGrantName names the ReferenceGrant for workspace in the target namespace.
+func workspaceReferenceGrantName(mlflow *mlflowv1.MLflow, workspace string) string {
+	return workspaceRouteName(mlflow) + "-" + workspace
+}
+
+// workspaceURL returns the external URL of workspace, or "" when the instance does not
+// publish workspace routes.
+func workspaceURL(mlflow *mlflowv1.MLflow, workspace string) string {
+	if !workspaceRoutesEnabled(mlflow) || mlflow.Status.URL == "" || slices.Contains(reservedWorkspacePaths, workspace) {
+		return ""
+	}
+	return strings.TrimRight(mlflow.Status.URL, "/") + "/" + workspace
+}
+
+// buildWorkspaceHTTPRoute builds the HTTPRoute in workspace that serves the instance under
+// <prefix>/<workspace>. Its rules mirror the instance HTTPRoute, including canary weights and
+// the artifact and registry servers, with each path moved under the workspace segment and
+// rewritten back before it reaches the Services in namespace. Every request gets the
+// workspace header, replacing any the client sent.
+func buildWorkspaceHTTPRoute(mlflow *mlflowv1.MLflow, namespace, workspace string, cfg *config.OperatorConfig) *gatewayv1.HTTPRoute {
+	base := buildHTTPRoute(mlflow, namespace, cfg)
+	pathPrefix := routePathPrefix(mlflow)
+	workspacePrefix := pathPrefix + "/" + workspace
+	backendNamespace := gatewayv1.Namespace(namespace)
+	headerFilter := gatewayv1.HTTPRouteFilter{
+		Type: gatewayv1.HTTPRouteFilterRequestHeaderModifier,
+		RequestHeaderModifier: &gatewayv1.HTTPHeaderFilter{
+			Set: []gatewayv1.HTTPHeader{{Name: workspaceHeader, Value: workspace}},
+		},
+	}
+
+	var rules []gatewayv1.HTTPRouteRule
+	for _, rule := range base.Spec.Rules {
+		backendRefs := make([]gatewayv1.HTTPBackendRef, len(rule.BackendRefs))
+		for i, backendRef := range rule.BackendRefs {
+			backendRefs[i] = *backendRef.DeepCopy()
+			backendRefs[i].Namespace = &backendNamespace
+		}
+		// A prefix rewrite replaces the whole matched prefix, so each match gets its own rule.
+		for _, match := range rule.Matches {
+			original := *match.Path.Value
+			// At the root path the catch-all "/" becomes the bare workspace prefix.
+			value := strings.TrimSuffix(workspacePrefix+strings.TrimPrefix(original, pathPrefix), "/")
+			replacement := original
+			for _, filter := range rule.Filters {
+				if filter.Type == gatewayv1.HTTPRouteFilterURLRewrite && filter.URLRewrite.Path != nil {
+					replacement = *filter.URLRewrite.Path.ReplacePrefixMatch
+				}
+			}
+			pathMatchType := gatewayv1.PathMatchPathPrefix
+			rules = append(rules, gatewayv1.HTTPRouteRule{
+				Matches: []gatewayv1.HTTPRouteMatch{{Path: &gatewayv1.HTTPPathMatch{Type: &pathMatchType, Value: &value}}},
+				Filters: []gatewayv1.HTTPRouteFilter{
+					headerFilter,
+					{
+						Type: gatewayv1.HTTPRouteFilterURLRewrite,
+						URLRewrite: &gatewayv1.HTTPURLRewriteFilter{
+							Path: &gatewayv1.HTTPPathModifier{
+								Type:               gatewayv1.PrefixMatchHTTPPathModifier,
+								ReplacePrefixMatch: &replacement,
+							},
+						},
+					},
+				},
+				BackendRefs: backendRefs,
+				Timeouts:    rule.Timeouts,
+			})
+		}
+	}
+
+	route := base.DeepCopy()
+	route.Name = workspaceRouteName(mlflow)
+	route.Namespace = workspace
+	route.Spec.Rules = rules
+	return route
+}
+
+// buildWorkspaceReferenceGrant lets the workspace HTTPRoute reference the MLflow Services in
+// namespace. Each workspace gets its own grant because a grant lists at most 16 sources.
+func buildWorkspaceReferenceGrant(mlflow *mlflowv1.MLflow, namespace, workspace string) *unstructured.Unstructured {
+	grant := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{
+			"from": []interface{}{map[string]interface{}{
+				"group":     gatewayv1.GroupName,
+				"kind":      "HTTPRoute",
+				"namespace": workspace,
+			}},
+			"to": []interface{}{map[string]interface{}{"group": "", "kind": "Service"}},
+		},
+	}}
+	grant.SetAPIVersion(referenceGrantAPIVersion)
+	grant.SetKind("ReferenceGrant")
+	grant.SetName(workspaceReferenceGrantName(mlflow, workspace))
+	grant.SetNamespace(namespace)
+	grant.SetLabels(map[string]string{"app": ResourceName, workspaceRouteLabel: workspace})
+	return grant
+}
+
+// reconcileWorkspaceRoutes publishes a workspace HTTPRoute and ReferenceGrant for every
+// namespace with an MLflowConfig when spec.workspaces.routes is set, and removes the ones no
+// longer wanted. Like the other workspace objects they are applied as unstructured objects,
+// since the cache only covers the operator's target namespace.
+func (r *MLflowReconciler) reconcileWorkspaceRoutes(ctx context.Context, mlflow *mlflowv1.MLflow, namespace string, cfg *config.OperatorConfig) error {
+	if !r.HTTPRouteAvailable || !r.MLflowConfigAvailable {
+		return nil
+	}
+	log := logf.FromContext(ctx)
+
+	grants := &unstructured.UnstructuredList{}
+	grants.SetAPIVersion(referenceGrantAPIVersion)
+	grants.SetKind("ReferenceGrantList")
+	err := r.List(ctx, grants, client.InNamespace(namespace), client.HasLabels{workspaceRouteLabel})
+	if apimeta.IsNoMatchError(err) {
+		if workspaceRoutesEnabled(mlflow) {
+			log.Info("ReferenceGrant API not available, skipping workspace routes")
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to list workspace ReferenceGrants: %w", err)
+	}
+
+	desired := map[string]bool{}
+	if workspaceRoutesEnabled(mlflow) {
+		mlflowConfigs, err := r.listMLflowConfigs(ctx)
+		if err != nil {
+			return err
+		}
+		for i := range mlflowConfigs {
+			mlflowConfig := &mlflowConfigs[i]
+			workspace := mlflowConfig.GetNamespace()
+			if mlflowConfig.GetName() != ResourceName || mlflowConfig.GetDeletionTimestamp() != nil {
+				continue
+			}
+			if slices.Contains(reservedWorkspacePaths, workspace) {
+				log.Info("Skipping workspace route that would shadow an MLflow server path", "workspace", workspace)
+				continue
+			}
+			if err := r.applyWorkspaceRoute(ctx, mlflow, namespace, mlflowConfig, cfg); err != nil {
+				return err
+			}
+			desired[workspace] = true
+		}
+	}
+
+	for i := range grants.Items {
+		grant := &grants.Items[i]
+		workspace := grant.GetLabels()[workspaceRouteLabel]
+		if desired[workspace] {
+			continue
+		}
+		route := &unstructured.Unstructured{}
+		route.SetAPIVersion(gatewayv1.GroupVersion.String())
+		route.SetKind("HTTPRoute")
+		route.SetName(workspaceRouteName(mlflow))
+		route.SetNamespace(workspace)
+		for _, obj := range []*unstructured.Unstructured{route, grant} {
+			if err := r.Delete(ctx, obj); err != nil && !errors.IsNotFound(err) {
+				return fmt.Errorf("failed to delete workspace %s %s/%s: %w", obj.GetKind(), obj.GetNamespace(), obj.GetName(), err)
+			}
+		}
+		log.Info("Deleted workspace route", "workspace", workspace)
+	}
+	return nil
+}
+
+// applyWorkspaceRoute applies the ReferenceGrant before the HTTPRoute, so the route never
+// reports unresolved references on creation. Both are controlled by the MLflow instance and
+// the route is also owned by its MLflowConfig, so it goes away with either.
+func (r *MLflowReconciler) applyWorkspaceRoute(
+	ctx context.Context,
+	mlflow *mlflowv1.MLflow,
+	namespace string,
+	mlflowConfig *unstructured.Unstructured,
+	cfg *config.OperatorConfig,
+) error {
+	workspace := mlflowConfig.GetNamespace()
+	grant := buildWorkspaceReferenceGrant(mlflow, namespace, workspace)
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(buildWorkspaceHTTPRoute(mlflow, namespace, workspace, cfg))
+	if err != nil {
+		return fmt.Errorf("failed to convert workspace HttpRoute: %w", err)
+	}
+	route := &unstructured.Unstructured{Object: content}
+	route.SetOwnerReferences([]metav1.OwnerReference{mlflowConfigOwnerReference(mlflowConfig)})
+
+	for _, obj := range []*unstructured.Unstructured{grant, route} {
+		propagateMetadata(mlflow, obj)
+		if err := controllerutil.SetControllerReference(mlflow, obj, r.Scheme); err != nil {
+			return fmt.Errorf("failed to set controller reference on workspace %s: %w", obj.GetKind(), err)
+		}
+		if err := r.applyObject(ctx, obj); err != nil {
+			return fmt.Errorf("failed to apply workspace %s in namespace %s: %w", obj.GetKind(), obj.GetNamespace(), err)
+		}
+	}
+	return nil
+}
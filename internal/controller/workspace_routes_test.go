@@ -0,0 +1,126 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+	"github.com/opendatahub-io/mlflow-operator/internal/render"
+)
+
+func TestBuildWorkspaceHTTPRoute(t *testing.T) {
+	g := gomega.NewWithT(t)
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "mlflow"}}
+	cfg := &config.OperatorConfig{GatewayName: "data-science-gateway"}
+
+	route := buildWorkspaceHTTPRoute(mlflow, "opendatahub", "team-a", cfg)
+	g.Expect(route.Namespace).To(gomega.Equal("team-a"))
+	g.Expect(route.Name).To(gomega.Equal("mlflow-workspace"))
+	g.Expect(route.Spec.ParentRefs).To(gomega.Equal(buildHTTPRouteParentRefs(nil, cfg)))
+
+	paths := map[string]string{}
+	for _, rule := range route.Spec.Rules {
+		g.Expect(rule.Matches).To(gomega.HaveLen(1))
+		g.Expect(rule.Filters).To(gomega.HaveLen(2))
+		g.Expect(rule.Filters[0].RequestHeaderModifier.Set).To(gomega.Equal([]gatewayv1.HTTPHeader{{Name: workspaceHeader, Value: "team-a"}}))
+		paths[*rule.Matches[0].Path.Value] = *rule.Filters[1].URLRewrite.Path.ReplacePrefixMatch
+		for _, backendRef := range rule.BackendRefs {
+			g.Expect(string(*backendRef.Namespace)).To(gomega.Equal("opendatahub"))
+		}
+	}
+	g.Expect(paths).To(gomega.Equal(map[string]string{
+		"/mlflow/team-a/v1": "/v1",
+		"/mlflow/team-a":    "/mlflow",
+	}))
+
+	// Each derived server path gets its own rule so it can be rewritten on its own.
+	mlflow.Spec.ArtifactsServer = &mlflowv1.ArtifactsServerConfig{Enabled: true}
+	route = buildWorkspaceHTTPRoute(mlflow, "opendatahub", "team-a", cfg)
+	g.Expect(route.Spec.Rules).To(gomega.HaveLen(2 + len(artifactsAPIPaths)))
+	last := route.Spec.Rules[len(route.Spec.Rules)-1]
+	g.Expect(*last.Filters[1].URLRewrite.Path.ReplacePrefixMatch).To(gomega.Equal("/mlflow" + artifactsAPIPaths[1]))
+	g.Expect(string(last.BackendRefs[0].Name)).To(gomega.Equal(render.ArtifactsServerResourceName(mlflow)))
+}
+
+func TestReconcileWorkspaceRoutes(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(gomega.Succeed())
+	g.Expect(mlflowv1.AddToScheme(scheme)).To(gomega.Succeed())
+	g.Expect(gatewayv1.Install(scheme)).To(gomega.Succeed())
+	g.Expect(gatewayv1beta1.Install(scheme)).To(gomega.Succeed())
+
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		newTestMLflowConfig("team-a", "mlflow"),
+		newTestMLflowConfig("api", "mlflow"),
+	).Build()
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow", UID: "mlflow-uid"},
+		Spec:       mlflowv1.MLflowSpec{Workspaces: &mlflowv1.WorkspacesConfig{Routes: true}},
+	}
+	r := &MLflowReconciler{Client: k8sClient, Scheme: scheme, HTTPRouteAvailable: true, MLflowConfigAvailable: true}
+	cfg := &config.OperatorConfig{GatewayName: "data-science-gateway"}
+
+	g.Expect(r.reconcileWorkspaceRoutes(ctx, mlflow, "opendatahub", cfg)).To(gomega.Succeed())
+	route := &gatewayv1.HTTPRoute{}
+	g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "mlflow-workspace", Namespace: "team-a"}, route)).To(gomega.Succeed())
+	g.Expect(route.OwnerReferences).To(gomega.HaveLen(2))
+	grant := &gatewayv1beta1.ReferenceGrant{}
+	g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "mlflow-workspace-team-a", Namespace: "opendatahub"}, grant)).To(gomega.Succeed())
+	g.Expect(grant.Spec.From).To(gomega.Equal([]gatewayv1beta1.ReferenceGrantFrom{{Group: gatewayv1.GroupName, Kind: "HTTPRoute", Namespace: "team-a"}}))
+
+	// A namespace named like a server path would shadow it on the shared Gateway.
+	err := k8sClient.Get(ctx, types.NamespacedName{Name: "mlflow-workspace", Namespace: "api"}, &gatewayv1.HTTPRoute{})
+	g.Expect(apierrors.IsNotFound(err)).To(gomega.BeTrue())
+
+	mlflow.Spec.Workspaces.Routes = false
+	g.Expect(r.reconcileWorkspaceRoutes(ctx, mlflow, "opendatahub", cfg)).To(gomega.Succeed())
+	err = k8sClient.Get(ctx, types.NamespacedName{Name: "mlflow-workspace", Namespace: "team-a"}, &gatewayv1.HTTPRoute{})
+	g.Expect(apierrors.IsNotFound(err)).To(gomega.BeTrue())
+	err = k8sClient.Get(ctx, types.NamespacedName{Name: "mlflow-workspace-team-a", Namespace: "opendatahub"}, &gatewayv1beta1.ReferenceGrant{})
+	g.Expect(apierrors.IsNotFound(err)).To(gomega.BeTrue())
+}
+
+func TestWorkspaceURL(t *testing.T) {
+	g := gomega.NewWithT(t)
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "mlflow"}}
+	mlflow.Status.URL = "https://data-science-gateway.apps.example.com/mlflow"
+
+	g.Expect(workspaceURL(mlflow, "team-a")).To(gomega.BeEmpty())
+
+	mlflow.Spec.Workspaces = &mlflowv1.WorkspacesConfig{Routes: true}
+	g.Expect(workspaceURL(mlflow, "team-a")).To(gomega.Equal("https://data-science-gateway.apps.example.com/mlflow/team-a"))
+	g.Expect(workspaceURL(mlflow, "api")).To(gomega.BeEmpty())
+
+	mlflow.Spec.Workspaces.Enabled = ptr(false)
+	g.Expect(workspaceURL(mlflow, "team-a")).To(gomega.BeEmpty())
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func ArtifactsServerEnabled(mlflow *mlflowv1.MLflow) bool {
+	return mlflow.Spec.ArtifactsServer != nil && mlflow.Spec.ArtifactsServer.Enabled
+}
+
+func ArtifactsServerResourceName(mlflow *mlflowv1.MLflow) string {
+	return ResourceName + "-artifacts" + ResourceSuffix(mlflow.Name)
+}
+
+// buildArtifactsServerObjects derives the artifacts-only Deployment, Service, and NetworkPolicy
+// from the rendered server objects, so both Deployments share image, storage, TLS, and auth
+// settings.
+func buildArtifactsServerObjects(mlflow *mlflowv1.MLflow, objects []*unstructured.Unstructured, suspended bool) ([]*unstructured.Unstructured, error) {
+	if !ArtifactsServerEnabled(mlflow) {
+		return nil, nil
+	}
+	artifactsServer := mlflow.Spec.ArtifactsServer
+	return buildDerivedServerObjects(mlflow, objects, derivedServer{
+		name:      ArtifactsServerResourceName(mlflow),
+		replicas:  derivedServerReplicas(artifactsServer.Replicas, suspended),
+		resources: artifactsServer.Resources,
+		extraArgs: []string{"--artifacts-only"},
+	})
+}
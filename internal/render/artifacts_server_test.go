@@ -0,0 +1,90 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func newArtifactsServerMLflow() *mlflowv1.MLflow {
+	return &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI:      ptr(testBackendStoreURI),
+			ServeArtifacts:       ptr(true),
+			ArtifactsDestination: ptr("s3://bucket/artifacts"),
+			ArtifactsServer: &mlflowv1.ArtifactsServerConfig{
+				Enabled:  true,
+				Replicas: ptr(int32(3)),
+				Resources: &corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+				},
+			},
+		},
+	}
+}
+
+func TestRenderChart_ArtifactsServer(t *testing.T) {
+	g := gomega.NewWithT(t)
+	renderer := NewHelmRenderer("../../charts/mlflow")
+
+	objs, err := renderer.RenderChart(newArtifactsServerMLflow(), "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	deploymentObj := findObject(objs, "Deployment", "mlflow-artifacts")
+	g.Expect(deploymentObj).NotTo(gomega.BeNil())
+	g.Expect(deploymentObj.GetLabels()).To(gomega.HaveKeyWithValue("app", "mlflow"), "metadata keeps the cached app label")
+	deployment := &appsv1.Deployment{}
+	g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(deploymentObj.Object, deployment)).To(gomega.Succeed())
+	g.Expect(*deployment.Spec.Replicas).To(gomega.Equal(int32(3)))
+	g.Expect(deployment.Spec.Selector.MatchLabels).To(gomega.HaveKeyWithValue("app", "mlflow-artifacts"))
+	g.Expect(deployment.Spec.Template.Labels).To(gomega.HaveKeyWithValue("app", "mlflow-artifacts"))
+	container := deployment.Spec.Template.Spec.Containers[0]
+	g.Expect(container.Args).To(gomega.ContainElements("--serve-artifacts", "--artifacts-only"))
+	g.Expect(container.Resources.Requests.Cpu().String()).To(gomega.Equal("2"))
+
+	server := &appsv1.Deployment{}
+	g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(findObject(objs, "Deployment", "mlflow").Object, server)).To(gomega.Succeed())
+	g.Expect(server.Spec.Template.Spec.Containers[0].Args).NotTo(gomega.ContainElement("--artifacts-only"))
+
+	service := &corev1.Service{}
+	g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(findObject(objs, "Service", "mlflow-artifacts").Object, service)).To(gomega.Succeed())
+	g.Expect(service.Spec.Selector).To(gomega.Equal(map[string]string{"app": "mlflow-artifacts"}))
+	g.Expect(service.Annotations).NotTo(gomega.HaveKey("service.beta.openshift.io/serving-cert-secret-name"))
+	g.Expect(findObject(objs, "NetworkPolicy", "mlflow-artifacts")).NotTo(gomega.BeNil())
+
+	objs, err = renderer.RenderChart(newArtifactsServerMLflow(), "test-ns", RenderOptions{Suspended: true}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	replicas, _, _ := unstructured.NestedInt64(findObject(objs, "Deployment", "mlflow-artifacts").Object, "spec", "replicas")
+	g.Expect(replicas).To(gomega.BeZero())
+
+	mlflow := newArtifactsServerMLflow()
+	mlflow.Spec.ArtifactsServer.Enabled = false
+	objs, err = renderer.RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(findObject(objs, "Deployment", "mlflow-artifacts")).To(gomega.BeNil())
+}
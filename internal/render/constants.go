@@ -0,0 +1,33 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+const (
+	// ResourceName is the base name used for MLflow resources (deployments, services, etc.)
+	ResourceName = "mlflow"
+	// ServiceAccountName is the name of the service account for MLflow deployments
+	ServiceAccountName = "mlflow-sa"
+	// GCServiceAccountName is the name of the service account for the GC CronJob
+	GCServiceAccountName = "mlflow-gc-sa"
+	// TLSSecretName is the default name for the TLS secret used by the MLflow server
+	TLSSecretName = "mlflow-tls"
+	// StaticPrefix is the URL prefix for MLflow when deployed via the operator
+	StaticPrefix = "/mlflow"
+
+	// PlatformTrustedCABundleConfigMapName is the well-known ConfigMap name for platform CA bundle
+	PlatformTrustedCABundleConfigMapName = "odh-trusted-ca-bundle"
+)
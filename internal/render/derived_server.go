@@ -0,0 +1,129 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+// derivedServer describes an extra MLflow server Deployment split off the main one.
+type derivedServer struct {
+	name      string
+	replicas  int64
+	resources *corev1.ResourceRequirements
+	extraArgs []string
+}
+
+// derivedServerReplicas returns the replica count of a derived server; suspended instances
+// scale every server to zero.
+func derivedServerReplicas(replicas *int32, suspended bool) int64 {
+	if suspended {
+		return 0
+	}
+	if replicas == nil {
+		return 1
+	}
+	return int64(*replicas)
+}
+
+// buildDerivedServerObjects copies the rendered server Deployment, Service, and NetworkPolicy
+// under server.name. Like the canary objects, the pods get their own app label so the main
+// selectors exclude them, while object metadata keeps the label the manager cache selects on.
+func buildDerivedServerObjects(mlflow *mlflowv1.MLflow, objects []*unstructured.Unstructured, server derivedServer) ([]*unstructured.Unstructured, error) {
+	mainName := ResourceName + ResourceSuffix(mlflow.Name)
+
+	var derived []*unstructured.Unstructured
+	for _, kind := range []string{"Deployment", "Service", "NetworkPolicy"} {
+		main := FindRenderedObject(objects, kind, mainName)
+		if main == nil {
+			continue
+		}
+		obj := main.DeepCopy()
+		obj.SetName(server.name)
+
+		var err error
+		switch kind {
+		case "Deployment":
+			err = setDerivedServerPodSpec(obj, server)
+		case "Service":
+			// Reuse the main serving certificate; a second serving-cert annotation
+			// would make service-ca fight over the same Secret.
+			annotations := obj.GetAnnotations()
+			delete(annotations, "service.beta.openshift.io/serving-cert-secret-name")
+			obj.SetAnnotations(annotations)
+			unstructured.RemoveNestedField(obj.Object, "spec", "loadBalancerSourceRanges")
+			if err = unstructured.SetNestedField(obj.Object, string(corev1.ServiceTypeClusterIP), "spec", "type"); err == nil {
+				err = unstructured.SetNestedField(obj.Object, server.name, "spec", "selector", "app")
+			}
+		case "NetworkPolicy":
+			err = unstructured.SetNestedField(obj.Object, server.name, "spec", "podSelector", "matchLabels", "app")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to build %s %s: %w", server.name, kind, err)
+		}
+		derived = append(derived, obj)
+	}
+	return derived, nil
+}
+
+// setDerivedServerPodSpec turns a copy of the main Deployment into the derived one.
+func setDerivedServerPodSpec(deployment *unstructured.Unstructured, server derivedServer) error {
+	if err := unstructured.SetNestedField(deployment.Object, server.replicas, "spec", "replicas"); err != nil {
+		return err
+	}
+	if err := unstructured.SetNestedField(deployment.Object, server.name, "spec", "selector", "matchLabels", "app"); err != nil {
+		return err
+	}
+	if err := unstructured.SetNestedField(deployment.Object, server.name, "spec", "template", "metadata", "labels", "app"); err != nil {
+		return err
+	}
+
+	containers, _, err := unstructured.NestedSlice(deployment.Object, "spec", "template", "spec", "containers")
+	if err != nil {
+		return err
+	}
+	for i, c := range containers {
+		container, ok := c.(map[string]interface{})
+		if !ok || container["name"] != ResourceName {
+			continue
+		}
+		if len(server.extraArgs) > 0 {
+			args, _, err := unstructured.NestedStringSlice(container, "args")
+			if err != nil {
+				return err
+			}
+			if err := unstructured.SetNestedStringSlice(container, append(args, server.extraArgs...), "args"); err != nil {
+				return err
+			}
+		}
+		if server.resources != nil {
+			resources, err := runtime.DefaultUnstructuredConverter.ToUnstructured(server.resources)
+			if err != nil {
+				return err
+			}
+			container["resources"] = resources
+		}
+		containers[i] = container
+	}
+	return unstructured.SetNestedSlice(deployment.Object, containers, "spec", "template", "spec", "containers")
+}
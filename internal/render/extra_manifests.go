@@ -0,0 +1,113 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"helm.sh/helm/v3/pkg/chart"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+const (
+	// ExtraManifestLabel marks objects rendered from spec.extraManifests, so those removed from
+	// the list can be told apart from objects the chart stops rendering.
+	ExtraManifestLabel = "mlflow.opendatahub.io/extra-manifest"
+
+	extraManifestTemplatePrefix = "extra-manifest-"
+)
+
+// extraManifestKinds are the kinds spec.extraManifests may hold. They are namespaced and run no
+// pods, so an editor of the MLflow resource cannot use them to run a workload as another
+// service account, mount Secrets into it, or change cluster-scoped objects through the operator.
+var extraManifestKinds = map[schema.GroupKind]bool{
+	{Kind: "ConfigMap"}: true,
+	{Kind: "Secret"}:    true,
+	{Group: "external-secrets.io", Kind: "ExternalSecret"}: true,
+}
+
+// addExtraManifestTemplates adds spec.extraManifests to the loaded chart as templates, so they
+// render with the same values and named templates as the chart's own.
+func addExtraManifestTemplates(c *chart.Chart, mlflow *mlflowv1.MLflow) {
+	for i, manifest := range mlflow.Spec.ExtraManifests {
+		c.Templates = append(c.Templates, &chart.File{
+			Name: fmt.Sprintf("templates/%s%d.yaml", extraManifestTemplatePrefix, i),
+			Data: []byte(manifest),
+		})
+	}
+}
+
+// extraManifestIndex returns the spec.extraManifests index a rendered template came from.
+func extraManifestIndex(templateName string) (string, bool) {
+	index, ok := strings.CutPrefix(filepath.Base(templateName), extraManifestTemplatePrefix)
+	return strings.TrimSuffix(index, ".yaml"), ok
+}
+
+// prepareExtraManifest checks an object rendered from spec.extraManifests and places it in
+// namespace. Only extraManifestKinds are accepted: anything else, such as a workload running as
+// the operator's service account, RBAC, or a cluster-scoped object, would let anyone who can edit
+// the MLflow resource act with the operator's permissions.
+func prepareExtraManifest(obj *unstructured.Unstructured, namespace string) error {
+	if obj.GetAPIVersion() == "" || obj.GetKind() == "" {
+		return fmt.Errorf("apiVersion and kind are required")
+	}
+	if obj.GetName() == "" {
+		return fmt.Errorf("%s has no metadata.name", obj.GetKind())
+	}
+	if !extraManifestKinds[obj.GroupVersionKind().GroupKind()] {
+		return fmt.Errorf("%s/%s is not allowed; extra manifests can only be ConfigMaps, Secrets, and ExternalSecrets",
+			obj.GetKind(), obj.GetName())
+	}
+	if objNamespace := obj.GetNamespace(); objNamespace != "" && objNamespace != namespace {
+		return fmt.Errorf("%s/%s must be in namespace %s, not %s", obj.GetKind(), obj.GetName(), namespace, objNamespace)
+	}
+	obj.SetNamespace(namespace)
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string, 1)
+	}
+	labels[ExtraManifestLabel] = "true"
+	obj.SetLabels(labels)
+	return nil
+}
+
+// checkExtraManifestNames rejects extra manifests that would overwrite a chart-rendered object
+// or each other, since the last one applied would silently win.
+func checkExtraManifestNames(objects []*unstructured.Unstructured) error {
+	seen := make(map[string]bool, len(objects))
+	for _, obj := range objects {
+		if obj.GetLabels()[ExtraManifestLabel] == "" {
+			seen[obj.GetKind()+"/"+obj.GetName()] = true
+		}
+	}
+	for _, obj := range objects {
+		if obj.GetLabels()[ExtraManifestLabel] == "" {
+			continue
+		}
+		key := obj.GetKind() + "/" + obj.GetName()
+		if seen[key] {
+			return fmt.Errorf("spec.extraManifests: %s is already rendered", key)
+		}
+		seen[key] = true
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func extraManifestsTestMLflow(manifests ...string) *mlflowv1.MLflow {
+	return &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: ResourceName},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI:      ptr("sqlite:////mlflow/mlflow.db"),
+			ArtifactsDestination: ptr("file:///mlflow/artifacts"),
+			CommonLabels:         map[string]string{"team": "ml"},
+			ExtraManifests:       manifests,
+		},
+	}
+}
+
+func TestRenderChart_ExtraManifests(t *testing.T) {
+	g := gomega.NewWithT(t)
+	renderer := NewHelmRenderer("../../charts/mlflow")
+
+	mlflow := extraManifestsTestMLflow(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: mlflow-extra
+  labels:
+    {{- toYaml .Values.commonLabels | nindent 4 }}
+data:
+  namespace: {{ .Release.Namespace }}
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: mlflow-extra
+`)
+	objs, err := renderer.RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	configMap := findObject(objs, "ConfigMap", "mlflow-extra")
+	g.Expect(configMap).NotTo(gomega.BeNil())
+	g.Expect(configMap.GetNamespace()).To(gomega.Equal("test-ns"))
+	g.Expect(configMap.GetLabels()).To(gomega.HaveKeyWithValue("team", "ml"))
+	g.Expect(configMap.GetLabels()).To(gomega.HaveKeyWithValue(ExtraManifestLabel, "true"))
+	data, _, _ := unstructured.NestedStringMap(configMap.Object, "data")
+	g.Expect(data).To(gomega.HaveKeyWithValue("namespace", "test-ns"))
+	g.Expect(findObject(objs, "Secret", "mlflow-extra")).NotTo(gomega.BeNil())
+
+	// Chart-rendered objects are not marked.
+	g.Expect(findObject(objs, deploymentKind, ResourceName).GetLabels()).NotTo(gomega.HaveKey(ExtraManifestLabel))
+}
+
+func TestRenderChart_ExtraManifestsRejected(t *testing.T) {
+	renderer := NewHelmRenderer("../../charts/mlflow")
+
+	tests := []struct {
+		name     string
+		manifest string
+		wantErr  string
+	}{
+		{
+			name:     "RBAC objects",
+			manifest: "apiVersion: rbac.authorization.k8s.io/v1\nkind: Role\nmetadata:\n  name: escalate\n",
+			wantErr:  "spec.extraManifests[0]: Role/escalate is not allowed",
+		},
+		{
+			name: "a Deployment",
+			manifest: "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: escalate\n" +
+				"spec:\n  template:\n    spec:\n      serviceAccountName: mlflow-operator-controller-manager\n",
+			wantErr: "Deployment/escalate is not allowed",
+		},
+		{
+			name:     "a Job",
+			manifest: "apiVersion: batch/v1\nkind: Job\nmetadata:\n  name: escalate\n",
+			wantErr:  "Job/escalate is not allowed",
+		},
+		{
+			name:     "a CronJob",
+			manifest: "apiVersion: batch/v1\nkind: CronJob\nmetadata:\n  name: escalate\n",
+			wantErr:  "CronJob/escalate is not allowed",
+		},
+		{
+			name:     "a Pod",
+			manifest: "apiVersion: v1\nkind: Pod\nmetadata:\n  name: escalate\n",
+			wantErr:  "Pod/escalate is not allowed",
+		},
+		{
+			name:     "a CustomResourceDefinition",
+			manifest: "apiVersion: apiextensions.k8s.io/v1\nkind: CustomResourceDefinition\nmetadata:\n  name: escalates.example.com\n",
+			wantErr:  "CustomResourceDefinition/escalates.example.com is not allowed",
+		},
+		{
+			name:     "a webhook configuration",
+			manifest: "apiVersion: admissionregistration.k8s.io/v1\nkind: ValidatingWebhookConfiguration\nmetadata:\n  name: escalate\n",
+			wantErr:  "ValidatingWebhookConfiguration/escalate is not allowed",
+		},
+		{
+			name:     "a PriorityClass",
+			manifest: "apiVersion: scheduling.k8s.io/v1\nkind: PriorityClass\nmetadata:\n  name: escalate\n",
+			wantErr:  "PriorityClass/escalate is not allowed",
+		},
+		{
+			name:     "a Namespace",
+			manifest: "apiVersion: v1\nkind: Namespace\nmetadata:\n  name: escalate\n",
+			wantErr:  "Namespace/escalate is not allowed",
+		},
+		{
+			name:     "another namespace",
+			manifest: "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: extra\n  namespace: other\n",
+			wantErr:  "must be in namespace test-ns, not other",
+		},
+		{
+			name:     "a duplicate name",
+			manifest: "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: extra\n---\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: extra\n",
+			wantErr:  "ConfigMap/extra is already rendered",
+		},
+		{
+			name:     "a missing name",
+			manifest: "apiVersion: v1\nkind: ConfigMap\n",
+			wantErr:  "ConfigMap has no metadata.name",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			_, err := renderer.RenderChart(extraManifestsTestMLflow(tt.manifest), "test-ns", RenderOptions{}, nil)
+			g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring(tt.wantErr)))
+		})
+	}
+}
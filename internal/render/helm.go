@@ -0,0 +1,1293 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package render builds the Helm values of an MLflow instance from its CR and renders the
+// operator's charts into the manifests the controller applies. It holds no client and
+// registers no metrics, so pkg/render can expose it without the reconciler.
+package render
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/engine"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+)
+
+const (
+	defaultStorageSize     = "2Gi"
+	defaultBackendStoreURI = "sqlite:////mlflow/mlflow.db"
+	defaultArtifactsDest   = "file:///mlflow/artifacts"
+	uvicornSSLCiphersEnv   = "UVICORN_SSL_CIPHERS"
+	uvicornSystemCiphers   = "PROFILE=SYSTEM"
+
+	// migrationStartupFailureThreshold gives the first start after a managed migration 30
+	// minutes at the default probe period.
+	migrationStartupFailureThreshold = 180
+)
+
+const (
+	systemCAPath    = "/etc/pki/tls/certs/ca-bundle.crt"
+	caPlatformMount = "/etc/pki/tls/certs/platform"
+	caCustomMount   = "/etc/pki/tls/certs/custom"
+
+	// ServiceCABundleConfigMapName and ServiceCABundleConfigMapKey locate the OpenShift
+	// service CA bundle that metrics scraping and backend TLS verify against.
+	ServiceCABundleConfigMapName = "openshift-service-ca.crt"
+	ServiceCABundleConfigMapKey  = "service-ca.crt"
+)
+
+const (
+	// caBundleHashAnnotation on the pod template rolls the MLflow pods when the injected
+	// bundle changes. Clients inside MLflow load their trust store once, so the ca-bundle
+	// watcher sidecar refreshing the combined file is not enough for them.
+	caBundleHashAnnotation = "mlflow.opendatahub.io/ca-bundle-hash"
+	// tlsCertificateHashAnnotation on the pod template rolls the MLflow pods when the
+	// self-signed certificate is rotated, since uvicorn reads it only at startup.
+	tlsCertificateHashAnnotation = "mlflow.opendatahub.io/tls-certificate-hash"
+)
+
+// ResourceSuffix returns the suffix used by most per-instance MLflow resources.
+// Returns empty string for CR named "mlflow", otherwise returns "-{crname}".
+// Shared server RBAC objects keep static names, while most namespaced resources
+// and GC RBAC objects are named as "mlflow{{ suffix }}".
+func ResourceSuffix(mlflowName string) string {
+	if mlflowName == ResourceName {
+		return ""
+	}
+	return "-" + mlflowName
+}
+
+// BuildCORSAllowedOrigins returns a comma-separated list of allowed CORS origins
+// combining safe defaults with any user-specified extra origins from the CR spec.
+func BuildCORSAllowedOrigins(mlflow *mlflowv1.MLflow, namespace string, cfg *config.OperatorConfig) string {
+	serviceName := ResourceName + ResourceSuffix(mlflow.Name)
+	const servicePort = 8443
+
+	corsOrigins := []string{
+		fmt.Sprintf("https://%s:%d", serviceName, servicePort),
+		fmt.Sprintf("https://%s.%s.svc:%d", serviceName, namespace, servicePort),
+		fmt.Sprintf("https://%s.%s.svc.cluster.local:%d", serviceName, namespace, servicePort),
+		"localhost:*",
+		"127.0.0.1:*",
+	}
+
+	for _, publicURL := range []string{cfg.MLflowURL, ServerExternalURL(mlflow)} {
+		if publicURL == "" {
+			continue
+		}
+		if u, err := url.Parse(publicURL); err == nil && u.Scheme != "" && u.Host != "" {
+			origin := u.Scheme + "://" + u.Host
+			corsOrigins = append(corsOrigins, origin)
+		}
+	}
+
+	for _, o := range mlflow.Spec.ExtraAllowedOrigins {
+		o = strings.TrimSpace(o)
+		if o == "" || strings.Contains(o, ",") {
+			continue
+		}
+		corsOrigins = append(corsOrigins, o)
+	}
+
+	return strings.Join(corsOrigins, ",")
+}
+
+// buildAffinity returns the pod affinity for the MLflow Deployment, adding the
+// spec.highAvailability anti-affinity preset when more than one replica runs and
+// the user has not configured podAntiAffinity themselves.
+func buildAffinity(mlflow *mlflowv1.MLflow, replicas int32) *corev1.Affinity {
+	ha := mlflow.Spec.HighAvailability
+	if ha == nil || ha.AntiAffinity == "" || replicas <= 1 ||
+		(mlflow.Spec.Affinity != nil && mlflow.Spec.Affinity.PodAntiAffinity != nil) {
+		return mlflow.Spec.Affinity
+	}
+
+	term := corev1.PodAffinityTerm{
+		LabelSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{"app": ResourceName + ResourceSuffix(mlflow.Name)},
+		},
+		TopologyKey: corev1.LabelHostname,
+	}
+
+	podAntiAffinity := &corev1.PodAntiAffinity{}
+	switch ha.AntiAffinity {
+	case mlflowv1.AntiAffinityHard:
+		podAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution = []corev1.PodAffinityTerm{term}
+	default:
+		podAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution = []corev1.WeightedPodAffinityTerm{
+			{Weight: 100, PodAffinityTerm: term},
+		}
+	}
+
+	affinity := &corev1.Affinity{}
+	if mlflow.Spec.Affinity != nil {
+		affinity = mlflow.Spec.Affinity.DeepCopy()
+	}
+	affinity.PodAntiAffinity = podAntiAffinity
+	return affinity
+}
+
+const (
+	// workersPerCore is the uvicorn worker count per CPU core under spec.workersPolicy=Auto.
+	workersPerCore = 2
+	// maxAutoWorkers caps spec.workersPolicy=Auto so large CPU limits do not fork more
+	// workers than the memory request can hold.
+	maxAutoWorkers = 16
+	// chartDefaultCPUMillis mirrors the chart's default CPU request, used by
+	// spec.workersPolicy=Auto when spec.resources is unset.
+	chartDefaultCPUMillis = 1000
+)
+
+// PodMonitorEnabled reports whether metrics are scraped through a PodMonitor instead of the
+// ServiceMonitor.
+func PodMonitorEnabled(mlflow *mlflowv1.MLflow, podMonitorAvailable bool) bool {
+	return podMonitorAvailable && mlflow.Spec.Metrics != nil &&
+		mlflow.Spec.Metrics.PodMonitor != nil && mlflow.Spec.Metrics.PodMonitor.Enabled
+}
+
+// AlertsEnabled reports whether the default PrometheusRule is rendered; spec.alerts is opt-out.
+func AlertsEnabled(mlflow *mlflowv1.MLflow, prometheusRuleAvailable bool) bool {
+	if !prometheusRuleAvailable {
+		return false
+	}
+	return mlflow.Spec.Alerts == nil || mlflow.Spec.Alerts.Enabled == nil || *mlflow.Spec.Alerts.Enabled
+}
+
+// ServerLimits returns spec.server.limits, or nil when unset.
+func ServerLimits(mlflow *mlflowv1.MLflow) *mlflowv1.ServerLimits {
+	if mlflow.Spec.Server == nil {
+		return nil
+	}
+	return mlflow.Spec.Server.Limits
+}
+
+// serverProxyHeaders returns spec.server.proxyHeaders, or nil when unset.
+func serverProxyHeaders(mlflow *mlflowv1.MLflow) *mlflowv1.ServerProxyHeaders {
+	if mlflow.Spec.Server == nil {
+		return nil
+	}
+	return mlflow.Spec.Server.ProxyHeaders
+}
+
+// intOrStringValue converts an IntOrString into the plain int or string Helm values expect.
+func intOrStringValue(v intstr.IntOrString) interface{} {
+	if v.Type == intstr.String {
+		return v.StrVal
+	}
+	return int64(v.IntVal)
+}
+
+// storageVolumeValues converts a PVC spec into chart storage values; a nil spec yields a
+// disabled volume with the chart defaults.
+func storageVolumeValues(spec *corev1.PersistentVolumeClaimSpec, existingClaim string) map[string]interface{} {
+	values := map[string]interface{}{
+		"enabled":          spec != nil,
+		"size":             defaultStorageSize,
+		"storageClassName": "",
+		"accessMode":       string(corev1.ReadWriteOnce),
+	}
+	if spec == nil {
+		return values
+	}
+	// Extract size from Resources.Requests[storage]
+	if storageQuantity, ok := spec.Resources.Requests[corev1.ResourceStorage]; ok {
+		values["size"] = storageQuantity.String()
+	}
+	if spec.StorageClassName != nil {
+		values["storageClassName"] = *spec.StorageClassName
+	}
+	// Only the first access mode is used, for simplicity
+	if len(spec.AccessModes) > 0 {
+		values["accessMode"] = string(spec.AccessModes[0])
+	}
+	if existingClaim != "" {
+		values["existingClaim"] = existingClaim
+	}
+	return values
+}
+
+// effectiveWorkers returns the uvicorn worker count: spec.workers when set, otherwise
+// derived from the CPU request (or limit) under spec.workersPolicy=Auto, otherwise the
+// profile's worker count, otherwise 1.
+func effectiveWorkers(mlflow *mlflowv1.MLflow) int32 {
+	if mlflow.Spec.Workers != nil {
+		return *mlflow.Spec.Workers
+	}
+	if mlflow.Spec.WorkersPolicy != mlflowv1.WorkersPolicyAuto {
+		if profile, ok := profileFor(mlflow); ok {
+			return profile.workers
+		}
+		return 1
+	}
+
+	cpuMillis := int64(chartDefaultCPUMillis)
+	if resources := effectiveResources(mlflow); resources != nil {
+		if request, ok := resources.Requests[corev1.ResourceCPU]; ok {
+			cpuMillis = request.MilliValue()
+		} else if limit, ok := resources.Limits[corev1.ResourceCPU]; ok {
+			cpuMillis = limit.MilliValue()
+		}
+	}
+	// Round up so fractional cores still get a worker per started half-core.
+	workers := (cpuMillis*workersPerCore + 999) / 1000
+	return int32(max(1, min(workers, maxAutoWorkers)))
+}
+
+// HelmRenderer handles rendering of Helm charts
+type HelmRenderer struct {
+	// Log receives notices about the spec being rendered, such as an overridden platform
+	// default. The zero value discards them.
+	Log logr.Logger
+
+	chartPath    string
+	chartVersion string
+	values       map[string]interface{}
+}
+
+// RenderOptions contains additional context needed for rendering
+type RenderOptions struct {
+	// PlatformTrustedCABundleExists indicates if the platform CA bundle ConfigMap exists in the target namespace
+	PlatformTrustedCABundleExists bool
+	// IsOpenShift indicates if the cluster is an OpenShift platform (detected via ConsoleLink CRD availability).
+	// When true, the operator configures service-ca-based TLS verification for Prometheus metrics scraping.
+	IsOpenShift bool
+	// ServiceMonitorAvailable indicates if the ServiceMonitor CRD (monitoring.coreos.com/v1) is available.
+	// When false, metrics.enabled is set to false to prevent rendering the ServiceMonitor manifest.
+	ServiceMonitorAvailable bool
+	// PodMonitorAvailable indicates if the PodMonitor CRD (monitoring.coreos.com/v1) is available.
+	// spec.metrics.podMonitor is ignored when it is false.
+	PodMonitorAvailable bool
+	// PrometheusRuleAvailable indicates if the PrometheusRule CRD (monitoring.coreos.com/v1) is
+	// available. When false, the default alerting rules are not rendered.
+	PrometheusRuleAvailable bool
+	// Suspended indicates the instance is hibernated. The Deployment is rendered with
+	// zero replicas and the GC CronJob is rendered suspended.
+	Suspended bool
+	// ResolvedImage, when set, replaces the MLflow image with its digest-pinned form
+	// (see spec.image.resolveDigest).
+	ResolvedImage string
+	// ImageArchitectures, when set, restricts MLflow pods to nodes of these architectures
+	// (see spec.image.architectureAffinity).
+	ImageArchitectures []string
+	// ManagedMigration indicates an operator-managed migration was requested or has run for
+	// the current generation. The startup probe then defaults to a longer failure budget.
+	ManagedMigration bool
+	// Proxy is the cluster-wide egress proxy passed to the MLflow containers.
+	Proxy ProxyConfig
+	// TLSCertificateHash identifies the operator-issued self-signed certificate. It is set as a
+	// pod annotation so the pods restart with a rotated certificate.
+	TLSCertificateHash string
+	// CABundleHash identifies the injected trusted CA bundle of spec.caBundleConfigMap. It is
+	// set as a pod annotation so the pods restart when the bundle changes.
+	CABundleHash string
+	// Namespaced renders Roles and RoleBindings in the target namespace instead of cluster RBAC
+	// (see WATCH_NAMESPACE).
+	Namespaced bool
+	// BaseValues are the merged spec.valuesFrom values. Values derived from the spec are
+	// merged over them.
+	BaseValues map[string]interface{}
+}
+
+// NewHelmRenderer creates a new HelmRenderer
+func NewHelmRenderer(chartPath string) *HelmRenderer {
+	return &HelmRenderer{
+		chartPath: chartPath,
+	}
+}
+
+// ChartPath returns the path of the chart the renderer loads.
+func (h *HelmRenderer) ChartPath() string {
+	return h.chartPath
+}
+
+// ValidateChart loads the chart at chartPath, so a missing or unparsable chart stops the manager
+// at startup instead of failing every reconcile with RenderFailed.
+func ValidateChart(chartPath string) error {
+	loadedChart, err := loader.Load(chartPath)
+	if err != nil {
+		return fmt.Errorf("failed to load chart %s: %w", chartPath, err)
+	}
+	if len(loadedChart.Templates) == 0 {
+		return fmt.Errorf("chart %s has no templates", chartPath)
+	}
+	return nil
+}
+
+// RenderChart renders the Helm chart with the given values.
+func (h *HelmRenderer) RenderChart(
+	mlflow *mlflowv1.MLflow,
+	namespace string,
+	opts RenderOptions,
+	cfg *config.OperatorConfig,
+) ([]*unstructured.Unstructured, error) {
+	// Load the Helm chart
+	loadedChart, err := loader.Load(h.chartPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load chart: %w", err)
+	}
+	if loadedChart.Metadata != nil {
+		h.chartVersion = loadedChart.Metadata.Version
+	}
+
+	h.values = nil
+	values, err := h.BuildValues(mlflow, namespace, opts, cfg)
+	if err != nil {
+		return nil, err
+	}
+	h.values = values
+
+	// Render the chart
+	addExtraManifestTemplates(loadedChart, mlflow)
+	rendered, err := h.renderTemplates(loadedChart, values, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render templates: %w", err)
+	}
+
+	migrationNetworkPolicy := buildMigrationNetworkPolicy(mlflow, namespace)
+	migrationNetworkPolicyMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(migrationNetworkPolicy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert migration NetworkPolicy: %w", err)
+	}
+	rendered = append(rendered, &unstructured.Unstructured{Object: migrationNetworkPolicyMap})
+
+	artifactsServer, err := buildArtifactsServerObjects(mlflow, rendered, opts.Suspended)
+	if err != nil {
+		return nil, err
+	}
+	registryServer, err := buildRegistryServerObjects(mlflow, rendered, opts.Suspended)
+	if err != nil {
+		return nil, err
+	}
+	rendered = append(rendered, artifactsServer...)
+	rendered = append(rendered, registryServer...)
+	if err := checkExtraManifestNames(rendered); err != nil {
+		return nil, err
+	}
+
+	return rendered, nil
+}
+
+// BuildValues returns the Helm values RenderChart renders the chart with: the values derived
+// from the spec merged over opts.BaseValues. It does not load the chart.
+func (h *HelmRenderer) BuildValues(
+	mlflow *mlflowv1.MLflow,
+	namespace string,
+	opts RenderOptions,
+	cfg *config.OperatorConfig,
+) (map[string]interface{}, error) {
+	values, err := h.mlflowToHelmValues(mlflow, namespace, opts, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert MLflow spec to Helm values: %w", err)
+	}
+	return CoalesceValues(values, opts.BaseValues), nil
+}
+
+// ChartVersion returns the version of the chart loaded by the last RenderChart call.
+func (h *HelmRenderer) ChartVersion() string {
+	return h.chartVersion
+}
+
+// Values returns the Helm values computed by the last RenderChart call, or nil when it failed
+// before computing them.
+func (h *HelmRenderer) Values() map[string]interface{} {
+	return h.values
+}
+
+// EffectiveMLflowImage returns the MLflow image for the instance: spec.image.image, then the
+// image for spec.version, then the operator default from config. The result is rewritten
+// through InstanceImage, so CRs never need to name a mirror themselves.
+func EffectiveMLflowImage(mlflow *mlflowv1.MLflow, cfg *config.OperatorConfig) (string, error) {
+	if mlflow.Spec.Image != nil && mlflow.Spec.Image.Image != nil {
+		return InstanceImage(mlflow, cfg, *mlflow.Spec.Image.Image), nil
+	}
+	versionImage, err := ResolveVersionImage(mlflow, cfg)
+	if err != nil {
+		return "", err
+	}
+	if versionImage != "" {
+		return InstanceImage(mlflow, cfg, versionImage), nil
+	}
+	return InstanceImage(mlflow, cfg, cfg.MLflowImage), nil
+}
+
+// InstanceImage moves an image the instance runs to spec.image.registry when it is set, and
+// otherwise rewrites it through the operator's registry mirrors and IMAGE_REGISTRY.
+func InstanceImage(mlflow *mlflowv1.MLflow, cfg *config.OperatorConfig, image string) string {
+	if mlflow.Spec.Image != nil && mlflow.Spec.Image.Registry != nil && *mlflow.Spec.Image.Registry != "" {
+		return config.ReplaceRegistry(image, *mlflow.Spec.Image.Registry)
+	}
+	return cfg.MirrorImage(image)
+}
+
+// mlflowToHelmValues converts MLflow CR spec to Helm values
+func (h *HelmRenderer) mlflowToHelmValues(
+	mlflow *mlflowv1.MLflow,
+	namespace string,
+	opts RenderOptions,
+	cfg *config.OperatorConfig,
+) (map[string]interface{}, error) {
+	values := make(map[string]interface{})
+
+	values["namespace"] = namespace
+
+	// Resource suffix for unique naming - empty string for singleton "mlflow" CR, "-<name>" for others.
+	// Shared server RBAC objects keep static names, while most namespaced resources and GC RBAC
+	// objects still use "mlflow{{ .Values.resourceSuffix }}".
+	values["resourceSuffix"] = ResourceSuffix(mlflow.Name)
+
+	commonLabels := map[string]interface{}{}
+	for k, v := range mlflow.Spec.CommonLabels {
+		commonLabels[k] = v
+	}
+	// The reserved keys are also enforced here for specs that bypassed CRD validation: a
+	// duplicate app label would replace the one Service selectors and caches rely on.
+	delete(commonLabels, "app")
+	commonLabels["component"] = "mlflow"
+	values["commonLabels"] = commonLabels
+
+	logCollectionLabels, logCollectionAnnotations := logCollectionPodMetadata(mlflow)
+	if len(mlflow.Spec.PodLabels) > 0 || len(logCollectionLabels) > 0 {
+		podLabels := make(map[string]interface{})
+		for k, v := range logCollectionLabels {
+			podLabels[k] = v
+		}
+		for k, v := range mlflow.Spec.PodLabels {
+			podLabels[k] = v
+		}
+		values["podLabels"] = podLabels
+	}
+
+	if len(mlflow.Spec.PodAnnotations) > 0 || ServiceMeshEnabled(mlflow) || len(logCollectionAnnotations) > 0 ||
+		opts.TLSCertificateHash != "" || opts.CABundleHash != "" {
+		podAnnotations := make(map[string]interface{})
+		if opts.TLSCertificateHash != "" {
+			podAnnotations[tlsCertificateHashAnnotation] = opts.TLSCertificateHash
+		}
+		if opts.CABundleHash != "" {
+			podAnnotations[caBundleHashAnnotation] = opts.CABundleHash
+		}
+		if ServiceMeshEnabled(mlflow) {
+			for k, v := range serviceMeshPodAnnotations {
+				podAnnotations[k] = v
+			}
+		}
+		for k, v := range logCollectionAnnotations {
+			podAnnotations[k] = v
+		}
+		// Explicit pod annotations win, so users can tune the sidecar and log collection.
+		for k, v := range mlflow.Spec.PodAnnotations {
+			podAnnotations[k] = v
+		}
+		values["podAnnotations"] = podAnnotations
+	}
+
+	effectiveCfg := config.GetConfig()
+	if cfg != nil {
+		// Callers can pass a reconcile-scoped config that already applied modular overrides.
+		effectiveCfg = cfg
+	}
+	tlsSecretName := TLSSecretName
+
+	tlsValues := map[string]interface{}{
+		"secretName": tlsSecretName,
+	}
+	if opts.IsOpenShift {
+		tlsValues["defaultMode"] = 416 // 0640 — group-readable; OpenShift SCC assigns fsGroup
+	} else {
+		tlsValues["defaultMode"] = 420 // 0644 — world-readable; no fsGroup on vanilla K8s
+	}
+
+	values["tls"] = tlsValues
+
+	// CA bundle configuration - build list of file paths and ConfigMap volume mounts
+	// The init container/sidecar will:
+	//   1. Include all files from caBundle.filePaths (system CA is always first)
+	//   2. Glob *.crt and *.pem files from each ConfigMap mount path
+	// Mount paths are derived from configMaps in the template via mlflow.caBundleMountPaths
+	var caConfigMaps []map[string]interface{}
+	var caFilePaths []string
+
+	// Always include system CA bundle first
+	caFilePaths = append(caFilePaths, systemCAPath)
+
+	// Add platform CA bundle if detected
+	if opts.PlatformTrustedCABundleExists {
+		caConfigMaps = append(caConfigMaps, map[string]interface{}{
+			"name":      PlatformTrustedCABundleConfigMapName,
+			"mountPath": caPlatformMount,
+		})
+	}
+
+	// Add user-provided CA bundle if specified
+	if mlflow.Spec.CABundleConfigMap != nil {
+		caConfigMaps = append(caConfigMaps, map[string]interface{}{
+			"name":      mlflow.Spec.CABundleConfigMap.Name,
+			"mountPath": caCustomMount,
+		})
+	}
+
+	values["caBundle"] = map[string]interface{}{
+		"configMaps": caConfigMaps,
+		"filePaths":  caFilePaths,
+	}
+	if proxy := proxyValues(mlflow, opts.Proxy); proxy != nil {
+		values["proxy"] = proxy
+	}
+
+	mlflowImage, err := EffectiveMLflowImage(mlflow, effectiveCfg)
+	if err != nil {
+		return nil, err
+	}
+	if opts.ResolvedImage != "" {
+		mlflowImage = opts.ResolvedImage
+	}
+	var imagePullPolicy *string
+
+	if mlflow.Spec.Image != nil && mlflow.Spec.Image.ImagePullPolicy != nil {
+		policy := string(*mlflow.Spec.Image.ImagePullPolicy)
+		imagePullPolicy = &policy
+	}
+
+	imageValues := map[string]interface{}{
+		"name": mlflowImage,
+	}
+	if imagePullPolicy != nil {
+		imageValues["imagePullPolicy"] = *imagePullPolicy
+	}
+	values["image"] = imageValues
+
+	replicas := int32(1)
+	if mlflow.Spec.Replicas != nil {
+		replicas = *mlflow.Spec.Replicas
+	}
+	if opts.Suspended {
+		replicas = 0
+	}
+	values["replicaCount"] = replicas
+	startupProbeValues := map[string]interface{}{}
+	if opts.ManagedMigration {
+		startupProbeValues["failureThreshold"] = int64(migrationStartupFailureThreshold)
+	}
+	if probe := mlflow.Spec.StartupProbe; probe != nil {
+		if probe.PeriodSeconds != nil {
+			startupProbeValues["periodSeconds"] = int64(*probe.PeriodSeconds)
+		}
+		if probe.TimeoutSeconds != nil {
+			startupProbeValues["timeoutSeconds"] = int64(*probe.TimeoutSeconds)
+		}
+		if probe.FailureThreshold != nil {
+			startupProbeValues["failureThreshold"] = int64(*probe.FailureThreshold)
+		}
+	}
+	if len(startupProbeValues) > 0 {
+		values["startupProbe"] = startupProbeValues
+	}
+	if mlflow.Spec.MinReadySeconds != nil {
+		values["minReadySeconds"] = int64(*mlflow.Spec.MinReadySeconds)
+	}
+	if strategy := mlflow.Spec.UpgradeStrategy; strategy != nil && strategy.RollingUpdate != nil {
+		rollingUpdateValues := map[string]interface{}{}
+		if strategy.RollingUpdate.MaxSurge != nil {
+			rollingUpdateValues["maxSurge"] = intOrStringValue(*strategy.RollingUpdate.MaxSurge)
+		}
+		if strategy.RollingUpdate.MaxUnavailable != nil {
+			rollingUpdateValues["maxUnavailable"] = intOrStringValue(*strategy.RollingUpdate.MaxUnavailable)
+		}
+		values["rollingUpdate"] = rollingUpdateValues
+	}
+
+	if resources := effectiveResources(mlflow); resources != nil {
+		resourcesMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(resources)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert resources: %w", err)
+		}
+		values["resources"] = resourcesMap
+	}
+
+	// Storage - only enabled if explicitly configured
+	// This allows users to use remote storage (S3, PostgreSQL, etc.) without PVC
+	storageValues := storageVolumeValues(nil, "")
+	databaseValues := storageVolumeValues(nil, "")
+	artifactsValues := storageVolumeValues(nil, "")
+	if storage := mlflow.Spec.Storage; storage != nil {
+		storageValues = storageVolumeValues(&storage.PersistentVolumeClaimSpec, storage.ExistingClaim)
+		if storage.Ephemeral {
+			storageValues["ephemeral"] = true
+			if storage.EphemeralSizeLimit != nil {
+				storageValues["ephemeralSizeLimit"] = storage.EphemeralSizeLimit.String()
+			}
+		}
+		if storage.Database != nil {
+			databaseValues = storageVolumeValues(&storage.Database.PersistentVolumeClaimSpec, storage.Database.ExistingClaim)
+		}
+		if storage.Artifacts != nil {
+			artifactsValues = storageVolumeValues(&storage.Artifacts.PersistentVolumeClaimSpec, storage.Artifacts.ExistingClaim)
+		}
+	}
+	storageValues["database"] = databaseValues
+	storageValues["artifacts"] = artifactsValues
+	values["storage"] = storageValues
+
+	backendStoreURI := ""
+	artifactsDest := defaultArtifactsDest
+
+	// BackendStoreURI: prefer secret ref over direct value
+	var backendStoreURIFrom map[string]interface{}
+	if mlflow.Spec.BackendStoreURIFrom != nil {
+		backendStoreURIFrom = map[string]interface{}{
+			"secretKeyRef": map[string]interface{}{
+				"name": mlflow.Spec.BackendStoreURIFrom.Name,
+				"key":  mlflow.Spec.BackendStoreURIFrom.Key,
+			},
+		}
+		if mlflow.Spec.BackendStoreURIFrom.Optional != nil {
+			backendStoreURIFrom["secretKeyRef"].(map[string]interface{})["optional"] = *mlflow.Spec.BackendStoreURIFrom.Optional
+		}
+	} else if mlflow.Spec.BackendStoreURI != nil {
+		backendStoreURI = *mlflow.Spec.BackendStoreURI
+	} else {
+		// Preserve the legacy implicit SQLite default for already-stored CRs that
+		// predate the explicit backendStoreUri validation. New creates and updates
+		// are still required to set backendStoreUri or backendStoreUriFrom by admission.
+		backendStoreURI = defaultBackendStoreURI
+	}
+
+	// RegistryStoreURI: defaults to backendStoreUri when omitted (per API contract)
+	// Prefer secret ref over direct value
+	var registryStoreURIFrom map[string]interface{}
+	registryStoreURI := backendStoreURI // Default to backend URI when provided
+	if mlflow.Spec.RegistryStoreURIFrom != nil {
+		registryStoreURIFrom = map[string]interface{}{
+			"secretKeyRef": map[string]interface{}{
+				"name": mlflow.Spec.RegistryStoreURIFrom.Name,
+				"key":  mlflow.Spec.RegistryStoreURIFrom.Key,
+			},
+		}
+		if mlflow.Spec.RegistryStoreURIFrom.Optional != nil {
+			registryStoreURIFrom["secretKeyRef"].(map[string]interface{})["optional"] = *mlflow.Spec.RegistryStoreURIFrom.Optional
+		}
+	} else if mlflow.Spec.RegistryStoreURI != nil {
+		registryStoreURI = *mlflow.Spec.RegistryStoreURI
+	} else if backendStoreURIFrom != nil {
+		// Registry isn't set, but backend uses secret ref - use the same secret for registry
+		registryStoreURIFrom = backendStoreURIFrom
+	}
+	// Otherwise registryStoreURI already defaults to backendStoreURI
+
+	if mlflow.Spec.ArtifactsDestination != nil {
+		artifactsDest = *mlflow.Spec.ArtifactsDestination
+	}
+
+	// DefaultArtifactRoot: only set if user explicitly specifies it. This is required when
+	// serveArtifacts is false.
+	// When unset, MLflow uses intelligent defaults when serveArtifacts is true:
+	var defaultArtifactRoot string
+	if mlflow.Spec.DefaultArtifactRoot != nil {
+		defaultArtifactRoot = *mlflow.Spec.DefaultArtifactRoot
+	}
+
+	// Wildcard to allow all hosts
+	allowedHosts := []string{"*"}
+
+	// Defaults to false, but MUST be true when using file-based artifact storage
+	serveArtifacts := false
+	if mlflow.Spec.ServeArtifacts != nil {
+		serveArtifacts = *mlflow.Spec.ServeArtifacts
+	}
+
+	workers := effectiveWorkers(mlflow)
+
+	var workspaceLabelSelector string
+	if mlflow.Spec.WorkspaceLabelSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(mlflow.Spec.WorkspaceLabelSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid workspaceLabelSelector: %w", err)
+		}
+		workspaceLabelSelector = selector.String()
+	}
+
+	mlflowConfig := map[string]interface{}{
+		"backendStoreUri":      backendStoreURI,
+		"registryStoreUri":     registryStoreURI,
+		"artifactsDestination": artifactsDest,
+		"defaultArtifactRoot":  defaultArtifactRoot,
+		"enableWorkspaces":     WorkspacesEnabled(mlflow),
+		"workspaceStoreUri":    WorkspaceStoreURI(mlflow),
+		"serveArtifacts":       serveArtifacts,
+		"workers":              workers,
+		"port":                 8443,
+		"allowedHosts":         allowedHosts,
+		"staticPrefix":         ServerStaticPrefix(mlflow),
+	}
+
+	if workspaceLabelSelector != "" {
+		mlflowConfig["workspaceLabelSelector"] = workspaceLabelSelector
+	}
+	if S3InsecureSkipTLSVerify(mlflow) {
+		mlflowConfig["s3IgnoreTLS"] = true
+	}
+	if s3 := s3ArtifactsConfig(mlflow); s3 != nil && usesS3ArtifactStore(mlflow) {
+		if s3.EndpointURL != nil {
+			mlflowConfig["s3EndpointUrl"] = *s3.EndpointURL
+		}
+		if s3.PathStyle != nil && *s3.PathStyle {
+			mlflowConfig["s3AddressingStyle"] = "path"
+		}
+	}
+	if limits := ServerLimits(mlflow); limits != nil && limits.KeepAliveTimeoutSeconds != nil {
+		mlflowConfig["timeoutKeepAlive"] = *limits.KeepAliveTimeoutSeconds
+	}
+	proxyHeaders := map[string]interface{}{"enabled": true}
+	if headers := serverProxyHeaders(mlflow); headers != nil {
+		if headers.Enabled != nil {
+			proxyHeaders["enabled"] = *headers.Enabled
+		}
+		if len(headers.ForwardedAllowIPs) > 0 {
+			proxyHeaders["forwardedAllowIps"] = strings.Join(headers.ForwardedAllowIPs, ",")
+		}
+	}
+	mlflowConfig["proxyHeaders"] = proxyHeaders
+	if mlflow.Spec.Server != nil && mlflow.Spec.Server.Logging != nil {
+		logging := mlflow.Spec.Server.Logging
+		loggingValues := map[string]interface{}{}
+		if logging.Level != "" {
+			loggingValues["level"] = string(logging.Level)
+		}
+		if logging.Format != "" {
+			loggingValues["format"] = string(logging.Format)
+		}
+		if logging.AccessLog != nil {
+			loggingValues["accessLog"] = *logging.AccessLog
+		}
+		mlflowConfig["logging"] = loggingValues
+	}
+
+	// Add secret references if provided
+	if backendStoreURIFrom != nil {
+		mlflowConfig["backendStoreUriFrom"] = backendStoreURIFrom
+	}
+	if registryStoreURIFrom != nil {
+		mlflowConfig["registryStoreUriFrom"] = registryStoreURIFrom
+	}
+
+	mlflowConfig["corsAllowedOrigins"] = BuildCORSAllowedOrigins(mlflow, namespace, effectiveCfg)
+
+	values["mlflow"] = mlflowConfig
+
+	profileEnvVars := profileEnv(mlflow)
+	tracingEnvVars := tracingEnv(mlflow, namespace)
+	envCapacity := len(mlflow.Spec.Env) + len(profileEnvVars) + len(tracingEnvVars)
+	if opts.IsOpenShift {
+		envCapacity++
+	}
+	env := make([]interface{}, 0, envCapacity)
+	hasCustomUvicornSSLCiphers := false
+
+	// Add custom env vars from spec
+	for i, e := range mlflow.Spec.Env {
+		if opts.IsOpenShift && e.Name == uvicornSSLCiphersEnv {
+			hasCustomUvicornSSLCiphers = true
+			h.Log.Info("MLflow CR overrides the default OpenShift uvicorn SSL ciphers",
+				"name", mlflow.Name,
+				"namespace", namespace,
+				"envVar", uvicornSSLCiphersEnv,
+			)
+		}
+		envMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&e)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert env[%d]: %w", i, err)
+		}
+		env = append(env, envMap)
+	}
+
+	for _, e := range profileEnvVars {
+		env = append(env, map[string]interface{}{"name": e.Name, "value": e.Value})
+	}
+
+	for _, e := range tracingEnvVars {
+		env = append(env, map[string]interface{}{"name": e.Name, "value": e.Value})
+	}
+
+	if opts.IsOpenShift && !hasCustomUvicornSSLCiphers {
+		env = append(env, map[string]interface{}{
+			"name":  uvicornSSLCiphersEnv,
+			"value": uvicornSystemCiphers,
+		})
+	}
+
+	values["env"] = env
+
+	if len(mlflow.Spec.EnvFrom) > 0 {
+		envFrom := make([]interface{}, 0, len(mlflow.Spec.EnvFrom))
+		for i, ef := range mlflow.Spec.EnvFrom {
+			envFromMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&ef)
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert envFrom[%d]: %w", i, err)
+			}
+			envFrom = append(envFrom, envFromMap)
+		}
+		values["envFrom"] = envFrom
+	}
+
+	serviceAccountName := ServiceAccountName
+	if mlflow.Spec.ServiceAccountName != nil {
+		serviceAccountName = *mlflow.Spec.ServiceAccountName
+	}
+	serviceAccountValues := map[string]interface{}{
+		"create": true,
+		"name":   serviceAccountName,
+	}
+	if mlflow.Spec.ServiceAccount != nil && len(mlflow.Spec.ServiceAccount.Annotations) > 0 {
+		serviceAccountAnnotations := make(map[string]interface{}, len(mlflow.Spec.ServiceAccount.Annotations))
+		for k, v := range mlflow.Spec.ServiceAccount.Annotations {
+			serviceAccountAnnotations[k] = v
+		}
+		serviceAccountValues["annotations"] = serviceAccountAnnotations
+	}
+	values["serviceAccount"] = serviceAccountValues
+
+	// Add OpenShift service-ca annotation for automatic cert provisioning
+	serviceAnnotations := map[string]interface{}{
+		"service.beta.openshift.io/serving-cert-secret-name": tlsSecretName,
+	}
+
+	serviceValues := map[string]interface{}{
+		"type":        string(corev1.ServiceTypeClusterIP),
+		"port":        8443,
+		"annotations": serviceAnnotations,
+	}
+	if svc := mlflow.Spec.Service; svc != nil {
+		if svc.Type != nil && *svc.Type != "" {
+			serviceValues["type"] = string(*svc.Type)
+		}
+		if lb := svc.LoadBalancer; lb != nil {
+			for k, v := range lb.Annotations {
+				if _, reserved := serviceAnnotations[k]; reserved {
+					continue
+				}
+				serviceAnnotations[k] = v
+			}
+			if len(lb.SourceRanges) > 0 {
+				sourceRanges := make([]interface{}, 0, len(lb.SourceRanges))
+				for _, cidr := range lb.SourceRanges {
+					sourceRanges = append(sourceRanges, cidr)
+				}
+				serviceValues["loadBalancerSourceRanges"] = sourceRanges
+			}
+		}
+		if svc.SessionAffinity != nil && *svc.SessionAffinity == corev1.ServiceAffinityClientIP {
+			serviceValues["sessionAffinity"] = string(corev1.ServiceAffinityClientIP)
+			if svc.SessionAffinityTimeoutSeconds != nil {
+				serviceValues["sessionAffinityTimeoutSeconds"] = int64(*svc.SessionAffinityTimeoutSeconds)
+			}
+		}
+	}
+	if ServiceMeshEnabled(mlflow) {
+		// Declare the port as TLS so the sidecar tunnels MLflow's own TLS instead of sniffing it.
+		serviceValues["appProtocol"] = "https"
+	}
+	values["service"] = serviceValues
+
+	// Metrics configuration - only enabled when the ServiceMonitor CRD, or the PodMonitor CRD
+	// for spec.metrics.podMonitor, is present in the cluster.
+	// On OpenShift, configure service-ca-based TLS verification for Prometheus scraping.
+	// On non-OpenShift clusters, fall back to insecureSkipVerify.
+	podMonitor := PodMonitorEnabled(mlflow, opts.PodMonitorAvailable)
+	metricsConfig := map[string]interface{}{
+		"enabled":    opts.ServiceMonitorAvailable || podMonitor,
+		"podMonitor": podMonitor,
+	}
+	if opts.IsOpenShift {
+		serviceName := "mlflow" + ResourceSuffix(mlflow.Name)
+		serverName := fmt.Sprintf("%s.%s.svc", serviceName, namespace)
+		metricsConfig["tlsConfig"] = map[string]interface{}{
+			"ca": map[string]interface{}{
+				"configMap": map[string]interface{}{
+					"name": ServiceCABundleConfigMapName,
+					"key":  ServiceCABundleConfigMapKey,
+				},
+			},
+			"serverName": serverName,
+		}
+	} else {
+		metricsConfig["tlsConfig"] = map[string]interface{}{
+			"insecureSkipVerify": true,
+		}
+	}
+	values["metrics"] = metricsConfig
+	values["alerts"] = map[string]interface{}{
+		"enabled": AlertsEnabled(mlflow, opts.PrometheusRuleAvailable),
+	}
+
+	if mlflow.Spec.PodSecurityContext != nil {
+		// Convert PodSecurityContext to map
+		// For now, we'll pass through the whole object as-is
+		// Helm templates will handle the YAML marshaling
+		values["podSecurityContext"] = mlflow.Spec.PodSecurityContext
+	} else {
+		values["podSecurityContext"] = map[string]interface{}{
+			"runAsNonRoot": true,
+			"seccompProfile": map[string]interface{}{
+				"type": "RuntimeDefault",
+			},
+		}
+	}
+
+	if mlflow.Spec.SecurityContext != nil {
+		values["securityContext"] = mlflow.Spec.SecurityContext
+	} else {
+		values["securityContext"] = map[string]interface{}{
+			"allowPrivilegeEscalation": false,
+			"readOnlyRootFilesystem":   true,
+			"capabilities": map[string]interface{}{
+				"drop": []string{"ALL"},
+			},
+		}
+	}
+
+	if len(mlflow.Spec.NodeSelector) > 0 {
+		values["nodeSelector"] = mlflow.Spec.NodeSelector
+	} else {
+		values["nodeSelector"] = map[string]string{}
+	}
+
+	if len(mlflow.Spec.Tolerations) > 0 {
+		values["tolerations"] = mlflow.Spec.Tolerations
+	} else {
+		values["tolerations"] = []corev1.Toleration{}
+	}
+
+	if len(mlflow.Spec.ResourceClaims) > 0 {
+		values["resourceClaims"] = mlflow.Spec.ResourceClaims
+	} else {
+		values["resourceClaims"] = []corev1.PodResourceClaim{}
+	}
+
+	affinity := withArchitectureAffinity(buildAffinity(mlflow, replicas), mlflow.Spec.NodeSelector, opts.ImageArchitectures)
+	if affinity != nil {
+		values["affinity"] = affinity
+	} else {
+		values["affinity"] = map[string]interface{}{}
+	}
+
+	egressRules := make([]interface{}, 0, len(mlflow.Spec.NetworkPolicyEgressRules))
+	for i, rule := range mlflow.Spec.NetworkPolicyEgressRules {
+		ruleMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&rule)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert networkPolicyEgressRules[%d]: %w", i, err)
+		}
+		egressRules = append(egressRules, ruleMap)
+	}
+	additionalEgressRules := make([]interface{}, 0, len(mlflow.Spec.NetworkPolicyAdditionalEgressRules))
+	for i, rule := range mlflow.Spec.NetworkPolicyAdditionalEgressRules {
+		ruleMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&rule)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert networkPolicyAdditionalEgressRules[%d]: %w", i, err)
+		}
+		additionalEgressRules = append(additionalEgressRules, ruleMap)
+	}
+	values["networkPolicy"] = map[string]interface{}{
+		"egressRules":           egressRules,
+		"additionalEgressRules": additionalEgressRules,
+	}
+
+	tracing, err := tracingValues(mlflow, effectiveCfg)
+	if err != nil {
+		return nil, err
+	}
+	values["tracing"] = tracing
+
+	// Garbage collection - disabled unless explicitly configured in the CR
+	gcValues := map[string]interface{}{
+		"enabled": false,
+	}
+	if mlflow.Spec.GarbageCollection != nil {
+		gcValues["enabled"] = true
+		gcValues["suspend"] = opts.Suspended
+		gcValues["schedule"] = mlflow.Spec.GarbageCollection.Schedule
+		gcValues["serviceAccount"] = map[string]interface{}{
+			"name": GCServiceAccountName,
+		}
+		if olderThan := gcOlderThan(mlflow); olderThan != nil {
+			gcValues["olderThan"] = *olderThan
+		}
+		maxRunAge, err := maxRunAgeSeconds(mlflow)
+		if err != nil {
+			return nil, err
+		}
+		if maxRunAge > 0 {
+			gcValues["maxRunAgeSeconds"] = maxRunAge
+		}
+		if mlflow.Spec.GarbageCollection.Resources != nil {
+			resourcesMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(mlflow.Spec.GarbageCollection.Resources)
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert garbageCollection.resources: %w", err)
+			}
+			gcValues["resources"] = resourcesMap
+		}
+	}
+	values["garbageCollection"] = gcValues
+
+	values["accessRoles"] = map[string]interface{}{
+		"aggregateToDefaultRoles": accessRolesAggregated(mlflow),
+	}
+	values["rbac"] = map[string]interface{}{
+		"namespaced": opts.Namespaced,
+	}
+
+	return values, nil
+}
+
+// DefaultWorkspaceStoreURI exposes namespaces as MLflow workspaces.
+const DefaultWorkspaceStoreURI = "kubernetes://"
+
+// WorkspacesEnabled reports whether spec.workspaces leaves MLflow workspaces on, the default.
+func WorkspacesEnabled(mlflow *mlflowv1.MLflow) bool {
+	return mlflow.Spec.Workspaces == nil || mlflow.Spec.Workspaces.Enabled == nil || *mlflow.Spec.Workspaces.Enabled
+}
+
+// WorkspaceStoreURI returns spec.workspaces.storeUri, or the Kubernetes workspace store.
+func WorkspaceStoreURI(mlflow *mlflowv1.MLflow) string {
+	if mlflow.Spec.Workspaces != nil && mlflow.Spec.Workspaces.StoreURI != nil {
+		return *mlflow.Spec.Workspaces.StoreURI
+	}
+	return DefaultWorkspaceStoreURI
+}
+
+// accessRolesAggregated reports whether the per-instance access roles aggregate into the
+// built-in view, edit, and admin ClusterRoles. It is on by default.
+func accessRolesAggregated(mlflow *mlflowv1.MLflow) bool {
+	return mlflow.Spec.AccessRoles == nil || mlflow.Spec.AccessRoles.AggregateToDefaultRoles == nil ||
+		*mlflow.Spec.AccessRoles.AggregateToDefaultRoles
+}
+
+// migrationNetworkPolicyLabels labels the Go-built migration NetworkPolicy like the chart
+// labels its objects: spec.commonLabels plus a fixed component.
+func migrationNetworkPolicyLabels(mlflow *mlflowv1.MLflow) map[string]string {
+	labels := map[string]string{}
+	for k, v := range mlflow.Spec.CommonLabels {
+		if k != "app" {
+			labels[k] = v
+		}
+	}
+	labels["component"] = "mlflow-migration"
+	return labels
+}
+
+func buildMigrationNetworkPolicy(mlflow *mlflowv1.MLflow, namespace string) *networkingv1.NetworkPolicy {
+	udp := corev1.ProtocolUDP
+	tcp := corev1.ProtocolTCP
+	port53 := intstr.FromInt32(53)
+	port5353 := intstr.FromInt32(5353)
+	port5432 := intstr.FromInt32(5432)
+	port3306 := intstr.FromInt32(3306)
+
+	egressRules := []networkingv1.NetworkPolicyEgressRule{
+		{
+			Ports: []networkingv1.NetworkPolicyPort{
+				{Protocol: &udp, Port: &port53},
+				{Protocol: &tcp, Port: &port53},
+				{Protocol: &udp, Port: &port5353},
+				{Protocol: &tcp, Port: &port5353},
+			},
+		},
+		{
+			Ports: []networkingv1.NetworkPolicyPort{
+				{Protocol: &tcp, Port: &port5432},
+				{Protocol: &tcp, Port: &port3306},
+			},
+		},
+	}
+	if len(mlflow.Spec.NetworkPolicyAdditionalEgressRules) > 0 {
+		egressRules = append(egressRules, mlflow.Spec.NetworkPolicyAdditionalEgressRules...)
+	}
+
+	return &networkingv1.NetworkPolicy{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "networking.k8s.io/v1",
+			Kind:       "NetworkPolicy",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s%s-migration", ResourceName, ResourceSuffix(mlflow.Name)),
+			Namespace: namespace,
+			Labels:    migrationNetworkPolicyLabels(mlflow),
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"component": "mlflow-migration",
+				},
+			},
+			PolicyTypes: []networkingv1.PolicyType{
+				networkingv1.PolicyTypeIngress,
+				networkingv1.PolicyTypeEgress,
+			},
+			Ingress: []networkingv1.NetworkPolicyIngressRule{},
+			Egress:  egressRules,
+		},
+	}
+}
+
+// chartValuesError reports computed values that violate the chart's values.schema.json.
+type chartValuesError struct {
+	problems []string
+}
+
+// newChartValuesError flattens the per-chart, multi-line report of the schema validator into
+// one problem per violation, so it reads well in a condition message.
+func newChartValuesError(err error) *chartValuesError {
+	var problems []string
+	for _, line := range strings.Split(err.Error(), "\n") {
+		line = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), "- "))
+		// Chart name headers ("mlflow:") and parents of nested violations carry no detail.
+		if line == "" || (strings.HasSuffix(line, ":") && !strings.Contains(line, " ")) ||
+			strings.HasSuffix(line, ": validation failed") {
+			continue
+		}
+		problems = append(problems, line)
+	}
+	// The validator reports in map order; sort so the condition message is stable.
+	slices.Sort(problems)
+	return &chartValuesError{problems: problems}
+}
+
+func (e *chartValuesError) Error() string {
+	return "chart values do not match values.schema.json: " + strings.Join(e.problems, "; ")
+}
+
+// validateChartValues checks the coalesced values against the chart schema. Values built by the
+// operator hold typed Go values, such as []corev1.Toleration, that the validator cannot inspect,
+// so they are round-tripped through JSON first.
+func validateChartValues(c *chart.Chart, values interface{}) error {
+	if c.Schema == nil {
+		return nil
+	}
+	raw, err := json.Marshal(values)
+	if err != nil {
+		return fmt.Errorf("failed to marshal chart values: %w", err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	normalized := map[string]interface{}{}
+	if err := decoder.Decode(&normalized); err != nil {
+		return fmt.Errorf("failed to decode chart values: %w", err)
+	}
+	if err := chartutil.ValidateAgainstSchema(c, normalized); err != nil {
+		return newChartValuesError(err)
+	}
+	return nil
+}
+
+// IsChartValuesError reports whether err was caused by a values.schema.json violation.
+func IsChartValuesError(err error) bool {
+	var valuesErr *chartValuesError
+	return errors.As(err, &valuesErr)
+}
+
+// renderTemplates renders the Helm templates with the given values
+func (h *HelmRenderer) renderTemplates(c *chart.Chart, values map[string]interface{}, namespace string) ([]*unstructured.Unstructured, error) {
+	// Create release options
+	releaseOptions := chartutil.ReleaseOptions{
+		Name:      "mlflow",
+		Namespace: namespace,
+		IsInstall: true,
+	}
+
+	// Generate values with built-in objects. Schema validation runs separately so that values
+	// violating values.schema.json are reported as such, rather than as a template failure.
+	valuesToRender, err := chartutil.ToRenderValuesWithSchemaValidation(c, values, releaseOptions, nil, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare values: %w", err)
+	}
+	if err := validateChartValues(c, valuesToRender["Values"]); err != nil {
+		return nil, err
+	}
+
+	// Render templates
+	renderedTemplates, err := engine.Render(c, valuesToRender)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render templates: %w", err)
+	}
+
+	// Parse rendered YAML into unstructured objects
+	var objects []*unstructured.Unstructured
+	for name, content := range renderedTemplates {
+		// Skip empty files and notes
+		if len(content) == 0 || filepath.Base(name) == "NOTES.txt" {
+			continue
+		}
+
+		// Parse YAML documents (may contain multiple documents separated by ---)
+		decoder := yaml.NewYAMLToJSONDecoder(bytes.NewBufferString(content))
+		for {
+			obj := &unstructured.Unstructured{}
+			err := decoder.Decode(obj)
+			if err != nil {
+				// io.EOF is expected - it means we've reached the end of the YAML stream
+				if err == io.EOF {
+					break
+				}
+				// Any other error is a real problem (e.g., malformed YAML)
+				return nil, fmt.Errorf("failed to decode template %s: %w", name, err)
+			}
+
+			// Skip empty objects
+			if len(obj.Object) == 0 {
+				continue
+			}
+			if index, ok := extraManifestIndex(name); ok {
+				if err := prepareExtraManifest(obj, namespace); err != nil {
+					return nil, fmt.Errorf("spec.extraManifests[%s]: %w", index, err)
+				}
+			}
+
+			objects = append(objects, obj)
+		}
+	}
+
+	return objects, nil
+}
+
+// FindRenderedObject returns the rendered object of the given kind and name, or nil.
+func FindRenderedObject(objects []*unstructured.Unstructured, kind, name string) *unstructured.Unstructured {
+	for _, obj := range objects {
+		if obj.GetKind() == kind && obj.GetName() == name {
+			return obj
+		}
+	}
+	return nil
+}
@@ -14,11 +14,12 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package controller
+package render
 
 import (
 	"testing"
 
+	"github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
@@ -381,3 +382,23 @@ func TestRenderChart_NoCABundle(t *testing.T) {
 		}
 	}
 }
+
+func TestRenderChart_CABundleHashAnnotation(t *testing.T) {
+	g := gomega.NewWithT(t)
+	renderer := NewHelmRenderer("../../charts/mlflow")
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI:   ptr(testBackendStoreURI),
+			CABundleConfigMap: &mlflowv1.CABundleConfigMapSpec{Name: "trusted-ca"},
+		},
+	}
+
+	objs, err := renderer.RenderChart(mlflow, "test-ns", RenderOptions{CABundleHash: "0123abcd"}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	deployment := findObject(objs, deploymentKind, "mlflow")
+	g.Expect(deployment).NotTo(gomega.BeNil())
+	annotations, _, err := unstructured.NestedStringMap(deployment.Object, "spec", "template", "metadata", "annotations")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(annotations).To(gomega.HaveKeyWithValue(caBundleHashAnnotation, "0123abcd"))
+}
@@ -14,7 +14,7 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package controller
+package render
 
 import (
 	"strings"
@@ -155,7 +155,7 @@ func TestBuildCORSAllowedOrigins(t *testing.T) {
 				MLflowURL: tt.mlflowURL,
 			}
 
-			result := buildCORSAllowedOrigins(tt.mlflow, tt.namespace, cfg)
+			result := BuildCORSAllowedOrigins(tt.mlflow, tt.namespace, cfg)
 			origins := strings.Split(result, ",")
 			originSet := make(map[string]struct{}, len(origins))
 			for _, o := range origins {
@@ -14,7 +14,7 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package controller
+package render
 
 import (
 	"testing"
@@ -391,7 +391,7 @@ func TestRenderChart_GarbageCollection(t *testing.T) {
 				Spec: mlflowv1.MLflowSpec{
 					BackendStoreURI:      ptr("sqlite:////mlflow/mlflow.db"),
 					ArtifactsDestination: ptr("file:///mlflow/artifacts"),
-					Storage:              &corev1.PersistentVolumeClaimSpec{},
+					Storage:              &mlflowv1.StorageConfig{},
 					GarbageCollection: &mlflowv1.GarbageCollectionSpec{
 						Schedule: "0 2 * * 0",
 					},
@@ -14,7 +14,7 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package controller
+package render
 
 import (
 	"testing"
@@ -43,7 +43,7 @@ func TestMlflowToHelmValues_Image(t *testing.T) {
 					BackendStoreURI: ptr(testBackendStoreURI),
 				},
 			},
-			wantName: controllerTestMLflowImage,
+			wantName: testMLflowImage,
 			// pullPolicy should not be set when not explicitly provided
 			wantPullPolicy: "",
 		},
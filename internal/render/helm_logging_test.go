@@ -0,0 +1,153 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func renderLoggingTestDeployment(g *gomega.WithT, logging *mlflowv1.ServerLogging) (*corev1.Container, *corev1.PodSpec, []*unstructured.Unstructured) {
+	objs, err := NewHelmRenderer("../../charts/mlflow").RenderChart(&mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI: ptr(testBackendStoreURI),
+			Server:          &mlflowv1.ServerConfig{Logging: logging},
+		},
+	}, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	deployment := &appsv1.Deployment{}
+	g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(findObject(objs, "Deployment", "mlflow").Object, deployment)).To(gomega.Succeed())
+	return findContainer(deployment.Spec.Template.Spec.Containers, "mlflow"), &deployment.Spec.Template.Spec, objs
+}
+
+func uvicornOpts(container *corev1.Container) string {
+	for _, arg := range container.Args {
+		if strings.HasPrefix(arg, "--uvicorn-opts=") {
+			return arg
+		}
+	}
+	return ""
+}
+
+func envNames(env []corev1.EnvVar) []string {
+	names := make([]string, 0, len(env))
+	for _, e := range env {
+		names = append(names, e.Name)
+	}
+	return names
+}
+
+func volumeNames(volumes []corev1.Volume) []string {
+	names := make([]string, 0, len(volumes))
+	for _, v := range volumes {
+		names = append(names, v.Name)
+	}
+	return names
+}
+
+func TestRenderChart_ServerLoggingDefaults(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	container, podSpec, objs := renderLoggingTestDeployment(g, nil)
+	opts := uvicornOpts(container)
+	g.Expect(opts).NotTo(gomega.ContainSubstring("--log-level"))
+	g.Expect(opts).NotTo(gomega.ContainSubstring("--no-access-log"))
+	g.Expect(opts).NotTo(gomega.ContainSubstring("--log-config"))
+	g.Expect(envNames(container.Env)).NotTo(gomega.ContainElement(gomega.BeElementOf("MLFLOW_LOGGING_LEVEL", "MLFLOW_CONFIGURE_LOGGING", "PYTHONPATH")))
+	g.Expect(volumeNames(podSpec.Volumes)).NotTo(gomega.ContainElement("mlflow-logging"))
+	g.Expect(findObject(objs, "ConfigMap", "mlflow-logging")).To(gomega.BeNil())
+}
+
+func TestRenderChart_ServerLoggingText(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	container, _, objs := renderLoggingTestDeployment(g, &mlflowv1.ServerLogging{
+		Level:     "warning",
+		Format:    mlflowv1.ServerLogFormatText,
+		AccessLog: ptr(false),
+	})
+	opts := uvicornOpts(container)
+	g.Expect(opts).To(gomega.ContainSubstring(" --log-level=warning"))
+	g.Expect(opts).To(gomega.ContainSubstring(" --no-access-log"))
+	g.Expect(opts).NotTo(gomega.ContainSubstring("--log-config"))
+	g.Expect(container.Env).To(gomega.ContainElement(corev1.EnvVar{Name: "MLFLOW_LOGGING_LEVEL", Value: "WARNING"}))
+	g.Expect(findObject(objs, "ConfigMap", "mlflow-logging")).To(gomega.BeNil())
+}
+
+func TestRenderChart_ServerLoggingJSON(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	container, podSpec, objs := renderLoggingTestDeployment(g, &mlflowv1.ServerLogging{
+		Level:  "debug",
+		Format: mlflowv1.ServerLogFormatJSON,
+	})
+	g.Expect(uvicornOpts(container)).To(gomega.ContainSubstring(" --log-config=/etc/mlflow/logging/logging.json"))
+	g.Expect(uvicornOpts(container)).NotTo(gomega.ContainSubstring("--no-access-log"))
+	g.Expect(container.Env).To(gomega.ContainElements(
+		corev1.EnvVar{Name: "MLFLOW_CONFIGURE_LOGGING", Value: "false"},
+		corev1.EnvVar{Name: "PYTHONPATH", Value: "/etc/mlflow/logging"},
+	))
+	g.Expect(container.VolumeMounts).To(gomega.ContainElement(corev1.VolumeMount{
+		Name: "mlflow-logging", MountPath: "/etc/mlflow/logging", ReadOnly: true,
+	}))
+	g.Expect(volumeNames(podSpec.Volumes)).To(gomega.ContainElement("mlflow-logging"))
+
+	configMap := findObject(objs, "ConfigMap", "mlflow-logging")
+	g.Expect(configMap).NotTo(gomega.BeNil())
+	g.Expect(configMap.GetLabels()).To(gomega.HaveKeyWithValue("app", ResourceName))
+	formatter, _, _ := unstructured.NestedString(configMap.Object, "data", "mlflow_json_logging.py")
+	g.Expect(formatter).To(gomega.ContainSubstring("class JsonFormatter(logging.Formatter):"))
+	logConfig, _, _ := unstructured.NestedString(configMap.Object, "data", "logging.json")
+	parsed := map[string]interface{}{}
+	g.Expect(json.Unmarshal([]byte(logConfig), &parsed)).To(gomega.Succeed(), "logging.json must be valid JSON")
+	mlflowLogger, _, _ := unstructured.NestedString(parsed, "loggers", "mlflow", "level")
+	g.Expect(mlflowLogger).To(gomega.Equal("DEBUG"))
+}
+
+func TestRenderChart_ServerProxyHeaders(t *testing.T) {
+	g := gomega.NewWithT(t)
+	render := func(headers *mlflowv1.ServerProxyHeaders) string {
+		objs, err := NewHelmRenderer("../../charts/mlflow").RenderChart(&mlflowv1.MLflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+			Spec: mlflowv1.MLflowSpec{
+				BackendStoreURI: ptr(testBackendStoreURI),
+				Server:          &mlflowv1.ServerConfig{ProxyHeaders: headers},
+			},
+		}, "test-ns", RenderOptions{}, nil)
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		deployment := &appsv1.Deployment{}
+		g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(findObject(objs, "Deployment", "mlflow").Object, deployment)).To(gomega.Succeed())
+		return uvicornOpts(findContainer(deployment.Spec.Template.Spec.Containers, "mlflow"))
+	}
+
+	g.Expect(render(nil)).To(gomega.ContainSubstring(" --proxy-headers"))
+	g.Expect(render(nil)).NotTo(gomega.ContainSubstring("--forwarded-allow-ips"))
+	g.Expect(render(&mlflowv1.ServerProxyHeaders{ForwardedAllowIPs: []string{"10.128.0.0/14", "192.0.2.10"}})).
+		To(gomega.ContainSubstring(" --proxy-headers --forwarded-allow-ips=10.128.0.0/14,192.0.2.10"))
+	g.Expect(render(&mlflowv1.ServerProxyHeaders{Enabled: ptr(false)})).NotTo(gomega.ContainSubstring("--proxy-headers"))
+}
@@ -14,14 +14,16 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package controller
+package render
 
 import (
 	"testing"
 
 	gomega "github.com/onsi/gomega"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
 )
@@ -289,3 +291,94 @@ func TestRenderChart_ServiceMonitorInsecureSkipVerify(t *testing.T) {
 	}
 	g.Expect(foundTLS).To(gomega.BeTrue(), "mlflow-tls volume should be present")
 }
+
+func TestRenderChart_PodMonitor(t *testing.T) {
+	g := gomega.NewWithT(t)
+	renderer := NewHelmRenderer("../../charts/mlflow")
+
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI: ptr(testBackendStoreURI),
+			Metrics:         &mlflowv1.MetricsConfig{PodMonitor: &mlflowv1.PodMonitorConfig{Enabled: true}},
+		},
+	}
+
+	objs, err := renderer.RenderChart(mlflow, "opendatahub", RenderOptions{IsOpenShift: true, ServiceMonitorAvailable: true, PodMonitorAvailable: true}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(findObject(objs, "ServiceMonitor", "mlflow-metrics-monitor")).To(gomega.BeNil(), "the PodMonitor replaces the ServiceMonitor")
+	podMonitor := findObject(objs, "PodMonitor", "mlflow-metrics-monitor")
+	g.Expect(podMonitor).NotTo(gomega.BeNil())
+
+	endpoints, _, _ := unstructured.NestedSlice(podMonitor.Object, "spec", "podMetricsEndpoints")
+	g.Expect(endpoints).To(gomega.HaveLen(1))
+	endpoint := endpoints[0].(map[string]interface{})
+	g.Expect(endpoint).To(gomega.HaveKeyWithValue("port", "https"))
+	g.Expect(endpoint).To(gomega.HaveKeyWithValue("scheme", "https"))
+	serverName, _, _ := unstructured.NestedString(endpoint, "tlsConfig", "serverName")
+	g.Expect(serverName).To(gomega.Equal("mlflow.opendatahub.svc"))
+	selector, _, _ := unstructured.NestedStringMap(podMonitor.Object, "spec", "selector", "matchLabels")
+	g.Expect(selector).To(gomega.Equal(map[string]string{"app": "mlflow"}))
+
+	// Without the PodMonitor CRD the ServiceMonitor is kept.
+	objs, err = renderer.RenderChart(mlflow, "opendatahub", RenderOptions{ServiceMonitorAvailable: true}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(findObject(objs, "PodMonitor", "mlflow-metrics-monitor")).To(gomega.BeNil())
+	g.Expect(findObject(objs, "ServiceMonitor", "mlflow-metrics-monitor")).NotTo(gomega.BeNil())
+
+	// Only the PodMonitor CRD is installed: metrics are still exposed.
+	objs, err = renderer.RenderChart(mlflow, "opendatahub", RenderOptions{PodMonitorAvailable: true}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(findObject(objs, "PodMonitor", "mlflow-metrics-monitor")).NotTo(gomega.BeNil())
+}
+
+func TestRenderChart_AlertRules(t *testing.T) {
+	g := gomega.NewWithT(t)
+	renderer := NewHelmRenderer("../../charts/mlflow")
+
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI: ptr(testBackendStoreURI),
+			Storage:         &mlflowv1.StorageConfig{},
+		},
+	}
+	alertNames := func(opts RenderOptions) map[string]string {
+		objs, err := renderer.RenderChart(mlflow, "opendatahub", opts, nil)
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		rule := findObject(objs, "PrometheusRule", "mlflow-alerts")
+		if rule == nil {
+			return nil
+		}
+		prometheusRule := &monitoringv1.PrometheusRule{}
+		g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(rule.Object, prometheusRule)).To(gomega.Succeed())
+		g.Expect(prometheusRule.Spec.Groups).To(gomega.HaveLen(1))
+		alerts := map[string]string{}
+		for _, r := range prometheusRule.Spec.Groups[0].Rules {
+			alerts[r.Alert] = r.Expr.String()
+		}
+		return alerts
+	}
+
+	alerts := alertNames(RenderOptions{ServiceMonitorAvailable: true, PrometheusRuleAvailable: true})
+	g.Expect(alerts).To(gomega.HaveKey("MLflowUnavailable"))
+	g.Expect(alerts).To(gomega.HaveKey("MLflowHighErrorRate"))
+	g.Expect(alerts).To(gomega.HaveKey("MLflowDBConnectionsSaturated"))
+	g.Expect(alerts).To(gomega.HaveKey("MLflowPVCAlmostFull"))
+	g.Expect(alerts["MLflowUnavailable"]).To(gomega.ContainSubstring(`kube_deployment_status_replicas_available{namespace="opendatahub",deployment="mlflow"} == 0`))
+	g.Expect(alerts["MLflowHighErrorRate"]).To(gomega.ContainSubstring(`mlflow_http_request_total{namespace="opendatahub",status=~"5.."}`))
+	g.Expect(alerts["MLflowPVCAlmostFull"]).To(gomega.ContainSubstring(`persistentvolumeclaim=~"mlflow-pvc"`))
+	mlflow.Spec.Storage.ExistingClaim = "mlflow-data"
+	g.Expect(alertNames(RenderOptions{PrometheusRuleAvailable: true})["MLflowPVCAlmostFull"]).To(gomega.ContainSubstring(`persistentvolumeclaim=~"mlflow-data"`))
+
+	// The error rate needs server metrics and the PVC alert needs a PVC.
+	mlflow.Spec.Storage = nil
+	alerts = alertNames(RenderOptions{PrometheusRuleAvailable: true})
+	g.Expect(alerts).To(gomega.HaveKey("MLflowUnavailable"))
+	g.Expect(alerts).NotTo(gomega.HaveKey("MLflowHighErrorRate"))
+	g.Expect(alerts).NotTo(gomega.HaveKey("MLflowPVCAlmostFull"))
+
+	g.Expect(alertNames(RenderOptions{ServiceMonitorAvailable: true})).To(gomega.BeNil(), "not rendered without the CRD")
+	mlflow.Spec.Alerts = &mlflowv1.AlertsConfig{Enabled: ptr(false)}
+	g.Expect(alertNames(RenderOptions{PrometheusRuleAvailable: true})).To(gomega.BeNil())
+}
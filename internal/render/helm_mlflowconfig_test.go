@@ -14,14 +14,17 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package controller
+package render
 
 import (
+	"strings"
 	"testing"
 
 	gomega "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
 )
@@ -261,3 +264,137 @@ func TestMlflowToHelmValues_MLflowConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestEffectiveWorkers(t *testing.T) {
+	cpu := func(requests, limits string) *corev1.ResourceRequirements {
+		resources := &corev1.ResourceRequirements{}
+		if requests != "" {
+			resources.Requests = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(requests)}
+		}
+		if limits != "" {
+			resources.Limits = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(limits)}
+		}
+		return resources
+	}
+
+	tests := []struct {
+		name        string
+		spec        mlflowv1.MLflowSpec
+		wantWorkers int32
+	}{
+		{name: "defaults to one worker", spec: mlflowv1.MLflowSpec{}, wantWorkers: 1},
+		{name: "fixed ignores resources", spec: mlflowv1.MLflowSpec{WorkersPolicy: mlflowv1.WorkersPolicyFixed, Resources: cpu("4", "")}, wantWorkers: 1},
+		{name: "auto uses chart default request", spec: mlflowv1.MLflowSpec{WorkersPolicy: mlflowv1.WorkersPolicyAuto}, wantWorkers: 2},
+		{name: "auto uses cpu request", spec: mlflowv1.MLflowSpec{WorkersPolicy: mlflowv1.WorkersPolicyAuto, Resources: cpu("3", "8")}, wantWorkers: 6},
+		{name: "auto falls back to cpu limit", spec: mlflowv1.MLflowSpec{WorkersPolicy: mlflowv1.WorkersPolicyAuto, Resources: cpu("", "2")}, wantWorkers: 4},
+		{name: "auto rounds fractional cores up", spec: mlflowv1.MLflowSpec{WorkersPolicy: mlflowv1.WorkersPolicyAuto, Resources: cpu("250m", "")}, wantWorkers: 1},
+		{name: "auto is capped", spec: mlflowv1.MLflowSpec{WorkersPolicy: mlflowv1.WorkersPolicyAuto, Resources: cpu("32", "")}, wantWorkers: maxAutoWorkers},
+		{name: "explicit workers override auto", spec: mlflowv1.MLflowSpec{WorkersPolicy: mlflowv1.WorkersPolicyAuto, Workers: ptr(int32(3)), Resources: cpu("4", "")}, wantWorkers: 3},
+		{name: "profile sets workers", spec: mlflowv1.MLflowSpec{Profile: mlflowv1.ResourceProfileLarge}, wantWorkers: 8},
+		{name: "explicit workers override profile", spec: mlflowv1.MLflowSpec{Profile: mlflowv1.ResourceProfileLarge, Workers: ptr(int32(3))}, wantWorkers: 3},
+		{name: "auto uses profile request", spec: mlflowv1.MLflowSpec{Profile: mlflowv1.ResourceProfileSmall, WorkersPolicy: mlflowv1.WorkersPolicyAuto}, wantWorkers: 1},
+		{name: "auto prefers explicit request over profile", spec: mlflowv1.MLflowSpec{Profile: mlflowv1.ResourceProfileSmall, WorkersPolicy: mlflowv1.WorkersPolicyAuto, Resources: cpu("2", "")}, wantWorkers: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "test"}, Spec: tt.spec}
+			if got := effectiveWorkers(mlflow); got != tt.wantWorkers {
+				t.Errorf("effectiveWorkers() = %d, want %d", got, tt.wantWorkers)
+			}
+		})
+	}
+}
+
+func TestRenderChart_Workspaces(t *testing.T) {
+	renderer := NewHelmRenderer("../../charts/mlflow")
+
+	tests := []struct {
+		name       string
+		workspaces *mlflowv1.WorkspacesConfig
+		wantArgs   []string
+	}{
+		{
+			name:     "enabled with the kubernetes store by default",
+			wantArgs: []string{"--enable-workspaces", "--workspace-store-uri=kubernetes://"},
+		},
+		{
+			name:       "custom store",
+			workspaces: &mlflowv1.WorkspacesConfig{StoreURI: ptr("postgresql://workspaces.example.com/mlflow")},
+			wantArgs:   []string{"--enable-workspaces", "--workspace-store-uri=postgresql://workspaces.example.com/mlflow"},
+		},
+		{
+			name:       "disabled",
+			workspaces: &mlflowv1.WorkspacesConfig{Enabled: ptr(false)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mlflow := &mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+				Spec: mlflowv1.MLflowSpec{
+					BackendStoreURI:   ptr(testBackendStoreURI),
+					Workspaces:        tt.workspaces,
+					GarbageCollection: &mlflowv1.GarbageCollectionSpec{Schedule: "0 2 * * 0"},
+				},
+			}
+			objs, err := renderer.RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+
+			workspaceArgs := func(obj *unstructured.Unstructured, containersPath ...string) []string {
+				containers, _, _ := unstructured.NestedSlice(obj.Object, containersPath...)
+				args, _, _ := unstructured.NestedStringSlice(containers[0].(map[string]interface{}), "args")
+				var matched []string
+				for _, arg := range args {
+					if strings.Contains(arg, "workspace") {
+						matched = append(matched, arg)
+					}
+				}
+				return matched
+			}
+
+			deployment := findObject(objs, "Deployment", "mlflow")
+			g.Expect(deployment).NotTo(gomega.BeNil())
+			g.Expect(workspaceArgs(deployment, "spec", "template", "spec", "containers")).To(gomega.Equal(tt.wantArgs))
+
+			cronJob := findObject(objs, "CronJob", "mlflow-gc")
+			g.Expect(cronJob).NotTo(gomega.BeNil())
+			gcArgs := workspaceArgs(cronJob, "spec", "jobTemplate", "spec", "template", "spec", "containers")
+			if tt.wantArgs == nil {
+				g.Expect(gcArgs).To(gomega.BeEmpty())
+			} else {
+				g.Expect(gcArgs).To(gomega.Equal([]string{"--all-workspaces"}))
+			}
+		})
+	}
+}
+
+func TestRenderChart_ServerKeepAliveTimeout(t *testing.T) {
+	g := gomega.NewWithT(t)
+	renderer := NewHelmRenderer("../../charts/mlflow")
+
+	uvicornOpts := func(limits *mlflowv1.ServerLimits) string {
+		mlflow := &mlflowv1.MLflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+			Spec: mlflowv1.MLflowSpec{
+				BackendStoreURI: ptr(testBackendStoreURI),
+				Server:          &mlflowv1.ServerConfig{Limits: limits},
+			},
+		}
+		objs, err := renderer.RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		containers, _, _ := unstructured.NestedSlice(findObject(objs, "Deployment", "mlflow").Object, "spec", "template", "spec", "containers")
+		args, _, _ := unstructured.NestedStringSlice(containers[0].(map[string]interface{}), "args")
+		for _, arg := range args {
+			if strings.HasPrefix(arg, "--uvicorn-opts=") {
+				return arg
+			}
+		}
+		return ""
+	}
+
+	g.Expect(uvicornOpts(&mlflowv1.ServerLimits{KeepAliveTimeoutSeconds: ptr(int32(75))})).To(gomega.HaveSuffix(" --proxy-headers --timeout-keep-alive=75"))
+	g.Expect(uvicornOpts(nil)).NotTo(gomega.ContainSubstring("--timeout-keep-alive"))
+}
@@ -14,7 +14,7 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package controller
+package render
 
 import (
 	"testing"
@@ -139,6 +139,47 @@ func TestRenderChart_PodAnnotations(t *testing.T) {
 	g.Expect(annotations).To(gomega.HaveKeyWithValue("prometheus.io/port", "8443"))
 }
 
+func TestRenderChart_LogCollection(t *testing.T) {
+	g := gomega.NewWithT(t)
+	renderer := NewHelmRenderer("../../charts/mlflow")
+
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI: ptr(testBackendStoreURI),
+			Server: &mlflowv1.ServerConfig{Logging: &mlflowv1.ServerLogging{
+				Collection: &mlflowv1.LogCollectionConfig{Enabled: true},
+			}},
+		},
+	}
+	podMetadata := func() (map[string]string, map[string]string) {
+		objs, err := renderer.RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		deployment := findObject(objs, deploymentKind, "mlflow")
+		labels, _, _ := unstructured.NestedStringMap(deployment.Object, "spec", "template", "metadata", "labels")
+		annotations, _, _ := unstructured.NestedStringMap(deployment.Object, "spec", "template", "metadata", "annotations")
+		return labels, annotations
+	}
+
+	labels, annotations := podMetadata()
+	g.Expect(labels).To(gomega.HaveKeyWithValue(LogStreamLabel, "mlflow"))
+	g.Expect(annotations).To(gomega.HaveKeyWithValue("containerType.logging.openshift.io/mlflow", "mlflow"))
+	g.Expect(annotations).NotTo(gomega.HaveKey("fluentbit.io/parser"), "text logs get no parser hint")
+
+	mlflow.Spec.Server.Logging.Format = mlflowv1.ServerLogFormatJSON
+	mlflow.Spec.Server.Logging.Collection.Stream = "ml-platform"
+	mlflow.Spec.PodAnnotations = map[string]string{"containerType.logging.openshift.io/mlflow": "custom"}
+	labels, annotations = podMetadata()
+	g.Expect(labels).To(gomega.HaveKeyWithValue(LogStreamLabel, "ml-platform"))
+	g.Expect(annotations).To(gomega.HaveKeyWithValue("fluentbit.io/parser", "json"))
+	g.Expect(annotations).To(gomega.HaveKeyWithValue("containerType.logging.openshift.io/mlflow", "custom"), "explicit pod annotations win")
+
+	mlflow.Spec.Server.Logging.Collection.Enabled = false
+	labels, annotations = podMetadata()
+	g.Expect(labels).NotTo(gomega.HaveKey(LogStreamLabel))
+	g.Expect(annotations).NotTo(gomega.HaveKey("fluentbit.io/parser"))
+}
+
 func TestMlflowToHelmValues_PodLabels(t *testing.T) {
 	renderer := &HelmRenderer{}
 
@@ -223,3 +264,62 @@ func TestMlflowToHelmValues_PodLabels(t *testing.T) {
 		})
 	}
 }
+
+func TestRenderChart_ServiceAccountAnnotations(t *testing.T) {
+	g := gomega.NewWithT(t)
+	renderer := NewHelmRenderer("../../charts/mlflow")
+
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI: ptr(testBackendStoreURI),
+			ServiceAccount: &mlflowv1.ServiceAccountConfig{
+				Annotations: map[string]string{
+					"eks.amazonaws.com/role-arn": "arn:aws:iam::123456789012:role/mlflow",
+				},
+			},
+		},
+	}
+
+	objs, err := renderer.RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	sa := findObject(objs, "ServiceAccount", ServiceAccountName)
+	g.Expect(sa).NotTo(gomega.BeNil(), "ServiceAccount should be rendered")
+	g.Expect(sa.GetAnnotations()).To(gomega.HaveKeyWithValue("eks.amazonaws.com/role-arn", "arn:aws:iam::123456789012:role/mlflow"))
+
+	// Without annotations the ServiceAccount carries no annotations block.
+	mlflow.Spec.ServiceAccount = nil
+	objs, err = renderer.RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	sa = findObject(objs, "ServiceAccount", ServiceAccountName)
+	g.Expect(sa).NotTo(gomega.BeNil(), "ServiceAccount should be rendered")
+	g.Expect(sa.GetAnnotations()).To(gomega.BeEmpty())
+}
+
+func TestRenderChart_CommonLabels(t *testing.T) {
+	g := gomega.NewWithT(t)
+	renderer := NewHelmRenderer("../../charts/mlflow")
+
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI: ptr(testBackendStoreURI),
+			CommonLabels:    map[string]string{"team": "ml-platform", "app": "ignored", "component": "ignored"},
+		},
+	}
+
+	objs, err := renderer.RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	for _, obj := range objs {
+		g.Expect(obj.GetLabels()).To(gomega.HaveKeyWithValue("team", "ml-platform"), "%s/%s", obj.GetKind(), obj.GetName())
+		g.Expect(obj.GetLabels()["component"]).To(gomega.HavePrefix("mlflow"), "%s/%s", obj.GetKind(), obj.GetName())
+		g.Expect(obj.GetLabels()["app"]).NotTo(gomega.Equal("ignored"), "%s/%s", obj.GetKind(), obj.GetName())
+	}
+
+	deployment := findObject(objs, deploymentKind, "mlflow")
+	podLabels, _, err := unstructured.NestedStringMap(deployment.Object, "spec", "template", "metadata", "labels")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(podLabels).To(gomega.HaveKeyWithValue("team", "ml-platform"))
+	g.Expect(podLabels).To(gomega.HaveKeyWithValue("app", "mlflow"))
+}
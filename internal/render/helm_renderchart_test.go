@@ -14,11 +14,17 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package controller
+package render
 
 import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
 	"testing"
 
+	"helm.sh/helm/v3/pkg/chart/loader"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
@@ -208,6 +214,10 @@ func TestRenderChart(t *testing.T) {
 				for _, obj := range objs {
 					switch obj.GetKind() {
 					case "ClusterRole":
+						if strings.HasPrefix(obj.GetName(), "mlflow-my-instance-") {
+							// Per-instance access roles are covered by TestRenderChartAccessRoles.
+							continue
+						}
 						foundClusterRole = true
 						if obj.GetName() != expectedClusterRoleName {
 							t.Errorf("ClusterRole name = %s, want %s (should be static, shared across all MLflow instances)", obj.GetName(), expectedClusterRoleName)
@@ -285,3 +295,202 @@ func TestRenderChart(t *testing.T) {
 		})
 	}
 }
+
+func TestRenderChart_ChartVersion(t *testing.T) {
+	renderer := NewHelmRenderer("../../charts/mlflow")
+	if got := renderer.ChartVersion(); got != "" {
+		t.Fatalf("ChartVersion() before render = %q, want empty", got)
+	}
+
+	_, err := renderer.RenderChart(&mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec:       mlflowv1.MLflowSpec{BackendStoreURI: ptr(testBackendStoreURI)},
+	}, "test-ns", RenderOptions{}, nil)
+	if err != nil {
+		t.Fatalf("RenderChart() error = %v", err)
+	}
+	if got := renderer.ChartVersion(); got == "" {
+		t.Error("ChartVersion() after render is empty, want the Chart.yaml version")
+	}
+}
+
+func TestValidateChart(t *testing.T) {
+	for _, chartPath := range []string{"../../charts/mlflow", "../../charts/mlflow-gateway"} {
+		if err := ValidateChart(chartPath); err != nil {
+			t.Errorf("ValidateChart(%s) error = %v", chartPath, err)
+		}
+	}
+
+	if err := ValidateChart(filepath.Join(t.TempDir(), "missing")); err == nil {
+		t.Error("ValidateChart() of a missing chart succeeded, want error")
+	}
+
+	broken := t.TempDir()
+	if err := os.WriteFile(filepath.Join(broken, "Chart.yaml"), []byte("name: [unterminated\n"), 0o600); err != nil {
+		t.Fatalf("write Chart.yaml: %v", err)
+	}
+	if err := ValidateChart(broken); err == nil {
+		t.Error("ValidateChart() of an unparsable chart succeeded, want error")
+	}
+}
+
+func TestRenderTemplates_ValuesSchema(t *testing.T) {
+	loadedChart, err := loader.Load("../../charts/mlflow")
+	if err != nil {
+		t.Fatalf("failed to load chart: %v", err)
+	}
+	values := map[string]interface{}{
+		"namespace":    "test-ns",
+		"image":        map[string]interface{}{"name": "quay.io/opendatahub/mlflow:main"},
+		"replicaCount": int32(-1),
+		"mlflow": map[string]interface{}{
+			"backendStoreUriFrom": map[string]interface{}{
+				"secretKeyRef": map[string]interface{}{"name": "db-credentials"},
+			},
+		},
+	}
+
+	_, err = NewHelmRenderer("../../charts/mlflow").renderTemplates(loadedChart, values, "test-ns")
+	if !IsChartValuesError(err) {
+		t.Fatalf("renderTemplates() error = %v, want a chart values error", err)
+	}
+	for _, want := range []string{"at '/replicaCount'", "at '/mlflow/backendStoreUriFrom"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("renderTemplates() error = %q, want it to mention %s", err, want)
+		}
+	}
+	if strings.Contains(err.Error(), "\n") {
+		t.Errorf("renderTemplates() error = %q, want a single line for condition messages", err)
+	}
+}
+
+func TestRenderChartAccessRoles(t *testing.T) {
+	renderer := NewHelmRenderer("../../charts/mlflow")
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec:       mlflowv1.MLflowSpec{BackendStoreURI: ptr(testBackendStoreURI)},
+	}
+	objs, err := renderer.RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+	if err != nil {
+		t.Fatalf("RenderChart() error = %v", err)
+	}
+
+	// verbs maps each access role to the verbs it grants on experiments.
+	verbs := map[string][]string{}
+	for _, obj := range objs {
+		if obj.GetKind() != "ClusterRole" || !strings.HasPrefix(obj.GetName(), "mlflow-") || obj.GetName() == "mlflow-gc" {
+			continue
+		}
+		rules, _, _ := unstructured.NestedSlice(obj.Object, "rules")
+		for _, rule := range rules {
+			ruleMap := rule.(map[string]interface{})
+			resources, _, _ := unstructured.NestedStringSlice(ruleMap, "resources")
+			ruleVerbs, _, _ := unstructured.NestedStringSlice(ruleMap, "verbs")
+			for _, resource := range resources {
+				if resource == "experiments" {
+					verbs[obj.GetName()] = append(verbs[obj.GetName()], ruleVerbs...)
+				}
+			}
+		}
+	}
+
+	want := map[string]string{
+		"mlflow-viewer": "get,list",
+		"mlflow-editor": "get,list,create,update,delete",
+		"mlflow-admin":  "get,list,create,update,delete",
+	}
+	if len(verbs) != len(want) {
+		t.Fatalf("access roles = %v, want %v", verbs, want)
+	}
+	for name, wantVerbs := range want {
+		if got := strings.Join(verbs[name], ","); got != wantVerbs {
+			t.Errorf("%s experiments verbs = %s, want %s", name, got, wantVerbs)
+		}
+	}
+}
+
+func TestRenderChartAccessRoleAggregation(t *testing.T) {
+	renderer := NewHelmRenderer("../../charts/mlflow")
+	aggregationLabels := func(aggregate *bool) map[string][]string {
+		mlflow := &mlflowv1.MLflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+			Spec: mlflowv1.MLflowSpec{
+				BackendStoreURI: ptr(testBackendStoreURI),
+				AccessRoles:     &mlflowv1.AccessRolesConfig{AggregateToDefaultRoles: aggregate},
+			},
+		}
+		objs, err := renderer.RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+		if err != nil {
+			t.Fatalf("RenderChart() error = %v", err)
+		}
+		labels := map[string][]string{}
+		for _, obj := range objs {
+			if obj.GetKind() != "ClusterRole" {
+				continue
+			}
+			for key := range obj.GetLabels() {
+				if target, ok := strings.CutPrefix(key, "rbac.authorization.k8s.io/aggregate-to-"); ok {
+					labels[obj.GetName()] = append(labels[obj.GetName()], target)
+				}
+			}
+			slices.Sort(labels[obj.GetName()])
+		}
+		return labels
+	}
+
+	want := map[string][]string{
+		"mlflow-viewer": {"admin", "edit", "view"},
+		"mlflow-editor": {"admin", "edit"},
+		"mlflow-admin":  {"admin"},
+	}
+	if got := aggregationLabels(nil); !reflect.DeepEqual(got, want) {
+		t.Errorf("default aggregation labels = %v, want %v", got, want)
+	}
+	if got := aggregationLabels(ptr(false)); len(got) != 0 {
+		t.Errorf("aggregation labels with aggregateToDefaultRoles=false = %v, want none", got)
+	}
+}
+
+func TestRenderChartNamespacedRBAC(t *testing.T) {
+	renderer := NewHelmRenderer("../../charts/mlflow")
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI:   ptr(testBackendStoreURI),
+			GarbageCollection: &mlflowv1.GarbageCollectionSpec{Schedule: "0 2 * * 0"},
+		},
+	}
+	objs, err := renderer.RenderChart(mlflow, "test-ns", RenderOptions{Namespaced: true}, nil)
+	if err != nil {
+		t.Fatalf("RenderChart() error = %v", err)
+	}
+
+	var roles []string
+	for _, obj := range objs {
+		switch obj.GetKind() {
+		case "ClusterRole", "ClusterRoleBinding":
+			t.Errorf("namespaced render has %s %s", obj.GetKind(), obj.GetName())
+		case "Role", "RoleBinding":
+			if obj.GetNamespace() != "test-ns" {
+				t.Errorf("%s %s namespace = %q, want test-ns", obj.GetKind(), obj.GetName(), obj.GetNamespace())
+			}
+			for key := range obj.GetLabels() {
+				if strings.HasPrefix(key, "rbac.authorization.k8s.io/aggregate-to-") {
+					t.Errorf("%s %s has aggregation label %s", obj.GetKind(), obj.GetName(), key)
+				}
+			}
+			if obj.GetKind() == "RoleBinding" {
+				if kind, _, _ := unstructured.NestedString(obj.Object, "roleRef", "kind"); kind != "Role" {
+					t.Errorf("RoleBinding %s roleRef kind = %q, want Role", obj.GetName(), kind)
+				}
+			} else {
+				roles = append(roles, obj.GetName())
+			}
+		}
+	}
+	slices.Sort(roles)
+	want := []string{"mlflow", "mlflow-admin", "mlflow-editor", "mlflow-gc", "mlflow-viewer"}
+	if !reflect.DeepEqual(roles, want) {
+		t.Errorf("namespaced roles = %v, want %v", roles, want)
+	}
+}
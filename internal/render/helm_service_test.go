@@ -0,0 +1,150 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"testing"
+
+	gomega "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+// collectIngressCIDRs returns the ipBlock CIDRs admitted by the first ingress rule.
+func collectIngressCIDRs(g gomega.Gomega, np *unstructured.Unstructured) []string {
+	ingress, found, err := unstructured.NestedSlice(np.Object, "spec", "ingress")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(found).To(gomega.BeTrue())
+	g.Expect(ingress).NotTo(gomega.BeEmpty())
+
+	from, _, err := unstructured.NestedSlice(ingress[0].(map[string]interface{}), "from")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	var cidrs []string
+	for _, peer := range from {
+		if cidr, ok, _ := unstructured.NestedString(peer.(map[string]interface{}), "ipBlock", "cidr"); ok {
+			cidrs = append(cidrs, cidr)
+		}
+	}
+	return cidrs
+}
+
+func TestRenderChart_ServiceType(t *testing.T) {
+	renderer := NewHelmRenderer("../../charts/mlflow")
+
+	tests := []struct {
+		name             string
+		service          *mlflowv1.ServiceConfig
+		wantType         string
+		wantSourceRanges []interface{}
+		wantCIDRs        []string
+		wantAnnotations  map[string]string
+		wantAffinity     string
+		wantTimeout      int64
+	}{
+		{
+			name:     "defaults to ClusterIP without external ingress",
+			wantType: "ClusterIP",
+		},
+		{
+			name:      "NodePort admits all external sources",
+			service:   &mlflowv1.ServiceConfig{Type: ptr(corev1.ServiceTypeNodePort)},
+			wantType:  "NodePort",
+			wantCIDRs: []string{"0.0.0.0/0", "::/0"},
+		},
+		{
+			name: "LoadBalancer with annotations and source ranges",
+			service: &mlflowv1.ServiceConfig{
+				Type: ptr(corev1.ServiceTypeLoadBalancer),
+				LoadBalancer: &mlflowv1.LoadBalancerConfig{
+					Annotations: map[string]string{
+						"metallb.universe.tf/address-pool":                   "edge",
+						"service.beta.openshift.io/serving-cert-secret-name": "override",
+					},
+					SourceRanges: []string{"10.20.0.0/16"},
+				},
+			},
+			wantType:         "LoadBalancer",
+			wantSourceRanges: []interface{}{"10.20.0.0/16"},
+			wantCIDRs:        []string{"10.20.0.0/16"},
+			wantAnnotations: map[string]string{
+				"metallb.universe.tf/address-pool":                   "edge",
+				"service.beta.openshift.io/serving-cert-secret-name": TLSSecretName,
+			},
+		},
+		{
+			name: "ClientIP session affinity with timeout",
+			service: &mlflowv1.ServiceConfig{
+				SessionAffinity:               ptr(corev1.ServiceAffinityClientIP),
+				SessionAffinityTimeoutSeconds: ptr(int32(600)),
+			},
+			wantType:     "ClusterIP",
+			wantAffinity: "ClientIP",
+			wantTimeout:  600,
+		},
+		{
+			name:         "ClientIP session affinity keeps the Kubernetes default timeout",
+			service:      &mlflowv1.ServiceConfig{SessionAffinity: ptr(corev1.ServiceAffinityClientIP)},
+			wantType:     "ClusterIP",
+			wantAffinity: "ClientIP",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+
+			objs, err := renderer.RenderChart(&mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+				Spec: mlflowv1.MLflowSpec{
+					BackendStoreURI: ptr(testBackendStoreURI),
+					Service:         tt.service,
+				},
+			}, "test-ns", RenderOptions{}, nil)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+
+			svc := findObject(objs, "Service", "mlflow")
+			g.Expect(svc).NotTo(gomega.BeNil(), "Service should be rendered")
+
+			svcType, _, _ := unstructured.NestedString(svc.Object, "spec", "type")
+			g.Expect(svcType).To(gomega.Equal(tt.wantType))
+
+			sourceRanges, found, _ := unstructured.NestedSlice(svc.Object, "spec", "loadBalancerSourceRanges")
+			if tt.wantSourceRanges == nil {
+				g.Expect(found).To(gomega.BeFalse())
+			} else {
+				g.Expect(sourceRanges).To(gomega.Equal(tt.wantSourceRanges))
+			}
+
+			affinity, _, _ := unstructured.NestedString(svc.Object, "spec", "sessionAffinity")
+			g.Expect(affinity).To(gomega.Equal(tt.wantAffinity))
+			timeout, found, _ := unstructured.NestedInt64(svc.Object, "spec", "sessionAffinityConfig", "clientIP", "timeoutSeconds")
+			g.Expect(found).To(gomega.Equal(tt.wantTimeout != 0))
+			g.Expect(timeout).To(gomega.Equal(tt.wantTimeout))
+
+			for k, v := range tt.wantAnnotations {
+				g.Expect(svc.GetAnnotations()).To(gomega.HaveKeyWithValue(k, v))
+			}
+
+			np := findObject(objs, "NetworkPolicy", "mlflow")
+			g.Expect(np).NotTo(gomega.BeNil(), "NetworkPolicy should be rendered")
+			g.Expect(collectIngressCIDRs(g, np)).To(gomega.Equal(tt.wantCIDRs))
+		})
+	}
+}
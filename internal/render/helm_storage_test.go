@@ -0,0 +1,260 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"testing"
+
+	gomega "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func TestMlflowToHelmValues_Storage(t *testing.T) {
+	renderer := &HelmRenderer{}
+
+	tests := []struct {
+		name           string
+		mlflow         *mlflowv1.MLflow
+		wantEnabled    bool
+		wantSize       string
+		wantClassName  string
+		wantAccessMode string
+	}{
+		{
+			name: "storage not configured - should be disabled",
+			mlflow: &mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: mlflowv1.MLflowSpec{
+					BackendStoreURI: ptr(testBackendStoreURI),
+				},
+			},
+			wantEnabled:    false,
+			wantSize:       defaultStorageSize,
+			wantClassName:  "",
+			wantAccessMode: "ReadWriteOnce",
+		},
+		{
+			name: "storage configured with defaults",
+			mlflow: &mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: mlflowv1.MLflowSpec{
+					BackendStoreURI: ptr(testBackendStoreURI),
+					Storage:         &mlflowv1.StorageConfig{},
+				},
+			},
+			wantEnabled:    true,
+			wantSize:       defaultStorageSize,
+			wantClassName:  "",
+			wantAccessMode: "ReadWriteOnce",
+		},
+		{
+			name: "storage configured with custom values",
+			mlflow: &mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: mlflowv1.MLflowSpec{
+					BackendStoreURI: ptr(testBackendStoreURI),
+					Storage: &mlflowv1.StorageConfig{PersistentVolumeClaimSpec: corev1.PersistentVolumeClaimSpec{
+						AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany},
+						StorageClassName: ptr("fast-ssd"),
+						Resources: corev1.VolumeResourceRequirements{
+							Requests: corev1.ResourceList{
+								corev1.ResourceStorage: resource.MustParse("20Gi"),
+							},
+						},
+					}},
+				},
+			},
+			wantEnabled:    true,
+			wantSize:       "20Gi",
+			wantClassName:  "fast-ssd",
+			wantAccessMode: "ReadWriteMany",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+
+			values, err := renderer.mlflowToHelmValues(tt.mlflow, "test-namespace", RenderOptions{}, nil)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+
+			storage, ok := values["storage"].(map[string]interface{})
+			if !ok {
+				t.Fatal("storage not found in values or wrong type")
+			}
+
+			if got := storage["enabled"].(bool); got != tt.wantEnabled {
+				t.Errorf("storage.enabled = %v, want %v", got, tt.wantEnabled)
+			}
+
+			if got := storage["size"].(string); got != tt.wantSize {
+				t.Errorf("storage.size = %v, want %v", got, tt.wantSize)
+			}
+
+			if got := storage["storageClassName"].(string); got != tt.wantClassName {
+				t.Errorf("storage.storageClassName = %v, want %v", got, tt.wantClassName)
+			}
+
+			if got := storage["accessMode"].(string); got != tt.wantAccessMode {
+				t.Errorf("storage.accessMode = %v, want %v", got, tt.wantAccessMode)
+			}
+		})
+	}
+}
+
+func TestRenderChart_StorageExistingClaim(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI: ptr("sqlite:////mlflow/mlflow.db"),
+			Storage:         &mlflowv1.StorageConfig{ExistingClaim: "mlflow-data"},
+		},
+	}
+	objs, err := NewHelmRenderer("../../charts/mlflow").RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(findObject(objs, "PersistentVolumeClaim", "mlflow-pvc")).To(gomega.BeNil())
+
+	deployment := &appsv1.Deployment{}
+	g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(findObject(objs, deploymentKind, "mlflow").Object, deployment)).To(gomega.Succeed())
+	var claimName string
+	for _, volume := range deployment.Spec.Template.Spec.Volumes {
+		if volume.Name == "mlflow-storage" {
+			claimName = volume.PersistentVolumeClaim.ClaimName
+		}
+	}
+	g.Expect(claimName).To(gomega.Equal("mlflow-data"))
+
+	mlflow.Spec.Storage.ExistingClaim = ""
+	objs, err = NewHelmRenderer("../../charts/mlflow").RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(findObject(objs, "PersistentVolumeClaim", "mlflow-pvc")).NotTo(gomega.BeNil())
+}
+
+func TestRenderChart_StorageVolumes(t *testing.T) {
+	g := gomega.NewWithT(t)
+	renderer := NewHelmRenderer("../../charts/mlflow")
+
+	mounts := func(objs []*unstructured.Unstructured) (map[string]string, map[string]string) {
+		deployment := &appsv1.Deployment{}
+		g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(findObject(objs, deploymentKind, "mlflow").Object, deployment)).To(gomega.Succeed())
+		claims := map[string]string{}
+		for _, volume := range deployment.Spec.Template.Spec.Volumes {
+			if volume.PersistentVolumeClaim != nil {
+				claims[volume.Name] = volume.PersistentVolumeClaim.ClaimName
+			}
+		}
+		paths := map[string]string{}
+		for _, mount := range deployment.Spec.Template.Spec.Containers[0].VolumeMounts {
+			if _, ok := claims[mount.Name]; ok {
+				paths[mount.Name] = mount.MountPath
+			}
+		}
+		return claims, paths
+	}
+
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI: ptr("sqlite:////mlflow/db/mlflow.db"),
+			Storage: &mlflowv1.StorageConfig{
+				Database: &mlflowv1.StorageVolume{PersistentVolumeClaimSpec: corev1.PersistentVolumeClaimSpec{
+					StorageClassName: ptr("fast-ssd"),
+					Resources: corev1.VolumeResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("5Gi")},
+					},
+				}},
+				Artifacts: &mlflowv1.StorageVolume{ExistingClaim: "shared-artifacts"},
+			},
+		},
+	}
+	objs, err := renderer.RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(findObject(objs, "PersistentVolumeClaim", "mlflow-pvc")).To(gomega.BeNil(), "nothing else lives at /mlflow")
+	g.Expect(findObject(objs, "PersistentVolumeClaim", "mlflow-artifacts-pvc")).To(gomega.BeNil())
+	dbClaim := &corev1.PersistentVolumeClaim{}
+	g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(findObject(objs, "PersistentVolumeClaim", "mlflow-db-pvc").Object, dbClaim)).To(gomega.Succeed())
+	g.Expect(*dbClaim.Spec.StorageClassName).To(gomega.Equal("fast-ssd"))
+	g.Expect(dbClaim.Spec.Resources.Requests.Storage().String()).To(gomega.Equal("5Gi"))
+
+	claims, paths := mounts(objs)
+	g.Expect(claims).To(gomega.Equal(map[string]string{
+		"mlflow-storage-db":        "mlflow-db-pvc",
+		"mlflow-storage-artifacts": "shared-artifacts",
+	}))
+	g.Expect(paths).To(gomega.Equal(map[string]string{
+		"mlflow-storage-db":        "/mlflow/db",
+		"mlflow-storage-artifacts": "/mlflow/artifacts",
+	}))
+
+	// With only artifacts split off, the root volume still holds everything else.
+	mlflow.Spec.Storage.Database = nil
+	objs, err = renderer.RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(findObject(objs, "PersistentVolumeClaim", "mlflow-pvc")).NotTo(gomega.BeNil())
+	claims, paths = mounts(objs)
+	g.Expect(claims).To(gomega.Equal(map[string]string{
+		"mlflow-storage":           "mlflow-pvc",
+		"mlflow-storage-artifacts": "shared-artifacts",
+	}))
+	g.Expect(paths).To(gomega.HaveKeyWithValue("mlflow-storage", "/mlflow"))
+}
+
+func TestRenderChart_EphemeralStorage(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	sizeLimit := resource.MustParse("1Gi")
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI: ptr("sqlite:////mlflow/mlflow.db"),
+			Storage:         &mlflowv1.StorageConfig{Ephemeral: true, EphemeralSizeLimit: &sizeLimit},
+		},
+	}
+	objs, err := NewHelmRenderer("../../charts/mlflow").RenderChart(mlflow, "test-ns", RenderOptions{PrometheusRuleAvailable: true}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(findObject(objs, "PersistentVolumeClaim", "mlflow-pvc")).To(gomega.BeNil())
+
+	deployment := &appsv1.Deployment{}
+	g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(findObject(objs, deploymentKind, "mlflow").Object, deployment)).To(gomega.Succeed())
+	var storage *corev1.Volume
+	for i, volume := range deployment.Spec.Template.Spec.Volumes {
+		if volume.Name == "mlflow-storage" {
+			storage = &deployment.Spec.Template.Spec.Volumes[i]
+		}
+	}
+	g.Expect(storage).NotTo(gomega.BeNil())
+	g.Expect(storage.EmptyDir).NotTo(gomega.BeNil())
+	g.Expect(storage.EmptyDir.SizeLimit.String()).To(gomega.Equal("1Gi"))
+	g.Expect(deployment.Spec.Template.Spec.Containers[0].VolumeMounts).To(gomega.ContainElement(corev1.VolumeMount{Name: "mlflow-storage", MountPath: "/mlflow"}))
+	// No PVC to protect, so rollouts do not need Recreate.
+	g.Expect(deployment.Spec.Strategy.Type).To(gomega.Equal(appsv1.RollingUpdateDeploymentStrategyType))
+
+	rule := findObject(objs, "PrometheusRule", "mlflow-alerts")
+	g.Expect(rule).NotTo(gomega.BeNil())
+	raw, err := rule.MarshalJSON()
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(string(raw)).NotTo(gomega.ContainSubstring("MLflowPVCAlmostFull"))
+}
@@ -0,0 +1,426 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"testing"
+
+	gomega "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func TestMlflowToHelmValues_Resources(t *testing.T) {
+	renderer := &HelmRenderer{}
+
+	tests := []struct {
+		name               string
+		mlflow             *mlflowv1.MLflow
+		wantResourcesSet   bool
+		wantRequestsCPU    string
+		wantRequestsMemory string
+		wantLimitsCPU      string
+		wantLimitsMemory   string
+	}{
+		{
+			name: "resources not configured - should not set in values (helm chart defaults apply)",
+			mlflow: &mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: mlflowv1.MLflowSpec{
+					BackendStoreURI: ptr(testBackendStoreURI),
+				},
+			},
+			wantResourcesSet: false,
+		},
+		{
+			name: "resources with custom values",
+			mlflow: &mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: mlflowv1.MLflowSpec{
+					BackendStoreURI: ptr(testBackendStoreURI),
+					Resources: &corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("500m"),
+							corev1.ResourceMemory: resource.MustParse("1Gi"),
+						},
+						Limits: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("2"),
+							corev1.ResourceMemory: resource.MustParse("4Gi"),
+						},
+					},
+				},
+			},
+			wantResourcesSet:   true,
+			wantRequestsCPU:    "500m",
+			wantRequestsMemory: "1Gi",
+			wantLimitsCPU:      "2",
+			wantLimitsMemory:   "4Gi",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+
+			values, err := renderer.mlflowToHelmValues(tt.mlflow, "test-namespace", RenderOptions{}, nil)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+
+			rawResources, exists := values["resources"]
+			if !tt.wantResourcesSet {
+				if exists {
+					t.Error("resources should not be set when not configured in CR spec")
+				}
+				return
+			}
+
+			resources, ok := rawResources.(map[string]interface{})
+			if !ok {
+				t.Fatal("resources not found in values or wrong type")
+			}
+
+			requests := resources["requests"].(map[string]interface{})
+			if got := requests["cpu"].(string); got != tt.wantRequestsCPU {
+				t.Errorf("resources.requests.cpu = %v, want %v", got, tt.wantRequestsCPU)
+			}
+			if got := requests["memory"].(string); got != tt.wantRequestsMemory {
+				t.Errorf("resources.requests.memory = %v, want %v", got, tt.wantRequestsMemory)
+			}
+
+			limits := resources["limits"].(map[string]interface{})
+			if got := limits["cpu"].(string); got != tt.wantLimitsCPU {
+				t.Errorf("resources.limits.cpu = %v, want %v", got, tt.wantLimitsCPU)
+			}
+			if got := limits["memory"].(string); got != tt.wantLimitsMemory {
+				t.Errorf("resources.limits.memory = %v, want %v", got, tt.wantLimitsMemory)
+			}
+		})
+	}
+}
+
+func TestRenderChart_ResourceNames(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	hugepages := corev1.ResourceName(corev1.ResourceHugePagesPrefix + "2Mi")
+	gpu := corev1.ResourceName("nvidia.com/gpu")
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI: ptr(testBackendStoreURI),
+			Resources: &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceEphemeralStorage: resource.MustParse("2Gi"),
+					hugepages:                       resource.MustParse("128Mi"),
+				},
+				Limits: corev1.ResourceList{
+					corev1.ResourceEphemeralStorage: resource.MustParse("4Gi"),
+					hugepages:                       resource.MustParse("128Mi"),
+					gpu:                             resource.MustParse("1"),
+				},
+			},
+		},
+	}
+	objs, err := NewHelmRenderer("../../charts/mlflow").RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	deployment := &appsv1.Deployment{}
+	g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(findObject(objs, deploymentKind, "mlflow").Object, deployment)).To(gomega.Succeed())
+
+	// Every resource name survives rendering; cpu and memory fall back to the chart defaults.
+	resources := deployment.Spec.Template.Spec.Containers[0].Resources
+	g.Expect(resources.Requests).To(gomega.HaveLen(4))
+	g.Expect(resources.Limits).To(gomega.HaveLen(5))
+	ephemeralLimit := resources.Limits[corev1.ResourceEphemeralStorage]
+	g.Expect(ephemeralLimit.String()).To(gomega.Equal("4Gi"))
+	hugepagesRequest := resources.Requests[hugepages]
+	g.Expect(hugepagesRequest.String()).To(gomega.Equal("128Mi"))
+	gpuLimit := resources.Limits[gpu]
+	g.Expect(gpuLimit.String()).To(gomega.Equal("1"))
+	g.Expect(resources.Requests.Cpu().String()).To(gomega.Equal("1"))
+}
+
+func TestMlflowToHelmValues_Replicas(t *testing.T) {
+	renderer := &HelmRenderer{}
+
+	tests := []struct {
+		name         string
+		mlflow       *mlflowv1.MLflow
+		wantReplicas int32
+	}{
+		{
+			name: "replicas not configured - should default to 1",
+			mlflow: &mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: mlflowv1.MLflowSpec{
+					BackendStoreURI: ptr(testBackendStoreURI),
+				},
+			},
+			wantReplicas: 1,
+		},
+		{
+			name: "replicas set to 3",
+			mlflow: &mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: mlflowv1.MLflowSpec{
+					BackendStoreURI: ptr(testBackendStoreURI),
+					Replicas:        ptr(int32(3)),
+				},
+			},
+			wantReplicas: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+
+			values, err := renderer.mlflowToHelmValues(tt.mlflow, "test-namespace", RenderOptions{}, nil)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+
+			if got := values["replicaCount"].(int32); got != tt.wantReplicas {
+				t.Errorf("replicaCount = %v, want %v", got, tt.wantReplicas)
+			}
+		})
+	}
+}
+
+func TestRenderChart_RolloutPacing(t *testing.T) {
+	g := gomega.NewWithT(t)
+	renderer := NewHelmRenderer("../../charts/mlflow")
+
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI: ptr(testBackendStoreURI),
+		},
+	}
+	objs, err := renderer.RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	deployment := &appsv1.Deployment{}
+	g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(findObject(objs, "Deployment", "mlflow").Object, deployment)).To(gomega.Succeed())
+	g.Expect(deployment.Spec.MinReadySeconds).To(gomega.BeZero())
+	g.Expect(*deployment.Spec.Strategy.RollingUpdate.MaxSurge).To(gomega.Equal(intstr.FromInt32(1)))
+	g.Expect(*deployment.Spec.Strategy.RollingUpdate.MaxUnavailable).To(gomega.Equal(intstr.FromInt32(0)))
+
+	mlflow.Spec.MinReadySeconds = ptr(int32(30))
+	mlflow.Spec.UpgradeStrategy = &mlflowv1.UpgradeStrategy{
+		RollingUpdate: &mlflowv1.RollingUpdateStrategy{MaxSurge: ptr(intstr.FromString("25%"))},
+	}
+	objs, err = renderer.RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	deployment = &appsv1.Deployment{}
+	g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(findObject(objs, "Deployment", "mlflow").Object, deployment)).To(gomega.Succeed())
+	g.Expect(deployment.Spec.MinReadySeconds).To(gomega.Equal(int32(30)))
+	g.Expect(*deployment.Spec.Strategy.RollingUpdate.MaxSurge).To(gomega.Equal(intstr.FromString("25%")))
+	g.Expect(*deployment.Spec.Strategy.RollingUpdate.MaxUnavailable).To(gomega.Equal(intstr.FromInt32(0)), "unset fields keep the chart default")
+}
+
+func TestRenderChart_StartupProbe(t *testing.T) {
+	renderer := NewHelmRenderer("../../charts/mlflow")
+
+	tests := []struct {
+		name          string
+		startupProbe  *mlflowv1.StartupProbeConfig
+		opts          RenderOptions
+		wantPeriod    int32
+		wantThreshold int32
+	}{
+		{
+			name:          "chart defaults",
+			wantPeriod:    10,
+			wantThreshold: 30,
+		},
+		{
+			name:          "managed migration raises the failure budget",
+			opts:          RenderOptions{ManagedMigration: true},
+			wantPeriod:    10,
+			wantThreshold: migrationStartupFailureThreshold,
+		},
+		{
+			name:          "spec overrides the migration default",
+			startupProbe:  &mlflowv1.StartupProbeConfig{PeriodSeconds: ptr(int32(15)), FailureThreshold: ptr(int32(400))},
+			opts:          RenderOptions{ManagedMigration: true},
+			wantPeriod:    15,
+			wantThreshold: 400,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+
+			objs, err := renderer.RenderChart(&mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+				Spec: mlflowv1.MLflowSpec{
+					BackendStoreURI: ptr(testBackendStoreURI),
+					StartupProbe:    tt.startupProbe,
+				},
+			}, "test-ns", tt.opts, nil)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			deployment := &appsv1.Deployment{}
+			g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(findObject(objs, "Deployment", "mlflow").Object, deployment)).To(gomega.Succeed())
+			probe := findContainer(deployment.Spec.Template.Spec.Containers, "mlflow").StartupProbe
+			g.Expect(probe).NotTo(gomega.BeNil())
+			g.Expect(probe.HTTPGet.Path).To(gomega.Equal("/mlflow/health"))
+			g.Expect(probe.PeriodSeconds).To(gomega.Equal(tt.wantPeriod))
+			g.Expect(probe.FailureThreshold).To(gomega.Equal(tt.wantThreshold))
+		})
+	}
+}
+
+func TestMlflowToHelmValues_Namespace(t *testing.T) {
+	g := gomega.NewWithT(t)
+	renderer := &HelmRenderer{}
+
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI: ptr(testBackendStoreURI),
+		},
+	}
+
+	testNamespace := "custom-namespace"
+	values, err := renderer.mlflowToHelmValues(mlflow, testNamespace, RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	if got := values["namespace"].(string); got != testNamespace {
+		t.Errorf("namespace = %v, want %v", got, testNamespace)
+	}
+}
+
+func TestMlflowToHelmValues_ResourceSuffix(t *testing.T) {
+	renderer := &HelmRenderer{}
+
+	tests := []struct {
+		name               string
+		crName             string
+		wantResourceSuffix string
+	}{
+		{
+			name:               "singleton mlflow CR should have empty suffix",
+			crName:             "mlflow",
+			wantResourceSuffix: "",
+		},
+		{
+			name:               "custom CR name should have suffix",
+			crName:             "dev",
+			wantResourceSuffix: "-dev",
+		},
+		{
+			name:               "another custom CR name",
+			crName:             "production",
+			wantResourceSuffix: "-production",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mlflow := &mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: tt.crName},
+				Spec: mlflowv1.MLflowSpec{
+					BackendStoreURI: ptr(testBackendStoreURI),
+				},
+			}
+
+			values, err := renderer.mlflowToHelmValues(mlflow, "test-namespace", RenderOptions{}, nil)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+
+			if got := values["resourceSuffix"].(string); got != tt.wantResourceSuffix {
+				t.Errorf("resourceSuffix = %v, want %v", got, tt.wantResourceSuffix)
+			}
+		})
+	}
+}
+
+func TestBuildAffinity_HighAvailability(t *testing.T) {
+	nodeAffinity := &corev1.NodeAffinity{
+		RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+			NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+				MatchExpressions: []corev1.NodeSelectorRequirement{{
+					Key:      "node-role.kubernetes.io/worker",
+					Operator: corev1.NodeSelectorOpExists,
+				}},
+			}},
+		},
+	}
+	userAntiAffinity := &corev1.PodAntiAffinity{
+		PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{{Weight: 1}},
+	}
+
+	tests := []struct {
+		name         string
+		replicas     int32
+		affinity     *corev1.Affinity
+		mode         mlflowv1.AntiAffinityMode
+		wantHard     bool
+		wantSoft     bool
+		wantUserRule bool
+	}{
+		{name: "no preset", replicas: 3},
+		{name: "single replica ignores preset", replicas: 1, mode: mlflowv1.AntiAffinityHard},
+		{name: "soft preset", replicas: 2, mode: mlflowv1.AntiAffinitySoft, wantSoft: true},
+		{name: "hard preset keeps node affinity", replicas: 3, mode: mlflowv1.AntiAffinityHard, wantHard: true,
+			affinity: &corev1.Affinity{NodeAffinity: nodeAffinity}},
+		{name: "explicit podAntiAffinity wins", replicas: 3, mode: mlflowv1.AntiAffinityHard, wantUserRule: true,
+			affinity: &corev1.Affinity{PodAntiAffinity: userAntiAffinity}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+
+			mlflow := &mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "dev"},
+				Spec:       mlflowv1.MLflowSpec{Affinity: tt.affinity},
+			}
+			if tt.mode != "" {
+				mlflow.Spec.HighAvailability = &mlflowv1.HighAvailabilityConfig{AntiAffinity: tt.mode}
+			}
+
+			affinity := buildAffinity(mlflow, tt.replicas)
+			if !tt.wantHard && !tt.wantSoft {
+				g.Expect(affinity).To(gomega.Equal(tt.affinity))
+				if tt.wantUserRule {
+					g.Expect(affinity.PodAntiAffinity).To(gomega.BeIdenticalTo(userAntiAffinity))
+				}
+				return
+			}
+
+			g.Expect(affinity).NotTo(gomega.BeNil())
+			g.Expect(affinity.PodAntiAffinity).NotTo(gomega.BeNil())
+			var term corev1.PodAffinityTerm
+			if tt.wantHard {
+				g.Expect(affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution).To(gomega.HaveLen(1))
+				term = affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution[0]
+			} else {
+				g.Expect(affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution).To(gomega.HaveLen(1))
+				term = affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution[0].PodAffinityTerm
+			}
+			g.Expect(term.TopologyKey).To(gomega.Equal(corev1.LabelHostname))
+			g.Expect(term.LabelSelector.MatchLabels).To(gomega.HaveKeyWithValue("app", "mlflow-dev"))
+			if tt.affinity != nil {
+				g.Expect(affinity.NodeAffinity).To(gomega.Equal(tt.affinity.NodeAffinity))
+				g.Expect(tt.affinity.PodAntiAffinity).To(gomega.BeNil(), "user affinity must not be mutated")
+			}
+		})
+	}
+}
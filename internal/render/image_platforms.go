@@ -0,0 +1,67 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// withArchitectureAffinity adds a required kubernetes.io/arch node affinity for
+// architectures. A nodeSelector or node affinity that already constrains the architecture
+// is left to the user.
+func withArchitectureAffinity(affinity *corev1.Affinity, nodeSelector map[string]string, architectures []string) *corev1.Affinity {
+	if len(architectures) == 0 {
+		return affinity
+	}
+	if _, pinned := nodeSelector[corev1.LabelArchStable]; pinned {
+		return affinity
+	}
+
+	requirement := corev1.NodeSelectorRequirement{
+		Key:      corev1.LabelArchStable,
+		Operator: corev1.NodeSelectorOpIn,
+		Values:   architectures,
+	}
+
+	result := &corev1.Affinity{}
+	if affinity != nil {
+		result = affinity.DeepCopy()
+	}
+	if result.NodeAffinity == nil {
+		result.NodeAffinity = &corev1.NodeAffinity{}
+	}
+	required := result.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+	if required == nil || len(required.NodeSelectorTerms) == 0 {
+		result.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &corev1.NodeSelector{
+			NodeSelectorTerms: []corev1.NodeSelectorTerm{{MatchExpressions: []corev1.NodeSelectorRequirement{requirement}}},
+		}
+		return result
+	}
+
+	for _, term := range required.NodeSelectorTerms {
+		for _, expr := range term.MatchExpressions {
+			if expr.Key == corev1.LabelArchStable {
+				return affinity
+			}
+		}
+	}
+	// Node selector terms are ORed, so every term must carry the requirement.
+	for i := range required.NodeSelectorTerms {
+		required.NodeSelectorTerms[i].MatchExpressions = append(required.NodeSelectorTerms[i].MatchExpressions, requirement)
+	}
+	return result
+}
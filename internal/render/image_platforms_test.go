@@ -0,0 +1,101 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+)
+
+func TestWithArchitectureAffinity(t *testing.T) {
+	architectures := []string{"amd64", "arm64"}
+	archRequirement := corev1.NodeSelectorRequirement{
+		Key:      corev1.LabelArchStable,
+		Operator: corev1.NodeSelectorOpIn,
+		Values:   architectures,
+	}
+	zoneRequirement := corev1.NodeSelectorRequirement{
+		Key:      corev1.LabelTopologyZone,
+		Operator: corev1.NodeSelectorOpIn,
+		Values:   []string{"zone-a"},
+	}
+
+	t.Run("adds required affinity", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		affinity := withArchitectureAffinity(nil, nil, architectures)
+		g.Expect(affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms).To(gomega.Equal(
+			[]corev1.NodeSelectorTerm{{MatchExpressions: []corev1.NodeSelectorRequirement{archRequirement}}}))
+	})
+
+	t.Run("ands into every existing term without mutating the input", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		user := &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+				NodeSelectorTerms: []corev1.NodeSelectorTerm{
+					{MatchExpressions: []corev1.NodeSelectorRequirement{zoneRequirement}},
+					{MatchFields: []corev1.NodeSelectorRequirement{{Key: "metadata.name", Operator: corev1.NodeSelectorOpIn, Values: []string{"node-1"}}}},
+				},
+			},
+		}}
+		affinity := withArchitectureAffinity(user, nil, architectures)
+		terms := affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+		g.Expect(terms[0].MatchExpressions).To(gomega.Equal([]corev1.NodeSelectorRequirement{zoneRequirement, archRequirement}))
+		g.Expect(terms[1].MatchExpressions).To(gomega.Equal([]corev1.NodeSelectorRequirement{archRequirement}))
+		g.Expect(user.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchExpressions).To(gomega.HaveLen(1))
+	})
+
+	t.Run("leaves user architecture constraints alone", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		user := &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+				NodeSelectorTerms: []corev1.NodeSelectorTerm{{MatchExpressions: []corev1.NodeSelectorRequirement{
+					{Key: corev1.LabelArchStable, Operator: corev1.NodeSelectorOpIn, Values: []string{"s390x"}},
+				}}},
+			},
+		}}
+		g.Expect(withArchitectureAffinity(user, nil, architectures)).To(gomega.BeIdenticalTo(user))
+		g.Expect(withArchitectureAffinity(nil, map[string]string{corev1.LabelArchStable: "amd64"}, architectures)).To(gomega.BeNil())
+	})
+}
+
+func TestMLflowToHelmValuesAddsArchitectureAffinity(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	renderer := NewHelmRenderer("../../charts/mlflow")
+	cfg := &config.OperatorConfig{MLflowImage: "quay.io/opendatahub/mlflow:odh-stable"}
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec:       mlflowv1.MLflowSpec{BackendStoreURI: ptr(testBackendStoreURI)},
+	}
+
+	values, err := renderer.mlflowToHelmValues(mlflow, "test-ns", RenderOptions{ImageArchitectures: []string{"amd64"}}, cfg)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	affinity, ok := values["affinity"].(*corev1.Affinity)
+	g.Expect(ok).To(gomega.BeTrue())
+	g.Expect(affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchExpressions[0].Values).
+		To(gomega.Equal([]string{"amd64"}))
+
+	values, err = renderer.mlflowToHelmValues(mlflow, "test-ns", RenderOptions{}, cfg)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(values["affinity"]).To(gomega.Equal(map[string]interface{}{}))
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"helm.sh/helm/v3/pkg/chart/loader"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+)
+
+const (
+	// gatewaySecretEnvPrefix prefixes the environment variables that carry secretConfig values.
+	gatewaySecretEnvPrefix = "MLFLOW_GATEWAY_SECRET_"
+)
+
+var nonEnvNameChars = regexp.MustCompile(`[^A-Z0-9_]`)
+
+// gatewaySecretEnvName returns the environment variable that carries one secretConfig value.
+func gatewaySecretEnvName(endpointIndex int, key string) string {
+	return fmt.Sprintf("%s%d_%s", gatewaySecretEnvPrefix, endpointIndex, nonEnvNameChars.ReplaceAllString(strings.ToUpper(key), "_"))
+}
+
+// buildGatewayConfig renders the `mlflow gateway` configuration file for the CR. Secret
+// provider settings are written as $VARIABLE references, and the returned env vars load
+// them from their Secrets, so credentials never land in the ConfigMap.
+func buildGatewayConfig(gateway *mlflowv1.MLflowGateway) (string, []corev1.EnvVar, error) {
+	var env []corev1.EnvVar
+	endpoints := make([]map[string]interface{}, 0, len(gateway.Spec.Endpoints))
+	for i, endpoint := range gateway.Spec.Endpoints {
+		modelConfig := map[string]interface{}{}
+		for key, value := range endpoint.Model.Config {
+			modelConfig[key] = value
+		}
+		// Sort so the env list, and with it the pod template, is stable across reconciles.
+		secretKeys := make([]string, 0, len(endpoint.Model.SecretConfig))
+		for key := range endpoint.Model.SecretConfig {
+			secretKeys = append(secretKeys, key)
+		}
+		slices.Sort(secretKeys)
+		for _, key := range secretKeys {
+			if _, ok := endpoint.Model.Config[key]; ok {
+				return "", nil, fmt.Errorf("endpoint %q sets %q in both config and secretConfig", endpoint.Name, key)
+			}
+			selector := endpoint.Model.SecretConfig[key]
+			envName := gatewaySecretEnvName(i, key)
+			env = append(env, corev1.EnvVar{
+				Name:      envName,
+				ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &selector},
+			})
+			modelConfig[key] = "$" + envName
+		}
+
+		model := map[string]interface{}{
+			"provider": endpoint.Model.Provider,
+			"name":     endpoint.Model.Name,
+		}
+		if len(modelConfig) > 0 {
+			model["config"] = modelConfig
+		}
+		endpoints = append(endpoints, map[string]interface{}{
+			"name":          endpoint.Name,
+			"endpoint_type": string(endpoint.EndpointType),
+			"model":         model,
+		})
+	}
+
+	out, err := yaml.Marshal(map[string]interface{}{"endpoints": endpoints})
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to marshal gateway config: %w", err)
+	}
+	return string(out), env, nil
+}
+
+// gatewayImage returns the gateway image: spec.image, then the operator default MLflow image,
+// rewritten through the configured registry mirrors.
+func gatewayImage(gateway *mlflowv1.MLflowGateway, cfg *config.OperatorConfig) string {
+	if gateway.Spec.Image != nil && *gateway.Spec.Image != "" {
+		return cfg.MirrorImage(*gateway.Spec.Image)
+	}
+	return cfg.MirrorImage(cfg.MLflowImage)
+}
+
+// gatewayToHelmValues converts an MLflowGateway CR to values for the mlflow-gateway chart.
+func gatewayToHelmValues(gateway *mlflowv1.MLflowGateway, namespace string, cfg *config.OperatorConfig) (map[string]interface{}, error) {
+	gatewayConfig, env, err := buildGatewayConfig(gateway)
+	if err != nil {
+		return nil, err
+	}
+
+	pullPolicy := string(corev1.PullIfNotPresent)
+	if gateway.Spec.ImagePullPolicy != nil {
+		pullPolicy = string(*gateway.Spec.ImagePullPolicy)
+	}
+	replicas := int32(1)
+	if gateway.Spec.Replicas != nil {
+		replicas = *gateway.Spec.Replicas
+	}
+
+	values := map[string]interface{}{
+		"namespace": namespace,
+		"image": map[string]interface{}{
+			"repository": gatewayImage(gateway, cfg),
+			"pullPolicy": pullPolicy,
+		},
+		"replicaCount": replicas,
+		"config":       gatewayConfig,
+	}
+
+	// Typed values are converted so the chart sees the same shapes as YAML input.
+	if len(env) > 0 {
+		envValues := make([]interface{}, 0, len(env))
+		for i := range env {
+			envValue, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&env[i])
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert gateway env: %w", err)
+			}
+			envValues = append(envValues, envValue)
+		}
+		values["env"] = envValues
+	}
+	if gateway.Spec.Resources != nil {
+		resources, err := runtime.DefaultUnstructuredConverter.ToUnstructured(gateway.Spec.Resources)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert gateway resources: %w", err)
+		}
+		values["resources"] = resources
+	}
+	return values, nil
+}
+
+// RenderGatewayChart renders the mlflow-gateway chart for an MLflowGateway CR.
+func (h *HelmRenderer) RenderGatewayChart(
+	gateway *mlflowv1.MLflowGateway,
+	namespace string,
+	cfg *config.OperatorConfig,
+) ([]*unstructured.Unstructured, error) {
+	loadedChart, err := loader.Load(h.chartPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load chart: %w", err)
+	}
+	if loadedChart.Metadata != nil {
+		h.chartVersion = loadedChart.Metadata.Version
+	}
+
+	values, err := gatewayToHelmValues(gateway, namespace, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert MLflowGateway spec to Helm values: %w", err)
+	}
+
+	rendered, err := h.renderTemplates(loadedChart, values, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render templates: %w", err)
+	}
+	return rendered, nil
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func newTestMLflowGateway() *mlflowv1.MLflowGateway {
+	return &mlflowv1.MLflowGateway{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow", UID: "gateway-uid"},
+		Spec: mlflowv1.MLflowGatewaySpec{
+			Endpoints: []mlflowv1.GatewayEndpoint{{
+				Name:         "chat",
+				EndpointType: mlflowv1.GatewayEndpointTypeChat,
+				Model: mlflowv1.GatewayModel{
+					Provider: "openai",
+					Name:     "gpt-4o-mini",
+					Config:   map[string]string{"openai_api_base": "https://llm.example.com/v1"},
+					SecretConfig: map[string]corev1.SecretKeySelector{
+						"openai_api_key": {LocalObjectReference: corev1.LocalObjectReference{Name: "openai-credentials"}, Key: "api-key"},
+					},
+				},
+			}},
+		},
+	}
+}
+
+func TestBuildGatewayConfig(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	gatewayConfig, env, err := buildGatewayConfig(newTestMLflowGateway())
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(gatewayConfig).NotTo(gomega.ContainSubstring("openai-credentials"))
+
+	parsed := map[string]interface{}{}
+	g.Expect(yaml.Unmarshal([]byte(gatewayConfig), &parsed)).To(gomega.Succeed())
+	g.Expect(parsed["endpoints"]).To(gomega.Equal([]interface{}{map[string]interface{}{
+		"name":          "chat",
+		"endpoint_type": "llm/v1/chat",
+		"model": map[string]interface{}{
+			"provider": "openai",
+			"name":     "gpt-4o-mini",
+			"config": map[string]interface{}{
+				"openai_api_base": "https://llm.example.com/v1",
+				"openai_api_key":  "$MLFLOW_GATEWAY_SECRET_0_OPENAI_API_KEY",
+			},
+		},
+	}}))
+	g.Expect(env).To(gomega.Equal([]corev1.EnvVar{{
+		Name: "MLFLOW_GATEWAY_SECRET_0_OPENAI_API_KEY",
+		ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "openai-credentials"},
+			Key:                  "api-key",
+		}},
+	}}))
+
+	gateway := newTestMLflowGateway()
+	gateway.Spec.Endpoints[0].Model.Config["openai_api_key"] = "sk-inline"
+	_, _, err = buildGatewayConfig(gateway)
+	g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("both config and secretConfig")))
+}
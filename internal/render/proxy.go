@@ -0,0 +1,137 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"net/url"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+// inClusterNoProxy are always added to NO_PROXY so in-cluster Services, including the
+// Kubernetes API behind the workspace store, are never sent through the proxy.
+var inClusterNoProxy = []string{"localhost", "127.0.0.1", ".svc", ".cluster.local"}
+
+// ProxyConfig holds the cluster-wide egress proxy settings passed to the MLflow pods.
+type ProxyConfig struct {
+	HTTPProxy  string
+	HTTPSProxy string
+	// NoProxy is the cluster's comma-separated list of hosts, domains and CIDRs that bypass
+	// the proxy.
+	NoProxy string
+}
+
+// Enabled reports whether an HTTP or HTTPS proxy is configured.
+func (p ProxyConfig) Enabled() bool {
+	return p.HTTPProxy != "" || p.HTTPSProxy != ""
+}
+
+// proxyValues returns the chart's proxy values for mlflow, or nil when no proxy is configured.
+// NO_PROXY gets the in-cluster domains and the artifact store endpoints on top of the cluster
+// list, so an object store inside the corporate network is reached directly. Variables set
+// in spec.env are left to the user.
+func proxyValues(mlflow *mlflowv1.MLflow, proxy ProxyConfig) map[string]interface{} {
+	if !proxy.Enabled() {
+		return nil
+	}
+	noProxy := MergeNoProxy(SplitNoProxy(proxy.NoProxy), inClusterNoProxy)
+	noProxy = MergeNoProxy(noProxy, artifactEndpointHosts(mlflow))
+
+	userSet := func(name string) bool {
+		return slices.ContainsFunc(mlflow.Spec.Env, func(e corev1.EnvVar) bool { return e.Name == name })
+	}
+	values := map[string]interface{}{"ports": proxyPorts(proxy.HTTPProxy, proxy.HTTPSProxy)}
+	if proxy.HTTPProxy != "" && !userSet("HTTP_PROXY") {
+		values["httpProxy"] = proxy.HTTPProxy
+	}
+	if proxy.HTTPSProxy != "" && !userSet("HTTPS_PROXY") {
+		values["httpsProxy"] = proxy.HTTPSProxy
+	}
+	if !userSet("NO_PROXY") {
+		values["noProxy"] = strings.Join(noProxy, ",")
+	}
+	return values
+}
+
+// artifactEndpointHosts returns the hosts of the configured S3 endpoints.
+func artifactEndpointHosts(mlflow *mlflowv1.MLflow) []string {
+	var endpoints []string
+	if s3 := s3ArtifactsConfig(mlflow); s3 != nil && s3.EndpointURL != nil {
+		endpoints = append(endpoints, *s3.EndpointURL)
+	}
+	for _, e := range mlflow.Spec.Env {
+		if e.Name == "MLFLOW_S3_ENDPOINT_URL" && e.Value != "" {
+			endpoints = append(endpoints, e.Value)
+		}
+	}
+	var hosts []string
+	for _, endpoint := range endpoints {
+		if u, err := url.Parse(endpoint); err == nil && u.Hostname() != "" {
+			hosts = append(hosts, u.Hostname())
+		}
+	}
+	return hosts
+}
+
+// proxyPorts returns the TCP ports of the proxy URLs, for the egress NetworkPolicy.
+func proxyPorts(proxyURLs ...string) []int {
+	var ports []int
+	for _, proxyURL := range proxyURLs {
+		u, err := url.Parse(proxyURL)
+		if err != nil || u.Hostname() == "" {
+			continue
+		}
+		port, err := strconv.Atoi(u.Port())
+		if err != nil {
+			port = 80
+			if u.Scheme == "https" {
+				port = 443
+			}
+		}
+		if !slices.Contains(ports, port) {
+			ports = append(ports, port)
+		}
+	}
+	sort.Ints(ports)
+	return ports
+}
+
+func SplitNoProxy(noProxy string) []string {
+	var entries []string
+	for _, entry := range strings.Split(noProxy, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// MergeNoProxy appends the entries of extra that are not in noProxy yet, keeping the order.
+func MergeNoProxy(noProxy, extra []string) []string {
+	for _, entry := range extra {
+		if !slices.Contains(noProxy, entry) {
+			noProxy = append(noProxy, entry)
+		}
+	}
+	return noProxy
+}
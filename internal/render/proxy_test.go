@@ -0,0 +1,73 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func TestRenderChart_Proxy(t *testing.T) {
+	g := gomega.NewWithT(t)
+	mlflow := s3TLSTestMLflow(nil)
+	mlflow.Spec.Artifacts = &mlflowv1.ArtifactsConfig{S3: &mlflowv1.S3ArtifactsConfig{EndpointURL: ptr("https://minio.corp.example.com:9000")}}
+	mlflow.Spec.DefaultArtifactRoot = ptr("s3://mlflow-artifacts")
+	mlflow.Spec.GarbageCollection = &mlflowv1.GarbageCollectionSpec{Schedule: "0 2 * * 0"}
+	mlflow.Spec.Env = []corev1.EnvVar{{Name: "HTTP_PROXY", Value: "http://team-proxy.example.com:8080"}}
+	proxy := ProxyConfig{
+		HTTPProxy:  "http://proxy.example.com:3128",
+		HTTPSProxy: "http://proxy.example.com:3128",
+		NoProxy:    ".cluster.local,172.30.0.0/16",
+	}
+
+	objs, err := NewHelmRenderer("../../charts/mlflow").RenderChart(mlflow, "test-ns", RenderOptions{Proxy: proxy}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	serverEnv, gcEnv := renderS3TestEnv(g, mlflow, RenderOptions{Proxy: proxy})
+	noProxy := corev1.EnvVar{Name: "NO_PROXY", Value: ".cluster.local,172.30.0.0/16,localhost,127.0.0.1,.svc,minio.corp.example.com"}
+	for _, env := range [][]corev1.EnvVar{serverEnv, gcEnv} {
+		g.Expect(env).To(gomega.ContainElements(
+			corev1.EnvVar{Name: "HTTPS_PROXY", Value: "http://proxy.example.com:3128"},
+			noProxy,
+		))
+	}
+	// A proxy set in spec.env is kept rather than duplicated.
+	g.Expect(serverEnv).To(gomega.ContainElement(corev1.EnvVar{Name: "HTTP_PROXY", Value: "http://team-proxy.example.com:8080"}))
+	g.Expect(serverEnv).NotTo(gomega.ContainElement(corev1.EnvVar{Name: "HTTP_PROXY", Value: "http://proxy.example.com:3128"}))
+
+	networkPolicy := &networkingv1.NetworkPolicy{}
+	g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(findObject(objs, "NetworkPolicy", "mlflow").Object, networkPolicy)).To(gomega.Succeed())
+	last := networkPolicy.Spec.Egress[len(networkPolicy.Spec.Egress)-1]
+	g.Expect(last.Ports).To(gomega.HaveLen(1))
+	g.Expect(last.Ports[0].Port.IntValue()).To(gomega.Equal(3128))
+
+	// Nothing is injected without a cluster proxy.
+	serverEnv, _ = renderS3TestEnv(g, s3TLSTestMLflow(nil), RenderOptions{})
+	g.Expect(envNames(serverEnv)).NotTo(gomega.ContainElements("HTTPS_PROXY", "NO_PROXY"))
+}
+
+func TestProxyPorts(t *testing.T) {
+	g := gomega.NewWithT(t)
+	g.Expect(proxyPorts("http://proxy.example.com:3128", "http://proxy.example.com:3128")).To(gomega.Equal([]int{3128}))
+	g.Expect(proxyPorts("http://proxy.example.com", "https://secure-proxy.example.com")).To(gomega.Equal([]int{80, 443}))
+	g.Expect(proxyPorts("", "not a url")).To(gomega.BeEmpty())
+}
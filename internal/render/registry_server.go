@@ -0,0 +1,46 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func RegistryServerEnabled(mlflow *mlflowv1.MLflow) bool {
+	return mlflow.Spec.Registry != nil && mlflow.Spec.Registry.SeparateDeployment
+}
+
+func RegistryServerResourceName(mlflow *mlflowv1.MLflow) string {
+	return ResourceName + "-registry" + ResourceSuffix(mlflow.Name)
+}
+
+// buildRegistryServerObjects derives the registry Deployment, Service, and NetworkPolicy from
+// the rendered server objects. The copy runs the same server with the same stores; only
+// routing decides that it answers registry requests.
+func buildRegistryServerObjects(mlflow *mlflowv1.MLflow, objects []*unstructured.Unstructured, suspended bool) ([]*unstructured.Unstructured, error) {
+	if !RegistryServerEnabled(mlflow) {
+		return nil, nil
+	}
+	registry := mlflow.Spec.Registry
+	return buildDerivedServerObjects(mlflow, objects, derivedServer{
+		name:      RegistryServerResourceName(mlflow),
+		replicas:  derivedServerReplicas(registry.Replicas, suspended),
+		resources: registry.Resources,
+	})
+}
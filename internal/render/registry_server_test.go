@@ -0,0 +1,75 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func newRegistryServerMLflow() *mlflowv1.MLflow {
+	return &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI:     ptr(testBackendStoreURI),
+			RegistryStoreURI:    ptr("postgresql://registry-db:5432/registry"),
+			DefaultArtifactRoot: ptr("s3://bucket/artifacts"),
+			Registry: &mlflowv1.RegistryConfig{
+				SeparateDeployment: true,
+				Replicas:           ptr(int32(2)),
+			},
+		},
+	}
+}
+
+func TestRenderChart_RegistryServer(t *testing.T) {
+	g := gomega.NewWithT(t)
+	renderer := NewHelmRenderer("../../charts/mlflow")
+
+	objs, err := renderer.RenderChart(newRegistryServerMLflow(), "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	deploymentObj := findObject(objs, "Deployment", "mlflow-registry")
+	g.Expect(deploymentObj).NotTo(gomega.BeNil())
+	g.Expect(deploymentObj.GetLabels()).To(gomega.HaveKeyWithValue("app", "mlflow"), "metadata keeps the cached app label")
+	deployment := &appsv1.Deployment{}
+	g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(deploymentObj.Object, deployment)).To(gomega.Succeed())
+	g.Expect(*deployment.Spec.Replicas).To(gomega.Equal(int32(2)))
+	g.Expect(deployment.Spec.Selector.MatchLabels).To(gomega.HaveKeyWithValue("app", "mlflow-registry"))
+	g.Expect(deployment.Spec.Template.Spec.Containers[0].Env).To(gomega.ContainElement(corev1.EnvVar{
+		Name:  "MLFLOW_REGISTRY_STORE_URI",
+		Value: "postgresql://registry-db:5432/registry",
+	}))
+
+	service := &corev1.Service{}
+	g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(findObject(objs, "Service", "mlflow-registry").Object, service)).To(gomega.Succeed())
+	g.Expect(service.Spec.Selector).To(gomega.Equal(map[string]string{"app": "mlflow-registry"}))
+	g.Expect(findObject(objs, "NetworkPolicy", "mlflow-registry")).NotTo(gomega.BeNil())
+
+	mlflow := newRegistryServerMLflow()
+	mlflow.Spec.Registry.SeparateDeployment = false
+	objs, err = renderer.RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(findObject(objs, "Deployment", "mlflow-registry")).To(gomega.BeNil())
+}
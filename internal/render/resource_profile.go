@@ -0,0 +1,142 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+const (
+	sqlAlchemyPoolSizeEnv    = "MLFLOW_SQLALCHEMYSTORE_POOL_SIZE"
+	sqlAlchemyMaxOverflowEnv = "MLFLOW_SQLALCHEMYSTORE_MAX_OVERFLOW"
+)
+
+// resourceProfile holds the sizing defaults behind a spec.profile value. Each worker opens its
+// own pool, so a pod may hold up to workers * (poolSize + maxOverflow) database connections.
+type resourceProfile struct {
+	requests    corev1.ResourceList
+	limits      corev1.ResourceList
+	workers     int32
+	poolSize    int32
+	maxOverflow int32
+}
+
+var resourceProfiles = map[mlflowv1.ResourceProfile]resourceProfile{
+	// Up to 10 database connections per pod.
+	mlflowv1.ResourceProfileSmall: {
+		requests:    resourceList("250m", "1Gi"),
+		limits:      resourceList("1", "2Gi"),
+		workers:     1,
+		poolSize:    5,
+		maxOverflow: 5,
+	},
+	// The chart's default resources; up to 30 database connections per pod.
+	mlflowv1.ResourceProfileMedium: {
+		requests:    resourceList("1", "2Gi"),
+		limits:      resourceList("4", "3Gi"),
+		workers:     2,
+		poolSize:    5,
+		maxOverflow: 10,
+	},
+	// Up to 160 database connections per pod; size the database's connection limit for it.
+	mlflowv1.ResourceProfileLarge: {
+		requests:    resourceList("4", "8Gi"),
+		limits:      resourceList("8", "12Gi"),
+		workers:     8,
+		poolSize:    10,
+		maxOverflow: 10,
+	},
+}
+
+func resourceList(cpu, memory string) corev1.ResourceList {
+	return corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse(cpu),
+		corev1.ResourceMemory: resource.MustParse(memory),
+	}
+}
+
+func profileFor(mlflow *mlflowv1.MLflow) (resourceProfile, bool) {
+	profile, ok := resourceProfiles[mlflow.Spec.Profile]
+	return profile, ok
+}
+
+// effectiveResources returns spec.resources laid over the profile's requests and limits, or
+// spec.resources alone without a profile. A request the profile sets above an explicit limit
+// is lowered to that limit, so overriding only a limit never yields request > limit.
+func effectiveResources(mlflow *mlflowv1.MLflow) *corev1.ResourceRequirements {
+	profile, ok := profileFor(mlflow)
+	if !ok {
+		return mlflow.Spec.Resources
+	}
+	resources := &corev1.ResourceRequirements{
+		Requests: profile.requests.DeepCopy(),
+		Limits:   profile.limits.DeepCopy(),
+	}
+	explicit := mlflow.Spec.Resources
+	if explicit == nil {
+		return resources
+	}
+	for name, quantity := range explicit.Limits {
+		resources.Limits[name] = quantity
+		_, explicitRequest := explicit.Requests[name]
+		if request, set := resources.Requests[name]; set && !explicitRequest && request.Cmp(quantity) > 0 {
+			resources.Requests[name] = quantity
+		}
+	}
+	for name, quantity := range explicit.Requests {
+		resources.Requests[name] = quantity
+	}
+	resources.Claims = explicit.Claims
+	return resources
+}
+
+// profileEnv returns the profile's connection pool settings, skipping variables spec.env sets
+// and SQLite backends, which gain nothing from a pool.
+func profileEnv(mlflow *mlflowv1.MLflow) []corev1.EnvVar {
+	profile, ok := profileFor(mlflow)
+	if !ok {
+		return nil
+	}
+	if uri := mlflow.Spec.BackendStoreURI; uri != nil && strings.HasPrefix(*uri, "sqlite:") {
+		return nil
+	}
+	var env []corev1.EnvVar
+	for _, e := range []corev1.EnvVar{
+		{Name: sqlAlchemyPoolSizeEnv, Value: strconv.Itoa(int(profile.poolSize))},
+		{Name: sqlAlchemyMaxOverflowEnv, Value: strconv.Itoa(int(profile.maxOverflow))},
+	} {
+		if !hasEnv(mlflow.Spec.Env, e.Name) {
+			env = append(env, e)
+		}
+	}
+	return env
+}
+
+func hasEnv(env []corev1.EnvVar, name string) bool {
+	for _, e := range env {
+		if e.Name == name {
+			return true
+		}
+	}
+	return false
+}
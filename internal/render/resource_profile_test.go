@@ -0,0 +1,103 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func TestEffectiveResources(t *testing.T) {
+	g := gomega.NewWithT(t)
+	mlflow := &mlflowv1.MLflow{Spec: mlflowv1.MLflowSpec{}}
+	g.Expect(effectiveResources(mlflow)).To(gomega.BeNil())
+
+	mlflow.Spec.Profile = mlflowv1.ResourceProfileLarge
+	resources := effectiveResources(mlflow)
+	g.Expect(resources.Requests.Cpu().String()).To(gomega.Equal("4"))
+	g.Expect(resources.Limits.Memory().String()).To(gomega.Equal("12Gi"))
+
+	// Explicit entries override the profile one key at a time.
+	mlflow.Spec.Resources = &corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("10Gi")},
+		Limits: corev1.ResourceList{
+			corev1.ResourceCPU:              resource.MustParse("2"),
+			corev1.ResourceEphemeralStorage: resource.MustParse("1Gi"),
+		},
+	}
+	resources = effectiveResources(mlflow)
+	g.Expect(resources.Requests.Memory().String()).To(gomega.Equal("10Gi"))
+	g.Expect(resources.Limits.Memory().String()).To(gomega.Equal("12Gi"))
+	g.Expect(resources.Limits.Cpu().String()).To(gomega.Equal("2"))
+	g.Expect(resources.Requests.Cpu().String()).To(gomega.Equal("2"), "the profile request is lowered to the explicit limit")
+	g.Expect(resources.Limits).To(gomega.HaveKey(corev1.ResourceEphemeralStorage))
+	large := resourceProfiles[mlflowv1.ResourceProfileLarge].requests
+	g.Expect(large.Cpu().String()).To(gomega.Equal("4"), "the profile table is not mutated")
+}
+
+func TestRenderChart_ResourceProfile(t *testing.T) {
+	g := gomega.NewWithT(t)
+	renderer := NewHelmRenderer("../../charts/mlflow")
+
+	render := func(mlflow *mlflowv1.MLflow) corev1.Container {
+		objs, err := renderer.RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		deployment := &appsv1.Deployment{}
+		g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(findObject(objs, deploymentKind, "mlflow").Object, deployment)).To(gomega.Succeed())
+		return deployment.Spec.Template.Spec.Containers[0]
+	}
+	newMLflow := func() *mlflowv1.MLflow {
+		return &mlflowv1.MLflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+			Spec: mlflowv1.MLflowSpec{
+				BackendStoreURI: ptr(testBackendStoreURI),
+				Profile:         mlflowv1.ResourceProfileSmall,
+			},
+		}
+	}
+
+	container := render(newMLflow())
+	g.Expect(container.Resources.Requests.Cpu().String()).To(gomega.Equal("250m"))
+	g.Expect(container.Resources.Limits.Memory().String()).To(gomega.Equal("2Gi"))
+	g.Expect(container.Env).To(gomega.ContainElements(
+		corev1.EnvVar{Name: sqlAlchemyPoolSizeEnv, Value: "5"},
+		corev1.EnvVar{Name: sqlAlchemyMaxOverflowEnv, Value: "5"},
+	))
+
+	mlflow := newMLflow()
+	mlflow.Spec.Env = []corev1.EnvVar{{Name: sqlAlchemyPoolSizeEnv, Value: "20"}}
+	container = render(mlflow)
+	g.Expect(container.Env).To(gomega.ContainElements(
+		corev1.EnvVar{Name: sqlAlchemyPoolSizeEnv, Value: "20"},
+		corev1.EnvVar{Name: sqlAlchemyMaxOverflowEnv, Value: "5"},
+	))
+	g.Expect(container.Env).NotTo(gomega.ContainElement(corev1.EnvVar{Name: sqlAlchemyPoolSizeEnv, Value: "5"}), "spec.env wins over the profile")
+
+	mlflow = newMLflow()
+	mlflow.Spec.BackendStoreURI = ptr("sqlite:////mlflow/mlflow.db")
+	for _, e := range render(mlflow).Env {
+		g.Expect(e.Name).NotTo(gomega.HavePrefix("MLFLOW_SQLALCHEMYSTORE_"))
+	}
+}
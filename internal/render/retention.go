@@ -0,0 +1,76 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+// retentionDurationPattern matches the `mlflow gc --older-than` format, e.g. "30d" or "7d12h".
+var retentionDurationPattern = regexp.MustCompile(`^(?:(\d+(?:\.\d+)?)d)?(?:(\d+(?:\.\d+)?)h)?(?:(\d+(?:\.\d+)?)m)?(?:(\d+(?:\.\d+)?)s)?$`)
+
+// parseRetentionDuration converts a retention duration to a time.Duration. The API pattern
+// admits an empty string, which is rejected here because it would archive every run.
+func parseRetentionDuration(value string) (time.Duration, error) {
+	match := retentionDurationPattern.FindStringSubmatch(value)
+	if value == "" || match == nil {
+		return 0, fmt.Errorf("invalid retention duration %q", value)
+	}
+	units := []time.Duration{24 * time.Hour, time.Hour, time.Minute, time.Second}
+	var total time.Duration
+	for i, unit := range units {
+		if match[i+1] == "" {
+			continue
+		}
+		amount, err := strconv.ParseFloat(match[i+1], 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid retention duration %q: %w", value, err)
+		}
+		total += time.Duration(amount * float64(unit))
+	}
+	return total, nil
+}
+
+// gcOlderThan returns the purge age passed to `mlflow gc --older-than`, preferring
+// spec.retention.deletedRunPurgeAfter over spec.garbageCollection.olderThan.
+func gcOlderThan(mlflow *mlflowv1.MLflow) *string {
+	if mlflow.Spec.Retention != nil && mlflow.Spec.Retention.DeletedRunPurgeAfter != nil {
+		return mlflow.Spec.Retention.DeletedRunPurgeAfter
+	}
+	if mlflow.Spec.GarbageCollection != nil {
+		return mlflow.Spec.GarbageCollection.OlderThan
+	}
+	return nil
+}
+
+// maxRunAgeSeconds returns the age after which the retention step archives runs, or 0 when
+// spec.retention.maxRunAge is unset.
+func maxRunAgeSeconds(mlflow *mlflowv1.MLflow) (int64, error) {
+	if mlflow.Spec.Retention == nil || mlflow.Spec.Retention.MaxRunAge == nil {
+		return 0, nil
+	}
+	age, err := parseRetentionDuration(*mlflow.Spec.Retention.MaxRunAge)
+	if err != nil {
+		return 0, fmt.Errorf("retention.maxRunAge: %w", err)
+	}
+	return int64(age / time.Second), nil
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func TestParseRetentionDuration(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	for value, want := range map[string]time.Duration{
+		"90d":   90 * 24 * time.Hour,
+		"7d12h": 7*24*time.Hour + 12*time.Hour,
+		"1.5h":  90 * time.Minute,
+		"30m1s": 30*time.Minute + time.Second,
+	} {
+		got, err := parseRetentionDuration(value)
+		g.Expect(err).NotTo(gomega.HaveOccurred(), value)
+		g.Expect(got).To(gomega.Equal(want), value)
+	}
+	for _, value := range []string{"", "90", "1w", "h"} {
+		_, err := parseRetentionDuration(value)
+		g.Expect(err).To(gomega.HaveOccurred(), value)
+	}
+}
+
+func TestRenderChart_Retention(t *testing.T) {
+	g := gomega.NewWithT(t)
+	renderer := NewHelmRenderer("../../charts/mlflow")
+
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI:   ptr(testBackendStoreURI),
+			GarbageCollection: &mlflowv1.GarbageCollectionSpec{Schedule: "0 2 * * *"},
+			Retention: &mlflowv1.RetentionSpec{
+				MaxRunAge:            ptr("90d"),
+				DeletedRunPurgeAfter: ptr("30d"),
+			},
+		},
+	}
+	objs, err := renderer.RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	cronJob := &batchv1.CronJob{}
+	g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(findObject(objs, "CronJob", "mlflow-gc").Object, cronJob)).To(gomega.Succeed())
+	podSpec := cronJob.Spec.JobTemplate.Spec.Template.Spec
+	g.Expect(podSpec.Containers[0].Args).To(gomega.ContainElement("--older-than=30d"))
+	g.Expect(podSpec.InitContainers).To(gomega.HaveLen(1))
+	retention := podSpec.InitContainers[0]
+	g.Expect(retention.Name).To(gomega.Equal("mlflow-retention"))
+	g.Expect(retention.Command[:2]).To(gomega.Equal([]string{"python3.12", "-c"}))
+	g.Expect(retention.Command[2]).To(gomega.ContainSubstring("client.delete_run(run_id)"))
+	g.Expect(retention.Env).To(gomega.ContainElements(
+		corev1.EnvVar{Name: "MLFLOW_RETENTION_MAX_RUN_AGE_SECONDS", Value: "7776000"},
+		corev1.EnvVar{Name: "MLFLOW_TRACKING_URI", Value: "https://mlflow.test-ns.svc:8443/mlflow"},
+		corev1.EnvVar{Name: "MLFLOW_TRACKING_AUTH", Value: "kubernetes"},
+	))
+
+	// Without maxRunAge only `mlflow gc` runs.
+	mlflow.Spec.Retention.MaxRunAge = nil
+	objs, err = renderer.RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	cronJob = &batchv1.CronJob{}
+	g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(findObject(objs, "CronJob", "mlflow-gc").Object, cronJob)).To(gomega.Succeed())
+	g.Expect(cronJob.Spec.JobTemplate.Spec.Template.Spec.InitContainers).To(gomega.BeEmpty())
+}
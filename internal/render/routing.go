@@ -0,0 +1,44 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"strings"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+// ServesAtRoot reports whether spec.routing.rootPath serves the instance at "/".
+func ServesAtRoot(mlflow *mlflowv1.MLflow) bool {
+	return mlflow.Spec.Routing != nil && mlflow.Spec.Routing.RootPath
+}
+
+// ServerStaticPrefix is the --static-prefix the MLflow server runs with.
+func ServerStaticPrefix(mlflow *mlflowv1.MLflow) string {
+	if ServesAtRoot(mlflow) {
+		return ""
+	}
+	return StaticPrefix
+}
+
+// ServerExternalURL returns spec.server.externalUrl without a trailing slash, or "" when unset.
+func ServerExternalURL(mlflow *mlflowv1.MLflow) string {
+	if mlflow.Spec.Server == nil || mlflow.Spec.Server.ExternalURL == nil {
+		return ""
+	}
+	return strings.TrimRight(*mlflow.Spec.Server.ExternalURL, "/")
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"strings"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func s3ArtifactsConfig(mlflow *mlflowv1.MLflow) *mlflowv1.S3ArtifactsConfig {
+	if mlflow.Spec.Artifacts == nil {
+		return nil
+	}
+	return mlflow.Spec.Artifacts.S3
+}
+
+// usesS3ArtifactStore reports whether the server or its clients write artifacts to S3: the
+// server through artifactsDestination when it proxies artifacts, and both through
+// defaultArtifactRoot.
+func usesS3ArtifactStore(mlflow *mlflowv1.MLflow) bool {
+	serveArtifacts := mlflow.Spec.ServeArtifacts != nil && *mlflow.Spec.ServeArtifacts
+	if serveArtifacts && mlflow.Spec.ArtifactsDestination != nil && strings.HasPrefix(*mlflow.Spec.ArtifactsDestination, "s3://") {
+		return true
+	}
+	return mlflow.Spec.DefaultArtifactRoot != nil && strings.HasPrefix(*mlflow.Spec.DefaultArtifactRoot, "s3://")
+}
+
+func S3InsecureSkipTLSVerify(mlflow *mlflowv1.MLflow) bool {
+	s3 := s3ArtifactsConfig(mlflow)
+	return s3 != nil && s3.InsecureSkipTLSVerify != nil && *s3.InsecureSkipTLSVerify
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func s3TLSTestMLflow(insecure *bool) *mlflowv1.MLflow {
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec:       mlflowv1.MLflowSpec{BackendStoreURI: ptr(testBackendStoreURI)},
+	}
+	if insecure != nil {
+		mlflow.Spec.Artifacts = &mlflowv1.ArtifactsConfig{S3: &mlflowv1.S3ArtifactsConfig{InsecureSkipTLSVerify: insecure}}
+	}
+	return mlflow
+}
+
+// renderS3TestEnv renders mlflow and returns the env of the MLflow server container and, when
+// garbage collection is enabled, of the GC CronJob container.
+func renderS3TestEnv(g *gomega.WithT, mlflow *mlflowv1.MLflow, opts RenderOptions) ([]corev1.EnvVar, []corev1.EnvVar) {
+	objs, err := NewHelmRenderer("../../charts/mlflow").RenderChart(mlflow, "test-ns", opts, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	deployment := &appsv1.Deployment{}
+	g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(findObject(objs, "Deployment", "mlflow").Object, deployment)).To(gomega.Succeed())
+	serverEnv := findContainer(deployment.Spec.Template.Spec.Containers, "mlflow").Env
+
+	cronJobObj := findObject(objs, "CronJob", "mlflow-gc")
+	if cronJobObj == nil {
+		return serverEnv, nil
+	}
+	cronJob := &batchv1.CronJob{}
+	g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(cronJobObj.Object, cronJob)).To(gomega.Succeed())
+	return serverEnv, cronJob.Spec.JobTemplate.Spec.Template.Spec.Containers[0].Env
+}
+
+func TestRenderChart_S3IgnoreTLS(t *testing.T) {
+	g := gomega.NewWithT(t)
+	renderEnv := func(mlflow *mlflowv1.MLflow) []corev1.EnvVar {
+		env, _ := renderS3TestEnv(g, mlflow, RenderOptions{})
+		return env
+	}
+
+	g.Expect(envNames(renderEnv(s3TLSTestMLflow(nil)))).NotTo(gomega.ContainElement("MLFLOW_S3_IGNORE_TLS"))
+	g.Expect(envNames(renderEnv(s3TLSTestMLflow(ptr(false))))).NotTo(gomega.ContainElement("MLFLOW_S3_IGNORE_TLS"))
+	g.Expect(renderEnv(s3TLSTestMLflow(ptr(true)))).To(gomega.ContainElement(corev1.EnvVar{Name: "MLFLOW_S3_IGNORE_TLS", Value: "true"}))
+
+	// A CA bundle keeps requiring verification unless the toggle is set.
+	withCA := s3TLSTestMLflow(nil)
+	withCA.Spec.CABundleConfigMap = &mlflowv1.CABundleConfigMapSpec{Name: "my-ca"}
+	g.Expect(renderEnv(withCA)).To(gomega.ContainElement(corev1.EnvVar{Name: "MLFLOW_S3_IGNORE_TLS", Value: "false"}))
+	withCA.Spec.Artifacts = &mlflowv1.ArtifactsConfig{S3: &mlflowv1.S3ArtifactsConfig{InsecureSkipTLSVerify: ptr(true)}}
+	g.Expect(renderEnv(withCA)).To(gomega.ContainElement(corev1.EnvVar{Name: "MLFLOW_S3_IGNORE_TLS", Value: "true"}))
+}
+
+func TestRenderChart_S3Endpoint(t *testing.T) {
+	g := gomega.NewWithT(t)
+	endpoint := corev1.EnvVar{Name: "MLFLOW_S3_ENDPOINT_URL", Value: "https://minio.minio.svc:9000"}
+	pathStyle := corev1.EnvVar{Name: "MLFLOW_BOTO_CLIENT_ADDRESSING_STYLE", Value: "path"}
+	mlflow := s3TLSTestMLflow(nil)
+	mlflow.Spec.Artifacts = &mlflowv1.ArtifactsConfig{S3: &mlflowv1.S3ArtifactsConfig{
+		EndpointURL: ptr("https://minio.minio.svc:9000"),
+		PathStyle:   ptr(true),
+	}}
+	mlflow.Spec.GarbageCollection = &mlflowv1.GarbageCollectionSpec{Schedule: "0 2 * * 0"}
+
+	// The default file:// destination does not talk to S3.
+	serverEnv, gcEnv := renderS3TestEnv(g, mlflow, RenderOptions{})
+	g.Expect(envNames(serverEnv)).NotTo(gomega.ContainElements(endpoint.Name, pathStyle.Name))
+	g.Expect(envNames(gcEnv)).NotTo(gomega.ContainElements(endpoint.Name, pathStyle.Name))
+
+	mlflow.Spec.ServeArtifacts = ptr(true)
+	mlflow.Spec.ArtifactsDestination = ptr("s3://mlflow-artifacts")
+	serverEnv, gcEnv = renderS3TestEnv(g, mlflow, RenderOptions{})
+	g.Expect(serverEnv).To(gomega.ContainElements(endpoint, pathStyle))
+	g.Expect(gcEnv).To(gomega.ContainElements(endpoint, pathStyle))
+
+	// artifactsDestination is ignored when the server does not proxy artifacts.
+	mlflow.Spec.ServeArtifacts = ptr(false)
+	mlflow.Spec.DefaultArtifactRoot = ptr("gs://mlflow-artifacts")
+	serverEnv, _ = renderS3TestEnv(g, mlflow, RenderOptions{})
+	g.Expect(envNames(serverEnv)).NotTo(gomega.ContainElement(endpoint.Name))
+
+	mlflow.Spec.DefaultArtifactRoot = ptr("s3://mlflow-artifacts")
+	mlflow.Spec.Artifacts.S3.PathStyle = nil
+	serverEnv, _ = renderS3TestEnv(g, mlflow, RenderOptions{})
+	g.Expect(serverEnv).To(gomega.ContainElement(endpoint))
+	g.Expect(envNames(serverEnv)).NotTo(gomega.ContainElement(pathStyle.Name))
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+const (
+	// LogStreamLabel names the log stream of the MLflow pods when spec.server.logging.collection
+	// is enabled, for log forwarder input selectors.
+	LogStreamLabel = "mlflow.opendatahub.io/log-stream"
+
+	defaultLogStream = "mlflow"
+	// openShiftLoggingContainerTypeAnnotation names the structured stream of the MLflow
+	// container for OpenShift Logging.
+	openShiftLoggingContainerTypeAnnotation = "containerType.logging.openshift.io/mlflow"
+	fluentBitParserAnnotation               = "fluentbit.io/parser"
+)
+
+// JSONLoggingEnabled reports whether the chart renders the JSON log configuration ConfigMap.
+func JSONLoggingEnabled(mlflow *mlflowv1.MLflow) bool {
+	return mlflow.Spec.Server != nil && mlflow.Spec.Server.Logging != nil &&
+		mlflow.Spec.Server.Logging.Format == mlflowv1.ServerLogFormatJSON
+}
+
+// logCollectionPodMetadata returns the pod labels and annotations that route MLflow logs to
+// their stream, or nil maps when spec.server.logging.collection is not enabled.
+func logCollectionPodMetadata(mlflow *mlflowv1.MLflow) (map[string]string, map[string]string) {
+	if mlflow.Spec.Server == nil || mlflow.Spec.Server.Logging == nil {
+		return nil, nil
+	}
+	collection := mlflow.Spec.Server.Logging.Collection
+	if collection == nil || !collection.Enabled {
+		return nil, nil
+	}
+	stream := collection.Stream
+	if stream == "" {
+		stream = defaultLogStream
+	}
+	labels := map[string]string{LogStreamLabel: stream}
+	annotations := map[string]string{openShiftLoggingContainerTypeAnnotation: stream}
+	if JSONLoggingEnabled(mlflow) {
+		annotations[fluentBitParserAnnotation] = "json"
+	}
+	return labels, annotations
+}
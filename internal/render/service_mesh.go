@@ -0,0 +1,34 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+// serviceMeshPodAnnotations enroll the server pods in the mesh. Probes are rewritten so the
+// kubelet, which is outside the mesh, reaches them through the sidecar agent, and MLflow waits
+// for the proxy so its startup database connections do not race the sidecar.
+var serviceMeshPodAnnotations = map[string]string{
+	"sidecar.istio.io/inject":                "true",
+	"sidecar.istio.io/rewriteAppHTTPProbers": "true",
+	"proxy.istio.io/config":                  `{"holdApplicationUntilProxyStarts": true}`,
+}
+
+func ServiceMeshEnabled(mlflow *mlflowv1.MLflow) bool {
+	return mlflow.Spec.ServiceMesh != nil && mlflow.Spec.ServiceMesh.Enabled
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func newServiceMeshMLflow() *mlflowv1.MLflow {
+	return &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow", UID: "mlflow-uid"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI: ptr(testBackendStoreURI),
+			ServiceMesh:     &mlflowv1.ServiceMeshConfig{Enabled: true},
+		},
+	}
+}
+
+func TestRenderChart_ServiceMesh(t *testing.T) {
+	g := gomega.NewWithT(t)
+	renderer := NewHelmRenderer("../../charts/mlflow")
+
+	mlflow := newServiceMeshMLflow()
+	mlflow.Spec.PodAnnotations = map[string]string{"sidecar.istio.io/proxyCPU": "200m", "sidecar.istio.io/rewriteAppHTTPProbers": "false"}
+	objs, err := renderer.RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	deployment := &appsv1.Deployment{}
+	g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(findObject(objs, "Deployment", "mlflow").Object, deployment)).To(gomega.Succeed())
+	g.Expect(deployment.Spec.Template.Annotations).To(gomega.HaveKeyWithValue("sidecar.istio.io/inject", "true"))
+	g.Expect(deployment.Spec.Template.Annotations).To(gomega.HaveKeyWithValue("proxy.istio.io/config", `{"holdApplicationUntilProxyStarts": true}`))
+	g.Expect(deployment.Spec.Template.Annotations).To(gomega.HaveKeyWithValue("sidecar.istio.io/proxyCPU", "200m"))
+	g.Expect(deployment.Spec.Template.Annotations).To(gomega.HaveKeyWithValue("sidecar.istio.io/rewriteAppHTTPProbers", "false"), "explicit pod annotations win")
+
+	service := &corev1.Service{}
+	g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(findObject(objs, "Service", "mlflow").Object, service)).To(gomega.Succeed())
+	g.Expect(service.Spec.Ports[0].AppProtocol).To(gomega.Equal(ptr("https")))
+
+	mlflow.Spec.ServiceMesh.Enabled = false
+	mlflow.Spec.PodAnnotations = nil
+	objs, err = renderer.RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	deployment = &appsv1.Deployment{}
+	g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(findObject(objs, "Deployment", "mlflow").Object, deployment)).To(gomega.Succeed())
+	g.Expect(deployment.Spec.Template.Annotations).To(gomega.BeEmpty())
+	service = &corev1.Service{}
+	g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(findObject(objs, "Service", "mlflow").Object, service)).To(gomega.Succeed())
+	g.Expect(service.Spec.Ports[0].AppProtocol).To(gomega.BeNil())
+}
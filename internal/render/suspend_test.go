@@ -0,0 +1,62 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"testing"
+
+	gomega "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func TestRenderChart_Suspended(t *testing.T) {
+	renderer := NewHelmRenderer("../../charts/mlflow")
+
+	for _, suspended := range []bool{false, true} {
+		g := gomega.NewWithT(t)
+
+		objs, err := renderer.RenderChart(&mlflowv1.MLflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+			Spec: mlflowv1.MLflowSpec{
+				BackendStoreURI:   ptr(testBackendStoreURI),
+				Replicas:          ptr(int32(3)),
+				GarbageCollection: &mlflowv1.GarbageCollectionSpec{Schedule: "0 2 * * 0"},
+			},
+		}, "test-ns", RenderOptions{Suspended: suspended}, nil)
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+
+		deployment := findObject(objs, deploymentKind, "mlflow")
+		g.Expect(deployment).NotTo(gomega.BeNil(), "Deployment should be rendered")
+		replicas, _, _ := unstructured.NestedInt64(deployment.Object, "spec", "replicas")
+
+		cronJob := findObject(objs, "CronJob", "mlflow-gc")
+		g.Expect(cronJob).NotTo(gomega.BeNil(), "GC CronJob should still be rendered while suspended")
+		cronSuspended, found, _ := unstructured.NestedBool(cronJob.Object, "spec", "suspend")
+		g.Expect(found).To(gomega.BeTrue())
+
+		if suspended {
+			g.Expect(replicas).To(gomega.BeZero())
+			g.Expect(cronSuspended).To(gomega.BeTrue())
+		} else {
+			g.Expect(replicas).To(gomega.Equal(int64(3)))
+			g.Expect(cronSuspended).To(gomega.BeFalse())
+		}
+	}
+}
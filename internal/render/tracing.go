@@ -0,0 +1,132 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+)
+
+const (
+	defaultTracingProtocol = "grpc"
+	// tracingCollectorEndpoint is where the collector sidecar receives OTLP over gRPC. It only
+	// listens on the loopback interface, so nothing outside the pod can send it spans.
+	tracingCollectorEndpoint = "http://127.0.0.1:4317"
+)
+
+// tracingEnabled reports whether spec.tracing turns on trace export.
+func tracingEnabled(mlflow *mlflowv1.MLflow) bool {
+	tracing := mlflow.Spec.Tracing
+	return tracing != nil && tracing.Enabled && tracing.OTLPEndpoint != nil && *tracing.OTLPEndpoint != ""
+}
+
+func tracingProtocol(tracing *mlflowv1.TracingConfig) string {
+	if tracing.Protocol != nil && *tracing.Protocol != "" {
+		return *tracing.Protocol
+	}
+	return defaultTracingProtocol
+}
+
+// tracingEnv returns the OpenTelemetry SDK settings for the MLflow container. With the
+// collector sidecar, MLflow sends to the sidecar, which forwards to the configured endpoint.
+// Variables set in spec.env win.
+func tracingEnv(mlflow *mlflowv1.MLflow, namespace string) []corev1.EnvVar {
+	if !tracingEnabled(mlflow) {
+		return nil
+	}
+	tracing := mlflow.Spec.Tracing
+	endpoint, protocol := *tracing.OTLPEndpoint, tracingProtocol(tracing)
+	if tracing.Collector != nil {
+		endpoint, protocol = tracingCollectorEndpoint, defaultTracingProtocol
+	}
+	candidates := []corev1.EnvVar{
+		{Name: "OTEL_SERVICE_NAME", Value: ResourceName + ResourceSuffix(mlflow.Name)},
+		{Name: "OTEL_RESOURCE_ATTRIBUTES", Value: "k8s.namespace.name=" + namespace},
+		{Name: "OTEL_TRACES_EXPORTER", Value: "otlp"},
+		// Only traces are configured here; MLflow metrics are scraped by Prometheus.
+		{Name: "OTEL_METRICS_EXPORTER", Value: "none"},
+		{Name: "OTEL_LOGS_EXPORTER", Value: "none"},
+		{Name: "OTEL_EXPORTER_OTLP_ENDPOINT", Value: endpoint},
+		{Name: "OTEL_EXPORTER_OTLP_PROTOCOL", Value: protocol},
+	}
+	if sampler := tracing.Sampler; sampler != nil {
+		candidates = append(candidates, corev1.EnvVar{Name: "OTEL_TRACES_SAMPLER", Value: sampler.Type})
+		if sampler.Ratio != nil {
+			candidates = append(candidates, corev1.EnvVar{Name: "OTEL_TRACES_SAMPLER_ARG", Value: *sampler.Ratio})
+		}
+	}
+	var env []corev1.EnvVar
+	for _, e := range candidates {
+		if !hasEnv(mlflow.Spec.Env, e.Name) {
+			env = append(env, e)
+		}
+	}
+	return env
+}
+
+// tracingValues returns the tracing Helm values: the collector sidecar, and the port the
+// NetworkPolicy opens for the OTLP endpoint.
+func tracingValues(mlflow *mlflowv1.MLflow, cfg *config.OperatorConfig) (map[string]interface{}, error) {
+	values := map[string]interface{}{"enabled": false}
+	if !tracingEnabled(mlflow) {
+		return values, nil
+	}
+	tracing := mlflow.Spec.Tracing
+	endpoint, err := url.Parse(*tracing.OTLPEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse tracing.otlpEndpoint: %w", err)
+	}
+	port := endpoint.Port()
+	if port == "" {
+		port = "443"
+		if endpoint.Scheme == "http" {
+			port = "80"
+		}
+	}
+	egressPort, err := strconv.Atoi(port)
+	if err != nil {
+		return nil, fmt.Errorf("invalid port in tracing.otlpEndpoint: %w", err)
+	}
+	values["enabled"] = true
+	values["endpoint"] = *tracing.OTLPEndpoint
+	values["protocol"] = tracingProtocol(tracing)
+	values["insecure"] = strings.EqualFold(endpoint.Scheme, "http")
+	values["egressPort"] = int64(egressPort)
+
+	collector := map[string]interface{}{"enabled": false}
+	if tracing.Collector != nil {
+		collector["enabled"] = true
+		collector["image"] = InstanceImage(mlflow, cfg, tracing.Collector.Image)
+		if tracing.Collector.Resources != nil {
+			resources, err := runtime.DefaultUnstructuredConverter.ToUnstructured(tracing.Collector.Resources)
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert tracing.collector.resources: %w", err)
+			}
+			collector["resources"] = resources
+		}
+	}
+	values["collector"] = collector
+	return values, nil
+}
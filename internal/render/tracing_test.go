@@ -0,0 +1,145 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+)
+
+func tracingMLflow(tracing *mlflowv1.TracingConfig) *mlflowv1.MLflow {
+	return &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: ResourceName},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI: ptr(testBackendStoreURI),
+			Tracing:         tracing,
+		},
+	}
+}
+
+func renderTracingDeployment(g *gomega.WithT, mlflow *mlflowv1.MLflow, cfg *config.OperatorConfig) (*corev1.PodSpec, []*unstructured.Unstructured) {
+	objs, err := NewHelmRenderer("../../charts/mlflow").RenderChart(mlflow, "test-ns", RenderOptions{}, cfg)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	deployment := &appsv1.Deployment{}
+	g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(findObject(objs, deploymentKind, "mlflow").Object, deployment)).To(gomega.Succeed())
+	return &deployment.Spec.Template.Spec, objs
+}
+
+func envValue(env []corev1.EnvVar, name string) (string, bool) {
+	for _, e := range env {
+		if e.Name == name {
+			return e.Value, true
+		}
+	}
+	return "", false
+}
+
+func TestTracingEnv(t *testing.T) {
+	g := gomega.NewWithT(t)
+	endpoint := "http://otel-collector.observability.svc:4317"
+
+	g.Expect(tracingEnv(tracingMLflow(nil), "test-ns")).To(gomega.BeEmpty())
+	g.Expect(tracingEnv(tracingMLflow(&mlflowv1.TracingConfig{OTLPEndpoint: &endpoint}), "test-ns")).To(gomega.BeEmpty())
+
+	mlflow := tracingMLflow(&mlflowv1.TracingConfig{
+		Enabled:      true,
+		OTLPEndpoint: &endpoint,
+		Sampler:      &mlflowv1.TracingSamplerConfig{Type: "parentbased_traceidratio", Ratio: ptr("0.25")},
+	})
+	env := tracingEnv(mlflow, "test-ns")
+	g.Expect(env).To(gomega.ContainElements(
+		corev1.EnvVar{Name: "OTEL_SERVICE_NAME", Value: "mlflow"},
+		corev1.EnvVar{Name: "OTEL_RESOURCE_ATTRIBUTES", Value: "k8s.namespace.name=test-ns"},
+		corev1.EnvVar{Name: "OTEL_EXPORTER_OTLP_ENDPOINT", Value: endpoint},
+		corev1.EnvVar{Name: "OTEL_EXPORTER_OTLP_PROTOCOL", Value: "grpc"},
+		corev1.EnvVar{Name: "OTEL_TRACES_SAMPLER", Value: "parentbased_traceidratio"},
+		corev1.EnvVar{Name: "OTEL_TRACES_SAMPLER_ARG", Value: "0.25"},
+	))
+
+	// spec.env wins, and the collector sidecar takes the endpoint.
+	mlflow.Spec.Env = []corev1.EnvVar{{Name: "OTEL_SERVICE_NAME", Value: "tracking"}}
+	mlflow.Spec.Tracing.Protocol = ptr("http/protobuf")
+	mlflow.Spec.Tracing.Collector = &mlflowv1.TracingCollectorConfig{Image: "otel/opentelemetry-collector-contrib:0.120.0"}
+	env = tracingEnv(mlflow, "test-ns")
+	g.Expect(envNames(env)).NotTo(gomega.ContainElement("OTEL_SERVICE_NAME"))
+	g.Expect(env).To(gomega.ContainElements(
+		corev1.EnvVar{Name: "OTEL_EXPORTER_OTLP_ENDPOINT", Value: tracingCollectorEndpoint},
+		corev1.EnvVar{Name: "OTEL_EXPORTER_OTLP_PROTOCOL", Value: "grpc"},
+	))
+}
+
+func TestRenderChart_Tracing(t *testing.T) {
+	g := gomega.NewWithT(t)
+	endpoint := "http://otel-collector.observability.svc:4317"
+
+	podSpec, objs := renderTracingDeployment(g, tracingMLflow(nil), nil)
+	g.Expect(podSpec.Containers).To(gomega.HaveLen(1))
+	g.Expect(envNames(podSpec.Containers[0].Env)).NotTo(gomega.ContainElement("OTEL_EXPORTER_OTLP_ENDPOINT"))
+	egress, _, _ := unstructured.NestedSlice(findObject(objs, "NetworkPolicy", "mlflow").Object, "spec", "egress")
+	g.Expect(collectEgressPorts(egress)).NotTo(gomega.ContainElement(int64(4317)))
+
+	podSpec, objs = renderTracingDeployment(g, tracingMLflow(&mlflowv1.TracingConfig{Enabled: true, OTLPEndpoint: &endpoint}), nil)
+	g.Expect(podSpec.Containers).To(gomega.HaveLen(1))
+	value, _ := envValue(podSpec.Containers[0].Env, "OTEL_EXPORTER_OTLP_ENDPOINT")
+	g.Expect(value).To(gomega.Equal(endpoint))
+	egress, _, _ = unstructured.NestedSlice(findObject(objs, "NetworkPolicy", "mlflow").Object, "spec", "egress")
+	g.Expect(collectEgressPorts(egress)).To(gomega.ContainElement(int64(4317)))
+}
+
+func TestRenderChart_TracingCollector(t *testing.T) {
+	g := gomega.NewWithT(t)
+	mlflow := tracingMLflow(&mlflowv1.TracingConfig{
+		Enabled:      true,
+		OTLPEndpoint: ptr("https://tempo.example.com"),
+		Protocol:     ptr("http/protobuf"),
+		Collector:    &mlflowv1.TracingCollectorConfig{Image: "quay.io/opendatahub/otel-collector:v0.120.0"},
+	})
+	cfg := &config.OperatorConfig{RegistryMirrors: map[string]string{"quay.io/opendatahub": "mirror.example.com/odh"}}
+
+	podSpec, objs := renderTracingDeployment(g, mlflow, cfg)
+	collector := findContainer(podSpec.Containers, "otel-collector")
+	g.Expect(collector).NotTo(gomega.BeNil())
+	g.Expect(collector.Image).To(gomega.Equal("mirror.example.com/odh/otel-collector:v0.120.0"))
+	g.Expect(collector.Args).To(gomega.Equal([]string{"--config=env:OTELCOL_CONFIG"}))
+	g.Expect(collector.Resources.Limits.Memory().String()).To(gomega.Equal("256Mi"))
+
+	raw, ok := envValue(collector.Env, "OTELCOL_CONFIG")
+	g.Expect(ok).To(gomega.BeTrue())
+	var collectorConfig map[string]interface{}
+	g.Expect(yaml.Unmarshal([]byte(raw), &collectorConfig)).To(gomega.Succeed())
+	receiver, _, _ := unstructured.NestedString(collectorConfig, "receivers", "otlp", "protocols", "grpc", "endpoint")
+	g.Expect(receiver).To(gomega.Equal("127.0.0.1:4317"))
+	exporter, _, _ := unstructured.NestedString(collectorConfig, "exporters", "otlphttp", "endpoint")
+	g.Expect(exporter).To(gomega.Equal("https://tempo.example.com"))
+	exporters, _, _ := unstructured.NestedStringSlice(collectorConfig, "service", "pipelines", "traces", "exporters")
+	g.Expect(exporters).To(gomega.Equal([]string{"otlphttp"}))
+
+	value, _ := envValue(findContainer(podSpec.Containers, "mlflow").Env, "OTEL_EXPORTER_OTLP_ENDPOINT")
+	g.Expect(value).To(gomega.Equal(tracingCollectorEndpoint))
+	egress, _, _ := unstructured.NestedSlice(findObject(objs, "NetworkPolicy", "mlflow").Object, "spec", "egress")
+	g.Expect(collectEgressPorts(egress)).To(gomega.ContainElement(int64(443)))
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// CoalesceValues fills keys missing from dst with those of base, recursing where both hold a
+// map, and returns dst. base is copied so later changes to dst leave it untouched.
+func CoalesceValues(dst, base map[string]interface{}) map[string]interface{} {
+	if len(base) == 0 {
+		return dst
+	}
+	if dst == nil {
+		dst = make(map[string]interface{}, len(base))
+	}
+	for key, baseValue := range runtime.DeepCopyJSON(base) {
+		value, ok := dst[key]
+		if !ok {
+			dst[key] = baseValue
+			continue
+		}
+		valueMap, isMap := value.(map[string]interface{})
+		baseMap, baseIsMap := baseValue.(map[string]interface{})
+		if isMap && baseIsMap {
+			dst[key] = CoalesceValues(valueMap, baseMap)
+		}
+	}
+	return dst
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func TestRenderChart_BaseValues(t *testing.T) {
+	g := gomega.NewWithT(t)
+	renderer := NewHelmRenderer("../../charts/mlflow")
+
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: ResourceName},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI:      ptr("sqlite:////mlflow/mlflow.db"),
+			ArtifactsDestination: ptr("file:///mlflow/artifacts"),
+			Replicas:             ptr(int32(2)),
+		},
+	}
+	opts := RenderOptions{BaseValues: map[string]interface{}{
+		"replicaCount":   int64(5),
+		"podAnnotations": map[string]interface{}{"team": "ml"},
+	}}
+	objs, err := renderer.RenderChart(mlflow, "test-ns", opts, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	deployment := findObject(objs, deploymentKind, ResourceName)
+	g.Expect(deployment).NotTo(gomega.BeNil())
+	// Values derived from the spec win over spec.valuesFrom.
+	replicas, _, _ := unstructured.NestedInt64(deployment.Object, "spec", "replicas")
+	g.Expect(replicas).To(gomega.Equal(int64(2)))
+	annotations, _, _ := unstructured.NestedStringMap(deployment.Object, "spec", "template", "metadata", "annotations")
+	g.Expect(annotations).To(gomega.HaveKeyWithValue("team", "ml"))
+	// The caller's values are left untouched.
+	g.Expect(opts.BaseValues["podAnnotations"]).To(gomega.Equal(map[string]interface{}{"team": "ml"}))
+
+	// spec.valuesFrom values are checked against the chart schema like the rest.
+	_, err = renderer.RenderChart(mlflow, "test-ns", RenderOptions{BaseValues: map[string]interface{}{"podAnnotations": "team"}}, nil)
+	g.Expect(IsChartValuesError(err)).To(gomega.BeTrue())
+}
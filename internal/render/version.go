@@ -0,0 +1,74 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+)
+
+// mlflowVersionImages maps each MLflow release the bundled chart supports to the image the
+// operator ships for it. Database migrations are pinned to config.SupportedMLflowVersion, so the
+// operator currently maps exactly that release; new entries land together with chart and
+// migration support for the release.
+func mlflowVersionImages(cfg *config.OperatorConfig) map[string]string {
+	images := map[string]string{}
+	if supported, err := semver.NewVersion(strings.TrimPrefix(config.SupportedMLflowVersion, "v")); err == nil {
+		images[supported.String()] = cfg.MLflowImage
+	}
+	return images
+}
+
+// ResolveVersionImage returns the image for spec.version, or "" when no version is requested.
+// A "<major>.<minor>" request matches the supported patch release of that line.
+func ResolveVersionImage(mlflow *mlflowv1.MLflow, cfg *config.OperatorConfig) (string, error) {
+	if mlflow.Spec.Version == nil {
+		return "", nil
+	}
+	requested := strings.TrimPrefix(*mlflow.Spec.Version, "v")
+	wantPatch := strings.Count(requested, ".") == 2
+	requestedVersion, err := semver.NewVersion(requested)
+	if err != nil {
+		return "", fmt.Errorf("invalid spec.version %q: %w", *mlflow.Spec.Version, err)
+	}
+
+	images := mlflowVersionImages(cfg)
+	supported := make([]string, 0, len(images))
+	for version, image := range images {
+		supported = append(supported, version)
+		candidate, err := semver.NewVersion(version)
+		if err != nil {
+			continue
+		}
+		if candidate.Major() != requestedVersion.Major() || candidate.Minor() != requestedVersion.Minor() {
+			continue
+		}
+		if wantPatch && candidate.Patch() != requestedVersion.Patch() {
+			continue
+		}
+		return image, nil
+	}
+	sort.Strings(supported)
+	return "", fmt.Errorf("spec.version %q is not supported by this operator; supported versions: %s",
+		*mlflow.Spec.Version, strings.Join(supported, ", "))
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+)
+
+func withSupportedMLflowVersion(t *testing.T, version string) {
+	t.Helper()
+	previous := config.SupportedMLflowVersion
+	config.SupportedMLflowVersion = version
+	t.Cleanup(func() { config.SupportedMLflowVersion = previous })
+}
+
+func TestResolveVersionImage(t *testing.T) {
+	withSupportedMLflowVersion(t, "v3.13.0")
+	cfg := &config.OperatorConfig{MLflowImage: "quay.io/opendatahub/mlflow:odh-stable"}
+
+	tests := []struct {
+		name      string
+		version   *string
+		wantImage string
+		wantErr   bool
+	}{
+		{name: "no version", version: nil, wantImage: ""},
+		{name: "minor line", version: ptr("3.13"), wantImage: cfg.MLflowImage},
+		{name: "exact release with v prefix", version: ptr("v3.13.0"), wantImage: cfg.MLflowImage},
+		{name: "other patch of supported line", version: ptr("3.13.1"), wantErr: true},
+		{name: "unsupported minor", version: ptr("2.17"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mlflow := &mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+				Spec:       mlflowv1.MLflowSpec{Version: tt.version},
+			}
+
+			image, err := ResolveVersionImage(mlflow, cfg)
+			if tt.wantErr {
+				g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("supported versions: 3.13.0")))
+				return
+			}
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(image).To(gomega.Equal(tt.wantImage))
+		})
+	}
+}
+
+func TestMLflowToHelmValuesUsesVersionImage(t *testing.T) {
+	g := gomega.NewWithT(t)
+	withSupportedMLflowVersion(t, "v3.13.0")
+
+	renderer := NewHelmRenderer("../../charts/mlflow")
+	cfg := &config.OperatorConfig{MLflowImage: "quay.io/opendatahub/mlflow:odh-stable"}
+
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI: ptr(testBackendStoreURI),
+			Version:         ptr("3.13"),
+		},
+	}
+	values, err := renderer.mlflowToHelmValues(mlflow, "test-ns", RenderOptions{}, cfg)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(values["image"]).To(gomega.HaveKeyWithValue("name", "quay.io/opendatahub/mlflow:odh-stable"))
+
+	mlflow.Spec.Version = ptr("2.17")
+	_, err = renderer.mlflowToHelmValues(mlflow, "test-ns", RenderOptions{}, cfg)
+	g.Expect(err).To(gomega.HaveOccurred())
+}
+
+func TestEffectiveMLflowImageAppliesRegistryMirrors(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	cfg := &config.OperatorConfig{
+		MLflowImage:     "quay.io/opendatahub/mlflow:odh-stable",
+		RegistryMirrors: map[string]string{"quay.io": "mirror.internal/quay"},
+	}
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "mlflow"}}
+
+	image, err := EffectiveMLflowImage(mlflow, cfg)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(image).To(gomega.Equal("mirror.internal/quay/opendatahub/mlflow:odh-stable"))
+
+	mlflow.Spec.Image = &mlflowv1.ImageConfig{Image: ptr("quay.io/team/mlflow:custom")}
+	image, err = EffectiveMLflowImage(mlflow, cfg)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(image).To(gomega.Equal("mirror.internal/quay/team/mlflow:custom"))
+
+	// spec.image.registry wins over the operator mirrors.
+	mlflow.Spec.Image.Registry = ptr("registry.team.example.com:5000")
+	image, err = EffectiveMLflowImage(mlflow, cfg)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(image).To(gomega.Equal("registry.team.example.com:5000/team/mlflow:custom"))
+	g.Expect(InstanceImage(mlflow, cfg, "registry.redhat.io/rhel9/postgresql-16")).
+		To(gomega.Equal("registry.team.example.com:5000/rhel9/postgresql-16"))
+}
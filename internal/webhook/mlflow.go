@@ -0,0 +1,294 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+// MLflowValidatorPath is where the MLflow reference validation webhook is served.
+const MLflowValidatorPath = "/validate-mlflow-opendatahub-io-v1-mlflow"
+
+// +kubebuilder:webhook:path=/validate-mlflow-opendatahub-io-v1-mlflow,mutating=false,failurePolicy=ignore,sideEffects=None,groups=mlflow.opendatahub.io,resources=mlflows,verbs=create;update,versions=v1,name=vmlflow.mlflow.opendatahub.io,admissionReviewVersions=v1
+
+// MLflowValidator warns about Secrets and ConfigMaps an MLflow spec references that do not
+// exist in the applications namespace, so a typo shows up on apply instead of as a pod stuck
+// in CreateContainerConfigError. Missing references never reject: the object may be created
+// right after the MLflow, e.g. by the same kubectl apply. The only changes it rejects are
+// storage changes the operator cannot apply to a claim it already created.
+type MLflowValidator struct {
+	// Client reads the referenced objects. They are read as unstructured objects, which go to
+	// the API server instead of the cache that only holds objects labeled for MLflow.
+	Client client.Reader
+	// Namespace is the applications namespace the references resolve in.
+	Namespace string
+}
+
+// fileStoreURIPattern matches the backend and registry store URIs of a SQLite database or a
+// file store, which a single writer must own.
+var fileStoreURIPattern = regexp.MustCompile(`^(sqlite(\+[A-Za-z0-9_]+)?|file)://`)
+
+// objectReference is a Secret or ConfigMap named by an MLflow spec field.
+type objectReference struct {
+	Kind  string
+	Name  string
+	Field string
+}
+
+// Handle denies updates that shrink a claim or change its storageClassName or accessModes.
+// Other requests are allowed, with a warning listing the referenced objects that are missing,
+// one for a file-based store read from a Secret while replicas or workers exceed 1, and one
+// per claim whose size request grew.
+func (v *MLflowValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	mlflow := &mlflowv1.MLflow{}
+	if err := json.Unmarshal(req.Object.Raw, mlflow); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	var storageWarnings []string
+	if req.Operation == admissionv1.Update && len(req.OldObject.Raw) > 0 {
+		old := &mlflowv1.MLflow{}
+		if err := json.Unmarshal(req.OldObject.Raw, old); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		denied, growth := storageChanges(old, mlflow)
+		if len(denied) > 0 {
+			return admission.Denied(fmt.Sprintf("%s: the operator does not update existing PersistentVolumeClaims; "+
+				"to move the data, copy it to a new claim and reference it with existingClaim", strings.Join(denied, "; ")))
+		}
+		storageWarnings = growth
+	}
+
+	var missing []string
+	checked := map[objectReference]bool{}
+	for _, ref := range mlflowReferences(mlflow) {
+		key := objectReference{Kind: ref.Kind, Name: ref.Name}
+		if checked[key] {
+			continue
+		}
+		checked[key] = true
+
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion("v1")
+		obj.SetKind(ref.Kind)
+		err := v.Client.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: v.Namespace}, obj)
+		if apierrors.IsNotFound(err) {
+			missing = append(missing, fmt.Sprintf("%s %q (%s)", ref.Kind, ref.Name, ref.Field))
+		} else if err != nil {
+			// Warnings are advisory; a failed lookup must not hold up the request.
+			logf.FromContext(ctx).Error(err, "Failed to check MLflow reference", "kind", ref.Kind, "name", ref.Name)
+		}
+	}
+	var warnings []string
+	if len(missing) > 0 {
+		warnings = append(warnings, fmt.Sprintf("referenced objects not found in namespace %s: %s",
+			v.Namespace, strings.Join(missing, ", ")))
+	}
+	if warning := v.fileStoreScaleWarning(ctx, mlflow); warning != "" {
+		warnings = append(warnings, warning)
+	}
+	warnings = append(warnings, storageWarnings...)
+	return admission.Allowed("").WithWarnings(warnings...)
+}
+
+// fileStoreScaleWarning warns when replicas or workers exceed 1 while the backend or registry
+// store URI read from a Secret is file-based. The CRD rejects this for inline URIs; only the
+// webhook can see the Secret value.
+func (v *MLflowValidator) fileStoreScaleWarning(ctx context.Context, mlflow *mlflowv1.MLflow) string {
+	spec := &mlflow.Spec
+	var scaled []string
+	if spec.Replicas != nil && *spec.Replicas > 1 {
+		scaled = append(scaled, fmt.Sprintf("replicas is %d", *spec.Replicas))
+	}
+	if spec.Workers != nil && *spec.Workers > 1 {
+		scaled = append(scaled, fmt.Sprintf("workers is %d", *spec.Workers))
+	}
+	if len(scaled) == 0 {
+		return ""
+	}
+
+	for _, ref := range []struct {
+		selector *corev1.SecretKeySelector
+		field    string
+	}{
+		{spec.BackendStoreURIFrom, "spec.backendStoreUriFrom"},
+		{spec.RegistryStoreURIFrom, "spec.registryStoreUriFrom"},
+	} {
+		if ref.selector == nil {
+			continue
+		}
+		uri, err := v.secretValue(ctx, ref.selector)
+		if err != nil {
+			logf.FromContext(ctx).Error(err, "Failed to read store URI", "secret", ref.selector.Name, "key", ref.selector.Key)
+			continue
+		}
+		if fileStoreURIPattern.MatchString(strings.TrimSpace(uri)) {
+			return fmt.Sprintf("%s is a file-based store (sqlite:// or file:// prefix) but %s: concurrent writers "+
+				"corrupt the database; set them to 1 or use PostgreSQL or MySQL", ref.field, strings.Join(scaled, " and "))
+		}
+	}
+	return ""
+}
+
+// storageChanges compares the storage of old and mlflow. denied lists the changes that cannot
+// reach a claim the operator created: a smaller size request, a different storageClassName, or
+// different accessModes. growth lists larger size requests, which the operator does not apply
+// either but an admin can by expanding the claim. StorageClassName going from unset to set is
+// not reported, since the claim got the cluster default the webhook cannot compare against.
+// Ephemeral volumes and existing claims are not the operator's to resize, so they are skipped.
+func storageChanges(old, mlflow *mlflowv1.MLflow) (denied, growth []string) {
+	oldStorage, newStorage := old.Spec.Storage, mlflow.Spec.Storage
+	if oldStorage == nil || newStorage == nil || oldStorage.Ephemeral || newStorage.Ephemeral {
+		return nil, nil
+	}
+
+	compare := func(oldClaim, newClaim *corev1.PersistentVolumeClaimSpec, field string) {
+		oldSize, newSize := oldClaim.Resources.Requests.Storage(), newClaim.Resources.Requests.Storage()
+		switch {
+		case oldSize.IsZero():
+			// Nothing to compare a new request against.
+		case newSize.Cmp(*oldSize) < 0:
+			denied = append(denied, fmt.Sprintf("%s.resources.requests.storage cannot shrink from %s to %s",
+				field, oldSize, newSize))
+		case newSize.Cmp(*oldSize) > 0:
+			growth = append(growth, fmt.Sprintf("%s.resources.requests.storage grew from %s to %s, but the operator "+
+				"does not resize existing claims; expand the PersistentVolumeClaim directly if its storage class allows it",
+				field, oldSize, newSize))
+		}
+		if oldClaim.StorageClassName != nil && !equality.Semantic.DeepEqual(oldClaim.StorageClassName, newClaim.StorageClassName) {
+			newClass := "unset"
+			if newClaim.StorageClassName != nil {
+				newClass = fmt.Sprintf("%q", *newClaim.StorageClassName)
+			}
+			denied = append(denied, fmt.Sprintf("%s.storageClassName cannot change from %q to %s",
+				field, *oldClaim.StorageClassName, newClass))
+		}
+		if len(oldClaim.AccessModes) > 0 && !equality.Semantic.DeepEqual(oldClaim.AccessModes, newClaim.AccessModes) {
+			denied = append(denied, fmt.Sprintf("%s.accessModes cannot change from %v to %v",
+				field, oldClaim.AccessModes, newClaim.AccessModes))
+		}
+	}
+
+	if oldStorage.ExistingClaim == "" && newStorage.ExistingClaim == "" {
+		compare(&oldStorage.PersistentVolumeClaimSpec, &newStorage.PersistentVolumeClaimSpec, "spec.storage")
+	}
+	for _, volume := range []struct {
+		old, new *mlflowv1.StorageVolume
+		field    string
+	}{
+		{oldStorage.Database, newStorage.Database, "spec.storage.database"},
+		{oldStorage.Artifacts, newStorage.Artifacts, "spec.storage.artifacts"},
+	} {
+		if volume.old != nil && volume.new != nil && volume.old.ExistingClaim == "" && volume.new.ExistingClaim == "" {
+			compare(&volume.old.PersistentVolumeClaimSpec, &volume.new.PersistentVolumeClaimSpec, volume.field)
+		}
+	}
+	return denied, growth
+}
+
+// secretValue returns the decoded value of the key selector points at in the applications
+// namespace, or "" when the Secret or key does not exist.
+func (v *MLflowValidator) secretValue(ctx context.Context, selector *corev1.SecretKeySelector) (string, error) {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("v1")
+	obj.SetKind("Secret")
+	if err := v.Client.Get(ctx, types.NamespacedName{Name: selector.Name, Namespace: v.Namespace}, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	encoded, _, _ := unstructured.NestedString(obj.Object, "data", selector.Key)
+	value, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", fmt.Errorf("decode key %s of Secret %s: %w", selector.Key, selector.Name, err)
+	}
+	return string(value), nil
+}
+
+// mlflowReferences returns the Secrets and ConfigMaps the spec of mlflow references, in spec
+// order. References marked optional are left out, since the pod starts without them.
+func mlflowReferences(mlflow *mlflowv1.MLflow) []objectReference {
+	var refs []objectReference
+	add := func(kind, name, field string) {
+		if name != "" {
+			refs = append(refs, objectReference{Kind: kind, Name: name, Field: field})
+		}
+	}
+	addSecretKey := func(selector *corev1.SecretKeySelector, field string) {
+		if selector != nil && !isOptional(selector.Optional) {
+			add("Secret", selector.Name, field)
+		}
+	}
+
+	spec := &mlflow.Spec
+	addSecretKey(spec.BackendStoreURIFrom, "spec.backendStoreUriFrom")
+	addSecretKey(spec.RegistryStoreURIFrom, "spec.registryStoreUriFrom")
+	for i, env := range spec.Env {
+		if env.ValueFrom == nil {
+			continue
+		}
+		field := fmt.Sprintf("spec.env[%d].valueFrom", i)
+		addSecretKey(env.ValueFrom.SecretKeyRef, field+".secretKeyRef")
+		if ref := env.ValueFrom.ConfigMapKeyRef; ref != nil && !isOptional(ref.Optional) {
+			add("ConfigMap", ref.Name, field+".configMapKeyRef")
+		}
+	}
+	for i, envFrom := range spec.EnvFrom {
+		field := fmt.Sprintf("spec.envFrom[%d]", i)
+		if ref := envFrom.SecretRef; ref != nil && !isOptional(ref.Optional) {
+			add("Secret", ref.Name, field+".secretRef")
+		}
+		if ref := envFrom.ConfigMapRef; ref != nil && !isOptional(ref.Optional) {
+			add("ConfigMap", ref.Name, field+".configMapRef")
+		}
+	}
+	if spec.CABundleConfigMap != nil {
+		add("ConfigMap", spec.CABundleConfigMap.Name, "spec.caBundleConfigMap")
+	}
+	if spec.WorkspaceArtifactCredentials != nil {
+		add("Secret", spec.WorkspaceArtifactCredentials.SecretName, "spec.workspaceArtifactCredentials.secretName")
+	}
+	if spec.Notifications != nil {
+		addSecretKey(&spec.Notifications.WebhookURLFrom, "spec.notifications.webhookUrlFrom")
+	}
+	if spec.Routing != nil && spec.Routing.CustomDomain != nil {
+		add("Secret", spec.Routing.CustomDomain.TLSSecretRef.Name, "spec.routing.customDomain.tlsSecretRef")
+	}
+	return refs
+}
+
+func isOptional(optional *bool) bool {
+	return optional != nil && *optional
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func mlflowRequest(t *testing.T, spec mlflowv1.MLflowSpec) admission.Request {
+	t.Helper()
+	raw, err := json.Marshal(&mlflowv1.MLflow{
+		TypeMeta:   metav1.TypeMeta{APIVersion: mlflowv1.GroupVersion.String(), Kind: "MLflow"},
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec:       spec,
+	})
+	if err != nil {
+		t.Fatalf("marshal MLflow: %v", err)
+	}
+	return admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+		Operation: admissionv1.Create,
+		Object:    runtime.RawExtension{Raw: raw},
+	}}
+}
+
+func TestMLflowValidator(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(gomega.Succeed())
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "db-credentials", Namespace: "opendatahub"}},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "odh-trusted-ca-bundle", Namespace: "opendatahub"}},
+	).Build()
+	validator := &MLflowValidator{Client: c, Namespace: "opendatahub"}
+
+	optional := true
+	resp := validator.Handle(ctx, mlflowRequest(t, mlflowv1.MLflowSpec{
+		BackendStoreURIFrom: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "db-credentials"}, Key: "uri"},
+		Env: []corev1.EnvVar{
+			{Name: "PLAIN", Value: "value"},
+			{Name: "TOKEN", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "db-credentails"}, Key: "token",
+			}}},
+			{Name: "OPTIONAL", ValueFrom: &corev1.EnvVarSource{ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "not-there-yet"}, Key: "value", Optional: &optional,
+			}}},
+		},
+		EnvFrom: []corev1.EnvFromSource{
+			{SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "aws-credentials"}}},
+			{SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "aws-credentials"}}},
+		},
+		CABundleConfigMap: &mlflowv1.CABundleConfigMapSpec{Name: "odh-trusted-ca-bundle"},
+	}))
+	g.Expect(resp.Allowed).To(gomega.BeTrue())
+	g.Expect(resp.Warnings).To(gomega.Equal([]string{
+		`referenced objects not found in namespace opendatahub: ` +
+			`Secret "db-credentails" (spec.env[1].valueFrom.secretKeyRef), Secret "aws-credentials" (spec.envFrom[0].secretRef)`,
+	}))
+
+	// Specs whose references all resolve get no warning.
+	resp = validator.Handle(ctx, mlflowRequest(t, mlflowv1.MLflowSpec{
+		CABundleConfigMap: &mlflowv1.CABundleConfigMapSpec{Name: "odh-trusted-ca-bundle"},
+	}))
+	g.Expect(resp.Allowed).To(gomega.BeTrue())
+	g.Expect(resp.Warnings).To(gomega.BeEmpty())
+}
+
+func TestMLflowValidatorFileStoreScaleWarning(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(gomega.Succeed())
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "db-credentials", Namespace: "opendatahub"},
+			Data: map[string][]byte{
+				"sqlite":   []byte("sqlite:////mlflow/mlflow.db"),
+				"postgres": []byte("postgresql://mlflow@db:5432/mlflow"),
+			},
+		},
+	).Build()
+	validator := &MLflowValidator{Client: c, Namespace: "opendatahub"}
+	storeFrom := func(key string) *corev1.SecretKeySelector {
+		return &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "db-credentials"}, Key: key}
+	}
+	replicas, workers := int32(2), int32(4)
+
+	resp := validator.Handle(ctx, mlflowRequest(t, mlflowv1.MLflowSpec{
+		BackendStoreURIFrom: storeFrom("sqlite"),
+		Replicas:            &replicas,
+		Workers:             &workers,
+	}))
+	g.Expect(resp.Allowed).To(gomega.BeTrue())
+	g.Expect(resp.Warnings).To(gomega.ConsistOf(gomega.ContainSubstring(
+		"spec.backendStoreUriFrom is a file-based store (sqlite:// or file:// prefix) but replicas is 2 and workers is 4")))
+
+	// A single replica, or a database server, is safe.
+	one := int32(1)
+	resp = validator.Handle(ctx, mlflowRequest(t, mlflowv1.MLflowSpec{BackendStoreURIFrom: storeFrom("sqlite"), Replicas: &one}))
+	g.Expect(resp.Warnings).To(gomega.BeEmpty())
+	resp = validator.Handle(ctx, mlflowRequest(t, mlflowv1.MLflowSpec{BackendStoreURIFrom: storeFrom("postgres"), Replicas: &replicas}))
+	g.Expect(resp.Warnings).To(gomega.BeEmpty())
+}
+
+func TestMLflowValidatorStorageChanges(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(gomega.Succeed())
+	validator := &MLflowValidator{Client: fake.NewClientBuilder().WithScheme(scheme).Build(), Namespace: "opendatahub"}
+	claim := func(size, class string, modes ...corev1.PersistentVolumeAccessMode) corev1.PersistentVolumeClaimSpec {
+		return corev1.PersistentVolumeClaimSpec{
+			AccessModes:      modes,
+			StorageClassName: &class,
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(size)},
+			},
+		}
+	}
+	update := func(old, storage *mlflowv1.StorageConfig) admission.Response {
+		req := mlflowRequest(t, mlflowv1.MLflowSpec{Storage: storage})
+		req.Operation = admissionv1.Update
+		req.OldObject = mlflowRequest(t, mlflowv1.MLflowSpec{Storage: old}).Object
+		return validator.Handle(ctx, req)
+	}
+	old := &mlflowv1.StorageConfig{
+		PersistentVolumeClaimSpec: claim("10Gi", "standard", corev1.ReadWriteOnce),
+		Artifacts:                 &mlflowv1.StorageVolume{PersistentVolumeClaimSpec: claim("100Gi", "standard", corev1.ReadWriteOnce)},
+	}
+
+	resp := update(old, &mlflowv1.StorageConfig{
+		PersistentVolumeClaimSpec: claim("5Gi", "fast-ssd", corev1.ReadWriteMany),
+		Artifacts:                 old.Artifacts,
+	})
+	g.Expect(resp.Allowed).To(gomega.BeFalse())
+	g.Expect(resp.Result.Message).To(gomega.And(
+		gomega.ContainSubstring("spec.storage.resources.requests.storage cannot shrink from 10Gi to 5Gi"),
+		gomega.ContainSubstring(`spec.storage.storageClassName cannot change from "standard" to "fast-ssd"`),
+		gomega.ContainSubstring("spec.storage.accessModes cannot change from [ReadWriteOnce] to [ReadWriteMany]"),
+		gomega.ContainSubstring("reference it with existingClaim"),
+	))
+
+	resp = update(old, &mlflowv1.StorageConfig{
+		PersistentVolumeClaimSpec: old.PersistentVolumeClaimSpec,
+		Artifacts:                 &mlflowv1.StorageVolume{PersistentVolumeClaimSpec: claim("50Gi", "standard", corev1.ReadWriteOnce)},
+	})
+	g.Expect(resp.Allowed).To(gomega.BeFalse())
+	g.Expect(resp.Result.Message).To(gomega.ContainSubstring("spec.storage.artifacts.resources.requests.storage cannot shrink"))
+
+	// Growing a claim is admitted with a warning, since the operator leaves the claim as is.
+	resp = update(old, &mlflowv1.StorageConfig{
+		PersistentVolumeClaimSpec: claim("20Gi", "standard", corev1.ReadWriteOnce),
+		Artifacts:                 old.Artifacts,
+	})
+	g.Expect(resp.Allowed).To(gomega.BeTrue())
+	g.Expect(resp.Warnings).To(gomega.ConsistOf(gomega.ContainSubstring(
+		"spec.storage.resources.requests.storage grew from 10Gi to 20Gi")))
+
+	// Switching to an existing claim or ephemeral storage is the documented way out.
+	resp = update(old, &mlflowv1.StorageConfig{ExistingClaim: "mlflow-data", Artifacts: old.Artifacts})
+	g.Expect(resp.Allowed).To(gomega.BeTrue())
+	g.Expect(update(old, &mlflowv1.StorageConfig{Ephemeral: true}).Allowed).To(gomega.BeTrue())
+
+	// Creates have no claim to compare against.
+	g.Expect(validator.Handle(ctx, mlflowRequest(t, mlflowv1.MLflowSpec{Storage: &mlflowv1.StorageConfig{
+		PersistentVolumeClaimSpec: claim("1Gi", "fast-ssd"),
+	}})).Allowed).To(gomega.BeTrue())
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package webhook holds the operator's admission webhooks.
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// MLflowConfigDefaulterPath is where the MLflowConfig defaulting webhook is served.
+const MLflowConfigDefaulterPath = "/mutate-mlflow-kubeflow-org-v1-mlflowconfig"
+
+// +kubebuilder:webhook:path=/mutate-mlflow-kubeflow-org-v1-mlflowconfig,mutating=true,failurePolicy=fail,sideEffects=None,groups=mlflow.kubeflow.org,resources=mlflowconfigs,verbs=create;update,versions=v1,name=mmlflowconfig.mlflow.opendatahub.io,admissionReviewVersions=v1
+
+// MLflowConfigDefaulter normalizes spec.artifactRootPath of MLflowConfig objects so the
+// artifact root MLflow resolves from it is predictable. MLflowConfig is an upstream MLflow
+// CRD without Go types here, so the handler works on the raw object.
+type MLflowConfigDefaulter struct{}
+
+// Handle strips leading and trailing slashes from artifactRootPath, rejects '..' segments,
+// and defaults an empty path on create to the namespace name. An empty path is kept on update
+// because defaulting it would move the artifact root of an existing namespace.
+func (d *MLflowConfigDefaulter) Handle(_ context.Context, req admission.Request) admission.Response {
+	obj := &unstructured.Unstructured{}
+	if err := json.Unmarshal(req.Object.Raw, &obj.Object); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	spec, found, err := unstructured.NestedMap(obj.Object, "spec")
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	if !found {
+		// The CRD schema requires spec; leave the rejection to the API server.
+		return admission.Allowed("")
+	}
+
+	current, _, err := unstructured.NestedString(spec, "artifactRootPath")
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	normalized, err := NormalizeArtifactRootPath(current)
+	if err != nil {
+		return admission.Denied(err.Error())
+	}
+	if normalized == "" && req.Operation == admissionv1.Create {
+		normalized = req.Namespace
+	}
+	if normalized == current {
+		return admission.Allowed("")
+	}
+
+	if normalized == "" {
+		unstructured.RemoveNestedField(obj.Object, "spec", "artifactRootPath")
+	} else if err := unstructured.SetNestedField(obj.Object, normalized, "spec", "artifactRootPath"); err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	defaulted, err := json.Marshal(obj.Object)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	return admission.PatchResponseFromRaw(req.Object.Raw, defaulted)
+}
+
+// NormalizeArtifactRootPath returns artifactRootPath as a clean relative path without leading
+// or trailing slashes. Paths with '..' segments are rejected instead of resolved, so a path
+// cannot point outside the bucket prefix it appears to name.
+func NormalizeArtifactRootPath(artifactRootPath string) (string, error) {
+	for _, segment := range strings.Split(artifactRootPath, "/") {
+		if segment == ".." {
+			return "", fmt.Errorf("artifactRootPath %q must not contain '..' path segments", artifactRootPath)
+		}
+	}
+	trimmed := strings.Trim(artifactRootPath, "/")
+	if trimmed == "" {
+		return "", nil
+	}
+	if cleaned := path.Clean(trimmed); cleaned != "." {
+		return cleaned, nil
+	}
+	return "", nil
+}
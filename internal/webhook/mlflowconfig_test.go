@@ -0,0 +1,132 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func TestNormalizeArtifactRootPath(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		want    string
+		wantErr bool
+	}{
+		{name: "keeps a clean path", path: "experiments/team-a", want: "experiments/team-a"},
+		{name: "strips leading and trailing slashes", path: "/experiments/team-a/", want: "experiments/team-a"},
+		{name: "collapses repeated slashes", path: "experiments//team-a", want: "experiments/team-a"},
+		{name: "drops dot segments", path: "./experiments/./team-a", want: "experiments/team-a"},
+		{name: "treats slashes only as empty", path: "///", want: ""},
+		{name: "keeps empty", path: "", want: ""},
+		{name: "rejects parent segments", path: "experiments/../other-team", wantErr: true},
+		{name: "rejects a leading parent segment", path: "../other-team", wantErr: true},
+		{name: "allows dots inside segment names", path: "runs..v2", want: "runs..v2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeArtifactRootPath(tt.path)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("NormalizeArtifactRootPath(%q) = %q, want error", tt.path, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NormalizeArtifactRootPath(%q) returned error: %v", tt.path, err)
+			}
+			if got != tt.want {
+				t.Fatalf("NormalizeArtifactRootPath(%q) = %q, want %q", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func mlflowConfigRequest(t *testing.T, operation admissionv1.Operation, spec map[string]interface{}) admission.Request {
+	t.Helper()
+	raw, err := json.Marshal(map[string]interface{}{
+		"apiVersion": "mlflow.kubeflow.org/v1",
+		"kind":       "MLflowConfig",
+		"metadata":   map[string]interface{}{"name": "mlflow", "namespace": "team-a"},
+		"spec":       spec,
+	})
+	if err != nil {
+		t.Fatalf("marshal MLflowConfig: %v", err)
+	}
+	return admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+		Operation: operation,
+		Namespace: "team-a",
+		Object:    runtime.RawExtension{Raw: raw},
+	}}
+}
+
+func patchJSON(t *testing.T, resp admission.Response) string {
+	t.Helper()
+	data, err := json.Marshal(resp.Patches)
+	if err != nil {
+		t.Fatalf("marshal patches: %v", err)
+	}
+	return string(data)
+}
+
+func TestMLflowConfigDefaulter(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+	defaulter := &MLflowConfigDefaulter{}
+	secret := map[string]interface{}{"artifactRootSecret": "mlflow-artifact-connection"}
+
+	// A path typed with slashes is normalized.
+	resp := defaulter.Handle(ctx, mlflowConfigRequest(t, admissionv1.Update, map[string]interface{}{
+		"artifactRootSecret": "mlflow-artifact-connection",
+		"artifactRootPath":   "/experiments/",
+	}))
+	g.Expect(resp.Allowed).To(gomega.BeTrue())
+	g.Expect(patchJSON(t, resp)).To(gomega.MatchJSON(`[{"op":"replace","path":"/spec/artifactRootPath","value":"experiments"}]`))
+
+	// An empty path defaults to the namespace on create only.
+	resp = defaulter.Handle(ctx, mlflowConfigRequest(t, admissionv1.Create, secret))
+	g.Expect(resp.Allowed).To(gomega.BeTrue())
+	g.Expect(patchJSON(t, resp)).To(gomega.MatchJSON(`[{"op":"add","path":"/spec/artifactRootPath","value":"team-a"}]`))
+
+	resp = defaulter.Handle(ctx, mlflowConfigRequest(t, admissionv1.Update, secret))
+	g.Expect(resp.Allowed).To(gomega.BeTrue())
+	g.Expect(resp.Patches).To(gomega.BeEmpty())
+
+	// A path that only held slashes is removed on update instead of left invalid.
+	resp = defaulter.Handle(ctx, mlflowConfigRequest(t, admissionv1.Update, map[string]interface{}{
+		"artifactRootSecret": "mlflow-artifact-connection",
+		"artifactRootPath":   "/",
+	}))
+	g.Expect(resp.Allowed).To(gomega.BeTrue())
+	g.Expect(patchJSON(t, resp)).To(gomega.MatchJSON(`[{"op":"remove","path":"/spec/artifactRootPath"}]`))
+
+	// Path traversal is rejected.
+	resp = defaulter.Handle(ctx, mlflowConfigRequest(t, admissionv1.Create, map[string]interface{}{
+		"artifactRootSecret": "mlflow-artifact-connection",
+		"artifactRootPath":   "experiments/../../other-team",
+	}))
+	g.Expect(resp.Allowed).To(gomega.BeFalse())
+	g.Expect(resp.Result.Message).To(gomega.ContainSubstring("must not contain '..'"))
+}
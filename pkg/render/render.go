@@ -0,0 +1,119 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package render renders the Kubernetes manifests of an MLflow instance from its CR with the
+// operator's Helm chart, without a cluster. It is the supported entry point for other
+// components, CLI tools, and tests; its API stays stable while the operator internals change.
+//
+// The output matches what the operator applies for the same CR and options, except for state
+// the operator reads from the cluster at reconcile time: resolved image digests, the egress
+// proxy, certificate and CA bundle hashes, and migration and hibernation state.
+package render
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+	chartrender "github.com/opendatahub-io/mlflow-operator/internal/render"
+)
+
+// Config is the operator configuration rendering depends on. The operator reads it from
+// MLFLOW_IMAGE (or RELATED_IMAGE_ODH_MLFLOW_IMAGE), MLFLOW_URL, IMAGE_REGISTRY_MIRRORS, and
+// IMAGE_REGISTRY.
+type Config struct {
+	// MLflowImage is the image used when the CR sets neither spec.image.image nor spec.version.
+	MLflowImage string
+	// MLflowURL is the external URL of MLflow. It is added to the allowed CORS origins.
+	MLflowURL string
+	// RegistryMirrors maps image reference prefixes to the mirror prefix that replaces them.
+	RegistryMirrors map[string]string
+	// ImageRegistry replaces the registry of images no RegistryMirrors entry matches.
+	ImageRegistry string
+}
+
+// Options describes where and how an instance is rendered.
+type Options struct {
+	// ChartPath is the directory of the mlflow chart: charts/mlflow in the source tree, or
+	// /charts/mlflow in the operator image. Only Render needs it.
+	ChartPath string
+	// Namespace is the namespace the instance is deployed to.
+	Namespace string
+	// Config is the operator configuration. Nil renders with an empty configuration, so the CR
+	// has to set the image.
+	Config *Config
+	// OpenShift renders the OpenShift-specific resources, such as service-ca TLS for metrics.
+	OpenShift bool
+	// ServiceMonitorAvailable, PodMonitorAvailable, and PrometheusRuleAvailable report the
+	// Prometheus Operator CRDs of the target cluster. Monitoring resources whose CRD is not
+	// available are not rendered.
+	ServiceMonitorAvailable bool
+	PodMonitorAvailable     bool
+	PrometheusRuleAvailable bool
+	// Namespaced renders Roles and RoleBindings in Namespace instead of cluster RBAC, as the
+	// operator does with WATCH_NAMESPACE.
+	Namespaced bool
+	// PlatformTrustedCABundle reports that the platform trusted CA bundle ConfigMap exists in
+	// Namespace, so it is mounted into the MLflow pods.
+	PlatformTrustedCABundle bool
+	// BaseValues are Helm values the values derived from the spec are merged over, like
+	// spec.valuesFrom.
+	BaseValues map[string]interface{}
+}
+
+// BuildValues returns the Helm values the chart is rendered with for mlflow.
+func BuildValues(mlflow *mlflowv1.MLflow, opts Options) (map[string]interface{}, error) {
+	if opts.Namespace == "" {
+		return nil, fmt.Errorf("a namespace is required")
+	}
+	renderOpts, cfg := opts.rendererOptions()
+	return chartrender.NewHelmRenderer(opts.ChartPath).BuildValues(mlflow, opts.Namespace, renderOpts, cfg)
+}
+
+// Render returns the manifests the operator applies for mlflow, in chart order.
+func Render(mlflow *mlflowv1.MLflow, opts Options) ([]*unstructured.Unstructured, error) {
+	if opts.Namespace == "" {
+		return nil, fmt.Errorf("a namespace is required")
+	}
+	if opts.ChartPath == "" {
+		return nil, fmt.Errorf("a chart path is required")
+	}
+	renderOpts, cfg := opts.rendererOptions()
+	return chartrender.NewHelmRenderer(opts.ChartPath).RenderChart(mlflow, opts.Namespace, renderOpts, cfg)
+}
+
+func (opts Options) rendererOptions() (chartrender.RenderOptions, *config.OperatorConfig) {
+	renderOpts := chartrender.RenderOptions{
+		PlatformTrustedCABundleExists: opts.PlatformTrustedCABundle,
+		IsOpenShift:                   opts.OpenShift,
+		ServiceMonitorAvailable:       opts.ServiceMonitorAvailable,
+		PodMonitorAvailable:           opts.PodMonitorAvailable,
+		PrometheusRuleAvailable:       opts.PrometheusRuleAvailable,
+		Namespaced:                    opts.Namespaced,
+		BaseValues:                    opts.BaseValues,
+	}
+	// A nil config would make the operator code read its own environment.
+	cfg := &config.OperatorConfig{}
+	if opts.Config != nil {
+		cfg.MLflowImage = opts.Config.MLflowImage
+		cfg.MLflowURL = opts.Config.MLflowURL
+		cfg.RegistryMirrors = opts.Config.RegistryMirrors
+		cfg.ImageRegistry = opts.Config.ImageRegistry
+	}
+	return renderOpts, cfg
+}
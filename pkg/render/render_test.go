@@ -0,0 +1,63 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func TestRender(t *testing.T) {
+	g := gomega.NewWithT(t)
+	mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "mlflow"}}
+	opts := Options{
+		ChartPath: "../../charts/mlflow",
+		Namespace: "opendatahub",
+		Config: &Config{
+			MLflowImage:     "quay.io/opendatahub/mlflow:latest",
+			RegistryMirrors: map[string]string{"quay.io/opendatahub": "mirror.example.com/odh"},
+		},
+	}
+
+	values, err := BuildValues(mlflow, opts)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(values).To(gomega.HaveKeyWithValue("namespace", "opendatahub"))
+
+	objs, err := Render(mlflow, opts)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	var deployment *appsv1.Deployment
+	for _, obj := range objs {
+		if obj.GetKind() == "Deployment" && obj.GetName() == "mlflow" {
+			deployment = &appsv1.Deployment{}
+			g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, deployment)).To(gomega.Succeed())
+		}
+	}
+	g.Expect(deployment).NotTo(gomega.BeNil())
+	g.Expect(deployment.Namespace).To(gomega.Equal("opendatahub"))
+	g.Expect(deployment.Spec.Template.Spec.Containers[0].Image).To(gomega.Equal("mirror.example.com/odh/mlflow:latest"))
+
+	_, err = Render(mlflow, Options{ChartPath: opts.ChartPath})
+	g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("namespace")))
+	_, err = Render(mlflow, Options{Namespace: opts.Namespace})
+	g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("chart path")))
+}
@@ -43,7 +43,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
-	controllerpkg "github.com/opendatahub-io/mlflow-operator/internal/controller"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
 )
 
 const (
@@ -197,7 +197,7 @@ var _ = Describe("Upgrade", Ordered, Label("upgrade"), func() {
 			err := k8sClient.Get(ctx, types.NamespacedName{Name: "mlflow"}, mlflow)
 			g.Expect(err).NotTo(HaveOccurred())
 			return mlflow.Status.Version
-		}, 2*time.Minute, time.Second).Should(Equal(controllerpkg.SupportedMLflowVersion))
+		}, 2*time.Minute, time.Second).Should(Equal(config.SupportedMLflowVersion))
 
 		By("waiting for the final MLflow deployment to become available again")
 		waitForDeploymentAvailable(ctx, k8sClient, "mlflow")